@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	pb "agent-platform/gen/go"
 	"agent-platform/internal/response"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
@@ -26,6 +28,7 @@ func setupGateway(grpcAddress string, httpPort string, logger *zap.Logger) error
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, NewCustomMarshaler()),
 		runtime.WithErrorHandler(customErrorHandler),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
 	)
 
 	// 设置 gRPC 连接选项
@@ -54,6 +57,14 @@ func setupGateway(grpcAddress string, httpPort string, logger *zap.Logger) error
 		return fmt.Errorf("failed to register UserService: %w", err)
 	}
 
+	if err := pb.RegisterMemoryServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts); err != nil {
+		return fmt.Errorf("failed to register MemoryService: %w", err)
+	}
+
+	if err := pb.RegisterWorkflowServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts); err != nil {
+		return fmt.Errorf("failed to register WorkflowService: %w", err)
+	}
+
 	// 添加 CORS 支持
 	handler := cors(mux)
 
@@ -75,7 +86,7 @@ func cors(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, Idempotency-Key, If-Match")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -86,14 +97,44 @@ func cors(h http.Handler) http.Handler {
 	})
 }
 
+// forwardedHeaders 需要原样转发给 gRPC 服务的请求头
+var forwardedHeaders = map[string]bool{
+	"idempotency-key": true,
+	"if-match":        true,
+}
+
+// headerMatcher 将 forwardedHeaders 作为同名 metadata 转发，其余请求头使用默认规则
+func headerMatcher(key string) (string, bool) {
+	if lower := strings.ToLower(key); forwardedHeaders[lower] {
+		return lower, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 // customErrorHandler 自定义错误处理器，包装错误响应为 {code, message, data} 格式
 func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 	// 从 gRPC 错误中提取状态码和消息
 	st := status.Convert(err)
 	httpStatus := runtime.HTTPStatusFromCode(st.Code())
 
-	// 包装为统一错误响应格式
+	// 包装为统一错误响应格式，参数校验错误在 data.violations 中列出每个字段的问题
 	errResp := response.Error(int(st.Code()), st.Message())
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		violations := make([]map[string]string, len(badRequest.FieldViolations))
+		for i, v := range badRequest.FieldViolations {
+			violations[i] = map[string]string{
+				"field":       v.Field,
+				"description": v.Description,
+			}
+		}
+		errResp = response.ErrorWithData(int(st.Code()), st.Message(), map[string]interface{}{
+			"violations": violations,
+		})
+	}
 
 	// 设置响应头和状态码
 	w.Header().Set("Content-Type", "application/json")
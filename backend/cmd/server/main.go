@@ -7,6 +7,7 @@ import (
 	"agent-platform/internal/db"
 	grpcserver "agent-platform/internal/grpc"
 	"agent-platform/internal/knowledge"
+	"agent-platform/internal/memory"
 	"context"
 	"fmt"
 	"log"
@@ -93,6 +94,16 @@ func main() {
 		logger.Fatal("Failed to initialize KB manager", zap.Error(err))
 	}
 
+	// Initialize long-term memory store, sharing the embedding provider with knowledge bases
+	var memoryEmbedder memory.Embedder
+	if embeddingAPIKey != "" && embeddingAPIKey != "your-openai-api-key" {
+		memoryEmbedder = knowledge.NewEmbeddingService(embeddingAPIKey, embeddingAPIBase, cfg.AI.EmbeddingModel, logger)
+	}
+	memoryStore, err := memory.NewStore(dbClient.Client, cfg.Postgres.DSN(), memoryEmbedder, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize memory store", zap.Error(err))
+	}
+
 	// Initialize JWT service
 	jwtService := auth.NewJWTService(cfg.JWT.Secret, cfg.JWT.ExpireHours)
 
@@ -115,11 +126,20 @@ func main() {
 
 	// Register services with database client, AI manager, and KB manager
 	kbServer := grpcserver.NewKnowledgeBaseServer(dbClient.Client, kbManager)
-	pb.RegisterAgentServiceServer(grpcServer, grpcserver.NewAgentServer(dbClient.Client))
-	pb.RegisterConversationServiceServer(grpcServer, grpcserver.NewConversationServer(dbClient.Client, aiManager, kbServer))
+	pb.RegisterAgentServiceServer(grpcServer, grpcserver.NewAgentServer(dbClient.Client, aiManager))
+	convServer := grpcserver.NewConversationServer(dbClient.Client, aiManager, kbServer, memoryStore, cfg.AI.TitleModel, logger)
+	pb.RegisterConversationServiceServer(grpcServer, convServer)
 	pb.RegisterToolServiceServer(grpcServer, grpcserver.NewToolServer(dbClient.Client))
 	pb.RegisterKnowledgeBaseServiceServer(grpcServer, kbServer)
 	pb.RegisterUserServiceServer(grpcServer, grpcserver.NewUserServer(dbClient.Client, jwtService))
+	pb.RegisterMemoryServiceServer(grpcServer, grpcserver.NewMemoryServer(memoryStore))
+	workflowServer := grpcserver.NewWorkflowServer(dbClient.Client, convServer, logger)
+	pb.RegisterWorkflowServiceServer(grpcServer, workflowServer)
+
+	// Resume workflow executions interrupted by the last shutdown
+	if err := workflowServer.ResumeExecutions(context.Background()); err != nil {
+		logger.Error("Failed to resume workflow executions", zap.Error(err))
+	}
 
 	// Register reflection service (for grpcurl and other tools)
 	reflection.Register(grpcServer)
@@ -27,25 +27,32 @@ const (
 
 // Agent 实体
 type Agent struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                           // single, workflow, multi-agent
-	ModelConfig    *structpb.Struct       `protobuf:"bytes,5,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`          // 模型配置
-	Tools          []string               `protobuf:"bytes,6,rep,name=tools,proto3" json:"tools,omitempty"`                                         // 工具ID列表
-	KnowledgeBases []string               `protobuf:"bytes,7,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"` // 知识库ID列表
-	PromptTemplate string                 `protobuf:"bytes,8,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"` // 提示词模板
-	Parameters     *structpb.Struct       `protobuf:"bytes,9,opt,name=parameters,proto3" json:"parameters,omitempty"`                               // 自定义参数
-	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                      // draft, published, archived
-	Version        string                 `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`                                    // 版本号
-	CreatedBy      string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`               // 创建者ID
-	Tags           []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`                                          // 标签
-	Folder         string                 `protobuf:"bytes,14,opt,name=folder,proto3" json:"folder,omitempty"`                                      // 文件夹路径
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // 创建时间
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // 更新时间
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                                          // single, workflow, multi-agent
+	ModelConfig        *structpb.Struct       `protobuf:"bytes,5,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`                         // 模型配置
+	Tools              []string               `protobuf:"bytes,6,rep,name=tools,proto3" json:"tools,omitempty"`                                                        // 工具ID列表
+	KnowledgeBases     []string               `protobuf:"bytes,7,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"`                // 知识库ID列表
+	PromptTemplate     string                 `protobuf:"bytes,8,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`                // 提示词模板，Go text/template 语法，可使用 .agent .params .context .user .knowledge .now .date .time .weekday 变量
+	Parameters         *structpb.Struct       `protobuf:"bytes,9,opt,name=parameters,proto3" json:"parameters,omitempty"`                                              // 自定义参数
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                                     // draft, published, archived；发布请使用 PublishAgent
+	Version            string                 `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`                                                   // 版本号
+	CreatedBy          string                 `protobuf:"bytes,12,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                              // 创建者ID
+	Tags               []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`                                                         // 标签
+	Folder             string                 `protobuf:"bytes,14,opt,name=folder,proto3" json:"folder,omitempty"`                                                     // 文件夹路径
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                              // 创建时间
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                              // 更新时间
+	PublishedVersionId string                 `protobuf:"bytes,17,opt,name=published_version_id,json=publishedVersionId,proto3" json:"published_version_id,omitempty"` // 新对话使用的已发布版本，未发布时为空
+	IsPublic           bool                   `protobuf:"varint,18,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`                                // 公开并发布后出现在 Agent 市场中，所有用户都可以对话
+	TemplateId         string                 `protobuf:"bytes,19,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`                           // 克隆来源的模板或 Agent
+	TemplateVersion    string                 `protobuf:"bytes,20,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`            // 克隆时来源模板的版本
+	AllowClone         bool                   `protobuf:"varint,21,opt,name=allow_clone,json=allowClone,proto3" json:"allow_clone,omitempty"`                          // 公开时允许所有用户克隆，克隆会复制提示词等配置
+	Permission         string                 `protobuf:"bytes,22,opt,name=permission,proto3" json:"permission,omitempty"`                                             // 当前用户的权限：owner, editor, viewer, chat（仅可对话，提示词等配置不可见）
+	Members            []string               `protobuf:"bytes,23,rep,name=members,proto3" json:"members,omitempty"`                                                   // multi-agent 类型的成员 Agent ID 列表，由主管 Agent 分派对话
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Agent) Reset() {
@@ -190,6 +197,220 @@ func (x *Agent) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Agent) GetPublishedVersionId() string {
+	if x != nil {
+		return x.PublishedVersionId
+	}
+	return ""
+}
+
+func (x *Agent) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Agent) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Agent) GetTemplateVersion() string {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return ""
+}
+
+func (x *Agent) GetAllowClone() bool {
+	if x != nil {
+		return x.AllowClone
+	}
+	return false
+}
+
+func (x *Agent) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *Agent) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// Agent 版本，发布时的配置快照，不可修改
+type AgentVersion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentId        string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Version        string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"` // 语义化版本号，如 1.2.0
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Type           string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	ModelConfig    *structpb.Struct       `protobuf:"bytes,7,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`
+	Tools          []string               `protobuf:"bytes,8,rep,name=tools,proto3" json:"tools,omitempty"`
+	KnowledgeBases []string               `protobuf:"bytes,9,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"`
+	PromptTemplate string                 `protobuf:"bytes,10,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	Parameters     *structpb.Struct       `protobuf:"bytes,11,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	Notes          string                 `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"` // 发布说明
+	CreatedBy      string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Current        bool                   `protobuf:"varint,15,opt,name=current,proto3" json:"current,omitempty"` // 是否为新对话使用的版本
+	Members        []string               `protobuf:"bytes,16,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AgentVersion) Reset() {
+	*x = AgentVersion{}
+	mi := &file_agent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentVersion) ProtoMessage() {}
+
+func (x *AgentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentVersion.ProtoReflect.Descriptor instead.
+func (*AgentVersion) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AgentVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetModelConfig() *structpb.Struct {
+	if x != nil {
+		return x.ModelConfig
+	}
+	return nil
+}
+
+func (x *AgentVersion) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *AgentVersion) GetKnowledgeBases() []string {
+	if x != nil {
+		return x.KnowledgeBases
+	}
+	return nil
+}
+
+func (x *AgentVersion) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetParameters() *structpb.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *AgentVersion) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AgentVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AgentVersion) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *AgentVersion) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
 // 创建 Agent 请求
 type CreateAgentRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -203,13 +424,16 @@ type CreateAgentRequest struct {
 	Parameters     *structpb.Struct       `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
 	Tags           []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
 	Folder         string                 `protobuf:"bytes,10,opt,name=folder,proto3" json:"folder,omitempty"`
+	IsPublic       bool                   `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	AllowClone     bool                   `protobuf:"varint,12,opt,name=allow_clone,json=allowClone,proto3" json:"allow_clone,omitempty"`
+	Members        []string               `protobuf:"bytes,13,rep,name=members,proto3" json:"members,omitempty"` // multi-agent 类型必填
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateAgentRequest) Reset() {
 	*x = CreateAgentRequest{}
-	mi := &file_agent_proto_msgTypes[1]
+	mi := &file_agent_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -221,7 +445,7 @@ func (x *CreateAgentRequest) String() string {
 func (*CreateAgentRequest) ProtoMessage() {}
 
 func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[1]
+	mi := &file_agent_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +458,7 @@ func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
 func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{1}
+	return file_agent_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateAgentRequest) GetName() string {
@@ -307,20 +531,48 @@ func (x *CreateAgentRequest) GetFolder() string {
 	return ""
 }
 
+func (x *CreateAgentRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *CreateAgentRequest) GetAllowClone() bool {
+	if x != nil {
+		return x.AllowClone
+	}
+	return false
+}
+
+func (x *CreateAgentRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
 // 列表 Agent 请求
 type ListAgentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // 筛选状态
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`     // 筛选类型
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                        // 筛选状态
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                            // 筛选类型
+	Folder        string                 `protobuf:"bytes,5,opt,name=folder,proto3" json:"folder,omitempty"`                        // 筛选文件夹，包含子文件夹
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                            // 筛选标签
+	TagMatch      string                 `protobuf:"bytes,7,opt,name=tag_match,json=tagMatch,proto3" json:"tag_match,omitempty"`    // any（默认，包含任一标签）, all（包含全部标签）
+	CreatedBy     string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // 筛选创建者
+	Query         string                 `protobuf:"bytes,9,opt,name=query,proto3" json:"query,omitempty"`                          // 搜索名称和描述，不区分大小写
+	SortBy        string                 `protobuf:"bytes,10,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`         // created_at（默认）, updated_at, name
+	Ascending     bool                   `protobuf:"varint,11,opt,name=ascending,proto3" json:"ascending,omitempty"`                // 升序排列，默认降序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAgentsRequest) Reset() {
 	*x = ListAgentsRequest{}
-	mi := &file_agent_proto_msgTypes[2]
+	mi := &file_agent_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -332,7 +584,7 @@ func (x *ListAgentsRequest) String() string {
 func (*ListAgentsRequest) ProtoMessage() {}
 
 func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[2]
+	mi := &file_agent_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -345,7 +597,7 @@ func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{2}
+	return file_agent_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListAgentsRequest) GetPage() int32 {
@@ -376,6 +628,55 @@ func (x *ListAgentsRequest) GetType() string {
 	return ""
 }
 
+func (x *ListAgentsRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListAgentsRequest) GetTagMatch() string {
+	if x != nil {
+		return x.TagMatch
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListAgentsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
 // 列表 Agent 响应
 type ListAgentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -389,7 +690,7 @@ type ListAgentsResponse struct {
 
 func (x *ListAgentsResponse) Reset() {
 	*x = ListAgentsResponse{}
-	mi := &file_agent_proto_msgTypes[3]
+	mi := &file_agent_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +702,7 @@ func (x *ListAgentsResponse) String() string {
 func (*ListAgentsResponse) ProtoMessage() {}
 
 func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[3]
+	mi := &file_agent_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +715,7 @@ func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{3}
+	return file_agent_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListAgentsResponse) GetItems() []*Agent {
@@ -455,7 +756,7 @@ type GetAgentRequest struct {
 
 func (x *GetAgentRequest) Reset() {
 	*x = GetAgentRequest{}
-	mi := &file_agent_proto_msgTypes[4]
+	mi := &file_agent_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +768,7 @@ func (x *GetAgentRequest) String() string {
 func (*GetAgentRequest) ProtoMessage() {}
 
 func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[4]
+	mi := &file_agent_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +781,7 @@ func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{4}
+	return file_agent_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetAgentRequest) GetId() string {
@@ -501,14 +802,19 @@ type UpdateAgentRequest struct {
 	KnowledgeBases []string               `protobuf:"bytes,6,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"`
 	PromptTemplate string                 `protobuf:"bytes,7,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
 	Parameters     *structpb.Struct       `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                             // 状态变更：draft → archived，archived → draft，published → archived
+	IsPublic       *bool                  `protobuf:"varint,10,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"` // 不设置时保持不变
+	Tags           []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Folder         *string                `protobuf:"bytes,12,opt,name=folder,proto3,oneof" json:"folder,omitempty"`                            // 不设置时保持不变，空字符串移出文件夹
+	AllowClone     *bool                  `protobuf:"varint,13,opt,name=allow_clone,json=allowClone,proto3,oneof" json:"allow_clone,omitempty"` // 不设置时保持不变
+	Members        []string               `protobuf:"bytes,14,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateAgentRequest) Reset() {
 	*x = UpdateAgentRequest{}
-	mi := &file_agent_proto_msgTypes[5]
+	mi := &file_agent_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +826,7 @@ func (x *UpdateAgentRequest) String() string {
 func (*UpdateAgentRequest) ProtoMessage() {}
 
 func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[5]
+	mi := &file_agent_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +839,7 @@ func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{5}
+	return file_agent_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateAgentRequest) GetId() string {
@@ -599,29 +905,1833 @@ func (x *UpdateAgentRequest) GetStatus() string {
 	return ""
 }
 
-// 删除 Agent 请求
-type DeleteAgentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateAgentRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
 }
 
-func (x *DeleteAgentRequest) Reset() {
-	*x = DeleteAgentRequest{}
-	mi := &file_agent_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateAgentRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *DeleteAgentRequest) String() string {
+func (x *UpdateAgentRequest) GetFolder() string {
+	if x != nil && x.Folder != nil {
+		return *x.Folder
+	}
+	return ""
+}
+
+func (x *UpdateAgentRequest) GetAllowClone() bool {
+	if x != nil && x.AllowClone != nil {
+		return *x.AllowClone
+	}
+	return false
+}
+
+func (x *UpdateAgentRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// 删除 Agent 请求
+type DeleteAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAgentRequest) Reset() {
+	*x = DeleteAgentRequest{}
+	mi := &file_agent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentRequest) ProtoMessage() {}
+
+func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAgentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 删除 Agent 响应
+type DeleteAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAgentResponse) Reset() {
+	*x = DeleteAgentResponse{}
+	mi := &file_agent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentResponse) ProtoMessage() {}
+
+func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAgentResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 发布 Agent 请求
+type PublishAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // 可选，默认在最新版本上递增次版本号
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishAgentRequest) Reset() {
+	*x = PublishAgentRequest{}
+	mi := &file_agent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishAgentRequest) ProtoMessage() {}
+
+func (x *PublishAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishAgentRequest.ProtoReflect.Descriptor instead.
+func (*PublishAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PublishAgentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishAgentRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PublishAgentRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// 列表 Agent 版本请求
+type ListAgentVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentVersionsRequest) Reset() {
+	*x = ListAgentVersionsRequest{}
+	mi := &file_agent_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentVersionsRequest) ProtoMessage() {}
+
+func (x *ListAgentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAgentVersionsRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *ListAgentVersionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAgentVersionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 列表 Agent 版本响应
+type ListAgentVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AgentVersion        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentVersionsResponse) Reset() {
+	*x = ListAgentVersionsResponse{}
+	mi := &file_agent_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentVersionsResponse) ProtoMessage() {}
+
+func (x *ListAgentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAgentVersionsResponse) GetItems() []*AgentVersion {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAgentVersionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAgentVersionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAgentVersionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 回滚 Agent 请求
+type RollbackAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // 回滚到的版本号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackAgentRequest) Reset() {
+	*x = RollbackAgentRequest{}
+	mi := &file_agent_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackAgentRequest) ProtoMessage() {}
+
+func (x *RollbackAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackAgentRequest.ProtoReflect.Descriptor instead.
+func (*RollbackAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RollbackAgentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollbackAgentRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// 比较 Agent 版本请求
+type DiffAgentVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	FromVersion   string                 `protobuf:"bytes,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"` // 为空时使用当前发布的版本
+	ToVersion     string                 `protobuf:"bytes,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`       // 为空时使用当前未发布的配置
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffAgentVersionsRequest) Reset() {
+	*x = DiffAgentVersionsRequest{}
+	mi := &file_agent_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffAgentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffAgentVersionsRequest) ProtoMessage() {}
+
+func (x *DiffAgentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffAgentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffAgentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DiffAgentVersionsRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *DiffAgentVersionsRequest) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *DiffAgentVersionsRequest) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+// Agent 配置字段变更
+type AgentFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          *structpb.Value        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *structpb.Value        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentFieldChange) Reset() {
+	*x = AgentFieldChange{}
+	mi := &file_agent_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentFieldChange) ProtoMessage() {}
+
+func (x *AgentFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentFieldChange.ProtoReflect.Descriptor instead.
+func (*AgentFieldChange) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AgentFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AgentFieldChange) GetFrom() *structpb.Value {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AgentFieldChange) GetTo() *structpb.Value {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// 比较 Agent 版本响应
+type DiffAgentVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromVersion   string                 `protobuf:"bytes,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     string                 `protobuf:"bytes,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"` // 比较当前配置时为空
+	Changes       []*AgentFieldChange    `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffAgentVersionsResponse) Reset() {
+	*x = DiffAgentVersionsResponse{}
+	mi := &file_agent_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffAgentVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffAgentVersionsResponse) ProtoMessage() {}
+
+func (x *DiffAgentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffAgentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffAgentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiffAgentVersionsResponse) GetFromVersion() string {
+	if x != nil {
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *DiffAgentVersionsResponse) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+func (x *DiffAgentVersionsResponse) GetChanges() []*AgentFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// 导出 Agent 清单请求
+type ExportAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAgentRequest) Reset() {
+	*x = ExportAgentRequest{}
+	mi := &file_agent_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAgentRequest) ProtoMessage() {}
+
+func (x *ExportAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAgentRequest.ProtoReflect.Descriptor instead.
+func (*ExportAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportAgentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 导出 Agent 清单响应
+type ExportAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      string                 `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"` // YAML 格式的 Agent 清单，工具和知识库按名称引用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAgentResponse) Reset() {
+	*x = ExportAgentResponse{}
+	mi := &file_agent_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAgentResponse) ProtoMessage() {}
+
+func (x *ExportAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAgentResponse.ProtoReflect.Descriptor instead.
+func (*ExportAgentResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportAgentResponse) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+// 应用 Agent 清单请求
+type ApplyAgentManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      string                 `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`            // YAML 格式的 Agent 清单，按名称匹配已有 Agent
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // 只返回变更计划，不写入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyAgentManifestRequest) Reset() {
+	*x = ApplyAgentManifestRequest{}
+	mi := &file_agent_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyAgentManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAgentManifestRequest) ProtoMessage() {}
+
+func (x *ApplyAgentManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAgentManifestRequest.ProtoReflect.Descriptor instead.
+func (*ApplyAgentManifestRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApplyAgentManifestRequest) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ApplyAgentManifestRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// 应用 Agent 清单响应
+type ApplyAgentManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`   // create, update, unchanged
+	Agent         *Agent                 `protobuf:"bytes,2,opt,name=agent,proto3" json:"agent,omitempty"`     // 应用后的 Agent，dry_run 时为预期结果
+	Changes       []*AgentFieldChange    `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"` // 相对已有 Agent 的字段变更
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyAgentManifestResponse) Reset() {
+	*x = ApplyAgentManifestResponse{}
+	mi := &file_agent_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyAgentManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAgentManifestResponse) ProtoMessage() {}
+
+func (x *ApplyAgentManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAgentManifestResponse.ProtoReflect.Descriptor instead.
+func (*ApplyAgentManifestResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApplyAgentManifestResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApplyAgentManifestResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *ApplyAgentManifestResponse) GetChanges() []*AgentFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyAgentManifestResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Agent 模板
+type AgentTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // 内置模板以 builtin- 开头，其余为允许克隆的公开 Agent 的 ID
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Tags           []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Builtin        bool                   `protobuf:"varint,6,opt,name=builtin,proto3" json:"builtin,omitempty"` // 是否为内置模板
+	Version        string                 `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`  // 模板版本，模板更新后变化
+	CreatedBy      string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ModelConfig    *structpb.Struct       `protobuf:"bytes,9,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`
+	Tools          []string               `protobuf:"bytes,10,rep,name=tools,proto3" json:"tools,omitempty"`                                         // 内置模板为工具名称，其余为工具ID
+	KnowledgeBases []string               `protobuf:"bytes,11,rep,name=knowledge_bases,json=knowledgeBases,proto3" json:"knowledge_bases,omitempty"` // 内置模板为知识库名称，其余为知识库ID
+	PromptTemplate string                 `protobuf:"bytes,12,opt,name=prompt_template,json=promptTemplate,proto3" json:"prompt_template,omitempty"`
+	Parameters     *structpb.Struct       `protobuf:"bytes,13,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AgentTemplate) Reset() {
+	*x = AgentTemplate{}
+	mi := &file_agent_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentTemplate) ProtoMessage() {}
+
+func (x *AgentTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentTemplate.ProtoReflect.Descriptor instead.
+func (*AgentTemplate) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AgentTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AgentTemplate) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *AgentTemplate) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetModelConfig() *structpb.Struct {
+	if x != nil {
+		return x.ModelConfig
+	}
+	return nil
+}
+
+func (x *AgentTemplate) GetTools() []string {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *AgentTemplate) GetKnowledgeBases() []string {
+	if x != nil {
+		return x.KnowledgeBases
+	}
+	return nil
+}
+
+func (x *AgentTemplate) GetPromptTemplate() string {
+	if x != nil {
+		return x.PromptTemplate
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetParameters() *structpb.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+// 列表 Agent 模板请求
+type ListAgentTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentTemplatesRequest) Reset() {
+	*x = ListAgentTemplatesRequest{}
+	mi := &file_agent_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentTemplatesRequest) ProtoMessage() {}
+
+func (x *ListAgentTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAgentTemplatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAgentTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 列表 Agent 模板响应
+type ListAgentTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AgentTemplate       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentTemplatesResponse) Reset() {
+	*x = ListAgentTemplatesResponse{}
+	mi := &file_agent_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentTemplatesResponse) ProtoMessage() {}
+
+func (x *ListAgentTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAgentTemplatesResponse) GetItems() []*AgentTemplate {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAgentTemplatesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAgentTemplatesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAgentTemplatesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 克隆 Agent 请求
+type CloneAgentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceId        string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`                       // 模板ID或 Agent ID
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                               // 为空时使用来源名称加 (copy)
+	IncludeBindings bool                   `protobuf:"varint,3,opt,name=include_bindings,json=includeBindings,proto3" json:"include_bindings,omitempty"` // 是否复制工具和知识库绑定
+	Folder          string                 `protobuf:"bytes,4,opt,name=folder,proto3" json:"folder,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CloneAgentRequest) Reset() {
+	*x = CloneAgentRequest{}
+	mi := &file_agent_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneAgentRequest) ProtoMessage() {}
+
+func (x *CloneAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneAgentRequest.ProtoReflect.Descriptor instead.
+func (*CloneAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CloneAgentRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *CloneAgentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneAgentRequest) GetIncludeBindings() bool {
+	if x != nil {
+		return x.IncludeBindings
+	}
+	return false
+}
+
+func (x *CloneAgentRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+// 比较 Agent 与来源模板请求
+type DiffAgentTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffAgentTemplateRequest) Reset() {
+	*x = DiffAgentTemplateRequest{}
+	mi := &file_agent_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffAgentTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffAgentTemplateRequest) ProtoMessage() {}
+
+func (x *DiffAgentTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffAgentTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DiffAgentTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DiffAgentTemplateRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+// 比较 Agent 与来源模板响应
+type DiffAgentTemplateResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId      string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ClonedVersion   string                 `protobuf:"bytes,2,opt,name=cloned_version,json=clonedVersion,proto3" json:"cloned_version,omitempty"`       // 克隆时的模板版本
+	TemplateVersion string                 `protobuf:"bytes,3,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"` // 模板的当前版本
+	Changes         []*AgentFieldChange    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`                                        // 从 Agent 当前配置到模板当前配置的变更
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiffAgentTemplateResponse) Reset() {
+	*x = DiffAgentTemplateResponse{}
+	mi := &file_agent_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffAgentTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffAgentTemplateResponse) ProtoMessage() {}
+
+func (x *DiffAgentTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffAgentTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DiffAgentTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DiffAgentTemplateResponse) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *DiffAgentTemplateResponse) GetClonedVersion() string {
+	if x != nil {
+		return x.ClonedVersion
+	}
+	return ""
+}
+
+func (x *DiffAgentTemplateResponse) GetTemplateVersion() string {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return ""
+}
+
+func (x *DiffAgentTemplateResponse) GetChanges() []*AgentFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Agent 文件夹
+type AgentFolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                // 以 / 分隔的路径，如 support/faq
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                // 路径的最后一段
+	AgentCount    int64                  `protobuf:"varint,3,opt,name=agent_count,json=agentCount,proto3" json:"agent_count,omitempty"` // 直接位于该文件夹的 Agent 数
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // 包含子文件夹的 Agent 数
+	Children      []*AgentFolder         `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 仅显式创建的文件夹有值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentFolder) Reset() {
+	*x = AgentFolder{}
+	mi := &file_agent_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentFolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentFolder) ProtoMessage() {}
+
+func (x *AgentFolder) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentFolder.ProtoReflect.Descriptor instead.
+func (*AgentFolder) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AgentFolder) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AgentFolder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentFolder) GetAgentCount() int64 {
+	if x != nil {
+		return x.AgentCount
+	}
+	return 0
+}
+
+func (x *AgentFolder) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *AgentFolder) GetChildren() []*AgentFolder {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *AgentFolder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 创建 Agent 文件夹请求
+type CreateAgentFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // 不存在的上级文件夹会一并创建
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAgentFolderRequest) Reset() {
+	*x = CreateAgentFolderRequest{}
+	mi := &file_agent_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAgentFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentFolderRequest) ProtoMessage() {}
+
+func (x *CreateAgentFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateAgentFolderRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateAgentFolderRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// 列表 Agent 文件夹请求
+type ListAgentFoldersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"` // 只返回该文件夹的子树，为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentFoldersRequest) Reset() {
+	*x = ListAgentFoldersRequest{}
+	mi := &file_agent_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentFoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentFoldersRequest) ProtoMessage() {}
+
+func (x *ListAgentFoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentFoldersRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAgentFoldersRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+// 列表 Agent 文件夹响应
+type ListAgentFoldersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       []*AgentFolder         `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`                                // 文件夹树
+	UnfiledCount  int64                  `protobuf:"varint,2,opt,name=unfiled_count,json=unfiledCount,proto3" json:"unfiled_count,omitempty"` // 调用者不在任何文件夹中的 Agent 数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentFoldersResponse) Reset() {
+	*x = ListAgentFoldersResponse{}
+	mi := &file_agent_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentFoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentFoldersResponse) ProtoMessage() {}
+
+func (x *ListAgentFoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentFoldersResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAgentFoldersResponse) GetFolders() []*AgentFolder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *ListAgentFoldersResponse) GetUnfiledCount() int64 {
+	if x != nil {
+		return x.UnfiledCount
+	}
+	return 0
+}
+
+// 移动 Agent 文件夹请求
+type MoveAgentFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NewPath       string                 `protobuf:"bytes,2,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"` // 子文件夹和其中的 Agent 一并移动
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveAgentFolderRequest) Reset() {
+	*x = MoveAgentFolderRequest{}
+	mi := &file_agent_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAgentFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAgentFolderRequest) ProtoMessage() {}
+
+func (x *MoveAgentFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAgentFolderRequest.ProtoReflect.Descriptor instead.
+func (*MoveAgentFolderRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MoveAgentFolderRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MoveAgentFolderRequest) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+// 删除 Agent 文件夹请求
+type DeleteAgentFolderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // 非空时删除子文件夹，并将其中的 Agent 移到上级文件夹
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAgentFolderRequest) Reset() {
+	*x = DeleteAgentFolderRequest{}
+	mi := &file_agent_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentFolderRequest) ProtoMessage() {}
+
+func (x *DeleteAgentFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAgentFolderRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteAgentFolderRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeleteAgentFolderRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// 移动 Agent 请求
+type MoveAgentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentIds      []string               `protobuf:"bytes,1,rep,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"` // 不存在的 ID 会被忽略
+	Folder        string                 `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`                     // 目标文件夹，为空时移出文件夹
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveAgentsRequest) Reset() {
+	*x = MoveAgentsRequest{}
+	mi := &file_agent_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAgentsRequest) ProtoMessage() {}
+
+func (x *MoveAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAgentsRequest.ProtoReflect.Descriptor instead.
+func (*MoveAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MoveAgentsRequest) GetAgentIds() []string {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
+}
+
+func (x *MoveAgentsRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+// 移动 Agent 响应
+type MoveAgentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moved         int32                  `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveAgentsResponse) Reset() {
+	*x = MoveAgentsResponse{}
+	mi := &file_agent_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAgentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAgentsResponse) ProtoMessage() {}
+
+func (x *MoveAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAgentsResponse.ProtoReflect.Descriptor instead.
+func (*MoveAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MoveAgentsResponse) GetMoved() int32 {
+	if x != nil {
+		return x.Moved
+	}
+	return 0
+}
+
+// Agent 共享
+type AgentShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentId       string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	PrincipalType string                 `protobuf:"bytes,3,opt,name=principal_type,json=principalType,proto3" json:"principal_type,omitempty"` // user, role
+	PrincipalId   string                 `protobuf:"bytes,4,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`       // 用户ID或角色名（admin, developer, user）
+	Permission    string                 `protobuf:"bytes,5,opt,name=permission,proto3" json:"permission,omitempty"`                            // viewer, editor
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentShare) Reset() {
+	*x = AgentShare{}
+	mi := &file_agent_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentShare) ProtoMessage() {}
+
+func (x *AgentShare) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentShare.ProtoReflect.Descriptor instead.
+func (*AgentShare) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AgentShare) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AgentShare) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *AgentShare) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *AgentShare) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *AgentShare) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AgentShare) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AgentShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// 共享 Agent 请求，已共享时更新权限
+type ShareAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	PrincipalType string                 `protobuf:"bytes,2,opt,name=principal_type,json=principalType,proto3" json:"principal_type,omitempty"`
+	PrincipalId   string                 `protobuf:"bytes,3,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareAgentRequest) Reset() {
+	*x = ShareAgentRequest{}
+	mi := &file_agent_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareAgentRequest) ProtoMessage() {}
+
+func (x *ShareAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareAgentRequest.ProtoReflect.Descriptor instead.
+func (*ShareAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShareAgentRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *ShareAgentRequest) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *ShareAgentRequest) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+func (x *ShareAgentRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// 列表 Agent 共享请求
+type ListAgentSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentSharesRequest) Reset() {
+	*x = ListAgentSharesRequest{}
+	mi := &file_agent_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentSharesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAgentRequest) ProtoMessage() {}
+func (*ListAgentSharesRequest) ProtoMessage() {}
 
-func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[6]
+func (x *ListAgentSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,41 +2742,41 @@ func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListAgentSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentSharesRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteAgentRequest) GetId() string {
+func (x *ListAgentSharesRequest) GetAgentId() string {
 	if x != nil {
-		return x.Id
+		return x.AgentId
 	}
 	return ""
 }
 
-// 删除 Agent 响应
-type DeleteAgentResponse struct {
+// 列表 Agent 共享响应
+type ListAgentSharesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items         []*AgentShare          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAgentResponse) Reset() {
-	*x = DeleteAgentResponse{}
-	mi := &file_agent_proto_msgTypes[7]
+func (x *ListAgentSharesResponse) Reset() {
+	*x = ListAgentSharesResponse{}
+	mi := &file_agent_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAgentResponse) String() string {
+func (x *ListAgentSharesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAgentResponse) ProtoMessage() {}
+func (*ListAgentSharesResponse) ProtoMessage() {}
 
-func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[7]
+func (x *ListAgentSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,23 +2787,291 @@ func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListAgentSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentSharesResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteAgentResponse) GetId() string {
+func (x *ListAgentSharesResponse) GetItems() []*AgentShare {
 	if x != nil {
-		return x.Id
+		return x.Items
+	}
+	return nil
+}
+
+// 取消共享 Agent 请求
+type UnshareAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	PrincipalType string                 `protobuf:"bytes,2,opt,name=principal_type,json=principalType,proto3" json:"principal_type,omitempty"`
+	PrincipalId   string                 `protobuf:"bytes,3,opt,name=principal_id,json=principalId,proto3" json:"principal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareAgentRequest) Reset() {
+	*x = UnshareAgentRequest{}
+	mi := &file_agent_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareAgentRequest) ProtoMessage() {}
+
+func (x *UnshareAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareAgentRequest.ProtoReflect.Descriptor instead.
+func (*UnshareAgentRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnshareAgentRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *UnshareAgentRequest) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *UnshareAgentRequest) GetPrincipalId() string {
+	if x != nil {
+		return x.PrincipalId
+	}
+	return ""
+}
+
+// Agent 市场条目
+type MarketplaceAgent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Agent             *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`                                                   // 不包含提示词等配置
+	ConversationCount int64                  `protobuf:"varint,2,opt,name=conversation_count,json=conversationCount,proto3" json:"conversation_count,omitempty"` // 对话数
+	UserCount         int64                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`                         // 对话过的用户数
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarketplaceAgent) Reset() {
+	*x = MarketplaceAgent{}
+	mi := &file_agent_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketplaceAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketplaceAgent) ProtoMessage() {}
+
+func (x *MarketplaceAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketplaceAgent.ProtoReflect.Descriptor instead.
+func (*MarketplaceAgent) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MarketplaceAgent) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *MarketplaceAgent) GetConversationCount() int64 {
+	if x != nil {
+		return x.ConversationCount
+	}
+	return 0
+}
+
+func (x *MarketplaceAgent) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+// 列表 Agent 市场请求
+type ListMarketplaceAgentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                 // 搜索名称和描述
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                   // 包含任一标签
+	SortBy        string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // popular（默认，按对话数）, recent, name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketplaceAgentsRequest) Reset() {
+	*x = ListMarketplaceAgentsRequest{}
+	mi := &file_agent_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketplaceAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketplaceAgentsRequest) ProtoMessage() {}
+
+func (x *ListMarketplaceAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketplaceAgentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketplaceAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListMarketplaceAgentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMarketplaceAgentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMarketplaceAgentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListMarketplaceAgentsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListMarketplaceAgentsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
 	}
 	return ""
 }
 
+// 列表 Agent 市场响应
+type ListMarketplaceAgentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MarketplaceAgent    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMarketplaceAgentsResponse) Reset() {
+	*x = ListMarketplaceAgentsResponse{}
+	mi := &file_agent_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMarketplaceAgentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMarketplaceAgentsResponse) ProtoMessage() {}
+
+func (x *ListMarketplaceAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMarketplaceAgentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMarketplaceAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListMarketplaceAgentsResponse) GetItems() []*MarketplaceAgent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListMarketplaceAgentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMarketplaceAgentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMarketplaceAgentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_agent_proto protoreflect.FileDescriptor
 
 const file_agent_proto_rawDesc = "" +
 	"\n" +
-	"\vagent.proto\x12\x03api\x1a\fcommon.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\xb1\x04\n" +
+	"\vagent.proto\x12\x03api\x1a\fcommon.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\"\xa7\x06\n" +
 	"\x05Agent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -716,7 +3094,40 @@ const file_agent_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe7\x02\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x14published_version_id\x18\x11 \x01(\tR\x12publishedVersionId\x12\x1b\n" +
+	"\tis_public\x18\x12 \x01(\bR\bisPublic\x12\x1f\n" +
+	"\vtemplate_id\x18\x13 \x01(\tR\n" +
+	"templateId\x12)\n" +
+	"\x10template_version\x18\x14 \x01(\tR\x0ftemplateVersion\x12\x1f\n" +
+	"\vallow_clone\x18\x15 \x01(\bR\n" +
+	"allowClone\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x16 \x01(\tR\n" +
+	"permission\x12\x18\n" +
+	"\amembers\x18\x17 \x03(\tR\amembers\"\x9e\x04\n" +
+	"\fAgentVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12:\n" +
+	"\fmodel_config\x18\a \x01(\v2\x17.google.protobuf.StructR\vmodelConfig\x12\x14\n" +
+	"\x05tools\x18\b \x03(\tR\x05tools\x12'\n" +
+	"\x0fknowledge_bases\x18\t \x03(\tR\x0eknowledgeBases\x12'\n" +
+	"\x0fprompt_template\x18\n" +
+	" \x01(\tR\x0epromptTemplate\x127\n" +
+	"\n" +
+	"parameters\x18\v \x01(\v2\x17.google.protobuf.StructR\n" +
+	"parameters\x12\x14\n" +
+	"\x05notes\x18\f \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\acurrent\x18\x0f \x01(\bR\acurrent\x12\x18\n" +
+	"\amembers\x18\x10 \x03(\tR\amembers\"\xbf\x03\n" +
 	"\x12CreateAgentRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
@@ -730,12 +3141,25 @@ const file_agent_proto_rawDesc = "" +
 	"parameters\x12\x12\n" +
 	"\x04tags\x18\t \x03(\tR\x04tags\x12\x16\n" +
 	"\x06folder\x18\n" +
-	" \x01(\tR\x06folder\"p\n" +
+	" \x01(\tR\x06folder\x12\x1b\n" +
+	"\tis_public\x18\v \x01(\bR\bisPublic\x12\x1f\n" +
+	"\vallow_clone\x18\f \x01(\bR\n" +
+	"allowClone\x12\x18\n" +
+	"\amembers\x18\r \x03(\tR\amembers\"\xa5\x02\n" +
 	"\x11ListAgentsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\"}\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06folder\x18\x05 \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x1b\n" +
+	"\ttag_match\x18\a \x01(\tR\btagMatch\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x14\n" +
+	"\x05query\x18\t \x01(\tR\x05query\x12\x17\n" +
+	"\asort_by\x18\n" +
+	" \x01(\tR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\v \x01(\bR\tascending\"}\n" +
 	"\x12ListAgentsResponse\x12 \n" +
 	"\x05items\x18\x01 \x03(\v2\n" +
 	".api.AgentR\x05items\x12\x12\n" +
@@ -743,7 +3167,7 @@ const file_agent_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x03R\x05total\"!\n" +
 	"\x0fGetAgentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xcf\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x04\n" +
 	"\x12UpdateAgentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -755,11 +3179,177 @@ const file_agent_proto_rawDesc = "" +
 	"\n" +
 	"parameters\x18\b \x01(\v2\x17.google.protobuf.StructR\n" +
 	"parameters\x12\x16\n" +
-	"\x06status\x18\t \x01(\tR\x06status\"$\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12 \n" +
+	"\tis_public\x18\n" +
+	" \x01(\bH\x00R\bisPublic\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x1b\n" +
+	"\x06folder\x18\f \x01(\tH\x01R\x06folder\x88\x01\x01\x12$\n" +
+	"\vallow_clone\x18\r \x01(\bH\x02R\n" +
+	"allowClone\x88\x01\x01\x12\x18\n" +
+	"\amembers\x18\x0e \x03(\tR\amembersB\f\n" +
+	"\n" +
+	"_is_publicB\t\n" +
+	"\a_folderB\x0e\n" +
+	"\f_allow_clone\"$\n" +
 	"\x12DeleteAgentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"%\n" +
 	"\x13DeleteAgentResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\xb0\x03\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x13PublishAgentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"f\n" +
+	"\x18ListAgentVersionsRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8b\x01\n" +
+	"\x19ListAgentVersionsResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.api.AgentVersionR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"@\n" +
+	"\x14RollbackAgentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"w\n" +
+	"\x18DiffAgentVersionsRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\tR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\tR\ttoVersion\"|\n" +
+	"\x10AgentFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12*\n" +
+	"\x04from\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x04from\x12&\n" +
+	"\x02to\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x02to\"\x8e\x01\n" +
+	"\x19DiffAgentVersionsResponse\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\tR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\tR\ttoVersion\x12/\n" +
+	"\achanges\x18\x03 \x03(\v2\x15.api.AgentFieldChangeR\achanges\"$\n" +
+	"\x12ExportAgentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x13ExportAgentResponse\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\"P\n" +
+	"\x19ApplyAgentManifestRequest\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xa0\x01\n" +
+	"\x1aApplyAgentManifestResponse\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12 \n" +
+	"\x05agent\x18\x02 \x01(\v2\n" +
+	".api.AgentR\x05agent\x12/\n" +
+	"\achanges\x18\x03 \x03(\v2\x15.api.AgentFieldChangeR\achanges\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xad\x03\n" +
+	"\rAgentTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x18\n" +
+	"\abuiltin\x18\x06 \x01(\bR\abuiltin\x12\x18\n" +
+	"\aversion\x18\a \x01(\tR\aversion\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12:\n" +
+	"\fmodel_config\x18\t \x01(\v2\x17.google.protobuf.StructR\vmodelConfig\x12\x14\n" +
+	"\x05tools\x18\n" +
+	" \x03(\tR\x05tools\x12'\n" +
+	"\x0fknowledge_bases\x18\v \x03(\tR\x0eknowledgeBases\x12'\n" +
+	"\x0fprompt_template\x18\f \x01(\tR\x0epromptTemplate\x127\n" +
+	"\n" +
+	"parameters\x18\r \x01(\v2\x17.google.protobuf.StructR\n" +
+	"parameters\"L\n" +
+	"\x19ListAgentTemplatesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x8d\x01\n" +
+	"\x1aListAgentTemplatesResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.api.AgentTemplateR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"\x87\x01\n" +
+	"\x11CloneAgentRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10include_bindings\x18\x03 \x01(\bR\x0fincludeBindings\x12\x16\n" +
+	"\x06folder\x18\x04 \x01(\tR\x06folder\"5\n" +
+	"\x18DiffAgentTemplateRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\"\xbf\x01\n" +
+	"\x19DiffAgentTemplateResponse\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12%\n" +
+	"\x0ecloned_version\x18\x02 \x01(\tR\rclonedVersion\x12)\n" +
+	"\x10template_version\x18\x03 \x01(\tR\x0ftemplateVersion\x12/\n" +
+	"\achanges\x18\x04 \x03(\v2\x15.api.AgentFieldChangeR\achanges\"\xe0\x01\n" +
+	"\vAgentFolder\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vagent_count\x18\x03 \x01(\x03R\n" +
+	"agentCount\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x03R\n" +
+	"totalCount\x12,\n" +
+	"\bchildren\x18\x05 \x03(\v2\x10.api.AgentFolderR\bchildren\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\".\n" +
+	"\x18CreateAgentFolderRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"-\n" +
+	"\x17ListAgentFoldersRequest\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\"k\n" +
+	"\x18ListAgentFoldersResponse\x12*\n" +
+	"\afolders\x18\x01 \x03(\v2\x10.api.AgentFolderR\afolders\x12#\n" +
+	"\runfiled_count\x18\x02 \x01(\x03R\funfiledCount\"G\n" +
+	"\x16MoveAgentFolderRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x19\n" +
+	"\bnew_path\x18\x02 \x01(\tR\anewPath\"D\n" +
+	"\x18DeleteAgentFolderRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"H\n" +
+	"\x11MoveAgentsRequest\x12\x1b\n" +
+	"\tagent_ids\x18\x01 \x03(\tR\bagentIds\x12\x16\n" +
+	"\x06folder\x18\x02 \x01(\tR\x06folder\"*\n" +
+	"\x12MoveAgentsResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x05R\x05moved\"\xfb\x01\n" +
+	"\n" +
+	"AgentShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\x12%\n" +
+	"\x0eprincipal_type\x18\x03 \x01(\tR\rprincipalType\x12!\n" +
+	"\fprincipal_id\x18\x04 \x01(\tR\vprincipalId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x05 \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x98\x01\n" +
+	"\x11ShareAgentRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12%\n" +
+	"\x0eprincipal_type\x18\x02 \x01(\tR\rprincipalType\x12!\n" +
+	"\fprincipal_id\x18\x03 \x01(\tR\vprincipalId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\"3\n" +
+	"\x16ListAgentSharesRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\"@\n" +
+	"\x17ListAgentSharesResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.api.AgentShareR\x05items\"z\n" +
+	"\x13UnshareAgentRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12%\n" +
+	"\x0eprincipal_type\x18\x02 \x01(\tR\rprincipalType\x12!\n" +
+	"\fprincipal_id\x18\x03 \x01(\tR\vprincipalId\"\x82\x01\n" +
+	"\x10MarketplaceAgent\x12 \n" +
+	"\x05agent\x18\x01 \x01(\v2\n" +
+	".api.AgentR\x05agent\x12-\n" +
+	"\x12conversation_count\x18\x02 \x01(\x03R\x11conversationCount\x12\x1d\n" +
+	"\n" +
+	"user_count\x18\x03 \x01(\x03R\tuserCount\"\x92\x01\n" +
+	"\x1cListMarketplaceAgentsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\"\x93\x01\n" +
+	"\x1dListMarketplaceAgentsResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.api.MarketplaceAgentR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total2\xb2\x13\n" +
 	"\fAgentService\x12M\n" +
 	"\vCreateAgent\x12\x17.api.CreateAgentRequest\x1a\n" +
 	".api.Agent\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/agents\x12U\n" +
@@ -769,7 +3359,30 @@ const file_agent_proto_rawDesc = "" +
 	".api.Agent\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/agents/{id}\x12R\n" +
 	"\vUpdateAgent\x12\x17.api.UpdateAgentRequest\x1a\n" +
 	".api.Agent\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/agents/{id}\x12[\n" +
-	"\vDeleteAgent\x12\x17.api.DeleteAgentRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/agents/{id}B<Z:github.com/yourusername/agent-opus/backend/api/proto;protob\x06proto3"
+	"\vDeleteAgent\x12\x17.api.DeleteAgentRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/agents/{id}\x12c\n" +
+	"\fPublishAgent\x12\x18.api.PublishAgentRequest\x1a\x11.api.AgentVersion\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/agents/{id}/publish\x12~\n" +
+	"\x11ListAgentVersions\x12\x1d.api.ListAgentVersionsRequest\x1a\x1e.api.ListAgentVersionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/agents/{agent_id}/versions\x12_\n" +
+	"\rRollbackAgent\x12\x19.api.RollbackAgentRequest\x1a\n" +
+	".api.Agent\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/agents/{id}/rollback\x12\x83\x01\n" +
+	"\x11DiffAgentVersions\x12\x1d.api.DiffAgentVersionsRequest\x1a\x1e.api.DiffAgentVersionsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/agents/{agent_id}/versions:diff\x12f\n" +
+	"\vExportAgent\x12\x17.api.ExportAgentRequest\x1a\x18.api.ExportAgentResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/agents/{id}/manifest\x12v\n" +
+	"\x12ApplyAgentManifest\x12\x1e.api.ApplyAgentManifestRequest\x1a\x1f.api.ApplyAgentManifestResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/agents:apply\x12v\n" +
+	"\x12ListAgentTemplates\x12\x1e.api.ListAgentTemplatesRequest\x1a\x1f.api.ListAgentTemplatesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/agent-templates\x12]\n" +
+	"\n" +
+	"CloneAgent\x12\x16.api.CloneAgentRequest\x1a\n" +
+	".api.Agent\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/agents/{source_id}/clone\x12\x83\x01\n" +
+	"\x11DiffAgentTemplate\x12\x1d.api.DiffAgentTemplateRequest\x1a\x1e.api.DiffAgentTemplateResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/agents/{agent_id}/template:diff\x12f\n" +
+	"\x11CreateAgentFolder\x12\x1d.api.CreateAgentFolderRequest\x1a\x10.api.AgentFolder\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/agent-folders\x12n\n" +
+	"\x10ListAgentFolders\x12\x1c.api.ListAgentFoldersRequest\x1a\x1d.api.ListAgentFoldersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/agent-folders\x12g\n" +
+	"\x0fMoveAgentFolder\x12\x1b.api.MoveAgentFolderRequest\x1a\x10.api.AgentFolder\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/agent-folders:move\x12i\n" +
+	"\x11DeleteAgentFolder\x12\x1d.api.DeleteAgentFolderRequest\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/agent-folders\x12]\n" +
+	"\n" +
+	"MoveAgents\x12\x16.api.MoveAgentsRequest\x1a\x17.api.MoveAgentsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/agents:move\x12b\n" +
+	"\n" +
+	"ShareAgent\x12\x16.api.ShareAgentRequest\x1a\x0f.api.AgentShare\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/agents/{agent_id}/shares\x12v\n" +
+	"\x0fListAgentShares\x12\x1b.api.ListAgentSharesRequest\x1a\x1c.api.ListAgentSharesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/agents/{agent_id}/shares\x12\x8a\x01\n" +
+	"\fUnshareAgent\x12\x18.api.UnshareAgentRequest\x1a\x16.google.protobuf.Empty\"H\x82\xd3\xe4\x93\x02B*@/api/v1/agents/{agent_id}/shares/{principal_type}/{principal_id}\x12\x82\x01\n" +
+	"\x15ListMarketplaceAgents\x12!.api.ListMarketplaceAgentsRequest\x1a\".api.ListMarketplaceAgentsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/marketplace/agentsB<Z:github.com/yourusername/agent-opus/backend/api/proto;protob\x06proto3"
 
 var (
 	file_agent_proto_rawDescOnce sync.Once
@@ -783,45 +3396,136 @@ func file_agent_proto_rawDescGZIP() []byte {
 	return file_agent_proto_rawDescData
 }
 
-var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_agent_proto_goTypes = []any{
-	(*Agent)(nil),                 // 0: api.Agent
-	(*CreateAgentRequest)(nil),    // 1: api.CreateAgentRequest
-	(*ListAgentsRequest)(nil),     // 2: api.ListAgentsRequest
-	(*ListAgentsResponse)(nil),    // 3: api.ListAgentsResponse
-	(*GetAgentRequest)(nil),       // 4: api.GetAgentRequest
-	(*UpdateAgentRequest)(nil),    // 5: api.UpdateAgentRequest
-	(*DeleteAgentRequest)(nil),    // 6: api.DeleteAgentRequest
-	(*DeleteAgentResponse)(nil),   // 7: api.DeleteAgentResponse
-	(*structpb.Struct)(nil),       // 8: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+	(*Agent)(nil),                         // 0: api.Agent
+	(*AgentVersion)(nil),                  // 1: api.AgentVersion
+	(*CreateAgentRequest)(nil),            // 2: api.CreateAgentRequest
+	(*ListAgentsRequest)(nil),             // 3: api.ListAgentsRequest
+	(*ListAgentsResponse)(nil),            // 4: api.ListAgentsResponse
+	(*GetAgentRequest)(nil),               // 5: api.GetAgentRequest
+	(*UpdateAgentRequest)(nil),            // 6: api.UpdateAgentRequest
+	(*DeleteAgentRequest)(nil),            // 7: api.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),           // 8: api.DeleteAgentResponse
+	(*PublishAgentRequest)(nil),           // 9: api.PublishAgentRequest
+	(*ListAgentVersionsRequest)(nil),      // 10: api.ListAgentVersionsRequest
+	(*ListAgentVersionsResponse)(nil),     // 11: api.ListAgentVersionsResponse
+	(*RollbackAgentRequest)(nil),          // 12: api.RollbackAgentRequest
+	(*DiffAgentVersionsRequest)(nil),      // 13: api.DiffAgentVersionsRequest
+	(*AgentFieldChange)(nil),              // 14: api.AgentFieldChange
+	(*DiffAgentVersionsResponse)(nil),     // 15: api.DiffAgentVersionsResponse
+	(*ExportAgentRequest)(nil),            // 16: api.ExportAgentRequest
+	(*ExportAgentResponse)(nil),           // 17: api.ExportAgentResponse
+	(*ApplyAgentManifestRequest)(nil),     // 18: api.ApplyAgentManifestRequest
+	(*ApplyAgentManifestResponse)(nil),    // 19: api.ApplyAgentManifestResponse
+	(*AgentTemplate)(nil),                 // 20: api.AgentTemplate
+	(*ListAgentTemplatesRequest)(nil),     // 21: api.ListAgentTemplatesRequest
+	(*ListAgentTemplatesResponse)(nil),    // 22: api.ListAgentTemplatesResponse
+	(*CloneAgentRequest)(nil),             // 23: api.CloneAgentRequest
+	(*DiffAgentTemplateRequest)(nil),      // 24: api.DiffAgentTemplateRequest
+	(*DiffAgentTemplateResponse)(nil),     // 25: api.DiffAgentTemplateResponse
+	(*AgentFolder)(nil),                   // 26: api.AgentFolder
+	(*CreateAgentFolderRequest)(nil),      // 27: api.CreateAgentFolderRequest
+	(*ListAgentFoldersRequest)(nil),       // 28: api.ListAgentFoldersRequest
+	(*ListAgentFoldersResponse)(nil),      // 29: api.ListAgentFoldersResponse
+	(*MoveAgentFolderRequest)(nil),        // 30: api.MoveAgentFolderRequest
+	(*DeleteAgentFolderRequest)(nil),      // 31: api.DeleteAgentFolderRequest
+	(*MoveAgentsRequest)(nil),             // 32: api.MoveAgentsRequest
+	(*MoveAgentsResponse)(nil),            // 33: api.MoveAgentsResponse
+	(*AgentShare)(nil),                    // 34: api.AgentShare
+	(*ShareAgentRequest)(nil),             // 35: api.ShareAgentRequest
+	(*ListAgentSharesRequest)(nil),        // 36: api.ListAgentSharesRequest
+	(*ListAgentSharesResponse)(nil),       // 37: api.ListAgentSharesResponse
+	(*UnshareAgentRequest)(nil),           // 38: api.UnshareAgentRequest
+	(*MarketplaceAgent)(nil),              // 39: api.MarketplaceAgent
+	(*ListMarketplaceAgentsRequest)(nil),  // 40: api.ListMarketplaceAgentsRequest
+	(*ListMarketplaceAgentsResponse)(nil), // 41: api.ListMarketplaceAgentsResponse
+	(*structpb.Struct)(nil),               // 42: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),         // 43: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                // 44: google.protobuf.Value
+	(*emptypb.Empty)(nil),                 // 45: google.protobuf.Empty
 }
 var file_agent_proto_depIdxs = []int32{
-	8,  // 0: api.Agent.model_config:type_name -> google.protobuf.Struct
-	8,  // 1: api.Agent.parameters:type_name -> google.protobuf.Struct
-	9,  // 2: api.Agent.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 3: api.Agent.updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 4: api.CreateAgentRequest.model_config:type_name -> google.protobuf.Struct
-	8,  // 5: api.CreateAgentRequest.parameters:type_name -> google.protobuf.Struct
-	0,  // 6: api.ListAgentsResponse.items:type_name -> api.Agent
-	8,  // 7: api.UpdateAgentRequest.model_config:type_name -> google.protobuf.Struct
-	8,  // 8: api.UpdateAgentRequest.parameters:type_name -> google.protobuf.Struct
-	1,  // 9: api.AgentService.CreateAgent:input_type -> api.CreateAgentRequest
-	2,  // 10: api.AgentService.ListAgents:input_type -> api.ListAgentsRequest
-	4,  // 11: api.AgentService.GetAgent:input_type -> api.GetAgentRequest
-	5,  // 12: api.AgentService.UpdateAgent:input_type -> api.UpdateAgentRequest
-	6,  // 13: api.AgentService.DeleteAgent:input_type -> api.DeleteAgentRequest
-	0,  // 14: api.AgentService.CreateAgent:output_type -> api.Agent
-	3,  // 15: api.AgentService.ListAgents:output_type -> api.ListAgentsResponse
-	0,  // 16: api.AgentService.GetAgent:output_type -> api.Agent
-	0,  // 17: api.AgentService.UpdateAgent:output_type -> api.Agent
-	10, // 18: api.AgentService.DeleteAgent:output_type -> google.protobuf.Empty
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	42, // 0: api.Agent.model_config:type_name -> google.protobuf.Struct
+	42, // 1: api.Agent.parameters:type_name -> google.protobuf.Struct
+	43, // 2: api.Agent.created_at:type_name -> google.protobuf.Timestamp
+	43, // 3: api.Agent.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 4: api.AgentVersion.model_config:type_name -> google.protobuf.Struct
+	42, // 5: api.AgentVersion.parameters:type_name -> google.protobuf.Struct
+	43, // 6: api.AgentVersion.created_at:type_name -> google.protobuf.Timestamp
+	42, // 7: api.CreateAgentRequest.model_config:type_name -> google.protobuf.Struct
+	42, // 8: api.CreateAgentRequest.parameters:type_name -> google.protobuf.Struct
+	0,  // 9: api.ListAgentsResponse.items:type_name -> api.Agent
+	42, // 10: api.UpdateAgentRequest.model_config:type_name -> google.protobuf.Struct
+	42, // 11: api.UpdateAgentRequest.parameters:type_name -> google.protobuf.Struct
+	1,  // 12: api.ListAgentVersionsResponse.items:type_name -> api.AgentVersion
+	44, // 13: api.AgentFieldChange.from:type_name -> google.protobuf.Value
+	44, // 14: api.AgentFieldChange.to:type_name -> google.protobuf.Value
+	14, // 15: api.DiffAgentVersionsResponse.changes:type_name -> api.AgentFieldChange
+	0,  // 16: api.ApplyAgentManifestResponse.agent:type_name -> api.Agent
+	14, // 17: api.ApplyAgentManifestResponse.changes:type_name -> api.AgentFieldChange
+	42, // 18: api.AgentTemplate.model_config:type_name -> google.protobuf.Struct
+	42, // 19: api.AgentTemplate.parameters:type_name -> google.protobuf.Struct
+	20, // 20: api.ListAgentTemplatesResponse.items:type_name -> api.AgentTemplate
+	14, // 21: api.DiffAgentTemplateResponse.changes:type_name -> api.AgentFieldChange
+	26, // 22: api.AgentFolder.children:type_name -> api.AgentFolder
+	43, // 23: api.AgentFolder.created_at:type_name -> google.protobuf.Timestamp
+	26, // 24: api.ListAgentFoldersResponse.folders:type_name -> api.AgentFolder
+	43, // 25: api.AgentShare.created_at:type_name -> google.protobuf.Timestamp
+	34, // 26: api.ListAgentSharesResponse.items:type_name -> api.AgentShare
+	0,  // 27: api.MarketplaceAgent.agent:type_name -> api.Agent
+	39, // 28: api.ListMarketplaceAgentsResponse.items:type_name -> api.MarketplaceAgent
+	2,  // 29: api.AgentService.CreateAgent:input_type -> api.CreateAgentRequest
+	3,  // 30: api.AgentService.ListAgents:input_type -> api.ListAgentsRequest
+	5,  // 31: api.AgentService.GetAgent:input_type -> api.GetAgentRequest
+	6,  // 32: api.AgentService.UpdateAgent:input_type -> api.UpdateAgentRequest
+	7,  // 33: api.AgentService.DeleteAgent:input_type -> api.DeleteAgentRequest
+	9,  // 34: api.AgentService.PublishAgent:input_type -> api.PublishAgentRequest
+	10, // 35: api.AgentService.ListAgentVersions:input_type -> api.ListAgentVersionsRequest
+	12, // 36: api.AgentService.RollbackAgent:input_type -> api.RollbackAgentRequest
+	13, // 37: api.AgentService.DiffAgentVersions:input_type -> api.DiffAgentVersionsRequest
+	16, // 38: api.AgentService.ExportAgent:input_type -> api.ExportAgentRequest
+	18, // 39: api.AgentService.ApplyAgentManifest:input_type -> api.ApplyAgentManifestRequest
+	21, // 40: api.AgentService.ListAgentTemplates:input_type -> api.ListAgentTemplatesRequest
+	23, // 41: api.AgentService.CloneAgent:input_type -> api.CloneAgentRequest
+	24, // 42: api.AgentService.DiffAgentTemplate:input_type -> api.DiffAgentTemplateRequest
+	27, // 43: api.AgentService.CreateAgentFolder:input_type -> api.CreateAgentFolderRequest
+	28, // 44: api.AgentService.ListAgentFolders:input_type -> api.ListAgentFoldersRequest
+	30, // 45: api.AgentService.MoveAgentFolder:input_type -> api.MoveAgentFolderRequest
+	31, // 46: api.AgentService.DeleteAgentFolder:input_type -> api.DeleteAgentFolderRequest
+	32, // 47: api.AgentService.MoveAgents:input_type -> api.MoveAgentsRequest
+	35, // 48: api.AgentService.ShareAgent:input_type -> api.ShareAgentRequest
+	36, // 49: api.AgentService.ListAgentShares:input_type -> api.ListAgentSharesRequest
+	38, // 50: api.AgentService.UnshareAgent:input_type -> api.UnshareAgentRequest
+	40, // 51: api.AgentService.ListMarketplaceAgents:input_type -> api.ListMarketplaceAgentsRequest
+	0,  // 52: api.AgentService.CreateAgent:output_type -> api.Agent
+	4,  // 53: api.AgentService.ListAgents:output_type -> api.ListAgentsResponse
+	0,  // 54: api.AgentService.GetAgent:output_type -> api.Agent
+	0,  // 55: api.AgentService.UpdateAgent:output_type -> api.Agent
+	45, // 56: api.AgentService.DeleteAgent:output_type -> google.protobuf.Empty
+	1,  // 57: api.AgentService.PublishAgent:output_type -> api.AgentVersion
+	11, // 58: api.AgentService.ListAgentVersions:output_type -> api.ListAgentVersionsResponse
+	0,  // 59: api.AgentService.RollbackAgent:output_type -> api.Agent
+	15, // 60: api.AgentService.DiffAgentVersions:output_type -> api.DiffAgentVersionsResponse
+	17, // 61: api.AgentService.ExportAgent:output_type -> api.ExportAgentResponse
+	19, // 62: api.AgentService.ApplyAgentManifest:output_type -> api.ApplyAgentManifestResponse
+	22, // 63: api.AgentService.ListAgentTemplates:output_type -> api.ListAgentTemplatesResponse
+	0,  // 64: api.AgentService.CloneAgent:output_type -> api.Agent
+	25, // 65: api.AgentService.DiffAgentTemplate:output_type -> api.DiffAgentTemplateResponse
+	26, // 66: api.AgentService.CreateAgentFolder:output_type -> api.AgentFolder
+	29, // 67: api.AgentService.ListAgentFolders:output_type -> api.ListAgentFoldersResponse
+	26, // 68: api.AgentService.MoveAgentFolder:output_type -> api.AgentFolder
+	45, // 69: api.AgentService.DeleteAgentFolder:output_type -> google.protobuf.Empty
+	33, // 70: api.AgentService.MoveAgents:output_type -> api.MoveAgentsResponse
+	34, // 71: api.AgentService.ShareAgent:output_type -> api.AgentShare
+	37, // 72: api.AgentService.ListAgentShares:output_type -> api.ListAgentSharesResponse
+	45, // 73: api.AgentService.UnshareAgent:output_type -> google.protobuf.Empty
+	41, // 74: api.AgentService.ListMarketplaceAgents:output_type -> api.ListMarketplaceAgentsResponse
+	52, // [52:75] is the sub-list for method output_type
+	29, // [29:52] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_agent_proto_init() }
@@ -830,13 +3534,14 @@ func file_agent_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_agent_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_proto_rawDesc), len(file_agent_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,11 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AgentService_CreateAgent_FullMethodName = "/api.AgentService/CreateAgent"
-	AgentService_ListAgents_FullMethodName  = "/api.AgentService/ListAgents"
-	AgentService_GetAgent_FullMethodName    = "/api.AgentService/GetAgent"
-	AgentService_UpdateAgent_FullMethodName = "/api.AgentService/UpdateAgent"
-	AgentService_DeleteAgent_FullMethodName = "/api.AgentService/DeleteAgent"
+	AgentService_CreateAgent_FullMethodName           = "/api.AgentService/CreateAgent"
+	AgentService_ListAgents_FullMethodName            = "/api.AgentService/ListAgents"
+	AgentService_GetAgent_FullMethodName              = "/api.AgentService/GetAgent"
+	AgentService_UpdateAgent_FullMethodName           = "/api.AgentService/UpdateAgent"
+	AgentService_DeleteAgent_FullMethodName           = "/api.AgentService/DeleteAgent"
+	AgentService_PublishAgent_FullMethodName          = "/api.AgentService/PublishAgent"
+	AgentService_ListAgentVersions_FullMethodName     = "/api.AgentService/ListAgentVersions"
+	AgentService_RollbackAgent_FullMethodName         = "/api.AgentService/RollbackAgent"
+	AgentService_DiffAgentVersions_FullMethodName     = "/api.AgentService/DiffAgentVersions"
+	AgentService_ExportAgent_FullMethodName           = "/api.AgentService/ExportAgent"
+	AgentService_ApplyAgentManifest_FullMethodName    = "/api.AgentService/ApplyAgentManifest"
+	AgentService_ListAgentTemplates_FullMethodName    = "/api.AgentService/ListAgentTemplates"
+	AgentService_CloneAgent_FullMethodName            = "/api.AgentService/CloneAgent"
+	AgentService_DiffAgentTemplate_FullMethodName     = "/api.AgentService/DiffAgentTemplate"
+	AgentService_CreateAgentFolder_FullMethodName     = "/api.AgentService/CreateAgentFolder"
+	AgentService_ListAgentFolders_FullMethodName      = "/api.AgentService/ListAgentFolders"
+	AgentService_MoveAgentFolder_FullMethodName       = "/api.AgentService/MoveAgentFolder"
+	AgentService_DeleteAgentFolder_FullMethodName     = "/api.AgentService/DeleteAgentFolder"
+	AgentService_MoveAgents_FullMethodName            = "/api.AgentService/MoveAgents"
+	AgentService_ShareAgent_FullMethodName            = "/api.AgentService/ShareAgent"
+	AgentService_ListAgentShares_FullMethodName       = "/api.AgentService/ListAgentShares"
+	AgentService_UnshareAgent_FullMethodName          = "/api.AgentService/UnshareAgent"
+	AgentService_ListMarketplaceAgents_FullMethodName = "/api.AgentService/ListMarketplaceAgents"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -43,6 +61,42 @@ type AgentServiceClient interface {
 	UpdateAgent(ctx context.Context, in *UpdateAgentRequest, opts ...grpc.CallOption) (*Agent, error)
 	// 删除 Agent
 	DeleteAgent(ctx context.Context, in *DeleteAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 发布 Agent 版本
+	PublishAgent(ctx context.Context, in *PublishAgentRequest, opts ...grpc.CallOption) (*AgentVersion, error)
+	// 获取 Agent 版本列表
+	ListAgentVersions(ctx context.Context, in *ListAgentVersionsRequest, opts ...grpc.CallOption) (*ListAgentVersionsResponse, error)
+	// 回滚 Agent 到指定版本
+	RollbackAgent(ctx context.Context, in *RollbackAgentRequest, opts ...grpc.CallOption) (*Agent, error)
+	// 比较 Agent 版本
+	DiffAgentVersions(ctx context.Context, in *DiffAgentVersionsRequest, opts ...grpc.CallOption) (*DiffAgentVersionsResponse, error)
+	// 导出 Agent 清单
+	ExportAgent(ctx context.Context, in *ExportAgentRequest, opts ...grpc.CallOption) (*ExportAgentResponse, error)
+	// 应用 Agent 清单，按名称创建或更新调用者的 Agent
+	ApplyAgentManifest(ctx context.Context, in *ApplyAgentManifestRequest, opts ...grpc.CallOption) (*ApplyAgentManifestResponse, error)
+	// 获取 Agent 模板列表
+	ListAgentTemplates(ctx context.Context, in *ListAgentTemplatesRequest, opts ...grpc.CallOption) (*ListAgentTemplatesResponse, error)
+	// 克隆 Agent 或模板为新的草稿
+	CloneAgent(ctx context.Context, in *CloneAgentRequest, opts ...grpc.CallOption) (*Agent, error)
+	// 比较 Agent 与来源模板
+	DiffAgentTemplate(ctx context.Context, in *DiffAgentTemplateRequest, opts ...grpc.CallOption) (*DiffAgentTemplateResponse, error)
+	// 创建 Agent 文件夹
+	CreateAgentFolder(ctx context.Context, in *CreateAgentFolderRequest, opts ...grpc.CallOption) (*AgentFolder, error)
+	// 获取调用者的 Agent 文件夹树
+	ListAgentFolders(ctx context.Context, in *ListAgentFoldersRequest, opts ...grpc.CallOption) (*ListAgentFoldersResponse, error)
+	// 移动或重命名 Agent 文件夹
+	MoveAgentFolder(ctx context.Context, in *MoveAgentFolderRequest, opts ...grpc.CallOption) (*AgentFolder, error)
+	// 删除 Agent 文件夹
+	DeleteAgentFolder(ctx context.Context, in *DeleteAgentFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 移动 Agent 到文件夹
+	MoveAgents(ctx context.Context, in *MoveAgentsRequest, opts ...grpc.CallOption) (*MoveAgentsResponse, error)
+	// 共享 Agent 给用户或角色
+	ShareAgent(ctx context.Context, in *ShareAgentRequest, opts ...grpc.CallOption) (*AgentShare, error)
+	// 获取 Agent 共享列表
+	ListAgentShares(ctx context.Context, in *ListAgentSharesRequest, opts ...grpc.CallOption) (*ListAgentSharesResponse, error)
+	// 取消共享 Agent
+	UnshareAgent(ctx context.Context, in *UnshareAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取 Agent 市场列表
+	ListMarketplaceAgents(ctx context.Context, in *ListMarketplaceAgentsRequest, opts ...grpc.CallOption) (*ListMarketplaceAgentsResponse, error)
 }
 
 type agentServiceClient struct {
@@ -103,6 +157,186 @@ func (c *agentServiceClient) DeleteAgent(ctx context.Context, in *DeleteAgentReq
 	return out, nil
 }
 
+func (c *agentServiceClient) PublishAgent(ctx context.Context, in *PublishAgentRequest, opts ...grpc.CallOption) (*AgentVersion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentVersion)
+	err := c.cc.Invoke(ctx, AgentService_PublishAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListAgentVersions(ctx context.Context, in *ListAgentVersionsRequest, opts ...grpc.CallOption) (*ListAgentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentVersionsResponse)
+	err := c.cc.Invoke(ctx, AgentService_ListAgentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) RollbackAgent(ctx context.Context, in *RollbackAgentRequest, opts ...grpc.CallOption) (*Agent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Agent)
+	err := c.cc.Invoke(ctx, AgentService_RollbackAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) DiffAgentVersions(ctx context.Context, in *DiffAgentVersionsRequest, opts ...grpc.CallOption) (*DiffAgentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffAgentVersionsResponse)
+	err := c.cc.Invoke(ctx, AgentService_DiffAgentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ExportAgent(ctx context.Context, in *ExportAgentRequest, opts ...grpc.CallOption) (*ExportAgentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAgentResponse)
+	err := c.cc.Invoke(ctx, AgentService_ExportAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ApplyAgentManifest(ctx context.Context, in *ApplyAgentManifestRequest, opts ...grpc.CallOption) (*ApplyAgentManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyAgentManifestResponse)
+	err := c.cc.Invoke(ctx, AgentService_ApplyAgentManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListAgentTemplates(ctx context.Context, in *ListAgentTemplatesRequest, opts ...grpc.CallOption) (*ListAgentTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentTemplatesResponse)
+	err := c.cc.Invoke(ctx, AgentService_ListAgentTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) CloneAgent(ctx context.Context, in *CloneAgentRequest, opts ...grpc.CallOption) (*Agent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Agent)
+	err := c.cc.Invoke(ctx, AgentService_CloneAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) DiffAgentTemplate(ctx context.Context, in *DiffAgentTemplateRequest, opts ...grpc.CallOption) (*DiffAgentTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffAgentTemplateResponse)
+	err := c.cc.Invoke(ctx, AgentService_DiffAgentTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) CreateAgentFolder(ctx context.Context, in *CreateAgentFolderRequest, opts ...grpc.CallOption) (*AgentFolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentFolder)
+	err := c.cc.Invoke(ctx, AgentService_CreateAgentFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListAgentFolders(ctx context.Context, in *ListAgentFoldersRequest, opts ...grpc.CallOption) (*ListAgentFoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentFoldersResponse)
+	err := c.cc.Invoke(ctx, AgentService_ListAgentFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) MoveAgentFolder(ctx context.Context, in *MoveAgentFolderRequest, opts ...grpc.CallOption) (*AgentFolder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentFolder)
+	err := c.cc.Invoke(ctx, AgentService_MoveAgentFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) DeleteAgentFolder(ctx context.Context, in *DeleteAgentFolderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AgentService_DeleteAgentFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) MoveAgents(ctx context.Context, in *MoveAgentsRequest, opts ...grpc.CallOption) (*MoveAgentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveAgentsResponse)
+	err := c.cc.Invoke(ctx, AgentService_MoveAgents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ShareAgent(ctx context.Context, in *ShareAgentRequest, opts ...grpc.CallOption) (*AgentShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgentShare)
+	err := c.cc.Invoke(ctx, AgentService_ShareAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListAgentShares(ctx context.Context, in *ListAgentSharesRequest, opts ...grpc.CallOption) (*ListAgentSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentSharesResponse)
+	err := c.cc.Invoke(ctx, AgentService_ListAgentShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) UnshareAgent(ctx context.Context, in *UnshareAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AgentService_UnshareAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ListMarketplaceAgents(ctx context.Context, in *ListMarketplaceAgentsRequest, opts ...grpc.CallOption) (*ListMarketplaceAgentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMarketplaceAgentsResponse)
+	err := c.cc.Invoke(ctx, AgentService_ListMarketplaceAgents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServiceServer is the server API for AgentService service.
 // All implementations must embed UnimplementedAgentServiceServer
 // for forward compatibility.
@@ -119,6 +353,42 @@ type AgentServiceServer interface {
 	UpdateAgent(context.Context, *UpdateAgentRequest) (*Agent, error)
 	// 删除 Agent
 	DeleteAgent(context.Context, *DeleteAgentRequest) (*emptypb.Empty, error)
+	// 发布 Agent 版本
+	PublishAgent(context.Context, *PublishAgentRequest) (*AgentVersion, error)
+	// 获取 Agent 版本列表
+	ListAgentVersions(context.Context, *ListAgentVersionsRequest) (*ListAgentVersionsResponse, error)
+	// 回滚 Agent 到指定版本
+	RollbackAgent(context.Context, *RollbackAgentRequest) (*Agent, error)
+	// 比较 Agent 版本
+	DiffAgentVersions(context.Context, *DiffAgentVersionsRequest) (*DiffAgentVersionsResponse, error)
+	// 导出 Agent 清单
+	ExportAgent(context.Context, *ExportAgentRequest) (*ExportAgentResponse, error)
+	// 应用 Agent 清单，按名称创建或更新调用者的 Agent
+	ApplyAgentManifest(context.Context, *ApplyAgentManifestRequest) (*ApplyAgentManifestResponse, error)
+	// 获取 Agent 模板列表
+	ListAgentTemplates(context.Context, *ListAgentTemplatesRequest) (*ListAgentTemplatesResponse, error)
+	// 克隆 Agent 或模板为新的草稿
+	CloneAgent(context.Context, *CloneAgentRequest) (*Agent, error)
+	// 比较 Agent 与来源模板
+	DiffAgentTemplate(context.Context, *DiffAgentTemplateRequest) (*DiffAgentTemplateResponse, error)
+	// 创建 Agent 文件夹
+	CreateAgentFolder(context.Context, *CreateAgentFolderRequest) (*AgentFolder, error)
+	// 获取调用者的 Agent 文件夹树
+	ListAgentFolders(context.Context, *ListAgentFoldersRequest) (*ListAgentFoldersResponse, error)
+	// 移动或重命名 Agent 文件夹
+	MoveAgentFolder(context.Context, *MoveAgentFolderRequest) (*AgentFolder, error)
+	// 删除 Agent 文件夹
+	DeleteAgentFolder(context.Context, *DeleteAgentFolderRequest) (*emptypb.Empty, error)
+	// 移动 Agent 到文件夹
+	MoveAgents(context.Context, *MoveAgentsRequest) (*MoveAgentsResponse, error)
+	// 共享 Agent 给用户或角色
+	ShareAgent(context.Context, *ShareAgentRequest) (*AgentShare, error)
+	// 获取 Agent 共享列表
+	ListAgentShares(context.Context, *ListAgentSharesRequest) (*ListAgentSharesResponse, error)
+	// 取消共享 Agent
+	UnshareAgent(context.Context, *UnshareAgentRequest) (*emptypb.Empty, error)
+	// 获取 Agent 市场列表
+	ListMarketplaceAgents(context.Context, *ListMarketplaceAgentsRequest) (*ListMarketplaceAgentsResponse, error)
 	mustEmbedUnimplementedAgentServiceServer()
 }
 
@@ -144,6 +414,60 @@ func (UnimplementedAgentServiceServer) UpdateAgent(context.Context, *UpdateAgent
 func (UnimplementedAgentServiceServer) DeleteAgent(context.Context, *DeleteAgentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAgent not implemented")
 }
+func (UnimplementedAgentServiceServer) PublishAgent(context.Context, *PublishAgentRequest) (*AgentVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAgent not implemented")
+}
+func (UnimplementedAgentServiceServer) ListAgentVersions(context.Context, *ListAgentVersionsRequest) (*ListAgentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentVersions not implemented")
+}
+func (UnimplementedAgentServiceServer) RollbackAgent(context.Context, *RollbackAgentRequest) (*Agent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackAgent not implemented")
+}
+func (UnimplementedAgentServiceServer) DiffAgentVersions(context.Context, *DiffAgentVersionsRequest) (*DiffAgentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffAgentVersions not implemented")
+}
+func (UnimplementedAgentServiceServer) ExportAgent(context.Context, *ExportAgentRequest) (*ExportAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAgent not implemented")
+}
+func (UnimplementedAgentServiceServer) ApplyAgentManifest(context.Context, *ApplyAgentManifestRequest) (*ApplyAgentManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAgentManifest not implemented")
+}
+func (UnimplementedAgentServiceServer) ListAgentTemplates(context.Context, *ListAgentTemplatesRequest) (*ListAgentTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentTemplates not implemented")
+}
+func (UnimplementedAgentServiceServer) CloneAgent(context.Context, *CloneAgentRequest) (*Agent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneAgent not implemented")
+}
+func (UnimplementedAgentServiceServer) DiffAgentTemplate(context.Context, *DiffAgentTemplateRequest) (*DiffAgentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffAgentTemplate not implemented")
+}
+func (UnimplementedAgentServiceServer) CreateAgentFolder(context.Context, *CreateAgentFolderRequest) (*AgentFolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAgentFolder not implemented")
+}
+func (UnimplementedAgentServiceServer) ListAgentFolders(context.Context, *ListAgentFoldersRequest) (*ListAgentFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentFolders not implemented")
+}
+func (UnimplementedAgentServiceServer) MoveAgentFolder(context.Context, *MoveAgentFolderRequest) (*AgentFolder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveAgentFolder not implemented")
+}
+func (UnimplementedAgentServiceServer) DeleteAgentFolder(context.Context, *DeleteAgentFolderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAgentFolder not implemented")
+}
+func (UnimplementedAgentServiceServer) MoveAgents(context.Context, *MoveAgentsRequest) (*MoveAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveAgents not implemented")
+}
+func (UnimplementedAgentServiceServer) ShareAgent(context.Context, *ShareAgentRequest) (*AgentShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareAgent not implemented")
+}
+func (UnimplementedAgentServiceServer) ListAgentShares(context.Context, *ListAgentSharesRequest) (*ListAgentSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentShares not implemented")
+}
+func (UnimplementedAgentServiceServer) UnshareAgent(context.Context, *UnshareAgentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareAgent not implemented")
+}
+func (UnimplementedAgentServiceServer) ListMarketplaceAgents(context.Context, *ListMarketplaceAgentsRequest) (*ListMarketplaceAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarketplaceAgents not implemented")
+}
 func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
 func (UnimplementedAgentServiceServer) testEmbeddedByValue()                      {}
 
@@ -255,6 +579,330 @@ func _AgentService_DeleteAgent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_PublishAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).PublishAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_PublishAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).PublishAgent(ctx, req.(*PublishAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListAgentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListAgentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ListAgentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListAgentVersions(ctx, req.(*ListAgentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_RollbackAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).RollbackAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_RollbackAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).RollbackAgent(ctx, req.(*RollbackAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_DiffAgentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffAgentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DiffAgentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_DiffAgentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DiffAgentVersions(ctx, req.(*DiffAgentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ExportAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ExportAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ExportAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ExportAgent(ctx, req.(*ExportAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ApplyAgentManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyAgentManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ApplyAgentManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ApplyAgentManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ApplyAgentManifest(ctx, req.(*ApplyAgentManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListAgentTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListAgentTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ListAgentTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListAgentTemplates(ctx, req.(*ListAgentTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_CloneAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CloneAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_CloneAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CloneAgent(ctx, req.(*CloneAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_DiffAgentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffAgentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DiffAgentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_DiffAgentTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DiffAgentTemplate(ctx, req.(*DiffAgentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_CreateAgentFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAgentFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CreateAgentFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_CreateAgentFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CreateAgentFolder(ctx, req.(*CreateAgentFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListAgentFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListAgentFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ListAgentFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListAgentFolders(ctx, req.(*ListAgentFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_MoveAgentFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveAgentFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).MoveAgentFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_MoveAgentFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).MoveAgentFolder(ctx, req.(*MoveAgentFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_DeleteAgentFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAgentFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DeleteAgentFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_DeleteAgentFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DeleteAgentFolder(ctx, req.(*DeleteAgentFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_MoveAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).MoveAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_MoveAgents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).MoveAgents(ctx, req.(*MoveAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ShareAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ShareAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ShareAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ShareAgent(ctx, req.(*ShareAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListAgentShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListAgentShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ListAgentShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListAgentShares(ctx, req.(*ListAgentSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_UnshareAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).UnshareAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_UnshareAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).UnshareAgent(ctx, req.(*UnshareAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ListMarketplaceAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketplaceAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ListMarketplaceAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ListMarketplaceAgents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ListMarketplaceAgents(ctx, req.(*ListMarketplaceAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +930,78 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAgent",
 			Handler:    _AgentService_DeleteAgent_Handler,
 		},
+		{
+			MethodName: "PublishAgent",
+			Handler:    _AgentService_PublishAgent_Handler,
+		},
+		{
+			MethodName: "ListAgentVersions",
+			Handler:    _AgentService_ListAgentVersions_Handler,
+		},
+		{
+			MethodName: "RollbackAgent",
+			Handler:    _AgentService_RollbackAgent_Handler,
+		},
+		{
+			MethodName: "DiffAgentVersions",
+			Handler:    _AgentService_DiffAgentVersions_Handler,
+		},
+		{
+			MethodName: "ExportAgent",
+			Handler:    _AgentService_ExportAgent_Handler,
+		},
+		{
+			MethodName: "ApplyAgentManifest",
+			Handler:    _AgentService_ApplyAgentManifest_Handler,
+		},
+		{
+			MethodName: "ListAgentTemplates",
+			Handler:    _AgentService_ListAgentTemplates_Handler,
+		},
+		{
+			MethodName: "CloneAgent",
+			Handler:    _AgentService_CloneAgent_Handler,
+		},
+		{
+			MethodName: "DiffAgentTemplate",
+			Handler:    _AgentService_DiffAgentTemplate_Handler,
+		},
+		{
+			MethodName: "CreateAgentFolder",
+			Handler:    _AgentService_CreateAgentFolder_Handler,
+		},
+		{
+			MethodName: "ListAgentFolders",
+			Handler:    _AgentService_ListAgentFolders_Handler,
+		},
+		{
+			MethodName: "MoveAgentFolder",
+			Handler:    _AgentService_MoveAgentFolder_Handler,
+		},
+		{
+			MethodName: "DeleteAgentFolder",
+			Handler:    _AgentService_DeleteAgentFolder_Handler,
+		},
+		{
+			MethodName: "MoveAgents",
+			Handler:    _AgentService_MoveAgents_Handler,
+		},
+		{
+			MethodName: "ShareAgent",
+			Handler:    _AgentService_ShareAgent_Handler,
+		},
+		{
+			MethodName: "ListAgentShares",
+			Handler:    _AgentService_ListAgentShares_Handler,
+		},
+		{
+			MethodName: "UnshareAgent",
+			Handler:    _AgentService_UnshareAgent_Handler,
+		},
+		{
+			MethodName: "ListMarketplaceAgents",
+			Handler:    _AgentService_ListMarketplaceAgents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agent.proto",
@@ -861,6 +861,619 @@ func (x *SearchKnowledgeBaseResponse) GetContext() string {
 	return ""
 }
 
+// Chunk 文档分块实体
+type Chunk struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KnowledgeBaseId string                 `protobuf:"bytes,2,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	DocumentId      string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	ChunkIndex      int32                  `protobuf:"varint,4,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"` // 在文档中的序号
+	Content         string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Metadata        *structpb.Struct       `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Enabled         bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"` // 是否参与检索
+	Pinned          bool                   `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`   // 是否置顶
+	Boost           float64                `protobuf:"fixed64,9,opt,name=boost,proto3" json:"boost,omitempty"`    // 置顶时的分数加权系数
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Chunk) Reset() {
+	*x = Chunk{}
+	mi := &file_knowledge_base_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chunk) ProtoMessage() {}
+
+func (x *Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Chunk) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Chunk) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *Chunk) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *Chunk) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *Chunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Chunk) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Chunk) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Chunk) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Chunk) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
+	}
+	return 0
+}
+
+func (x *Chunk) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Chunk) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 列表分块请求
+type ListChunksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId string                 `protobuf:"bytes,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	DocumentId      string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListChunksRequest) Reset() {
+	*x = ListChunksRequest{}
+	mi := &file_knowledge_base_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunksRequest) ProtoMessage() {}
+
+func (x *ListChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunksRequest.ProtoReflect.Descriptor instead.
+func (*ListChunksRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListChunksRequest) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *ListChunksRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ListChunksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListChunksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 列表分块响应
+type ListChunksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Chunk               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChunksResponse) Reset() {
+	*x = ListChunksResponse{}
+	mi := &file_knowledge_base_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChunksResponse) ProtoMessage() {}
+
+func (x *ListChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChunksResponse.ProtoReflect.Descriptor instead.
+func (*ListChunksResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListChunksResponse) GetItems() []*Chunk {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListChunksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListChunksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChunksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取分块请求
+type GetChunkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId string                 `protobuf:"bytes,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Neighbors       int32                  `protobuf:"varint,3,opt,name=neighbors,proto3" json:"neighbors,omitempty"` // 前后各返回的相邻分块数量，默认0
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetChunkRequest) Reset() {
+	*x = GetChunkRequest{}
+	mi := &file_knowledge_base_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkRequest) ProtoMessage() {}
+
+func (x *GetChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkRequest.ProtoReflect.Descriptor instead.
+func (*GetChunkRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetChunkRequest) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *GetChunkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetChunkRequest) GetNeighbors() int32 {
+	if x != nil {
+		return x.Neighbors
+	}
+	return 0
+}
+
+// 获取分块响应
+type GetChunkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *Chunk                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Previous      []*Chunk               `protobuf:"bytes,2,rep,name=previous,proto3" json:"previous,omitempty"` // 之前的相邻分块（按序号升序）
+	Next          []*Chunk               `protobuf:"bytes,3,rep,name=next,proto3" json:"next,omitempty"`         // 之后的相邻分块（按序号升序）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChunkResponse) Reset() {
+	*x = GetChunkResponse{}
+	mi := &file_knowledge_base_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChunkResponse) ProtoMessage() {}
+
+func (x *GetChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChunkResponse.ProtoReflect.Descriptor instead.
+func (*GetChunkResponse) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetChunkResponse) GetChunk() *Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *GetChunkResponse) GetPrevious() []*Chunk {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *GetChunkResponse) GetNext() []*Chunk {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// 更新分块内容请求
+type UpdateChunkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId string                 `protobuf:"bytes,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // 新内容，保存后重新生成向量
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateChunkRequest) Reset() {
+	*x = UpdateChunkRequest{}
+	mi := &file_knowledge_base_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChunkRequest) ProtoMessage() {}
+
+func (x *UpdateChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChunkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChunkRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateChunkRequest) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *UpdateChunkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateChunkRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 启用/禁用分块请求
+type SetChunkEnabledRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId string                 `protobuf:"bytes,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetChunkEnabledRequest) Reset() {
+	*x = SetChunkEnabledRequest{}
+	mi := &file_knowledge_base_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChunkEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChunkEnabledRequest) ProtoMessage() {}
+
+func (x *SetChunkEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChunkEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetChunkEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetChunkEnabledRequest) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *SetChunkEnabledRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 置顶分块请求
+type PinChunkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId string                 `protobuf:"bytes,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Boost           float64                `protobuf:"fixed64,3,opt,name=boost,proto3" json:"boost,omitempty"` // 分数加权系数，默认1.5
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PinChunkRequest) Reset() {
+	*x = PinChunkRequest{}
+	mi := &file_knowledge_base_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinChunkRequest) ProtoMessage() {}
+
+func (x *PinChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinChunkRequest.ProtoReflect.Descriptor instead.
+func (*PinChunkRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PinChunkRequest) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *PinChunkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PinChunkRequest) GetBoost() float64 {
+	if x != nil {
+		return x.Boost
+	}
+	return 0
+}
+
+// 取消置顶分块请求
+type UnpinChunkRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	KnowledgeBaseId string                 `protobuf:"bytes,1,opt,name=knowledge_base_id,json=knowledgeBaseId,proto3" json:"knowledge_base_id,omitempty"`
+	Id              string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpinChunkRequest) Reset() {
+	*x = UnpinChunkRequest{}
+	mi := &file_knowledge_base_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinChunkRequest) ProtoMessage() {}
+
+func (x *UnpinChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_knowledge_base_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinChunkRequest.ProtoReflect.Descriptor instead.
+func (*UnpinChunkRequest) Descriptor() ([]byte, []int) {
+	return file_knowledge_base_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnpinChunkRequest) GetKnowledgeBaseId() string {
+	if x != nil {
+		return x.KnowledgeBaseId
+	}
+	return ""
+}
+
+func (x *UnpinChunkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 var File_knowledge_base_proto protoreflect.FileDescriptor
 
 const file_knowledge_base_proto_rawDesc = "" +
@@ -933,14 +1546,82 @@ const file_knowledge_base_proto_rawDesc = "" +
 	"\bmetadata\x18\x05 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"h\n" +
 	"\x1bSearchKnowledgeBaseResponse\x12/\n" +
 	"\aresults\x18\x01 \x03(\v2\x15.api.SearchResultItemR\aresults\x12\x18\n" +
-	"\acontext\x18\x02 \x01(\tR\acontext2\xf9\x05\n" +
+	"\acontext\x18\x02 \x01(\tR\acontext\"\x92\x03\n" +
+	"\x05Chunk\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11knowledge_base_id\x18\x02 \x01(\tR\x0fknowledgeBaseId\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\tR\n" +
+	"documentId\x12\x1f\n" +
+	"\vchunk_index\x18\x04 \x01(\x05R\n" +
+	"chunkIndex\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x123\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x16\n" +
+	"\x06pinned\x18\b \x01(\bR\x06pinned\x12\x14\n" +
+	"\x05boost\x18\t \x01(\x01R\x05boost\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x91\x01\n" +
+	"\x11ListChunksRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\tR\x0fknowledgeBaseId\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"}\n" +
+	"\x12ListChunksResponse\x12 \n" +
+	"\x05items\x18\x01 \x03(\v2\n" +
+	".api.ChunkR\x05items\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"k\n" +
+	"\x0fGetChunkRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\tR\x0fknowledgeBaseId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1c\n" +
+	"\tneighbors\x18\x03 \x01(\x05R\tneighbors\"|\n" +
+	"\x10GetChunkResponse\x12 \n" +
+	"\x05chunk\x18\x01 \x01(\v2\n" +
+	".api.ChunkR\x05chunk\x12&\n" +
+	"\bprevious\x18\x02 \x03(\v2\n" +
+	".api.ChunkR\bprevious\x12\x1e\n" +
+	"\x04next\x18\x03 \x03(\v2\n" +
+	".api.ChunkR\x04next\"j\n" +
+	"\x12UpdateChunkRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\tR\x0fknowledgeBaseId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"T\n" +
+	"\x16SetChunkEnabledRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\tR\x0fknowledgeBaseId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"c\n" +
+	"\x0fPinChunkRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\tR\x0fknowledgeBaseId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05boost\x18\x03 \x01(\x01R\x05boost\"O\n" +
+	"\x11UnpinChunkRequest\x12*\n" +
+	"\x11knowledge_base_id\x18\x01 \x01(\tR\x0fknowledgeBaseId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id2\xfb\f\n" +
 	"\x14KnowledgeBaseService\x12n\n" +
 	"\x13CreateKnowledgeBase\x12\x1f.api.CreateKnowledgeBaseRequest\x1a\x12.api.KnowledgeBase\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/knowledge-bases\x12v\n" +
 	"\x12ListKnowledgeBases\x12\x1e.api.ListKnowledgeBasesRequest\x1a\x1f.api.ListKnowledgeBasesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/knowledge-bases\x12j\n" +
 	"\x10GetKnowledgeBase\x12\x1c.api.GetKnowledgeBaseRequest\x1a\x12.api.KnowledgeBase\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/knowledge-bases/{id}\x12}\n" +
 	"\x0eUploadDocument\x12\x1a.api.UploadDocumentRequest\x1a\r.api.Document\"@\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/knowledge-bases/{knowledge_base_id}/documents\x12t\n" +
 	"\x13DeleteKnowledgeBase\x12\x1f.api.DeleteKnowledgeBaseRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/knowledge-bases/{id}\x12\x97\x01\n" +
-	"\x13SearchKnowledgeBase\x12\x1f.api.SearchKnowledgeBaseRequest\x1a .api.SearchKnowledgeBaseResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/knowledge-bases/{knowledge_base_id}/searchB<Z:github.com/yourusername/agent-opus/backend/api/proto;protob\x06proto3"
+	"\x13SearchKnowledgeBase\x12\x1f.api.SearchKnowledgeBaseRequest\x1a .api.SearchKnowledgeBaseResponse\"=\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/knowledge-bases/{knowledge_base_id}/search\x12\x91\x01\n" +
+	"\n" +
+	"ListChunks\x12\x16.api.ListChunksRequest\x1a\x17.api.ListChunksResponse\"R\x82\xd3\xe4\x93\x02L\x12J/api/v1/knowledge-bases/{knowledge_base_id}/documents/{document_id}/chunks\x12x\n" +
+	"\bGetChunk\x12\x14.api.GetChunkRequest\x1a\x15.api.GetChunkResponse\"?\x82\xd3\xe4\x93\x029\x127/api/v1/knowledge-bases/{knowledge_base_id}/chunks/{id}\x12v\n" +
+	"\vUpdateChunk\x12\x17.api.UpdateChunkRequest\x1a\n" +
+	".api.Chunk\"B\x82\xd3\xe4\x93\x02<:\x01*\x1a7/api/v1/knowledge-bases/{knowledge_base_id}/chunks/{id}\x12\x81\x01\n" +
+	"\vEnableChunk\x12\x1b.api.SetChunkEnabledRequest\x1a\n" +
+	".api.Chunk\"I\x82\xd3\xe4\x93\x02C:\x01*\">/api/v1/knowledge-bases/{knowledge_base_id}/chunks/{id}/enable\x12\x83\x01\n" +
+	"\fDisableChunk\x12\x1b.api.SetChunkEnabledRequest\x1a\n" +
+	".api.Chunk\"J\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/knowledge-bases/{knowledge_base_id}/chunks/{id}/disable\x12t\n" +
+	"\bPinChunk\x12\x14.api.PinChunkRequest\x1a\n" +
+	".api.Chunk\"F\x82\xd3\xe4\x93\x02@:\x01*\";/api/v1/knowledge-bases/{knowledge_base_id}/chunks/{id}/pin\x12z\n" +
+	"\n" +
+	"UnpinChunk\x12\x16.api.UnpinChunkRequest\x1a\n" +
+	".api.Chunk\"H\x82\xd3\xe4\x93\x02B:\x01*\"=/api/v1/knowledge-bases/{knowledge_base_id}/chunks/{id}/unpinB<Z:github.com/yourusername/agent-opus/backend/api/proto;protob\x06proto3"
 
 var (
 	file_knowledge_base_proto_rawDescOnce sync.Once
@@ -954,7 +1635,7 @@ func file_knowledge_base_proto_rawDescGZIP() []byte {
 	return file_knowledge_base_proto_rawDescData
 }
 
-var file_knowledge_base_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_knowledge_base_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_knowledge_base_proto_goTypes = []any{
 	(*Document)(nil),                    // 0: api.Document
 	(*KnowledgeBase)(nil),               // 1: api.KnowledgeBase
@@ -968,39 +1649,69 @@ var file_knowledge_base_proto_goTypes = []any{
 	(*SearchKnowledgeBaseRequest)(nil),  // 9: api.SearchKnowledgeBaseRequest
 	(*SearchResultItem)(nil),            // 10: api.SearchResultItem
 	(*SearchKnowledgeBaseResponse)(nil), // 11: api.SearchKnowledgeBaseResponse
-	(*structpb.Struct)(nil),             // 12: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 14: google.protobuf.Empty
+	(*Chunk)(nil),                       // 12: api.Chunk
+	(*ListChunksRequest)(nil),           // 13: api.ListChunksRequest
+	(*ListChunksResponse)(nil),          // 14: api.ListChunksResponse
+	(*GetChunkRequest)(nil),             // 15: api.GetChunkRequest
+	(*GetChunkResponse)(nil),            // 16: api.GetChunkResponse
+	(*UpdateChunkRequest)(nil),          // 17: api.UpdateChunkRequest
+	(*SetChunkEnabledRequest)(nil),      // 18: api.SetChunkEnabledRequest
+	(*PinChunkRequest)(nil),             // 19: api.PinChunkRequest
+	(*UnpinChunkRequest)(nil),           // 20: api.UnpinChunkRequest
+	(*structpb.Struct)(nil),             // 21: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),       // 22: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 23: google.protobuf.Empty
 }
 var file_knowledge_base_proto_depIdxs = []int32{
-	12, // 0: api.Document.metadata:type_name -> google.protobuf.Struct
-	13, // 1: api.Document.created_at:type_name -> google.protobuf.Timestamp
-	13, // 2: api.Document.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 3: api.KnowledgeBase.chunk_config:type_name -> google.protobuf.Struct
-	13, // 4: api.KnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
-	13, // 5: api.KnowledgeBase.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 6: api.CreateKnowledgeBaseRequest.chunk_config:type_name -> google.protobuf.Struct
+	21, // 0: api.Document.metadata:type_name -> google.protobuf.Struct
+	22, // 1: api.Document.created_at:type_name -> google.protobuf.Timestamp
+	22, // 2: api.Document.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 3: api.KnowledgeBase.chunk_config:type_name -> google.protobuf.Struct
+	22, // 4: api.KnowledgeBase.created_at:type_name -> google.protobuf.Timestamp
+	22, // 5: api.KnowledgeBase.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 6: api.CreateKnowledgeBaseRequest.chunk_config:type_name -> google.protobuf.Struct
 	1,  // 7: api.ListKnowledgeBasesResponse.items:type_name -> api.KnowledgeBase
-	12, // 8: api.UploadDocumentRequest.metadata:type_name -> google.protobuf.Struct
-	12, // 9: api.SearchResultItem.metadata:type_name -> google.protobuf.Struct
+	21, // 8: api.UploadDocumentRequest.metadata:type_name -> google.protobuf.Struct
+	21, // 9: api.SearchResultItem.metadata:type_name -> google.protobuf.Struct
 	10, // 10: api.SearchKnowledgeBaseResponse.results:type_name -> api.SearchResultItem
-	2,  // 11: api.KnowledgeBaseService.CreateKnowledgeBase:input_type -> api.CreateKnowledgeBaseRequest
-	3,  // 12: api.KnowledgeBaseService.ListKnowledgeBases:input_type -> api.ListKnowledgeBasesRequest
-	5,  // 13: api.KnowledgeBaseService.GetKnowledgeBase:input_type -> api.GetKnowledgeBaseRequest
-	6,  // 14: api.KnowledgeBaseService.UploadDocument:input_type -> api.UploadDocumentRequest
-	7,  // 15: api.KnowledgeBaseService.DeleteKnowledgeBase:input_type -> api.DeleteKnowledgeBaseRequest
-	9,  // 16: api.KnowledgeBaseService.SearchKnowledgeBase:input_type -> api.SearchKnowledgeBaseRequest
-	1,  // 17: api.KnowledgeBaseService.CreateKnowledgeBase:output_type -> api.KnowledgeBase
-	4,  // 18: api.KnowledgeBaseService.ListKnowledgeBases:output_type -> api.ListKnowledgeBasesResponse
-	1,  // 19: api.KnowledgeBaseService.GetKnowledgeBase:output_type -> api.KnowledgeBase
-	0,  // 20: api.KnowledgeBaseService.UploadDocument:output_type -> api.Document
-	14, // 21: api.KnowledgeBaseService.DeleteKnowledgeBase:output_type -> google.protobuf.Empty
-	11, // 22: api.KnowledgeBaseService.SearchKnowledgeBase:output_type -> api.SearchKnowledgeBaseResponse
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	21, // 11: api.Chunk.metadata:type_name -> google.protobuf.Struct
+	22, // 12: api.Chunk.created_at:type_name -> google.protobuf.Timestamp
+	22, // 13: api.Chunk.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 14: api.ListChunksResponse.items:type_name -> api.Chunk
+	12, // 15: api.GetChunkResponse.chunk:type_name -> api.Chunk
+	12, // 16: api.GetChunkResponse.previous:type_name -> api.Chunk
+	12, // 17: api.GetChunkResponse.next:type_name -> api.Chunk
+	2,  // 18: api.KnowledgeBaseService.CreateKnowledgeBase:input_type -> api.CreateKnowledgeBaseRequest
+	3,  // 19: api.KnowledgeBaseService.ListKnowledgeBases:input_type -> api.ListKnowledgeBasesRequest
+	5,  // 20: api.KnowledgeBaseService.GetKnowledgeBase:input_type -> api.GetKnowledgeBaseRequest
+	6,  // 21: api.KnowledgeBaseService.UploadDocument:input_type -> api.UploadDocumentRequest
+	7,  // 22: api.KnowledgeBaseService.DeleteKnowledgeBase:input_type -> api.DeleteKnowledgeBaseRequest
+	9,  // 23: api.KnowledgeBaseService.SearchKnowledgeBase:input_type -> api.SearchKnowledgeBaseRequest
+	13, // 24: api.KnowledgeBaseService.ListChunks:input_type -> api.ListChunksRequest
+	15, // 25: api.KnowledgeBaseService.GetChunk:input_type -> api.GetChunkRequest
+	17, // 26: api.KnowledgeBaseService.UpdateChunk:input_type -> api.UpdateChunkRequest
+	18, // 27: api.KnowledgeBaseService.EnableChunk:input_type -> api.SetChunkEnabledRequest
+	18, // 28: api.KnowledgeBaseService.DisableChunk:input_type -> api.SetChunkEnabledRequest
+	19, // 29: api.KnowledgeBaseService.PinChunk:input_type -> api.PinChunkRequest
+	20, // 30: api.KnowledgeBaseService.UnpinChunk:input_type -> api.UnpinChunkRequest
+	1,  // 31: api.KnowledgeBaseService.CreateKnowledgeBase:output_type -> api.KnowledgeBase
+	4,  // 32: api.KnowledgeBaseService.ListKnowledgeBases:output_type -> api.ListKnowledgeBasesResponse
+	1,  // 33: api.KnowledgeBaseService.GetKnowledgeBase:output_type -> api.KnowledgeBase
+	0,  // 34: api.KnowledgeBaseService.UploadDocument:output_type -> api.Document
+	23, // 35: api.KnowledgeBaseService.DeleteKnowledgeBase:output_type -> google.protobuf.Empty
+	11, // 36: api.KnowledgeBaseService.SearchKnowledgeBase:output_type -> api.SearchKnowledgeBaseResponse
+	14, // 37: api.KnowledgeBaseService.ListChunks:output_type -> api.ListChunksResponse
+	16, // 38: api.KnowledgeBaseService.GetChunk:output_type -> api.GetChunkResponse
+	12, // 39: api.KnowledgeBaseService.UpdateChunk:output_type -> api.Chunk
+	12, // 40: api.KnowledgeBaseService.EnableChunk:output_type -> api.Chunk
+	12, // 41: api.KnowledgeBaseService.DisableChunk:output_type -> api.Chunk
+	12, // 42: api.KnowledgeBaseService.PinChunk:output_type -> api.Chunk
+	12, // 43: api.KnowledgeBaseService.UnpinChunk:output_type -> api.Chunk
+	31, // [31:44] is the sub-list for method output_type
+	18, // [18:31] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_knowledge_base_proto_init() }
@@ -1015,7 +1726,7 @@ func file_knowledge_base_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_knowledge_base_proto_rawDesc), len(file_knowledge_base_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,13 @@ const (
 	KnowledgeBaseService_UploadDocument_FullMethodName      = "/api.KnowledgeBaseService/UploadDocument"
 	KnowledgeBaseService_DeleteKnowledgeBase_FullMethodName = "/api.KnowledgeBaseService/DeleteKnowledgeBase"
 	KnowledgeBaseService_SearchKnowledgeBase_FullMethodName = "/api.KnowledgeBaseService/SearchKnowledgeBase"
+	KnowledgeBaseService_ListChunks_FullMethodName          = "/api.KnowledgeBaseService/ListChunks"
+	KnowledgeBaseService_GetChunk_FullMethodName            = "/api.KnowledgeBaseService/GetChunk"
+	KnowledgeBaseService_UpdateChunk_FullMethodName         = "/api.KnowledgeBaseService/UpdateChunk"
+	KnowledgeBaseService_EnableChunk_FullMethodName         = "/api.KnowledgeBaseService/EnableChunk"
+	KnowledgeBaseService_DisableChunk_FullMethodName        = "/api.KnowledgeBaseService/DisableChunk"
+	KnowledgeBaseService_PinChunk_FullMethodName            = "/api.KnowledgeBaseService/PinChunk"
+	KnowledgeBaseService_UnpinChunk_FullMethodName          = "/api.KnowledgeBaseService/UnpinChunk"
 )
 
 // KnowledgeBaseServiceClient is the client API for KnowledgeBaseService service.
@@ -46,6 +53,20 @@ type KnowledgeBaseServiceClient interface {
 	DeleteKnowledgeBase(ctx context.Context, in *DeleteKnowledgeBaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 搜索知识库
 	SearchKnowledgeBase(ctx context.Context, in *SearchKnowledgeBaseRequest, opts ...grpc.CallOption) (*SearchKnowledgeBaseResponse, error)
+	// 获取文档分块列表
+	ListChunks(ctx context.Context, in *ListChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error)
+	// 获取分块详情及相邻分块
+	GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (*GetChunkResponse, error)
+	// 更新分块内容
+	UpdateChunk(ctx context.Context, in *UpdateChunkRequest, opts ...grpc.CallOption) (*Chunk, error)
+	// 启用分块
+	EnableChunk(ctx context.Context, in *SetChunkEnabledRequest, opts ...grpc.CallOption) (*Chunk, error)
+	// 禁用分块
+	DisableChunk(ctx context.Context, in *SetChunkEnabledRequest, opts ...grpc.CallOption) (*Chunk, error)
+	// 置顶分块
+	PinChunk(ctx context.Context, in *PinChunkRequest, opts ...grpc.CallOption) (*Chunk, error)
+	// 取消置顶分块
+	UnpinChunk(ctx context.Context, in *UnpinChunkRequest, opts ...grpc.CallOption) (*Chunk, error)
 }
 
 type knowledgeBaseServiceClient struct {
@@ -116,6 +137,76 @@ func (c *knowledgeBaseServiceClient) SearchKnowledgeBase(ctx context.Context, in
 	return out, nil
 }
 
+func (c *knowledgeBaseServiceClient) ListChunks(ctx context.Context, in *ListChunksRequest, opts ...grpc.CallOption) (*ListChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChunksResponse)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_ListChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) GetChunk(ctx context.Context, in *GetChunkRequest, opts ...grpc.CallOption) (*GetChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChunkResponse)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_GetChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) UpdateChunk(ctx context.Context, in *UpdateChunkRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_UpdateChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) EnableChunk(ctx context.Context, in *SetChunkEnabledRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_EnableChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) DisableChunk(ctx context.Context, in *SetChunkEnabledRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_DisableChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) PinChunk(ctx context.Context, in *PinChunkRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_PinChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *knowledgeBaseServiceClient) UnpinChunk(ctx context.Context, in *UnpinChunkRequest, opts ...grpc.CallOption) (*Chunk, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Chunk)
+	err := c.cc.Invoke(ctx, KnowledgeBaseService_UnpinChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KnowledgeBaseServiceServer is the server API for KnowledgeBaseService service.
 // All implementations must embed UnimplementedKnowledgeBaseServiceServer
 // for forward compatibility.
@@ -134,6 +225,20 @@ type KnowledgeBaseServiceServer interface {
 	DeleteKnowledgeBase(context.Context, *DeleteKnowledgeBaseRequest) (*emptypb.Empty, error)
 	// 搜索知识库
 	SearchKnowledgeBase(context.Context, *SearchKnowledgeBaseRequest) (*SearchKnowledgeBaseResponse, error)
+	// 获取文档分块列表
+	ListChunks(context.Context, *ListChunksRequest) (*ListChunksResponse, error)
+	// 获取分块详情及相邻分块
+	GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error)
+	// 更新分块内容
+	UpdateChunk(context.Context, *UpdateChunkRequest) (*Chunk, error)
+	// 启用分块
+	EnableChunk(context.Context, *SetChunkEnabledRequest) (*Chunk, error)
+	// 禁用分块
+	DisableChunk(context.Context, *SetChunkEnabledRequest) (*Chunk, error)
+	// 置顶分块
+	PinChunk(context.Context, *PinChunkRequest) (*Chunk, error)
+	// 取消置顶分块
+	UnpinChunk(context.Context, *UnpinChunkRequest) (*Chunk, error)
 	mustEmbedUnimplementedKnowledgeBaseServiceServer()
 }
 
@@ -162,6 +267,27 @@ func (UnimplementedKnowledgeBaseServiceServer) DeleteKnowledgeBase(context.Conte
 func (UnimplementedKnowledgeBaseServiceServer) SearchKnowledgeBase(context.Context, *SearchKnowledgeBaseRequest) (*SearchKnowledgeBaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchKnowledgeBase not implemented")
 }
+func (UnimplementedKnowledgeBaseServiceServer) ListChunks(context.Context, *ListChunksRequest) (*ListChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChunks not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) GetChunk(context.Context, *GetChunkRequest) (*GetChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChunk not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) UpdateChunk(context.Context, *UpdateChunkRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChunk not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) EnableChunk(context.Context, *SetChunkEnabledRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableChunk not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) DisableChunk(context.Context, *SetChunkEnabledRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableChunk not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) PinChunk(context.Context, *PinChunkRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinChunk not implemented")
+}
+func (UnimplementedKnowledgeBaseServiceServer) UnpinChunk(context.Context, *UnpinChunkRequest) (*Chunk, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinChunk not implemented")
+}
 func (UnimplementedKnowledgeBaseServiceServer) mustEmbedUnimplementedKnowledgeBaseServiceServer() {}
 func (UnimplementedKnowledgeBaseServiceServer) testEmbeddedByValue()                              {}
 
@@ -291,6 +417,132 @@ func _KnowledgeBaseService_SearchKnowledgeBase_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KnowledgeBaseService_ListChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).ListChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_ListChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).ListChunks(ctx, req.(*ListChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_GetChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).GetChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_GetChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).GetChunk(ctx, req.(*GetChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_UpdateChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).UpdateChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_UpdateChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).UpdateChunk(ctx, req.(*UpdateChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_EnableChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChunkEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).EnableChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_EnableChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).EnableChunk(ctx, req.(*SetChunkEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_DisableChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChunkEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).DisableChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_DisableChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).DisableChunk(ctx, req.(*SetChunkEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_PinChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).PinChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_PinChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).PinChunk(ctx, req.(*PinChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KnowledgeBaseService_UnpinChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KnowledgeBaseServiceServer).UnpinChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KnowledgeBaseService_UnpinChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KnowledgeBaseServiceServer).UnpinChunk(ctx, req.(*UnpinChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KnowledgeBaseService_ServiceDesc is the grpc.ServiceDesc for KnowledgeBaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -322,6 +574,34 @@ var KnowledgeBaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchKnowledgeBase",
 			Handler:    _KnowledgeBaseService_SearchKnowledgeBase_Handler,
 		},
+		{
+			MethodName: "ListChunks",
+			Handler:    _KnowledgeBaseService_ListChunks_Handler,
+		},
+		{
+			MethodName: "GetChunk",
+			Handler:    _KnowledgeBaseService_GetChunk_Handler,
+		},
+		{
+			MethodName: "UpdateChunk",
+			Handler:    _KnowledgeBaseService_UpdateChunk_Handler,
+		},
+		{
+			MethodName: "EnableChunk",
+			Handler:    _KnowledgeBaseService_EnableChunk_Handler,
+		},
+		{
+			MethodName: "DisableChunk",
+			Handler:    _KnowledgeBaseService_DisableChunk_Handler,
+		},
+		{
+			MethodName: "PinChunk",
+			Handler:    _KnowledgeBaseService_PinChunk_Handler,
+		},
+		{
+			MethodName: "UnpinChunk",
+			Handler:    _KnowledgeBaseService_UnpinChunk_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "knowledge_base.proto",
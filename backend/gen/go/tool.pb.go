@@ -31,9 +31,9 @@ type Tool struct {
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                     // api, function, plugin
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                     // api, function, plugin, agent
 	Schema         *structpb.Struct       `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`                 // 工具的schema定义
-	Implementation string                 `protobuf:"bytes,6,opt,name=implementation,proto3" json:"implementation,omitempty"` // 实现代码或URL
+	Implementation string                 `protobuf:"bytes,6,opt,name=implementation,proto3" json:"implementation,omitempty"` // 实现代码或URL，agent 类型为被调用 Agent 的 ID
 	Version        string                 `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
 	IsPublic       bool                   `protobuf:"varint,8,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"` // 是否公开
 	CreatedBy      string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
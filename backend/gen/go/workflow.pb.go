@@ -141,9 +141,9 @@ type WorkflowStep struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                            // agent, condition, parallel
 	AgentId       string                 `protobuf:"bytes,4,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`       // For agent type steps
-	Config        *structpb.Struct       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`                        // Step configuration
-	NextSteps     []string               `protobuf:"bytes,6,rep,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"` // Next step IDs
-	Condition     *structpb.Struct       `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`                  // Condition for conditional steps
+	Config        *structpb.Struct       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`                        // Step configuration: input or inputs, context and output for agent steps
+	NextSteps     []string               `protobuf:"bytes,6,rep,name=next_steps,json=nextSteps,proto3" json:"next_steps,omitempty"` // Next step IDs, for agent and parallel steps
+	Condition     *structpb.Struct       `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`                  // Condition for conditional steps: expression, or variable, operator and value; then, else
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -234,9 +234,9 @@ type WorkflowExecution struct {
 	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 	Input         *structpb.Struct       `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
 	Output        *structpb.Struct       `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
-	Context       *structpb.Struct       `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // pending, running, completed, failed, cancelled
-	CurrentStep   string                 `protobuf:"bytes,7,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	Context       *structpb.Struct       `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`                            // State of each step under steps
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                              // pending, running, completed, failed, cancelled
+	CurrentStep   string                 `protobuf:"bytes,7,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"` // IDs of the running steps, comma separated
 	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 	StartedBy     string                 `protobuf:"bytes,9,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`
 	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
@@ -1015,6 +1015,153 @@ func (x *CancelExecutionRequest) GetId() string {
 	return ""
 }
 
+// Evaluate expression request. The expression is evaluated in the scope of an execution, or
+// with the config of a workflow; input, steps and config replace the values they provide.
+type EvaluateExpressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Input         *structpb.Struct       `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`                                // Execution input
+	Steps         *structpb.Struct       `protobuf:"bytes,3,opt,name=steps,proto3" json:"steps,omitempty"`                                // Outputs of finished steps by step ID
+	Config        *structpb.Struct       `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`                              // Workflow config
+	WorkflowId    string                 `protobuf:"bytes,5,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`    // Workflow providing config
+	ExecutionId   string                 `protobuf:"bytes,6,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"` // Execution providing input, step outputs and config
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExpressionRequest) Reset() {
+	*x = EvaluateExpressionRequest{}
+	mi := &file_workflow_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExpressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionRequest) ProtoMessage() {}
+
+func (x *EvaluateExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvaluateExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetInput() *structpb.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionRequest) GetSteps() *structpb.Struct {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionRequest) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+// Evaluate expression response
+type EvaluateExpressionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *structpb.Value        `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`             // null, bool, number, string, list or map
+	References    []string               `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"` // Paths of the scope values referred to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExpressionResponse) Reset() {
+	*x = EvaluateExpressionResponse{}
+	mi := &file_workflow_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionResponse) ProtoMessage() {}
+
+func (x *EvaluateExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_workflow_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_workflow_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EvaluateExpressionResponse) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResponse) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
 var File_workflow_proto protoreflect.FileDescriptor
 
 const file_workflow_proto_rawDesc = "" +
@@ -1103,7 +1250,23 @@ const file_workflow_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x03R\x05total\"(\n" +
 	"\x16CancelExecutionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\xa5\a\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8e\x02\n" +
+	"\x19EvaluateExpressionRequest\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12-\n" +
+	"\x05input\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x05input\x12-\n" +
+	"\x05steps\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x05steps\x12/\n" +
+	"\x06config\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x06config\x12\x1f\n" +
+	"\vworkflow_id\x18\x05 \x01(\tR\n" +
+	"workflowId\x12!\n" +
+	"\fexecution_id\x18\x06 \x01(\tR\vexecutionId\"~\n" +
+	"\x1aEvaluateExpressionResponse\x12,\n" +
+	"\x05value\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"references\x18\x03 \x03(\tR\n" +
+	"references2\xb0\b\n" +
 	"\x0fWorkflowService\x12Y\n" +
 	"\x0eCreateWorkflow\x12\x1a.api.CreateWorkflowRequest\x1a\r.api.Workflow\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/workflows\x12a\n" +
 	"\rListWorkflows\x12\x19.api.ListWorkflowsRequest\x1a\x1a.api.ListWorkflowsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/workflows\x12U\n" +
@@ -1113,7 +1276,8 @@ const file_workflow_proto_rawDesc = "" +
 	"\x0fExecuteWorkflow\x12\x1b.api.ExecuteWorkflowRequest\x1a\x16.api.WorkflowExecution\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/workflows/{workflow_id}/execute\x12a\n" +
 	"\fGetExecution\x12\x18.api.GetExecutionRequest\x1a\x16.api.WorkflowExecution\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/executions/{id}\x12e\n" +
 	"\x0eListExecutions\x12\x1a.api.ListExecutionsRequest\x1a\x1b.api.ListExecutionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/executions\x12q\n" +
-	"\x0fCancelExecution\x12\x1b.api.CancelExecutionRequest\x1a\x16.api.WorkflowExecution\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/executions/{id}/cancelB<Z:github.com/yourusername/agent-opus/backend/api/proto;protob\x06proto3"
+	"\x0fCancelExecution\x12\x1b.api.CancelExecutionRequest\x1a\x16.api.WorkflowExecution\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/executions/{id}/cancel\x12\x88\x01\n" +
+	"\x12EvaluateExpression\x12\x1e.api.EvaluateExpressionRequest\x1a\x1f.api.EvaluateExpressionResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/workflows/expressions:evaluateB<Z:github.com/yourusername/agent-opus/backend/api/proto;protob\x06proto3"
 
 var (
 	file_workflow_proto_rawDescOnce sync.Once
@@ -1127,69 +1291,78 @@ func file_workflow_proto_rawDescGZIP() []byte {
 	return file_workflow_proto_rawDescData
 }
 
-var file_workflow_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_workflow_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_workflow_proto_goTypes = []any{
-	(*Workflow)(nil),               // 0: api.Workflow
-	(*WorkflowStep)(nil),           // 1: api.WorkflowStep
-	(*WorkflowExecution)(nil),      // 2: api.WorkflowExecution
-	(*CreateWorkflowRequest)(nil),  // 3: api.CreateWorkflowRequest
-	(*ListWorkflowsRequest)(nil),   // 4: api.ListWorkflowsRequest
-	(*ListWorkflowsResponse)(nil),  // 5: api.ListWorkflowsResponse
-	(*GetWorkflowRequest)(nil),     // 6: api.GetWorkflowRequest
-	(*UpdateWorkflowRequest)(nil),  // 7: api.UpdateWorkflowRequest
-	(*DeleteWorkflowRequest)(nil),  // 8: api.DeleteWorkflowRequest
-	(*ExecuteWorkflowRequest)(nil), // 9: api.ExecuteWorkflowRequest
-	(*GetExecutionRequest)(nil),    // 10: api.GetExecutionRequest
-	(*ListExecutionsRequest)(nil),  // 11: api.ListExecutionsRequest
-	(*ListExecutionsResponse)(nil), // 12: api.ListExecutionsResponse
-	(*CancelExecutionRequest)(nil), // 13: api.CancelExecutionRequest
-	(*structpb.Struct)(nil),        // 14: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),  // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),          // 16: google.protobuf.Empty
+	(*Workflow)(nil),                   // 0: api.Workflow
+	(*WorkflowStep)(nil),               // 1: api.WorkflowStep
+	(*WorkflowExecution)(nil),          // 2: api.WorkflowExecution
+	(*CreateWorkflowRequest)(nil),      // 3: api.CreateWorkflowRequest
+	(*ListWorkflowsRequest)(nil),       // 4: api.ListWorkflowsRequest
+	(*ListWorkflowsResponse)(nil),      // 5: api.ListWorkflowsResponse
+	(*GetWorkflowRequest)(nil),         // 6: api.GetWorkflowRequest
+	(*UpdateWorkflowRequest)(nil),      // 7: api.UpdateWorkflowRequest
+	(*DeleteWorkflowRequest)(nil),      // 8: api.DeleteWorkflowRequest
+	(*ExecuteWorkflowRequest)(nil),     // 9: api.ExecuteWorkflowRequest
+	(*GetExecutionRequest)(nil),        // 10: api.GetExecutionRequest
+	(*ListExecutionsRequest)(nil),      // 11: api.ListExecutionsRequest
+	(*ListExecutionsResponse)(nil),     // 12: api.ListExecutionsResponse
+	(*CancelExecutionRequest)(nil),     // 13: api.CancelExecutionRequest
+	(*EvaluateExpressionRequest)(nil),  // 14: api.EvaluateExpressionRequest
+	(*EvaluateExpressionResponse)(nil), // 15: api.EvaluateExpressionResponse
+	(*structpb.Struct)(nil),            // 16: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+	(*structpb.Value)(nil),             // 18: google.protobuf.Value
+	(*emptypb.Empty)(nil),              // 19: google.protobuf.Empty
 }
 var file_workflow_proto_depIdxs = []int32{
 	1,  // 0: api.Workflow.steps:type_name -> api.WorkflowStep
-	14, // 1: api.Workflow.config:type_name -> google.protobuf.Struct
-	15, // 2: api.Workflow.created_at:type_name -> google.protobuf.Timestamp
-	15, // 3: api.Workflow.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 4: api.WorkflowStep.config:type_name -> google.protobuf.Struct
-	14, // 5: api.WorkflowStep.condition:type_name -> google.protobuf.Struct
-	14, // 6: api.WorkflowExecution.input:type_name -> google.protobuf.Struct
-	14, // 7: api.WorkflowExecution.output:type_name -> google.protobuf.Struct
-	14, // 8: api.WorkflowExecution.context:type_name -> google.protobuf.Struct
-	15, // 9: api.WorkflowExecution.started_at:type_name -> google.protobuf.Timestamp
-	15, // 10: api.WorkflowExecution.completed_at:type_name -> google.protobuf.Timestamp
-	15, // 11: api.WorkflowExecution.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 1: api.Workflow.config:type_name -> google.protobuf.Struct
+	17, // 2: api.Workflow.created_at:type_name -> google.protobuf.Timestamp
+	17, // 3: api.Workflow.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 4: api.WorkflowStep.config:type_name -> google.protobuf.Struct
+	16, // 5: api.WorkflowStep.condition:type_name -> google.protobuf.Struct
+	16, // 6: api.WorkflowExecution.input:type_name -> google.protobuf.Struct
+	16, // 7: api.WorkflowExecution.output:type_name -> google.protobuf.Struct
+	16, // 8: api.WorkflowExecution.context:type_name -> google.protobuf.Struct
+	17, // 9: api.WorkflowExecution.started_at:type_name -> google.protobuf.Timestamp
+	17, // 10: api.WorkflowExecution.completed_at:type_name -> google.protobuf.Timestamp
+	17, // 11: api.WorkflowExecution.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 12: api.CreateWorkflowRequest.steps:type_name -> api.WorkflowStep
-	14, // 13: api.CreateWorkflowRequest.config:type_name -> google.protobuf.Struct
+	16, // 13: api.CreateWorkflowRequest.config:type_name -> google.protobuf.Struct
 	0,  // 14: api.ListWorkflowsResponse.items:type_name -> api.Workflow
 	1,  // 15: api.UpdateWorkflowRequest.steps:type_name -> api.WorkflowStep
-	14, // 16: api.UpdateWorkflowRequest.config:type_name -> google.protobuf.Struct
-	14, // 17: api.ExecuteWorkflowRequest.input:type_name -> google.protobuf.Struct
+	16, // 16: api.UpdateWorkflowRequest.config:type_name -> google.protobuf.Struct
+	16, // 17: api.ExecuteWorkflowRequest.input:type_name -> google.protobuf.Struct
 	2,  // 18: api.ListExecutionsResponse.items:type_name -> api.WorkflowExecution
-	3,  // 19: api.WorkflowService.CreateWorkflow:input_type -> api.CreateWorkflowRequest
-	4,  // 20: api.WorkflowService.ListWorkflows:input_type -> api.ListWorkflowsRequest
-	6,  // 21: api.WorkflowService.GetWorkflow:input_type -> api.GetWorkflowRequest
-	7,  // 22: api.WorkflowService.UpdateWorkflow:input_type -> api.UpdateWorkflowRequest
-	8,  // 23: api.WorkflowService.DeleteWorkflow:input_type -> api.DeleteWorkflowRequest
-	9,  // 24: api.WorkflowService.ExecuteWorkflow:input_type -> api.ExecuteWorkflowRequest
-	10, // 25: api.WorkflowService.GetExecution:input_type -> api.GetExecutionRequest
-	11, // 26: api.WorkflowService.ListExecutions:input_type -> api.ListExecutionsRequest
-	13, // 27: api.WorkflowService.CancelExecution:input_type -> api.CancelExecutionRequest
-	0,  // 28: api.WorkflowService.CreateWorkflow:output_type -> api.Workflow
-	5,  // 29: api.WorkflowService.ListWorkflows:output_type -> api.ListWorkflowsResponse
-	0,  // 30: api.WorkflowService.GetWorkflow:output_type -> api.Workflow
-	0,  // 31: api.WorkflowService.UpdateWorkflow:output_type -> api.Workflow
-	16, // 32: api.WorkflowService.DeleteWorkflow:output_type -> google.protobuf.Empty
-	2,  // 33: api.WorkflowService.ExecuteWorkflow:output_type -> api.WorkflowExecution
-	2,  // 34: api.WorkflowService.GetExecution:output_type -> api.WorkflowExecution
-	12, // 35: api.WorkflowService.ListExecutions:output_type -> api.ListExecutionsResponse
-	2,  // 36: api.WorkflowService.CancelExecution:output_type -> api.WorkflowExecution
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	16, // 19: api.EvaluateExpressionRequest.input:type_name -> google.protobuf.Struct
+	16, // 20: api.EvaluateExpressionRequest.steps:type_name -> google.protobuf.Struct
+	16, // 21: api.EvaluateExpressionRequest.config:type_name -> google.protobuf.Struct
+	18, // 22: api.EvaluateExpressionResponse.value:type_name -> google.protobuf.Value
+	3,  // 23: api.WorkflowService.CreateWorkflow:input_type -> api.CreateWorkflowRequest
+	4,  // 24: api.WorkflowService.ListWorkflows:input_type -> api.ListWorkflowsRequest
+	6,  // 25: api.WorkflowService.GetWorkflow:input_type -> api.GetWorkflowRequest
+	7,  // 26: api.WorkflowService.UpdateWorkflow:input_type -> api.UpdateWorkflowRequest
+	8,  // 27: api.WorkflowService.DeleteWorkflow:input_type -> api.DeleteWorkflowRequest
+	9,  // 28: api.WorkflowService.ExecuteWorkflow:input_type -> api.ExecuteWorkflowRequest
+	10, // 29: api.WorkflowService.GetExecution:input_type -> api.GetExecutionRequest
+	11, // 30: api.WorkflowService.ListExecutions:input_type -> api.ListExecutionsRequest
+	13, // 31: api.WorkflowService.CancelExecution:input_type -> api.CancelExecutionRequest
+	14, // 32: api.WorkflowService.EvaluateExpression:input_type -> api.EvaluateExpressionRequest
+	0,  // 33: api.WorkflowService.CreateWorkflow:output_type -> api.Workflow
+	5,  // 34: api.WorkflowService.ListWorkflows:output_type -> api.ListWorkflowsResponse
+	0,  // 35: api.WorkflowService.GetWorkflow:output_type -> api.Workflow
+	0,  // 36: api.WorkflowService.UpdateWorkflow:output_type -> api.Workflow
+	19, // 37: api.WorkflowService.DeleteWorkflow:output_type -> google.protobuf.Empty
+	2,  // 38: api.WorkflowService.ExecuteWorkflow:output_type -> api.WorkflowExecution
+	2,  // 39: api.WorkflowService.GetExecution:output_type -> api.WorkflowExecution
+	12, // 40: api.WorkflowService.ListExecutions:output_type -> api.ListExecutionsResponse
+	2,  // 41: api.WorkflowService.CancelExecution:output_type -> api.WorkflowExecution
+	15, // 42: api.WorkflowService.EvaluateExpression:output_type -> api.EvaluateExpressionResponse
+	33, // [33:43] is the sub-list for method output_type
+	23, // [23:33] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_workflow_proto_init() }
@@ -1203,7 +1376,7 @@ func file_workflow_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_workflow_proto_rawDesc), len(file_workflow_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
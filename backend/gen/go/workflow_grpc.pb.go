@@ -20,15 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkflowService_CreateWorkflow_FullMethodName  = "/api.WorkflowService/CreateWorkflow"
-	WorkflowService_ListWorkflows_FullMethodName   = "/api.WorkflowService/ListWorkflows"
-	WorkflowService_GetWorkflow_FullMethodName     = "/api.WorkflowService/GetWorkflow"
-	WorkflowService_UpdateWorkflow_FullMethodName  = "/api.WorkflowService/UpdateWorkflow"
-	WorkflowService_DeleteWorkflow_FullMethodName  = "/api.WorkflowService/DeleteWorkflow"
-	WorkflowService_ExecuteWorkflow_FullMethodName = "/api.WorkflowService/ExecuteWorkflow"
-	WorkflowService_GetExecution_FullMethodName    = "/api.WorkflowService/GetExecution"
-	WorkflowService_ListExecutions_FullMethodName  = "/api.WorkflowService/ListExecutions"
-	WorkflowService_CancelExecution_FullMethodName = "/api.WorkflowService/CancelExecution"
+	WorkflowService_CreateWorkflow_FullMethodName     = "/api.WorkflowService/CreateWorkflow"
+	WorkflowService_ListWorkflows_FullMethodName      = "/api.WorkflowService/ListWorkflows"
+	WorkflowService_GetWorkflow_FullMethodName        = "/api.WorkflowService/GetWorkflow"
+	WorkflowService_UpdateWorkflow_FullMethodName     = "/api.WorkflowService/UpdateWorkflow"
+	WorkflowService_DeleteWorkflow_FullMethodName     = "/api.WorkflowService/DeleteWorkflow"
+	WorkflowService_ExecuteWorkflow_FullMethodName    = "/api.WorkflowService/ExecuteWorkflow"
+	WorkflowService_GetExecution_FullMethodName       = "/api.WorkflowService/GetExecution"
+	WorkflowService_ListExecutions_FullMethodName     = "/api.WorkflowService/ListExecutions"
+	WorkflowService_CancelExecution_FullMethodName    = "/api.WorkflowService/CancelExecution"
+	WorkflowService_EvaluateExpression_FullMethodName = "/api.WorkflowService/EvaluateExpression"
 )
 
 // WorkflowServiceClient is the client API for WorkflowService service.
@@ -55,6 +56,8 @@ type WorkflowServiceClient interface {
 	ListExecutions(ctx context.Context, in *ListExecutionsRequest, opts ...grpc.CallOption) (*ListExecutionsResponse, error)
 	// Cancel execution
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	// Evaluate an expression of conditions and input mappings, to try it while authoring
+	EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error)
 }
 
 type workflowServiceClient struct {
@@ -155,6 +158,16 @@ func (c *workflowServiceClient) CancelExecution(ctx context.Context, in *CancelE
 	return out, nil
 }
 
+func (c *workflowServiceClient) EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateExpressionResponse)
+	err := c.cc.Invoke(ctx, WorkflowService_EvaluateExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowServiceServer is the server API for WorkflowService service.
 // All implementations must embed UnimplementedWorkflowServiceServer
 // for forward compatibility.
@@ -179,6 +192,8 @@ type WorkflowServiceServer interface {
 	ListExecutions(context.Context, *ListExecutionsRequest) (*ListExecutionsResponse, error)
 	// Cancel execution
 	CancelExecution(context.Context, *CancelExecutionRequest) (*WorkflowExecution, error)
+	// Evaluate an expression of conditions and input mappings, to try it while authoring
+	EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error)
 	mustEmbedUnimplementedWorkflowServiceServer()
 }
 
@@ -216,6 +231,9 @@ func (UnimplementedWorkflowServiceServer) ListExecutions(context.Context, *ListE
 func (UnimplementedWorkflowServiceServer) CancelExecution(context.Context, *CancelExecutionRequest) (*WorkflowExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelExecution not implemented")
 }
+func (UnimplementedWorkflowServiceServer) EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateExpression not implemented")
+}
 func (UnimplementedWorkflowServiceServer) mustEmbedUnimplementedWorkflowServiceServer() {}
 func (UnimplementedWorkflowServiceServer) testEmbeddedByValue()                         {}
 
@@ -399,6 +417,24 @@ func _WorkflowService_CancelExecution_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_EvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).EvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowService_EvaluateExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).EvaluateExpression(ctx, req.(*EvaluateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkflowService_ServiceDesc is the grpc.ServiceDesc for WorkflowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -442,6 +478,10 @@ var WorkflowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _WorkflowService_CancelExecution_Handler,
 		},
+		{
+			MethodName: "EvaluateExpression",
+			Handler:    _WorkflowService_EvaluateExpression_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workflow.proto",
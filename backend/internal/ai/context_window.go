@@ -0,0 +1,88 @@
+package ai
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ModelInfo describes the token limits of a model
+type ModelInfo struct {
+	ContextWindow   int // Maximum tokens for prompt plus completion
+	MaxOutputTokens int // Default number of tokens reserved for the completion
+}
+
+// DefaultModelInfo is used for models without known limits
+var DefaultModelInfo = ModelInfo{ContextWindow: 8192, MaxOutputTokens: 1024}
+
+// modelInfos maps model name prefixes (lowercase) to their limits.
+// Longer prefixes are matched first, so specific entries override generic ones.
+var modelInfos = map[string]ModelInfo{
+	"gpt-4o":                  {ContextWindow: 128000, MaxOutputTokens: 4096},
+	"gpt-4-turbo":             {ContextWindow: 128000, MaxOutputTokens: 4096},
+	"gpt-4-32k":               {ContextWindow: 32768, MaxOutputTokens: 2048},
+	"gpt-4":                   {ContextWindow: 8192, MaxOutputTokens: 1024},
+	"gpt-3.5-turbo":           {ContextWindow: 16385, MaxOutputTokens: 2048},
+	"deepseek-ai/deepseek-v3": {ContextWindow: 64000, MaxOutputTokens: 4096},
+	"deepseek-ai/deepseek-r1": {ContextWindow: 64000, MaxOutputTokens: 8192},
+	"deepseek-chat":           {ContextWindow: 64000, MaxOutputTokens: 4096},
+	"claude-3":                {ContextWindow: 200000, MaxOutputTokens: 4096},
+}
+
+// GetModelInfo returns the token limits for a model
+func GetModelInfo(model string) ModelInfo {
+	modelLower := strings.ToLower(model)
+
+	best := ""
+	for prefix := range modelInfos {
+		if strings.HasPrefix(modelLower, prefix) && len(prefix) > len(best) {
+			best = prefix
+		}
+	}
+	if best == "" {
+		return DefaultModelInfo
+	}
+
+	return modelInfos[best]
+}
+
+// messageOverheadTokens approximates the per-message framing tokens added by chat formats
+const messageOverheadTokens = 4
+
+// EstimateTokens approximates the token count of a text without a model-specific tokenizer.
+// Latin text averages about four characters per token, while CJK characters are
+// typically one token each.
+func EstimateTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+
+	cjk, other := 0, 0
+	for _, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			cjk++
+		} else {
+			other += utf8.RuneLen(r)
+		}
+	}
+
+	return cjk + (other+3)/4
+}
+
+// EstimateMessageTokens approximates the token count of a single chat message
+func EstimateMessageTokens(msg Message) int {
+	tokens := EstimateTokens(msg.Content) + messageOverheadTokens
+	for _, call := range msg.ToolCalls {
+		tokens += EstimateTokens(call.Name) + EstimateTokens(call.Arguments)
+	}
+	return tokens
+}
+
+// EstimateMessagesTokens approximates the token count of a list of chat messages
+func EstimateMessagesTokens(messages []Message) int {
+	total := 0
+	for _, msg := range messages {
+		total += EstimateMessageTokens(msg)
+	}
+	return total
+}
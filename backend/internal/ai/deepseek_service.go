@@ -31,15 +31,9 @@ func NewDeepSeekService(apiKey, apiBase, defaultModel string, logger *zap.Logger
 }
 
 // Chat sends a chat request to DeepSeek and returns the response
-func (s *DeepSeekService) Chat(request ChatRequest) (*ChatResponse, error) {
+func (s *DeepSeekService) Chat(ctx context.Context, request ChatRequest) (*ChatResponse, error) {
 	// Convert messages to OpenAI format
-	messages := make([]openai.ChatCompletionMessage, len(request.Messages))
-	for i, msg := range request.Messages {
-		messages[i] = openai.ChatCompletionMessage{
-			Role:    msg.Role,
-			Content: msg.Content,
-		}
-	}
+	messages := toOpenAIMessages(request.Messages)
 
 	// Set default model if not specified
 	model := request.Model
@@ -58,6 +52,7 @@ func (s *DeepSeekService) Chat(request ChatRequest) (*ChatResponse, error) {
 		Model:       model,
 		Messages:    messages,
 		Temperature: temperature,
+		Tools:       toOpenAITools(request.Tools),
 	}
 
 	if request.MaxTokens > 0 {
@@ -70,7 +65,7 @@ func (s *DeepSeekService) Chat(request ChatRequest) (*ChatResponse, error) {
 	)
 
 	// Send request
-	resp, err := s.client.CreateChatCompletion(context.Background(), req)
+	resp, err := s.client.CreateChatCompletion(ctx, req)
 	if err != nil {
 		s.logger.Error("Failed to create chat completion", zap.Any("dsclient", s), zap.Error(err))
 		return nil, fmt.Errorf("failed to create chat completion: %w", err)
@@ -83,15 +78,18 @@ func (s *DeepSeekService) Chat(request ChatRequest) (*ChatResponse, error) {
 
 	choice := resp.Choices[0]
 	return &ChatResponse{
-		Content:      choice.Message.Content,
-		FinishReason: string(choice.FinishReason),
-		TokensUsed:   resp.Usage.TotalTokens,
-		Model:        resp.Model,
+		Content:          choice.Message.Content,
+		FinishReason:     string(choice.FinishReason),
+		TokensUsed:       resp.Usage.TotalTokens,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+		Model:            resp.Model,
+		ToolCalls:        fromOpenAIToolCalls(choice.Message.ToolCalls),
 	}, nil
 }
 
 // ChatStream sends a streaming chat request to DeepSeek
-func (s *DeepSeekService) ChatStream(request ChatRequest) (<-chan string, <-chan error) {
+func (s *DeepSeekService) ChatStream(ctx context.Context, request ChatRequest) (<-chan string, <-chan error) {
 	contentChan := make(chan string)
 	errChan := make(chan error, 1)
 
@@ -100,13 +98,7 @@ func (s *DeepSeekService) ChatStream(request ChatRequest) (<-chan string, <-chan
 		defer close(errChan)
 
 		// Convert messages to OpenAI format
-		messages := make([]openai.ChatCompletionMessage, len(request.Messages))
-		for i, msg := range request.Messages {
-			messages[i] = openai.ChatCompletionMessage{
-				Role:    msg.Role,
-				Content: msg.Content,
-			}
-		}
+		messages := toOpenAIMessages(request.Messages)
 
 		// Set default model if not specified
 		model := request.Model
@@ -138,7 +130,7 @@ func (s *DeepSeekService) ChatStream(request ChatRequest) (<-chan string, <-chan
 		)
 
 		// Send streaming request
-		stream, err := s.client.CreateChatCompletionStream(context.Background(), req)
+		stream, err := s.client.CreateChatCompletionStream(ctx, req)
 		if err != nil {
 			s.logger.Error("Failed to create chat completion stream", zap.Error(err))
 			errChan <- fmt.Errorf("failed to create chat completion stream: %w", err)
@@ -161,7 +153,13 @@ func (s *DeepSeekService) ChatStream(request ChatRequest) (<-chan string, <-chan
 			if len(response.Choices) > 0 {
 				delta := response.Choices[0].Delta.Content
 				if delta != "" {
-					contentChan <- delta
+					// Stop when the consumer went away instead of blocking forever
+					select {
+					case contentChan <- delta:
+					case <-ctx.Done():
+						errChan <- ctx.Err()
+						return
+					}
 				}
 			}
 		}
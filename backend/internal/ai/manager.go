@@ -2,6 +2,7 @@ package ai
 
 import (
 	"agent-platform/internal/config"
+	"context"
 	"fmt"
 	"strings"
 
@@ -155,18 +156,44 @@ func (m *Manager) GetService(model string) (AIService, error) {
 	return nil, fmt.Errorf("no AI service available for model: %s", model)
 }
 
+// CheckModel returns an error when no configured provider serves the model. Unlike
+// GetService it does not fall back to the default provider.
+func (m *Manager) CheckModel(model string) error {
+	for providerName, providerCfg := range m.config.Providers {
+		if _, ok := m.services[providerName]; !ok {
+			continue
+		}
+		for _, supportedModel := range providerCfg.Models {
+			if supportedModel == model {
+				return nil
+			}
+		}
+	}
+
+	// Models routed by name only need their provider to be configured
+	modelLower := strings.ToLower(model)
+	for _, pattern := range []string{"deepseek", "claude", "gpt", "text-"} {
+		if strings.Contains(modelLower, pattern) {
+			_, err := m.GetService(model)
+			return err
+		}
+	}
+
+	return fmt.Errorf("model %s is not served by any configured provider", model)
+}
+
 // Chat is a convenience method that routes to the appropriate service
-func (m *Manager) Chat(request ChatRequest) (*ChatResponse, error) {
+func (m *Manager) Chat(ctx context.Context, request ChatRequest) (*ChatResponse, error) {
 	service, err := m.GetService(request.Model)
 	if err != nil {
 		return nil, err
 	}
 
-	return service.Chat(request)
+	return service.Chat(ctx, request)
 }
 
 // ChatStream is a convenience method that routes to the appropriate service
-func (m *Manager) ChatStream(request ChatRequest) (<-chan string, <-chan error) {
+func (m *Manager) ChatStream(ctx context.Context, request ChatRequest) (<-chan string, <-chan error) {
 	service, err := m.GetService(request.Model)
 	if err != nil {
 		errChan := make(chan error, 1)
@@ -177,5 +204,5 @@ func (m *Manager) ChatStream(request ChatRequest) (<-chan string, <-chan error)
 		return contentChan, errChan
 	}
 
-	return service.ChatStream(request)
+	return service.ChatStream(ctx, request)
 }
@@ -25,15 +25,9 @@ func NewOpenAIService(apiKey string, logger *zap.Logger) *OpenAIService {
 }
 
 // Chat sends a chat request to OpenAI and returns the response
-func (s *OpenAIService) Chat(request ChatRequest) (*ChatResponse, error) {
+func (s *OpenAIService) Chat(ctx context.Context, request ChatRequest) (*ChatResponse, error) {
 	// Convert messages to OpenAI format
-	messages := make([]openai.ChatCompletionMessage, len(request.Messages))
-	for i, msg := range request.Messages {
-		messages[i] = openai.ChatCompletionMessage{
-			Role:    msg.Role,
-			Content: msg.Content,
-		}
-	}
+	messages := toOpenAIMessages(request.Messages)
 
 	// Set default model if not specified
 	model := request.Model
@@ -52,6 +46,7 @@ func (s *OpenAIService) Chat(request ChatRequest) (*ChatResponse, error) {
 		Model:       model,
 		Messages:    messages,
 		Temperature: temperature,
+		Tools:       toOpenAITools(request.Tools),
 	}
 
 	if request.MaxTokens > 0 {
@@ -64,7 +59,7 @@ func (s *OpenAIService) Chat(request ChatRequest) (*ChatResponse, error) {
 	)
 
 	// Send request
-	resp, err := s.client.CreateChatCompletion(context.Background(), req)
+	resp, err := s.client.CreateChatCompletion(ctx, req)
 	if err != nil {
 		s.logger.Error("Failed to create chat completion", zap.Error(err))
 		return nil, fmt.Errorf("failed to create chat completion: %w", err)
@@ -77,15 +72,18 @@ func (s *OpenAIService) Chat(request ChatRequest) (*ChatResponse, error) {
 
 	choice := resp.Choices[0]
 	return &ChatResponse{
-		Content:      choice.Message.Content,
-		FinishReason: string(choice.FinishReason),
-		TokensUsed:   resp.Usage.TotalTokens,
-		Model:        resp.Model,
+		Content:          choice.Message.Content,
+		FinishReason:     string(choice.FinishReason),
+		TokensUsed:       resp.Usage.TotalTokens,
+		PromptTokens:     resp.Usage.PromptTokens,
+		CompletionTokens: resp.Usage.CompletionTokens,
+		Model:            resp.Model,
+		ToolCalls:        fromOpenAIToolCalls(choice.Message.ToolCalls),
 	}, nil
 }
 
 // ChatStream sends a streaming chat request to OpenAI
-func (s *OpenAIService) ChatStream(request ChatRequest) (<-chan string, <-chan error) {
+func (s *OpenAIService) ChatStream(ctx context.Context, request ChatRequest) (<-chan string, <-chan error) {
 	contentChan := make(chan string)
 	errChan := make(chan error, 1)
 
@@ -94,13 +92,7 @@ func (s *OpenAIService) ChatStream(request ChatRequest) (<-chan string, <-chan e
 		defer close(errChan)
 
 		// Convert messages to OpenAI format
-		messages := make([]openai.ChatCompletionMessage, len(request.Messages))
-		for i, msg := range request.Messages {
-			messages[i] = openai.ChatCompletionMessage{
-				Role:    msg.Role,
-				Content: msg.Content,
-			}
-		}
+		messages := toOpenAIMessages(request.Messages)
 
 		// Set default model if not specified
 		model := request.Model
@@ -132,7 +124,7 @@ func (s *OpenAIService) ChatStream(request ChatRequest) (<-chan string, <-chan e
 		)
 
 		// Send streaming request
-		stream, err := s.client.CreateChatCompletionStream(context.Background(), req)
+		stream, err := s.client.CreateChatCompletionStream(ctx, req)
 		if err != nil {
 			s.logger.Error("Failed to create chat completion stream", zap.Error(err))
 			errChan <- fmt.Errorf("failed to create chat completion stream: %w", err)
@@ -155,7 +147,13 @@ func (s *OpenAIService) ChatStream(request ChatRequest) (<-chan string, <-chan e
 			if len(response.Choices) > 0 {
 				delta := response.Choices[0].Delta.Content
 				if delta != "" {
-					contentChan <- delta
+					// Stop when the consumer went away instead of blocking forever
+					select {
+					case contentChan <- delta:
+					case <-ctx.Done():
+						errChan <- ctx.Err()
+						return
+					}
 				}
 			}
 		}
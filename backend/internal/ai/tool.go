@@ -0,0 +1,21 @@
+package ai
+
+import (
+	"regexp"
+	"strings"
+)
+
+// functionNameInvalid matches the characters models do not accept in function names
+var functionNameInvalid = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// FunctionName turns a tool name into a function name models accept
+func FunctionName(name string) string {
+	fn := strings.Trim(functionNameInvalid.ReplaceAllString(name, "_"), "_")
+	if fn == "" {
+		fn = "tool"
+	}
+	if len(fn) > 64 {
+		fn = fn[:64]
+	}
+	return fn
+}
@@ -0,0 +1,127 @@
+package ai
+
+import "fmt"
+
+// TruncationStrategy determines which history messages are kept when a conversation
+// does not fit into the model's context window
+type TruncationStrategy string
+
+const (
+	// StrategySlidingWindow keeps the most recent messages
+	StrategySlidingWindow TruncationStrategy = "sliding_window"
+	// StrategyKeepFirstAndLast keeps the opening messages plus the most recent ones
+	StrategyKeepFirstAndLast TruncationStrategy = "keep_first_and_last"
+	// StrategyTokenBudget keeps as many recent messages as fit into the token budget
+	StrategyTokenBudget TruncationStrategy = "token_budget"
+)
+
+// ParseTruncationStrategy validates a strategy name; empty selects the token budget strategy
+func ParseTruncationStrategy(name string) (TruncationStrategy, error) {
+	switch TruncationStrategy(name) {
+	case "":
+		return StrategyTokenBudget, nil
+	case StrategySlidingWindow, StrategyKeepFirstAndLast, StrategyTokenBudget:
+		return TruncationStrategy(name), nil
+	default:
+		return "", fmt.Errorf("unknown context strategy: %s", name)
+	}
+}
+
+// TruncateOptions configures history truncation
+type TruncateOptions struct {
+	Strategy    TruncationStrategy
+	MaxMessages int // Maximum history messages kept by sliding_window and keep_first_and_last (0 = unlimited)
+	KeepFirst   int // Leading messages always kept by keep_first_and_last
+	TokenBudget int // Maximum tokens for the kept history (0 = unlimited)
+}
+
+// TruncateHistory selects the history messages to send to the model. Every strategy
+// honours the token budget, and the kept messages stay in their original order.
+func TruncateHistory(history []Message, opts TruncateOptions) []Message {
+	var kept []Message
+
+	switch opts.Strategy {
+	case StrategySlidingWindow:
+		kept = lastMessages(history, opts.MaxMessages)
+
+	case StrategyKeepFirstAndLast:
+		first := opts.KeepFirst
+		if first > len(history) {
+			first = len(history)
+		}
+		if opts.MaxMessages > 0 && first > opts.MaxMessages {
+			first = opts.MaxMessages
+		}
+
+		// The leading messages may take up the whole window
+		rest := history[first:]
+		if opts.MaxMessages > 0 {
+			if keep := opts.MaxMessages - first; keep > 0 {
+				rest = lastMessages(rest, keep)
+			} else {
+				rest = nil
+			}
+		}
+
+		head := fitFromStart(history[:first], opts.TokenBudget)
+		budget := opts.TokenBudget
+		if budget > 0 {
+			budget -= EstimateMessagesTokens(head)
+			if budget <= 0 {
+				return head
+			}
+		}
+
+		return append(append([]Message{}, head...), fitFromEnd(rest, budget)...)
+
+	default:
+		kept = history
+	}
+
+	return fitFromEnd(kept, opts.TokenBudget)
+}
+
+// lastMessages returns the last n messages (all when n <= 0)
+func lastMessages(messages []Message, n int) []Message {
+	if n <= 0 || len(messages) <= n {
+		return messages
+	}
+	return messages[len(messages)-n:]
+}
+
+// fitFromEnd keeps the longest suffix of messages that fits into budget tokens (all when budget <= 0)
+func fitFromEnd(messages []Message, budget int) []Message {
+	if budget <= 0 {
+		return messages
+	}
+
+	used := 0
+	start := len(messages)
+	for start > 0 {
+		tokens := EstimateMessageTokens(messages[start-1])
+		if used+tokens > budget {
+			break
+		}
+		used += tokens
+		start--
+	}
+
+	return messages[start:]
+}
+
+// fitFromStart keeps the longest prefix of messages that fits into budget tokens (all when budget <= 0)
+func fitFromStart(messages []Message, budget int) []Message {
+	if budget <= 0 {
+		return messages
+	}
+
+	used := 0
+	for i, msg := range messages {
+		used += EstimateMessageTokens(msg)
+		if used > budget {
+			return messages[:i]
+		}
+	}
+
+	return messages
+}
@@ -1,9 +1,27 @@
 package ai
 
+import "context"
+
 // Message represents a chat message
 type Message struct {
-	Role    string // system, user, assistant
-	Content string
+	Role       string // system, user, assistant, tool
+	Content    string
+	ToolCalls  []ToolCall // Tools an assistant message calls
+	ToolCallID string     // Call a tool message answers
+}
+
+// Tool is a function the model may call
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{} // JSON schema of the arguments
+}
+
+// ToolCall is a model's request to call a tool
+type ToolCall struct {
+	ID        string
+	Name      string
+	Arguments string // JSON encoded arguments
 }
 
 // ChatRequest represents a request to generate a chat response
@@ -13,18 +31,23 @@ type ChatRequest struct {
 	Temperature float32
 	MaxTokens   int
 	Stream      bool
+	Tools       []Tool // Tools the model may call, only honoured by Chat
 }
 
 // ChatResponse represents a response from the AI model
 type ChatResponse struct {
-	Content      string
-	FinishReason string
-	TokensUsed   int
-	Model        string
+	Content          string
+	FinishReason     string
+	TokensUsed       int
+	PromptTokens     int
+	CompletionTokens int
+	Model            string
+	ToolCalls        []ToolCall // Set when the model calls tools instead of answering
 }
 
-// AIService defines the interface for AI model interactions
+// AIService defines the interface for AI model interactions.
+// Requests are aborted when ctx is cancelled.
 type AIService interface {
-	Chat(request ChatRequest) (*ChatResponse, error)
-	ChatStream(request ChatRequest) (<-chan string, <-chan error)
+	Chat(ctx context.Context, request ChatRequest) (*ChatResponse, error)
+	ChatStream(ctx context.Context, request ChatRequest) (<-chan string, <-chan error)
 }
@@ -65,6 +65,7 @@ type AIConfig struct {
 	EmbeddingModel     string                       // Model to use for embeddings
 	EmbeddingDimension int                          // Dimension of embeddings
 	EmbeddingProvider  string                       // Provider to use for embeddings
+	TitleModel         string                       // Model used to title conversations (empty = the agent's model)
 }
 
 type CORSConfig struct {
@@ -191,6 +192,7 @@ func loadAIConfig(embeddingDim int) AIConfig {
 		EmbeddingModel:     getEnv("EMBEDDING_MODEL", "text-embedding-ada-002"),
 		EmbeddingDimension: embeddingDim,
 		EmbeddingProvider:  getEnv("EMBEDDING_PROVIDER", "openai"),
+		TitleModel:         getEnv("TITLE_MODEL", ""),
 	}
 }
 
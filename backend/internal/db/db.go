@@ -4,8 +4,10 @@ import (
 	"agent-platform/internal/config"
 	"agent-platform/internal/model/ent"
 	"context"
+	"database/sql"
 	"fmt"
 
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 )
@@ -13,6 +15,7 @@ import (
 // Client wraps the ent client
 type Client struct {
 	*ent.Client
+	db     *sql.DB // Raw connection for migrations ent cannot express
 	logger *zap.Logger
 }
 
@@ -20,10 +23,11 @@ type Client struct {
 func NewClient(cfg *config.Config, logger *zap.Logger) (*Client, error) {
 	dsn := cfg.Postgres.DSN()
 
-	client, err := ent.Open("postgres", dsn)
+	drv, err := entsql.Open("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 
 	// Connection pool settings are configured via DSN parameters or driver config
 
@@ -35,6 +39,7 @@ func NewClient(cfg *config.Config, logger *zap.Logger) (*Client, error) {
 
 	return &Client{
 		Client: client,
+		db:     drv.DB(),
 		logger: logger,
 	}, nil
 }
@@ -47,6 +52,22 @@ func (c *Client) AutoMigrate(ctx context.Context) error {
 		return fmt.Errorf("failed creating schema resources: %w", err)
 	}
 
+	if err := c.migrateAgentFolderPaths(ctx); err != nil {
+		return err
+	}
+
+	if err := c.migrateLegacyMessages(ctx); err != nil {
+		return err
+	}
+
+	if err := c.migrateSearchIndexes(ctx); err != nil {
+		return err
+	}
+
+	if err := c.migrateMultiAgentType(ctx); err != nil {
+		return err
+	}
+
 	c.logger.Info("Database migrations completed successfully")
 	return nil
 }
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentversion"
+
+	"go.uber.org/zap"
+)
+
+// migrateMultiAgentType renames the "multi" agent type, used by supervisor agents before
+// they adopted the documented "multi-agent" type, in agents and their published versions.
+func (c *Client) migrateMultiAgentType(ctx context.Context) error {
+	agents, err := c.Agent.Update().
+		Where(agent.Type("multi")).
+		SetType("multi-agent").
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed migrating multi agent types: %w", err)
+	}
+
+	// Versions are immutable in the schema, so their type is updated directly
+	res, err := c.db.ExecContext(ctx,
+		"UPDATE "+agentversion.Table+" SET "+agentversion.FieldType+" = $1 WHERE "+agentversion.FieldType+" = $2",
+		"multi-agent", "multi")
+	if err != nil {
+		return fmt.Errorf("failed migrating multi agent version types: %w", err)
+	}
+	versions, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed migrating multi agent version types: %w", err)
+	}
+
+	if agents > 0 || versions > 0 {
+		c.logger.Info("Migrated multi agents to the multi-agent type",
+			zap.Int("agents", agents),
+			zap.Int64("versions", versions),
+		)
+	}
+
+	return nil
+}
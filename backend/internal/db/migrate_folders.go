@@ -0,0 +1,16 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// migrateAgentFolderPaths drops the unique constraint on agent folder paths. Folder paths
+// used to be unique across users and are now unique per owner, which the schema covers with
+// an index on (created_by, path). Auto migration leaves dropped indexes in place.
+func (c *Client) migrateAgentFolderPaths(ctx context.Context) error {
+	if _, err := c.db.ExecContext(ctx, "ALTER TABLE agent_folders DROP CONSTRAINT IF EXISTS agent_folders_path_key"); err != nil {
+		return fmt.Errorf("failed dropping unique agent folder path: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/conversation"
+	"agent-platform/internal/model/ent/message"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// migrateLegacyMessages moves messages stored in the deprecated JSON column of
+// conversations into the messages table. Conversations are migrated one at a time
+// and the JSON column is cleared afterwards, so the migration can be resumed.
+func (c *Client) migrateLegacyMessages(ctx context.Context) error {
+	ids, err := c.Conversation.Query().
+		Where(conversation.LegacyMessagesNotNil()).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying conversations with legacy messages: %w", err)
+	}
+
+	migrated := 0
+	for _, id := range ids {
+		n, err := c.migrateConversationMessages(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed migrating messages of conversation %s: %w", id, err)
+		}
+		migrated += n
+	}
+
+	if len(ids) > 0 {
+		c.logger.Info("Migrated legacy conversation messages",
+			zap.Int("conversations", len(ids)),
+			zap.Int("messages", migrated),
+		)
+	}
+
+	return nil
+}
+
+// migrateConversationMessages migrates the legacy messages of one conversation
+func (c *Client) migrateConversationMessages(ctx context.Context, id string) (int, error) {
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	conv, err := tx.Conversation.Get(ctx, id)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	// Skip conversations that already have rows, e.g. from an interrupted migration
+	exists, err := tx.Message.Query().
+		Where(message.ConversationID(id)).
+		Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	builders := []*ent.MessageCreate{}
+	parentID := ""
+	if !exists {
+		var previous time.Time
+		for _, raw := range conv.LegacyMessages {
+			msgMap, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			role, _ := msgMap["role"].(string)
+			content, _ := msgMap["content"].(string)
+			if role == "" {
+				continue
+			}
+			msgID, _ := msgMap["id"].(string)
+			if msgID == "" {
+				msgID = uuid.New().String()
+			}
+
+			// Legacy timestamps have second precision, keep the original order when they collide
+			createdAt := conv.CreatedAt
+			if ts, ok := msgMap["timestamp"].(float64); ok && ts > 0 {
+				createdAt = time.Unix(int64(ts), 0)
+			}
+			if !createdAt.After(previous) {
+				createdAt = previous.Add(time.Microsecond)
+			}
+			previous = createdAt
+
+			builder := tx.Message.Create().
+				SetID(msgID).
+				SetConversationID(id).
+				SetRole(role).
+				SetContent(content).
+				SetCreatedAt(createdAt).
+				SetUpdatedAt(createdAt)
+			if parentID != "" {
+				builder = builder.SetParentID(parentID)
+			}
+			builders = append(builders, builder)
+			parentID = msgID
+		}
+	}
+
+	if len(builders) > 0 {
+		if err := tx.Message.CreateBulk(builders...).Exec(ctx); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	// Keep updated_at so conversation ordering is unaffected by the migration
+	update := tx.Conversation.UpdateOneID(id).
+		ClearLegacyMessages().
+		SetUpdatedAt(conv.UpdatedAt)
+	if parentID != "" {
+		update = update.SetActiveMessageID(parentID)
+	}
+	if err := update.Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(builders), nil
+}
@@ -0,0 +1,24 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"agent-platform/internal/repository"
+)
+
+// migrateSearchIndexes creates the full-text indexes of conversation search, which ent
+// cannot declare. They index the expressions repository.ConversationRepository.Search
+// queries, so searching titles and message contents does not scan every row.
+func (c *Client) migrateSearchIndexes(ctx context.Context) error {
+	indexes := map[string]string{
+		"conversations_title_search": "conversations USING GIN (to_tsvector('" + repository.SearchConfig + "', title))",
+		"messages_content_search":    "messages USING GIN (to_tsvector('" + repository.SearchConfig + "', content))",
+	}
+	for name, definition := range indexes {
+		if _, err := c.db.ExecContext(ctx, "CREATE INDEX IF NOT EXISTS "+name+" ON "+definition); err != nil {
+			return fmt.Errorf("failed creating search index %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"agent-platform/internal/ai"
+	"agent-platform/internal/model/ent"
+)
+
+// Format is a conversation export format
+type Format string
+
+const (
+	// FormatMarkdown renders a human readable transcript
+	FormatMarkdown Format = "markdown"
+	// FormatJSON renders the conversation with all message fields
+	FormatJSON Format = "json"
+	// FormatOpenAI renders OpenAI fine-tuning JSONL, one conversation per line
+	FormatOpenAI Format = "openai_jsonl"
+)
+
+// ParseFormat validates a format name; empty selects Markdown
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case "":
+		return FormatMarkdown, nil
+	case FormatMarkdown, FormatJSON, FormatOpenAI:
+		return Format(name), nil
+	default:
+		return "", fmt.Errorf("unknown export format: %s", name)
+	}
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatJSON:
+		return "application/json"
+	case FormatOpenAI:
+		return "application/jsonl"
+	default:
+		return "text/markdown; charset=utf-8"
+	}
+}
+
+// Extension returns the file extension of the format
+func (f Format) Extension() string {
+	switch f {
+	case FormatJSON:
+		return "json"
+	case FormatOpenAI:
+		return "jsonl"
+	default:
+		return "md"
+	}
+}
+
+// Transcript is a conversation with everything needed to export it
+type Transcript struct {
+	Conversation *ent.Conversation
+	Agent        *ent.Agent // May be nil when the agent was deleted
+	Tools        []*ent.Tool
+	Messages     []*ent.Message // Active branch, oldest first
+	// SystemPrompt is the agent's rendered prompt, empty when the exporter may not see it
+	SystemPrompt string
+}
+
+// Render exports a single conversation
+func Render(format Format, t *Transcript) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(toJSON(t), "", "  ")
+	case FormatOpenAI:
+		return renderOpenAI([]*Transcript{t})
+	default:
+		return []byte(renderMarkdown(t)), nil
+	}
+}
+
+// RenderAll exports several conversations into one document
+func RenderAll(format Format, transcripts []*Transcript) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		items := make([]jsonConversation, len(transcripts))
+		for i, t := range transcripts {
+			items[i] = toJSON(t)
+		}
+		return json.MarshalIndent(items, "", "  ")
+	case FormatOpenAI:
+		return renderOpenAI(transcripts)
+	default:
+		parts := make([]string, len(transcripts))
+		for i, t := range transcripts {
+			parts[i] = renderMarkdown(t)
+		}
+		return []byte(strings.Join(parts, "\n---\n\n")), nil
+	}
+}
+
+// renderMarkdown renders a conversation as a Markdown transcript
+func renderMarkdown(t *Transcript) string {
+	var b strings.Builder
+	conv := t.Conversation
+
+	fmt.Fprintf(&b, "# %s\n\n", conv.Title)
+	if t.Agent != nil {
+		fmt.Fprintf(&b, "- Agent: %s\n", t.Agent.Name)
+	} else {
+		fmt.Fprintf(&b, "- Agent: %s\n", conv.AgentID)
+	}
+	fmt.Fprintf(&b, "- Conversation: %s\n", conv.ID)
+	fmt.Fprintf(&b, "- Created: %s\n\n", conv.CreatedAt.Format(time.RFC3339))
+
+	for _, msg := range t.Messages {
+		fmt.Fprintf(&b, "### %s (%s)\n\n%s\n\n", roleTitle(msg.Role), msg.CreatedAt.Format(time.RFC3339), msg.Content)
+	}
+
+	return b.String()
+}
+
+// roleTitle returns the heading used for a message role
+func roleTitle(role string) string {
+	switch role {
+	case "user":
+		return "User"
+	case "assistant":
+		return "Assistant"
+	case "system":
+		return "System"
+	default:
+		return role
+	}
+}
+
+type jsonConversation struct {
+	ID           string                 `json:"id"`
+	Title        string                 `json:"title"`
+	AgentID      string                 `json:"agent_id"`
+	AgentName    string                 `json:"agent_name,omitempty"`
+	UserID       string                 `json:"user_id"`
+	Status       string                 `json:"status"`
+	SystemPrompt string                 `json:"system_prompt,omitempty"`
+	Context      map[string]interface{} `json:"context,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+	Messages     []jsonMessage          `json:"messages"`
+}
+
+type jsonMessage struct {
+	ID               string                 `json:"id"`
+	ParentID         string                 `json:"parent_id,omitempty"`
+	Role             string                 `json:"role"`
+	Content          string                 `json:"content"`
+	Model            string                 `json:"model,omitempty"`
+	PromptTokens     int                    `json:"prompt_tokens,omitempty"`
+	CompletionTokens int                    `json:"completion_tokens,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt        time.Time              `json:"created_at"`
+}
+
+// toJSON converts a transcript to its JSON representation
+func toJSON(t *Transcript) jsonConversation {
+	conv := t.Conversation
+	out := jsonConversation{
+		ID:           conv.ID,
+		Title:        conv.Title,
+		AgentID:      conv.AgentID,
+		UserID:       conv.UserID,
+		Status:       conv.Status,
+		SystemPrompt: t.SystemPrompt,
+		Context:      conv.Context,
+		CreatedAt:    conv.CreatedAt,
+		UpdatedAt:    conv.UpdatedAt,
+		Messages:     make([]jsonMessage, len(t.Messages)),
+	}
+	if t.Agent != nil {
+		out.AgentName = t.Agent.Name
+	}
+
+	for i, msg := range t.Messages {
+		out.Messages[i] = jsonMessage{
+			ID:               msg.ID,
+			ParentID:         msg.ParentID,
+			Role:             msg.Role,
+			Content:          msg.Content,
+			Model:            msg.Model,
+			PromptTokens:     msg.PromptTokens,
+			CompletionTokens: msg.CompletionTokens,
+			Metadata:         msg.Metadata,
+			CreatedAt:        msg.CreatedAt,
+		}
+	}
+
+	return out
+}
+
+type openAIExample struct {
+	Messages []openAIMessage `json:"messages"`
+	Tools    []openAITool    `json:"tools,omitempty"`
+}
+
+// openAIMessage is a message in OpenAI chat format
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAITool struct {
+	Type     string         `json:"type"`
+	Function openAIFunction `json:"function"`
+}
+
+type openAIFunction struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// renderOpenAI renders conversations as OpenAI fine-tuning JSONL, including the
+// agent's system prompt and tool definitions
+func renderOpenAI(transcripts []*Transcript) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, t := range transcripts {
+		example := openAIExample{}
+		if t.SystemPrompt != "" {
+			example.Messages = append(example.Messages, openAIMessage{Role: "system", Content: t.SystemPrompt})
+		}
+		for _, msg := range t.Messages {
+			example.Messages = append(example.Messages, openAIMessage{Role: msg.Role, Content: msg.Content})
+		}
+		names := make(map[string]bool, len(t.Tools))
+		for _, tool := range t.Tools {
+			// Function names follow the rules models apply, and stay unique like at runtime
+			name := ai.FunctionName(tool.Name)
+			for i := 2; names[name]; i++ {
+				name = fmt.Sprintf("%s_%d", ai.FunctionName(tool.Name), i)
+			}
+			names[name] = true
+			example.Tools = append(example.Tools, openAITool{
+				Type: "function",
+				Function: openAIFunction{
+					Name:        name,
+					Description: tool.Description,
+					Parameters:  tool.Schema,
+				},
+			})
+		}
+
+		line, err := json.Marshal(example)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode conversation %s: %w", t.Conversation.ID, err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,366 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/auth"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Agents belong to the user who created them. Owners share them with users or roles as
+// viewers or editors, and public published agents can be chatted with by everyone, without
+// revealing their configuration. Admins have owner permission on every agent.
+
+// agentPermission is what a user may do with an agent, each level including the ones below
+type agentPermission int
+
+const (
+	permissionNone agentPermission = iota
+	// permissionChat allows chatting with a public agent without seeing its configuration
+	permissionChat
+	// permissionViewer allows seeing the configuration, versions and shares
+	permissionViewer
+	// permissionEditor allows changing, publishing and moving the agent
+	permissionEditor
+	// permissionOwner allows deleting and sharing the agent and listing it publicly
+	permissionOwner
+)
+
+// permissionNames are the names of permissions in API responses
+var permissionNames = map[agentPermission]string{
+	permissionChat:   "chat",
+	permissionViewer: "viewer",
+	permissionEditor: "editor",
+	permissionOwner:  "owner",
+}
+
+// sharePermissions are the permissions agents can be shared with
+var sharePermissions = map[string]agentPermission{
+	"viewer": permissionViewer,
+	"editor": permissionEditor,
+}
+
+// shareRoles are the roles agents can be shared with
+var shareRoles = map[string]bool{
+	"admin":     true,
+	"developer": true,
+	"user":      true,
+}
+
+// marketplaceSorts are the orders of the marketplace listing
+var marketplaceSorts = map[string]bool{
+	"popular": true,
+	"recent":  true,
+	"name":    true,
+}
+
+// ownsAgent reports whether the caller has owner permission on an agent
+func ownsAgent(ctx context.Context, a *ent.Agent) bool {
+	return auth.GetUserRole(ctx) == "admin" || isCaller(ctx, a.CreatedBy)
+}
+
+// resolveAgentPermission returns the caller's permission on an agent, given the strongest
+// permission the agent is shared with them, empty when it is not
+func resolveAgentPermission(ctx context.Context, a *ent.Agent, shared string) agentPermission {
+	if ownsAgent(ctx, a) {
+		return permissionOwner
+	}
+	if p, ok := sharePermissions[shared]; ok {
+		return p
+	}
+	if a.IsPublic && a.Status == "published" {
+		return permissionChat
+	}
+	return permissionNone
+}
+
+// agentPermissionFor returns the caller's permission on an agent
+func agentPermissionFor(ctx context.Context, shares *repository.AgentShareRepository, a *ent.Agent) (agentPermission, error) {
+	if ownsAgent(ctx, a) {
+		return permissionOwner, nil
+	}
+	shared, err := shares.Permission(ctx, a.ID, auth.GetUserID(ctx), auth.GetUserRole(ctx))
+	if err != nil {
+		return permissionNone, status.Errorf(codes.Internal, "failed to load agent shares: %v", err)
+	}
+	return resolveAgentPermission(ctx, a, shared), nil
+}
+
+// checkAgentPermission returns NotFound when the caller may not see an agent at all, so that
+// private agents are not revealed, and PermissionDenied when their permission is below need
+func checkAgentPermission(a *ent.Agent, have, need agentPermission) error {
+	if have == permissionNone {
+		return status.Errorf(codes.NotFound, "agent not found: %s", a.ID)
+	}
+	if have < need {
+		return status.Errorf(codes.PermissionDenied, "%s permission on agent %s required", permissionNames[need], a.ID)
+	}
+	return nil
+}
+
+// loadAgent loads an agent the caller has at least the need permission on
+func (s *AgentServer) loadAgent(ctx context.Context, id string, need agentPermission) (*ent.Agent, agentPermission, error) {
+	agent, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, permissionNone, status.Errorf(codes.NotFound, "agent not found: %v", err)
+	}
+	perm, err := agentPermissionFor(ctx, s.shareRepo, agent)
+	if err != nil {
+		return nil, permissionNone, err
+	}
+	if err := checkAgentPermission(agent, perm, need); err != nil {
+		return nil, permissionNone, err
+	}
+	return agent, perm, nil
+}
+
+// visibleAgents returns a filter matching the agents the caller may see, with the permissions
+// of the agents shared with them; admins see every agent
+func (s *AgentServer) visibleAgents(ctx context.Context) (repository.AgentFilter, map[string]string, error) {
+	if auth.GetUserRole(ctx) == "admin" {
+		return repository.AgentFilter{}, nil, nil
+	}
+	userID, err := callerID(ctx)
+	if err != nil {
+		return repository.AgentFilter{}, nil, err
+	}
+	shared, err := s.shareRepo.SharedWith(ctx, userID, auth.GetUserRole(ctx))
+	if err != nil {
+		return repository.AgentFilter{}, nil, status.Errorf(codes.Internal, "failed to load agent shares: %v", err)
+	}
+	filter := repository.AgentFilter{VisibleTo: userID}
+	for id := range shared {
+		filter.SharedIDs = append(filter.SharedIDs, id)
+	}
+	return filter, shared, nil
+}
+
+// requireEditable returns an error unless the caller may edit all of the agents
+func (s *AgentServer) requireEditable(ctx context.Context, agents []*ent.Agent) error {
+	var shared map[string]string
+	for _, a := range agents {
+		if ownsAgent(ctx, a) {
+			continue
+		}
+		if shared == nil {
+			var err error
+			shared, err = s.shareRepo.SharedWith(ctx, auth.GetUserID(ctx), auth.GetUserRole(ctx))
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to load agent shares: %v", err)
+			}
+		}
+		if err := checkAgentPermission(a, resolveAgentPermission(ctx, a, shared[a.ID]), permissionEditor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// agentToProto converts an agent for a caller, hiding its configuration from callers who
+// may only chat with it
+func agentToProto(a *ent.Agent, perm agentPermission) *pb.Agent {
+	pbAgent := entAgentToProto(a)
+	pbAgent.Permission = permissionNames[perm]
+	if perm < permissionViewer {
+		pbAgent.PromptTemplate = ""
+		pbAgent.KnowledgeBases = nil
+		pbAgent.Members = nil
+		pbAgent.Folder = ""
+		pbAgent.TemplateId = ""
+		pbAgent.TemplateVersion = ""
+	}
+	return pbAgent
+}
+
+// ShareAgent 共享 Agent 给用户或角色
+func (s *AgentServer) ShareAgent(ctx context.Context, req *pb.ShareAgentRequest) (*pb.AgentShare, error) {
+	var violations fieldViolations
+	if req.AgentId == "" {
+		violations.add("agent_id", "is required")
+	}
+	switch req.PrincipalType {
+	case "user":
+		if req.PrincipalId == "" {
+			violations.add("principal_id", "is required")
+		} else if _, err := s.userRepo.GetByID(ctx, req.PrincipalId); err != nil {
+			violations.add("principal_id", "user not found: %s", req.PrincipalId)
+		}
+	case "role":
+		if !shareRoles[req.PrincipalId] {
+			violations.add("principal_id", "must be admin, developer or user, got %q", req.PrincipalId)
+		}
+	default:
+		violations.add("principal_type", "must be user or role, got %q", req.PrincipalType)
+	}
+	if _, ok := sharePermissions[req.Permission]; !ok {
+		violations.add("permission", "must be viewer or editor, got %q", req.Permission)
+	}
+	if err := violations.err("share"); err != nil {
+		return nil, err
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.AgentId, permissionOwner)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.PrincipalType == "user" && req.PrincipalId == agent.CreatedBy {
+		return nil, status.Error(codes.InvalidArgument, "agents cannot be shared with their owner")
+	}
+
+	share, err := s.shareRepo.Upsert(ctx, &ent.AgentShare{
+		ID:            uuid.New().String(),
+		AgentID:       agent.ID,
+		PrincipalType: req.PrincipalType,
+		PrincipalID:   req.PrincipalId,
+		Permission:    req.Permission,
+		CreatedBy:     userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to share agent: %v", err)
+	}
+
+	return entAgentShareToProto(share), nil
+}
+
+// ListAgentShares 获取 Agent 共享列表
+func (s *AgentServer) ListAgentShares(ctx context.Context, req *pb.ListAgentSharesRequest) (*pb.ListAgentSharesResponse, error) {
+	if req.AgentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent_id is required")
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.AgentId, permissionViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.shareRepo.List(ctx, agent.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list agent shares: %v", err)
+	}
+
+	items := make([]*pb.AgentShare, len(shares))
+	for i, share := range shares {
+		items[i] = entAgentShareToProto(share)
+	}
+	return &pb.ListAgentSharesResponse{Items: items}, nil
+}
+
+// UnshareAgent 取消共享 Agent
+func (s *AgentServer) UnshareAgent(ctx context.Context, req *pb.UnshareAgentRequest) (*emptypb.Empty, error) {
+	if req.AgentId == "" || req.PrincipalType == "" || req.PrincipalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent_id, principal_type and principal_id are required")
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.AgentId, permissionOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.shareRepo.Delete(ctx, agent.ID, req.PrincipalType, req.PrincipalId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListMarketplaceAgents 获取 Agent 市场列表
+func (s *AgentServer) ListMarketplaceAgents(ctx context.Context, req *pb.ListMarketplaceAgentsRequest) (*pb.ListMarketplaceAgentsResponse, error) {
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = "popular"
+	}
+	if !marketplaceSorts[sortBy] {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort_by: %s", req.SortBy)
+	}
+
+	agents, err := s.repo.Find(ctx, repository.AgentFilter{
+		PublicOnly: true,
+		Tags:       req.Tags,
+		Query:      strings.TrimSpace(req.Query),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list public agents: %v", err)
+	}
+
+	// 使用量需要在排序前统计
+	ids := make([]string, len(agents))
+	for i, a := range agents {
+		ids[i] = a.ID
+	}
+	usage, err := s.convRepo.UsageByAgent(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count agent usage: %v", err)
+	}
+
+	switch sortBy {
+	case "popular":
+		sort.SliceStable(agents, func(i, j int) bool {
+			return usage[agents[i].ID].Conversations > usage[agents[j].ID].Conversations
+		})
+	case "recent":
+		sort.SliceStable(agents, func(i, j int) bool { return agents[i].UpdatedAt.After(agents[j].UpdatedAt) })
+	case "name":
+		sort.SliceStable(agents, func(i, j int) bool { return strings.ToLower(agents[i].Name) < strings.ToLower(agents[j].Name) })
+	}
+
+	start := int((page - 1) * pageSize)
+	if start > len(agents) {
+		start = len(agents)
+	}
+	end := start + int(pageSize)
+	if end > len(agents) {
+		end = len(agents)
+	}
+
+	items := make([]*pb.MarketplaceAgent, 0, end-start)
+	for _, a := range agents[start:end] {
+		items = append(items, &pb.MarketplaceAgent{
+			Agent:             agentToProto(a, resolveAgentPermission(ctx, a, "")),
+			ConversationCount: int64(usage[a.ID].Conversations),
+			UserCount:         int64(usage[a.ID].Users),
+		})
+	}
+
+	return &pb.ListMarketplaceAgentsResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(len(agents)),
+	}, nil
+}
+
+// entAgentShareToProto converts ent.AgentShare to pb.AgentShare
+func entAgentShareToProto(share *ent.AgentShare) *pb.AgentShare {
+	return &pb.AgentShare{
+		Id:            share.ID,
+		AgentId:       share.AgentID,
+		PrincipalType: share.PrincipalType,
+		PrincipalId:   share.PrincipalID,
+		Permission:    share.Permission,
+		CreatedBy:     share.CreatedBy,
+		CreatedAt:     timestamppb.New(share.CreatedAt),
+	}
+}
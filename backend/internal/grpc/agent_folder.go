@@ -0,0 +1,258 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxFolderPathLength bounds the length of folder paths
+const maxFolderPathLength = 255
+
+// CreateAgentFolder 创建 Agent 文件夹
+func (s *AgentServer) CreateAgentFolder(ctx context.Context, req *pb.CreateAgentFolderRequest) (*pb.AgentFolder, error) {
+	path, err := folderPath(req.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid path: %v", err)
+	}
+	if path == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.folderRepo.Create(ctx, path, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrFolderExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "folder already exists: %s", path)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create folder: %v", err)
+	}
+
+	folder := newFolderNode(created.Path)
+	folder.CreatedAt = timestamppb.New(created.CreatedAt)
+	return folder, nil
+}
+
+// ListAgentFolders 获取 Agent 文件夹树
+func (s *AgentServer) ListAgentFolders(ctx context.Context, req *pb.ListAgentFoldersRequest) (*pb.ListAgentFoldersResponse, error) {
+	root, err := folderPath(req.Root)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid root: %v", err)
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.folderRepo.List(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list folders: %v", err)
+	}
+	counts, err := s.repo.FolderCounts(ctx, repository.AgentFilter{CreatedBy: userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count agents: %v", err)
+	}
+
+	// 文件夹树包含调用者创建的文件夹和其 Agent 所在的文件夹
+	nodes := make(map[string]*pb.AgentFolder)
+	var top []*pb.AgentFolder
+	var node func(path string) *pb.AgentFolder
+	node = func(path string) *pb.AgentFolder {
+		if n, ok := nodes[path]; ok {
+			return n
+		}
+		n := newFolderNode(path)
+		nodes[path] = n
+		if i := strings.LastIndex(path, "/"); i >= 0 {
+			parent := node(path[:i])
+			parent.Children = append(parent.Children, n)
+		} else {
+			top = append(top, n)
+		}
+		return n
+	}
+	for _, f := range records {
+		node(f.Path).CreatedAt = timestamppb.New(f.CreatedAt)
+	}
+	for path, count := range counts {
+		if path != "" {
+			node(path).AgentCount += int64(count)
+		}
+	}
+	sortFolders(top)
+
+	folders := top
+	if root != "" {
+		n, ok := nodes[root]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "folder not found: %s", root)
+		}
+		folders = []*pb.AgentFolder{n}
+	}
+
+	return &pb.ListAgentFoldersResponse{
+		Folders:      folders,
+		UnfiledCount: int64(counts[""]),
+	}, nil
+}
+
+// MoveAgentFolder 移动或重命名 Agent 文件夹
+func (s *AgentServer) MoveAgentFolder(ctx context.Context, req *pb.MoveAgentFolderRequest) (*pb.AgentFolder, error) {
+	path, err := folderPath(req.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid path: %v", err)
+	}
+	newPath, err := folderPath(req.NewPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new_path: %v", err)
+	}
+	if path == "" || newPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "path and new_path are required")
+	}
+	if newPath == path || strings.HasPrefix(newPath, path+"/") {
+		return nil, status.Error(codes.InvalidArgument, "a folder cannot be moved into itself")
+	}
+
+	// 只能移动自己的文件夹，其中只有自己的 Agent
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := s.folderRepo.Exists(ctx, path, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load folder: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "folder not found: %s", path)
+	}
+
+	if err := s.folderRepo.Move(ctx, path, newPath, userID); err != nil {
+		if errors.Is(err, repository.ErrFolderExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "folder already exists: %s", newPath)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to move folder: %v", err)
+	}
+
+	return newFolderNode(newPath), nil
+}
+
+// DeleteAgentFolder 删除 Agent 文件夹
+func (s *AgentServer) DeleteAgentFolder(ctx context.Context, req *pb.DeleteAgentFolderRequest) (*emptypb.Empty, error) {
+	path, err := folderPath(req.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid path: %v", err)
+	}
+	if path == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+
+	// 只能删除自己的文件夹，强制删除会将其中的 Agent 移到上级文件夹
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := s.folderRepo.Exists(ctx, path, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load folder: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "folder not found: %s", path)
+	}
+
+	if err := s.folderRepo.Delete(ctx, path, userID, req.Force); err != nil {
+		if errors.Is(err, repository.ErrFolderNotEmpty) {
+			return nil, status.Errorf(codes.FailedPrecondition, "folder is not empty, set force to delete it: %s", path)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete folder: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// MoveAgents 移动 Agent 到文件夹
+func (s *AgentServer) MoveAgents(ctx context.Context, req *pb.MoveAgentsRequest) (*pb.MoveAgentsResponse, error) {
+	if len(req.AgentIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "agent_ids is required")
+	}
+	folder, err := folderPath(req.Folder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder: %v", err)
+	}
+
+	agents, err := s.repo.ListByIDs(ctx, req.AgentIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load agents: %v", err)
+	}
+	if err := s.requireEditable(ctx, agents); err != nil {
+		return nil, err
+	}
+
+	// 文件夹只用于组织 Agent，已归档的 Agent 同样可以移动
+	moved, err := s.repo.MoveToFolder(ctx, req.AgentIds, folder)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move agents: %v", err)
+	}
+
+	return &pb.MoveAgentsResponse{Moved: int32(moved)}, nil
+}
+
+// folderPath normalizes a slash separated folder path: surrounding slashes and spaces are
+// removed, so "/support/faq/" becomes "support/faq". The empty path is the root.
+func folderPath(path string) (string, error) {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	if path == "" {
+		return "", nil
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segment = strings.TrimSpace(segment)
+		switch segment {
+		case "":
+			return "", errors.New("folder names must not be empty")
+		case ".", "..":
+			return "", fmt.Errorf("invalid folder name: %s", segment)
+		}
+		segments[i] = segment
+	}
+
+	path = strings.Join(segments, "/")
+	if len(path) > maxFolderPathLength {
+		return "", fmt.Errorf("path exceeds %d characters", maxFolderPathLength)
+	}
+	return path, nil
+}
+
+// newFolderNode returns the tree node of a folder without counts
+func newFolderNode(path string) *pb.AgentFolder {
+	return &pb.AgentFolder{
+		Path: path,
+		Name: path[strings.LastIndex(path, "/")+1:],
+	}
+}
+
+// sortFolders orders folders by name recursively and sums the agent counts of their subtrees
+func sortFolders(folders []*pb.AgentFolder) {
+	sort.Slice(folders, func(i, j int) bool { return folders[i].Name < folders[j].Name })
+	for _, f := range folders {
+		sortFolders(f.Children)
+		f.TotalCount = f.AgentCount
+		for _, child := range f.Children {
+			f.TotalCount += child.TotalCount
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/prompt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Agents move through draft, published and archived. Drafts can only be tried out in
+// preview conversations, published agents serve everyone and archived agents are read-only.
+
+// agentTypes are the supported agent types. Multi-agent agents hand turns to their members,
+// workflow agents answer like single agents.
+var agentTypes = map[string]bool{
+	"single":      true,
+	"workflow":    true,
+	"multi-agent": true,
+}
+
+// agentTransitions lists the statuses an agent may move to through UpdateAgent. Publishing
+// goes through PublishAgent so that a version snapshot is taken.
+var agentTransitions = map[string][]string{
+	"draft":     {"archived"},
+	"published": {"archived"},
+	"archived":  {"draft"},
+}
+
+// agentModelKeys are the model_config keys naming a model
+var agentModelKeys = []string{"model", "summary_model", "memory_model", "title_model"}
+
+// checkAgentTransition returns a FailedPrecondition error when an agent may not move from one status to another
+func checkAgentTransition(from, to string) error {
+	if to == "published" {
+		return status.Error(codes.FailedPrecondition, "agents are published with PublishAgent")
+	}
+	for _, allowed := range agentTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	if _, known := agentTransitions[to]; !known {
+		return status.Errorf(codes.InvalidArgument, "unknown agent status: %s", to)
+	}
+	return status.Errorf(codes.FailedPrecondition, "agent status cannot change from %s to %s", from, to)
+}
+
+// checkAgentAvailable returns a FailedPrecondition error when a conversation may not be
+// started with an agent. Drafts are only available to preview conversations.
+func checkAgentAvailable(agent *ent.Agent, preview bool) error {
+	switch agent.Status {
+	case "archived":
+		return status.Errorf(codes.FailedPrecondition, "agent is archived: %s", agent.ID)
+	case "draft":
+		if !preview {
+			return status.Errorf(codes.FailedPrecondition, "agent is a draft, publish it or start a preview conversation: %s", agent.ID)
+		}
+	}
+	return nil
+}
+
+// validateAgent checks an agent's configuration and the tools, knowledge bases and models
+// it references, reporting every problem at once. The folder path is normalized in place.
+func (s *AgentServer) validateAgent(ctx context.Context, a *ent.Agent) error {
+	var violations fieldViolations
+
+	if a.Name == "" {
+		violations.add("name", "is required")
+	}
+	if !agentTypes[a.Type] {
+		violations.add("type", "must be single, workflow or multi-agent, got %q", a.Type)
+	}
+	if folder, err := folderPath(a.Folder); err != nil {
+		violations.add("folder", "%v", err)
+	} else {
+		a.Folder = folder
+	}
+
+	if _, err := parseContextConfig("", a.ModelConfig); err != nil {
+		violations.add("model_config", "%v", err)
+	}
+	if s.aiManager != nil {
+		for _, key := range agentModelKeys {
+			model, _ := a.ModelConfig[key].(string)
+			if model == "" {
+				continue
+			}
+			if err := s.aiManager.CheckModel(model); err != nil {
+				violations.add("model_config."+key, "%v", err)
+			}
+		}
+	}
+
+	if len(a.Tools) > 0 {
+		tools, err := s.toolRepo.ListByIDs(ctx, a.Tools)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load tools: %v", err)
+		}
+		found := make(map[string]bool, len(tools))
+		for _, t := range tools {
+			found[t.ID] = true
+			if t.Type == "agent" && t.Implementation == a.ID {
+				violations.add("tools", "tool %s calls the agent itself", t.ID)
+			}
+		}
+		checkReferences(&violations, "tools", "tool", a.Tools, found)
+	}
+
+	if len(a.KnowledgeBases) > 0 {
+		kbs, err := s.kbRepo.ListByIDs(ctx, a.KnowledgeBases)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load knowledge bases: %v", err)
+		}
+		found := make(map[string]bool, len(kbs))
+		for _, kb := range kbs {
+			found[kb.ID] = true
+		}
+		checkReferences(&violations, "knowledge_bases", "knowledge base", a.KnowledgeBases, found)
+	}
+
+	if err := s.checkMembers(ctx, a, &violations); err != nil {
+		return err
+	}
+
+	if err := checkPromptTemplate(a.PromptTemplate, a.Parameters); err != nil {
+		violations.add("prompt_template", "%v", err)
+	}
+
+	return violations.err("agent")
+}
+
+// checkMembers checks the members of multi-agent agents: they must be agents the caller can
+// chat with, neither archived nor multi-agent agents themselves. Other agents have no members.
+func (s *AgentServer) checkMembers(ctx context.Context, a *ent.Agent, violations *fieldViolations) error {
+	if a.Type != "multi-agent" {
+		if len(a.Members) > 0 {
+			violations.add("members", "only multi-agent agents have members")
+		}
+		return nil
+	}
+
+	if _, err := parseOrchestrationConfig(a.ModelConfig); err != nil {
+		violations.add("model_config", "%v", err)
+	}
+	if len(a.Members) == 0 {
+		violations.add("members", "multi-agent agents need at least one member")
+		return nil
+	}
+
+	members, err := s.repo.ListByIDs(ctx, a.Members)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to load member agents: %v", err)
+	}
+	found := make(map[string]bool, len(members))
+	byID := make(map[string]*ent.Agent, len(members))
+	for _, m := range members {
+		perm, err := agentPermissionFor(ctx, s.shareRepo, m)
+		if err != nil {
+			return err
+		}
+		// Agents the caller cannot use are reported as not found
+		if perm >= permissionChat {
+			found[m.ID] = true
+			byID[m.ID] = m
+		}
+	}
+	checkReferences(violations, "members", "agent", a.Members, found)
+
+	for i, id := range a.Members {
+		m, ok := byID[id]
+		if !ok {
+			continue
+		}
+		switch {
+		case m.Type == "multi-agent":
+			violations.add(fmt.Sprintf("members[%d]", i), "multi-agent agents cannot be members: %s", id)
+		case m.Status == "archived":
+			violations.add(fmt.Sprintf("members[%d]", i), "agent is archived: %s", id)
+		}
+	}
+	return nil
+}
+
+// checkReferences reports the IDs of a list field that are unknown or listed twice
+func checkReferences(violations *fieldViolations, field, kind string, ids []string, found map[string]bool) {
+	seen := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		name := fmt.Sprintf("%s[%d]", field, i)
+		switch {
+		case seen[id]:
+			violations.add(name, "duplicate %s: %s", kind, id)
+		case !found[id]:
+			violations.add(name, "%s not found: %s", kind, id)
+		}
+		seen[id] = true
+	}
+}
+
+// checkPromptTemplate checks the template syntax and that the agent parameters it requires are set
+func checkPromptTemplate(text string, params map[string]interface{}) error {
+	if text == "" {
+		return nil
+	}
+
+	tmpl, err := prompt.Parse(text)
+	if err != nil {
+		return err
+	}
+	if missing := tmpl.Missing(prompt.Variables{Params: params}, "params"); len(missing) > 0 {
+		return &prompt.MissingVariablesError{Names: missing}
+	}
+	return nil
+}
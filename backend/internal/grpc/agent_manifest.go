@@ -0,0 +1,282 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/manifest"
+	"agent-platform/internal/model/ent"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Manifests describe agents declaratively. Applying one creates the agent with the manifest's
+// name or updates the caller's agent of that name in place, so the same manifest can be
+// applied repeatedly. Agents of other users are never matched.
+
+// ExportAgent 导出 Agent 清单
+func (s *AgentServer) ExportAgent(ctx context.Context, req *pb.ExportAgentRequest) (*pb.ExportAgentResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.Id, permissionViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// 工具和知识库按名称引用
+	toolNames := make(map[string]string)
+	if len(agent.Tools) > 0 {
+		tools, err := s.toolRepo.ListByIDs(ctx, agent.Tools)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load tools: %v", err)
+		}
+		for _, t := range tools {
+			toolNames[t.ID] = t.Name
+		}
+	}
+	kbNames := make(map[string]string)
+	if len(agent.KnowledgeBases) > 0 {
+		kbs, err := s.kbRepo.ListByIDs(ctx, agent.KnowledgeBases)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load knowledge bases: %v", err)
+		}
+		for _, kb := range kbs {
+			kbNames[kb.ID] = kb.Name
+		}
+	}
+	memberNames := make(map[string]string)
+	if len(agent.Members) > 0 {
+		members, err := s.repo.ListByIDs(ctx, agent.Members)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load member agents: %v", err)
+		}
+		for _, member := range members {
+			memberNames[member.ID] = member.Name
+		}
+	}
+
+	m := &manifest.Agent{
+		APIVersion:     manifest.APIVersion,
+		Kind:           manifest.KindAgent,
+		Name:           agent.Name,
+		Description:    agent.Description,
+		Type:           agent.Type,
+		Folder:         agent.Folder,
+		Tags:           agent.Tags,
+		Prompt:         agent.PromptTemplate,
+		ModelConfig:    agent.ModelConfig,
+		Tools:          referenceNames(agent.Tools, toolNames),
+		KnowledgeBases: referenceNames(agent.KnowledgeBases, kbNames),
+		Members:        referenceNames(agent.Members, memberNames),
+		Parameters:     agent.Parameters,
+	}
+	data, err := m.Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode manifest: %v", err)
+	}
+
+	return &pb.ExportAgentResponse{Manifest: string(data)}, nil
+}
+
+// ApplyAgentManifest 应用 Agent 清单，按名称创建或更新调用者的 Agent
+func (s *AgentServer) ApplyAgentManifest(ctx context.Context, req *pb.ApplyAgentManifestRequest) (*pb.ApplyAgentManifestResponse, error) {
+	if req.Manifest == "" {
+		return nil, status.Error(codes.InvalidArgument, "manifest is required")
+	}
+
+	m, err := manifest.ParseAgent([]byte(req.Manifest))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid manifest: %v", err)
+	}
+
+	// 按名称查找调用者自己的 Agent，其他用户的同名 Agent 不受影响
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.repo.ListByName(ctx, m.Name, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up agent: %v", err)
+	}
+	if len(matches) > 1 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%d of your agents are named %s, rename them before applying a manifest", len(matches), m.Name)
+	}
+
+	agentType := m.Type
+	if agentType == "" {
+		agentType = "single"
+	}
+
+	current := &ent.Agent{}
+	desired := &ent.Agent{
+		Status:  "draft",
+		Version: "1.0.0",
+	}
+	action := "create"
+	if len(matches) == 1 {
+		current = matches[0]
+		copied := *current
+		desired = &copied
+		action = "update"
+	}
+	desired.Name = m.Name
+	desired.Description = m.Description
+	desired.Type = agentType
+	desired.Folder = m.Folder
+	desired.Tags = m.Tags
+	desired.PromptTemplate = m.Prompt
+	desired.ModelConfig = m.ModelConfig
+	desired.Parameters = m.Parameters
+
+	// 将工具、知识库和成员 Agent 名称解析为 ID
+	if err := s.resolveManifestReferences(ctx, m, desired); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateAgent(ctx, desired); err != nil {
+		return nil, err
+	}
+
+	changes := diffAgentFields(manifestFields(current), manifestFields(desired))
+	if action == "update" && len(changes) == 0 {
+		action = "unchanged"
+	}
+	if action == "update" && current.Status == "archived" {
+		return nil, status.Error(codes.FailedPrecondition, "archived agents cannot be edited, restore them to draft first")
+	}
+
+	resp := &pb.ApplyAgentManifestResponse{
+		Action:  action,
+		Changes: changes,
+		DryRun:  req.DryRun,
+	}
+	if req.DryRun || action == "unchanged" {
+		resp.Agent = agentToProto(desired, permissionOwner)
+		return resp, nil
+	}
+
+	var applied *ent.Agent
+	if action == "create" {
+		desired.ID = uuid.New().String()
+		desired.CreatedBy = userID
+		applied, err = s.repo.Create(ctx, desired)
+	} else {
+		applied, err = s.repo.Update(ctx, current.ID, map[string]interface{}{
+			"description":     desired.Description,
+			"type":            desired.Type,
+			"folder":          desired.Folder,
+			"tags":            desired.Tags,
+			"prompt_template": desired.PromptTemplate,
+			"model_config":    desired.ModelConfig,
+			"tools":           desired.Tools,
+			"knowledge_bases": desired.KnowledgeBases,
+			"members":         desired.Members,
+			"parameters":      desired.Parameters,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to apply manifest: %v", err)
+	}
+
+	resp.Agent = agentToProto(applied, permissionOwner)
+	return resp, nil
+}
+
+// resolveManifestReferences sets the tools, knowledge bases and members of a to the IDs of
+// the resources the manifest references by name, reporting unknown and ambiguous names
+func (s *AgentServer) resolveManifestReferences(ctx context.Context, m *manifest.Agent, a *ent.Agent) error {
+	var violations fieldViolations
+
+	a.Tools = nil
+	if len(m.Tools) > 0 {
+		tools, err := s.toolRepo.ListByNames(ctx, m.Tools)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load tools: %v", err)
+		}
+		byName := make(map[string][]string)
+		for _, t := range tools {
+			byName[t.Name] = append(byName[t.Name], t.ID)
+		}
+		a.Tools = resolveNames(&violations, "tools", "tool", m.Tools, byName)
+	}
+
+	a.KnowledgeBases = nil
+	if len(m.KnowledgeBases) > 0 {
+		kbs, err := s.kbRepo.ListByNames(ctx, m.KnowledgeBases)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load knowledge bases: %v", err)
+		}
+		byName := make(map[string][]string)
+		for _, kb := range kbs {
+			byName[kb.Name] = append(byName[kb.Name], kb.ID)
+		}
+		a.KnowledgeBases = resolveNames(&violations, "knowledge_bases", "knowledge base", m.KnowledgeBases, byName)
+	}
+
+	a.Members = nil
+	if len(m.Members) > 0 {
+		members, err := s.repo.ListByNames(ctx, m.Members)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load member agents: %v", err)
+		}
+		byName := make(map[string][]string)
+		for _, member := range members {
+			// Agents the caller cannot chat with are neither candidates nor reported
+			perm, err := agentPermissionFor(ctx, s.shareRepo, member)
+			if err != nil {
+				return err
+			}
+			if perm >= permissionChat {
+				byName[member.Name] = append(byName[member.Name], member.ID)
+			}
+		}
+		a.Members = resolveNames(&violations, "members", "agent", m.Members, byName)
+	}
+
+	return violations.err("manifest")
+}
+
+// resolveNames maps names to the IDs of the only resource with that name
+func resolveNames(violations *fieldViolations, field, kind string, names []string, byName map[string][]string) []string {
+	ids := make([]string, 0, len(names))
+	for i, name := range names {
+		switch matches := byName[name]; len(matches) {
+		case 0:
+			violations.add(fmt.Sprintf("%s[%d]", field, i), "%s not found: %s", kind, name)
+		case 1:
+			ids = append(ids, matches[0])
+		default:
+			violations.add(fmt.Sprintf("%s[%d]", field, i), "%d %ss are named %s", len(matches), kind, name)
+		}
+	}
+	return ids
+}
+
+// referenceNames maps IDs to resource names; IDs of deleted resources are kept as they are
+func referenceNames(ids []string, names map[string]string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	refs := make([]string, len(ids))
+	for i, id := range ids {
+		if name, ok := names[id]; ok {
+			refs[i] = name
+		} else {
+			refs[i] = id
+		}
+	}
+	return refs
+}
+
+// manifestFields returns the agent fields described by manifests
+func manifestFields(a *ent.Agent) []agentField {
+	return append(agentFields(a),
+		agentField{"tags", a.Tags},
+		agentField{"folder", a.Folder},
+	)
+}
@@ -2,8 +2,11 @@ package grpc
 
 import (
 	"context"
+	"strings"
 
 	pb "agent-platform/gen/go"
+	"agent-platform/internal/ai"
+	"agent-platform/internal/auth"
 	"agent-platform/internal/model/ent"
 	"agent-platform/internal/repository"
 
@@ -17,23 +20,39 @@ import (
 // AgentServer gRPC Agent 服务实现
 type AgentServer struct {
 	pb.UnimplementedAgentServiceServer
-	client *ent.Client
-	repo   *repository.AgentRepository
+	client      *ent.Client
+	aiManager   *ai.Manager
+	repo        *repository.AgentRepository
+	versionRepo *repository.AgentVersionRepository
+	folderRepo  *repository.AgentFolderRepository
+	toolRepo    *repository.ToolRepository
+	kbRepo      *repository.KnowledgeBaseRepository
+	shareRepo   *repository.AgentShareRepository
+	userRepo    *repository.UserRepository
+	convRepo    *repository.ConversationRepository
 }
 
 // NewAgentServer 创建 Agent 服务实例
-func NewAgentServer(client *ent.Client) *AgentServer {
+func NewAgentServer(client *ent.Client, aiManager *ai.Manager) *AgentServer {
 	return &AgentServer{
-		client: client,
-		repo:   repository.NewAgentRepository(client),
+		client:      client,
+		aiManager:   aiManager,
+		repo:        repository.NewAgentRepository(client),
+		versionRepo: repository.NewAgentVersionRepository(client),
+		folderRepo:  repository.NewAgentFolderRepository(client),
+		toolRepo:    repository.NewToolRepository(client),
+		kbRepo:      repository.NewKnowledgeBaseRepository(client),
+		shareRepo:   repository.NewAgentShareRepository(client),
+		userRepo:    repository.NewUserRepository(client),
+		convRepo:    repository.NewConversationRepository(client),
 	}
 }
 
 // CreateAgent 创建 Agent
 func (s *AgentServer) CreateAgent(ctx context.Context, req *pb.CreateAgentRequest) (*pb.Agent, error) {
-	// 验证请求
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// 准备数据
@@ -51,7 +70,9 @@ func (s *AgentServer) CreateAgent(ctx context.Context, req *pb.CreateAgentReques
 		Type:        agentType,
 		Status:      "draft",
 		Version:     "1.0.0",
-		CreatedBy:   "system", // TODO: 从 context 中获取用户信息
+		CreatedBy:   userID,
+		IsPublic:    req.IsPublic,
+		AllowClone:  req.AllowClone,
 	}
 
 	// 设置可选字段
@@ -64,6 +85,9 @@ func (s *AgentServer) CreateAgent(ctx context.Context, req *pb.CreateAgentReques
 	if req.KnowledgeBases != nil {
 		entAgent.KnowledgeBases = req.KnowledgeBases
 	}
+	if req.Members != nil {
+		entAgent.Members = req.Members
+	}
 	if req.PromptTemplate != "" {
 		entAgent.PromptTemplate = req.PromptTemplate
 	}
@@ -77,6 +101,11 @@ func (s *AgentServer) CreateAgent(ctx context.Context, req *pb.CreateAgentReques
 		entAgent.Folder = req.Folder
 	}
 
+	// 验证配置及引用的工具、知识库和模型
+	if err := s.validateAgent(ctx, entAgent); err != nil {
+		return nil, err
+	}
+
 	// 保存到数据库
 	created, err := s.repo.Create(ctx, entAgent)
 	if err != nil {
@@ -84,7 +113,7 @@ func (s *AgentServer) CreateAgent(ctx context.Context, req *pb.CreateAgentReques
 	}
 
 	// 转换为 protobuf
-	return entAgentToProto(created), nil
+	return agentToProto(created, permissionOwner), nil
 }
 
 // ListAgents 获取 Agent 列表
@@ -99,8 +128,35 @@ func (s *AgentServer) ListAgents(ctx context.Context, req *pb.ListAgentsRequest)
 		pageSize = 10
 	}
 
+	// 校验筛选和排序参数
+	if req.SortBy != "" {
+		if _, ok := repository.AgentSortFields[req.SortBy]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported sort_by: %s", req.SortBy)
+		}
+	}
+	if req.TagMatch != "" && req.TagMatch != "any" && req.TagMatch != "all" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag_match must be any or all: %s", req.TagMatch)
+	}
+	folder, err := folderPath(req.Folder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid folder: %v", err)
+	}
+
+	// 非管理员只能看到自己的、共享给自己的和公开的 Agent
+	filter, shared, err := s.visibleAgents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = req.Status
+	filter.Type = req.Type
+	filter.CreatedBy = req.CreatedBy
+	filter.Folder = folder
+	filter.Tags = req.Tags
+	filter.AllTags = req.TagMatch == "all"
+	filter.Query = strings.TrimSpace(req.Query)
+
 	// 从数据库查询
-	agents, total, err := s.repo.List(ctx, page, pageSize, req.Status, req.Type, "")
+	agents, total, err := s.repo.List(ctx, page, pageSize, filter, req.SortBy, req.Ascending)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list agents: %v", err)
 	}
@@ -108,7 +164,7 @@ func (s *AgentServer) ListAgents(ctx context.Context, req *pb.ListAgentsRequest)
 	// 转换为 protobuf
 	pbAgents := make([]*pb.Agent, len(agents))
 	for i, agent := range agents {
-		pbAgents[i] = entAgentToProto(agent)
+		pbAgents[i] = agentToProto(agent, resolveAgentPermission(ctx, agent, shared[agent.ID]))
 	}
 
 	return &pb.ListAgentsResponse{
@@ -125,14 +181,14 @@ func (s *AgentServer) GetAgent(ctx context.Context, req *pb.GetAgentRequest) (*p
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	// 从数据库查询
-	agent, err := s.repo.Get(ctx, req.Id)
+	// 从数据库查询，仅能对话的用户看不到 Agent 的配置
+	agent, perm, err := s.loadAgent(ctx, req.Id, permissionChat)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
+		return nil, err
 	}
 
 	// 转换为 protobuf
-	return entAgentToProto(agent), nil
+	return agentToProto(agent, perm), nil
 }
 
 // UpdateAgent 更新 Agent
@@ -141,33 +197,87 @@ func (s *AgentServer) UpdateAgent(ctx context.Context, req *pb.UpdateAgentReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	// 准备更新字段
+	existing, perm, err := s.loadAgent(ctx, req.Id, permissionEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	// 准备更新字段，merged 为更新后的 Agent，用于整体校验
 	updates := make(map[string]interface{})
+	merged := *existing
 	if req.Name != "" {
 		updates["name"] = req.Name
+		merged.Name = req.Name
 	}
 	if req.Description != "" {
 		updates["description"] = req.Description
+		merged.Description = req.Description
 	}
 	if req.ModelConfig != nil {
 		updates["model_config"] = req.ModelConfig.AsMap()
+		merged.ModelConfig = req.ModelConfig.AsMap()
 	}
 	if req.Tools != nil {
 		updates["tools"] = req.Tools
+		merged.Tools = req.Tools
 	}
 	if req.KnowledgeBases != nil {
 		updates["knowledge_bases"] = req.KnowledgeBases
+		merged.KnowledgeBases = req.KnowledgeBases
+	}
+	if req.Members != nil {
+		updates["members"] = req.Members
+		merged.Members = req.Members
 	}
 	if req.PromptTemplate != "" {
 		updates["prompt_template"] = req.PromptTemplate
+		merged.PromptTemplate = req.PromptTemplate
 	}
 	if req.Parameters != nil {
 		updates["parameters"] = req.Parameters.AsMap()
+		merged.Parameters = req.Parameters.AsMap()
+	}
+	if req.IsPublic != nil {
+		updates["is_public"] = *req.IsPublic
+		merged.IsPublic = *req.IsPublic
+	}
+	if req.AllowClone != nil {
+		updates["allow_clone"] = *req.AllowClone
+		merged.AllowClone = *req.AllowClone
 	}
-	if req.Status != "" {
+	if req.Tags != nil {
+		updates["tags"] = req.Tags
+		merged.Tags = req.Tags
+	}
+	if req.Folder != nil {
+		updates["folder"] = *req.Folder
+		merged.Folder = *req.Folder
+	}
+
+	// 只有所有者可以公开 Agent 或允许克隆
+	if perm < permissionOwner && (req.IsPublic != nil || req.AllowClone != nil) {
+		return nil, status.Error(codes.PermissionDenied, "only the owner can change is_public and allow_clone")
+	}
+
+	// 已归档的 Agent 只能变更状态
+	if existing.Status == "archived" && len(updates) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "archived agents cannot be edited, restore them to draft first")
+	}
+	if req.Status != "" && req.Status != existing.Status {
+		if err := checkAgentTransition(existing.Status, req.Status); err != nil {
+			return nil, err
+		}
 		updates["status"] = req.Status
 	}
 
+	if err := s.validateAgent(ctx, &merged); err != nil {
+		return nil, err
+	}
+	if req.Folder != nil {
+		// 使用校验时规范化的路径
+		updates["folder"] = merged.Folder
+	}
+
 	// 更新数据库
 	updated, err := s.repo.Update(ctx, req.Id, updates)
 	if err != nil {
@@ -175,7 +285,7 @@ func (s *AgentServer) UpdateAgent(ctx context.Context, req *pb.UpdateAgentReques
 	}
 
 	// 转换为 protobuf
-	return entAgentToProto(updated), nil
+	return agentToProto(updated, perm), nil
 }
 
 // DeleteAgent 删除 Agent
@@ -184,6 +294,10 @@ func (s *AgentServer) DeleteAgent(ctx context.Context, req *pb.DeleteAgentReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
+	if _, _, err := s.loadAgent(ctx, req.Id, permissionOwner); err != nil {
+		return nil, err
+	}
+
 	// 从数据库删除
 	err := s.repo.Delete(ctx, req.Id)
 	if err != nil {
@@ -193,18 +307,38 @@ func (s *AgentServer) DeleteAgent(ctx context.Context, req *pb.DeleteAgentReques
 	return &emptypb.Empty{}, nil
 }
 
+// callerID returns the authenticated user of the request. Calls without one are rejected
+// rather than attributed to a fallback user, who would own whatever they create.
+func callerID(ctx context.Context) (string, error) {
+	if userID := auth.GetUserID(ctx); userID != "" {
+		return userID, nil
+	}
+	return "", status.Error(codes.Unauthenticated, "authentication required")
+}
+
+// isCaller reports whether userID is the authenticated user of the request
+func isCaller(ctx context.Context, userID string) bool {
+	caller := auth.GetUserID(ctx)
+	return caller != "" && caller == userID
+}
+
 // Helper function to convert ent.Agent to pb.Agent
 func entAgentToProto(agent *ent.Agent) *pb.Agent {
 	pbAgent := &pb.Agent{
-		Id:          agent.ID,
-		Name:        agent.Name,
-		Description: agent.Description,
-		Type:        agent.Type,
-		Status:      agent.Status,
-		Version:     agent.Version,
-		CreatedBy:   agent.CreatedBy,
-		CreatedAt:   timestamppb.New(agent.CreatedAt),
-		UpdatedAt:   timestamppb.New(agent.UpdatedAt),
+		Id:                 agent.ID,
+		Name:               agent.Name,
+		Description:        agent.Description,
+		Type:               agent.Type,
+		Status:             agent.Status,
+		Version:            agent.Version,
+		CreatedBy:          agent.CreatedBy,
+		CreatedAt:          timestamppb.New(agent.CreatedAt),
+		UpdatedAt:          timestamppb.New(agent.UpdatedAt),
+		PublishedVersionId: agent.PublishedVersionID,
+		IsPublic:           agent.IsPublic,
+		AllowClone:         agent.AllowClone,
+		TemplateId:         agent.TemplateID,
+		TemplateVersion:    agent.TemplateVersion,
 	}
 
 	// 设置可选字段
@@ -220,6 +354,9 @@ func entAgentToProto(agent *ent.Agent) *pb.Agent {
 	if agent.KnowledgeBases != nil {
 		pbAgent.KnowledgeBases = agent.KnowledgeBases
 	}
+	if agent.Members != nil {
+		pbAgent.Members = agent.Members
+	}
 	if agent.Tags != nil {
 		pbAgent.Tags = agent.Tags
 	}
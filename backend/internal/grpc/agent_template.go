@@ -0,0 +1,327 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/manifest"
+	"agent-platform/internal/model/ent"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Agents can be cloned from the built-in templates, from public published agents that allow
+// cloning, which form the template catalog together with the built-ins, and from the agents
+// the caller may view.
+// Clones remember their source so that they can be compared with it later.
+
+// templateSource is an agent configuration new agents can be cloned from
+type templateSource struct {
+	id      string
+	version string
+	// builtin is set for built-in templates, whose tools and knowledge bases are names
+	builtin *manifest.Agent
+	// agent holds the configuration of other sources
+	agent *ent.Agent
+}
+
+// ListAgentTemplates 获取 Agent 模板列表
+func (s *AgentServer) ListAgentTemplates(ctx context.Context, req *pb.ListAgentTemplatesRequest) (*pb.ListAgentTemplatesResponse, error) {
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	builtins, err := manifest.Builtins()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load built-in templates: %v", err)
+	}
+
+	// 内置模板在前，公开 Agent 在后
+	offset := int((page - 1) * pageSize)
+	items := make([]*pb.AgentTemplate, 0, pageSize)
+	for i := offset; i < len(builtins) && len(items) < int(pageSize); i++ {
+		items = append(items, builtinTemplateToProto(builtins[i]))
+	}
+
+	publicOffset := offset - len(builtins)
+	if publicOffset < 0 {
+		publicOffset = 0
+	}
+	agents, total, err := s.repo.ListCloneable(ctx, publicOffset, int(pageSize)-len(items))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list public agents: %v", err)
+	}
+
+	// 公开 Agent 以其发布的版本作为模板
+	if len(agents) > 0 {
+		ids := make([]string, len(agents))
+		for i, a := range agents {
+			ids[i] = a.PublishedVersionID
+		}
+		versions, err := s.versionRepo.ListByIDs(ctx, ids)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load agent versions: %v", err)
+		}
+		byID := make(map[string]*ent.AgentVersion, len(versions))
+		for _, v := range versions {
+			byID[v.ID] = v
+		}
+		for _, a := range agents {
+			if v, ok := byID[a.PublishedVersionID]; ok {
+				items = append(items, agentTemplateToProto(applyAgentVersion(a, v)))
+			}
+		}
+	}
+
+	return &pb.ListAgentTemplatesResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(len(builtins) + total),
+	}, nil
+}
+
+// CloneAgent 克隆 Agent 或模板为新的草稿
+func (s *AgentServer) CloneAgent(ctx context.Context, req *pb.CloneAgentRequest) (*pb.Agent, error) {
+	if req.SourceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "source_id is required")
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	source, err := s.templateSource(ctx, req.SourceId)
+	if err != nil {
+		return nil, err
+	}
+	config, err := s.sourceConfig(ctx, source, req.IncludeBindings)
+	if err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = config.Name + " (copy)"
+	}
+
+	clone := &ent.Agent{
+		ID:              uuid.New().String(),
+		Name:            name,
+		Description:     config.Description,
+		Type:            config.Type,
+		ModelConfig:     config.ModelConfig,
+		Tools:           config.Tools,
+		KnowledgeBases:  config.KnowledgeBases,
+		Members:         config.Members,
+		PromptTemplate:  config.PromptTemplate,
+		Parameters:      config.Parameters,
+		Tags:            config.Tags,
+		Folder:          req.Folder,
+		Status:          "draft",
+		Version:         "1.0.0",
+		CreatedBy:       userID,
+		TemplateID:      source.id,
+		TemplateVersion: source.version,
+	}
+	if err := s.validateAgent(ctx, clone); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, clone)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clone agent: %v", err)
+	}
+
+	return agentToProto(created, permissionOwner), nil
+}
+
+// DiffAgentTemplate 比较 Agent 与来源模板
+func (s *AgentServer) DiffAgentTemplate(ctx context.Context, req *pb.DiffAgentTemplateRequest) (*pb.DiffAgentTemplateResponse, error) {
+	if req.AgentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent_id is required")
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.AgentId, permissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	if agent.TemplateID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "agent was not cloned from a template")
+	}
+
+	source, err := s.templateSource(ctx, agent.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	config, err := s.sourceConfig(ctx, source, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiffAgentTemplateResponse{
+		TemplateId:      source.id,
+		ClonedVersion:   agent.TemplateVersion,
+		TemplateVersion: source.version,
+		Changes:         diffAgentFields(templateFields(agent), templateFields(config)),
+	}, nil
+}
+
+// templateSource loads a clone source: a built-in template, a public published agent that
+// allows cloning as published, or an agent the caller may view as currently configured
+func (s *AgentServer) templateSource(ctx context.Context, id string) (*templateSource, error) {
+	if strings.HasPrefix(id, manifest.BuiltinPrefix) {
+		t, err := manifest.Builtin(id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load built-in templates: %v", err)
+		}
+		if t == nil {
+			return nil, status.Errorf(codes.NotFound, "template not found: %s", id)
+		}
+		return &templateSource{id: t.ID, version: t.Version, builtin: t.Agent}, nil
+	}
+
+	agent, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
+	}
+	perm, err := agentPermissionFor(ctx, s.shareRepo, agent)
+	if err != nil {
+		return nil, err
+	}
+	cloneable := perm >= permissionViewer || perm == permissionChat && agent.AllowClone
+	if cloneable && agent.IsPublic && agent.PublishedVersionID != "" {
+		v, err := s.versionRepo.Get(ctx, agent.PublishedVersionID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load published version: %v", err)
+		}
+		return &templateSource{id: agent.ID, version: v.Version, agent: applyAgentVersion(agent, v)}, nil
+	}
+	if perm == permissionChat {
+		return nil, status.Errorf(codes.PermissionDenied, "agent does not allow cloning: %s", id)
+	}
+	if err := checkAgentPermission(agent, perm, permissionViewer); err != nil {
+		return nil, err
+	}
+	return &templateSource{id: agent.ID, version: agent.Version, agent: agent}, nil
+}
+
+// sourceConfig returns the configuration of a clone source with tools, knowledge bases and
+// members as IDs, or without them unless includeBindings is set
+func (s *AgentServer) sourceConfig(ctx context.Context, source *templateSource, includeBindings bool) (*ent.Agent, error) {
+	var config ent.Agent
+	if source.builtin != nil {
+		m := source.builtin
+		config = ent.Agent{
+			Name:           m.Name,
+			Description:    m.Description,
+			Type:           m.Type,
+			ModelConfig:    m.ModelConfig,
+			PromptTemplate: m.Prompt,
+			Parameters:     m.Parameters,
+			Tags:           m.Tags,
+		}
+		if config.Type == "" {
+			config.Type = "single"
+		}
+		if includeBindings {
+			if err := s.resolveManifestReferences(ctx, m, &config); err != nil {
+				return nil, err
+			}
+		}
+		return &config, nil
+	}
+
+	config = *source.agent
+	if !includeBindings {
+		config.Tools, config.KnowledgeBases, config.Members = nil, nil, nil
+	}
+	return &config, nil
+}
+
+// templateFields returns the agent fields compared with templates; the name is left out
+// since clones are usually renamed
+func templateFields(a *ent.Agent) []agentField {
+	var fields []agentField
+	for _, f := range agentFields(a) {
+		if f.name != "name" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// builtinTemplateToProto converts a built-in template to pb.AgentTemplate
+func builtinTemplateToProto(t *manifest.Template) *pb.AgentTemplate {
+	m := t.Agent
+	pbTemplate := &pb.AgentTemplate{
+		Id:             t.ID,
+		Name:           m.Name,
+		Description:    m.Description,
+		Type:           m.Type,
+		Tags:           m.Tags,
+		Builtin:        true,
+		Version:        t.Version,
+		CreatedBy:      "system",
+		Tools:          m.Tools,
+		KnowledgeBases: m.KnowledgeBases,
+		PromptTemplate: m.Prompt,
+	}
+	if pbTemplate.Type == "" {
+		pbTemplate.Type = "single"
+	}
+
+	// 设置可选字段
+	if m.ModelConfig != nil {
+		if modelConfig, err := structpb.NewStruct(m.ModelConfig); err == nil {
+			pbTemplate.ModelConfig = modelConfig
+		}
+	}
+	if m.Parameters != nil {
+		if parameters, err := structpb.NewStruct(m.Parameters); err == nil {
+			pbTemplate.Parameters = parameters
+		}
+	}
+
+	return pbTemplate
+}
+
+// agentTemplateToProto converts a public agent, configured as published, to pb.AgentTemplate
+func agentTemplateToProto(a *ent.Agent) *pb.AgentTemplate {
+	pbTemplate := &pb.AgentTemplate{
+		Id:             a.ID,
+		Name:           a.Name,
+		Description:    a.Description,
+		Type:           a.Type,
+		Tags:           a.Tags,
+		Version:        a.Version,
+		CreatedBy:      a.CreatedBy,
+		Tools:          a.Tools,
+		KnowledgeBases: a.KnowledgeBases,
+		PromptTemplate: a.PromptTemplate,
+	}
+
+	// 设置可选字段
+	if a.ModelConfig != nil {
+		if modelConfig, err := structpb.NewStruct(a.ModelConfig); err == nil {
+			pbTemplate.ModelConfig = modelConfig
+		}
+	}
+	if a.Parameters != nil {
+		if parameters, err := structpb.NewStruct(a.Parameters); err == nil {
+			pbTemplate.Parameters = parameters
+		}
+	}
+
+	return pbTemplate
+}
@@ -0,0 +1,343 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"agent-platform/internal/ai"
+	"agent-platform/internal/model/ent"
+
+	"go.uber.org/zap"
+)
+
+// Tools of type agent let an agent call another one. The tool's implementation holds the ID
+// of the agent called and its schema is generated: an input string and optional context. A
+// call runs the target agent in an ephemeral sub-conversation, which is not stored, and
+// returns its answer. Calls nest, the agents of a turn share one budget of calls and tokens,
+// and calls that would exceed a limit or loop back to an agent already answering are refused
+// with a message the calling model sees as the tool's result.
+
+const (
+	// maxAgentToolDepth is the number of nested agent tool calls a turn makes at most
+	maxAgentToolDepth = 3
+	// maxAgentToolCalls is the number of agent tool calls a turn makes at most
+	maxAgentToolCalls = 10
+	// maxAgentToolTokens is the number of tokens the agents called during a turn use at most
+	maxAgentToolTokens = 100000
+	// maxAgentToolInput is the length of the input and context of a call, in characters
+	maxAgentToolInput = 8000
+	// maxToolRounds is the number of times a model calls tools before it must answer
+	maxToolRounds = 5
+)
+
+// agentToolParameters returns the JSON schema of the arguments of agent tools
+func agentToolParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"input": map[string]interface{}{
+				"type":        "string",
+				"description": "The request for the agent, written as self-contained instructions",
+			},
+			"context": map[string]interface{}{
+				"type":        "string",
+				"description": "Background the agent needs, it does not see this conversation",
+			},
+		},
+		"required": []interface{}{"input"},
+	}
+}
+
+// agentToolSchema generates the schema of a tool calling an agent
+func agentToolSchema(t *ent.Tool) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "function",
+		"function": map[string]interface{}{
+			"name":        ai.FunctionName(t.Name),
+			"description": t.Description,
+			"parameters":  agentToolParameters(),
+		},
+	}
+}
+
+// estimateToolsTokens approximates the tokens the definitions of tools take in a request
+func estimateToolsTokens(tools []ai.Tool) int {
+	total := 0
+	for _, t := range tools {
+		params, _ := json.Marshal(t.Parameters)
+		total += ai.EstimateTokens(t.Name) + ai.EstimateTokens(t.Description) + ai.EstimateTokens(string(params))
+	}
+	return total
+}
+
+// agentCallBudget is shared by the agents answering a turn
+type agentCallBudget struct {
+	mu     sync.Mutex
+	calls  int
+	tokens int
+}
+
+// reserve counts a call, returning an error when the turn's budget is exhausted
+func (b *agentCallBudget) reserve() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.calls >= maxAgentToolCalls {
+		return fmt.Errorf("the limit of %d agent calls per turn is reached", maxAgentToolCalls)
+	}
+	if b.tokens >= maxAgentToolTokens {
+		return fmt.Errorf("the limit of %d tokens for agent calls per turn is reached", maxAgentToolTokens)
+	}
+	b.calls++
+	return nil
+}
+
+// spend counts the tokens used by a call
+func (b *agentCallBudget) spend(tokens int) {
+	b.mu.Lock()
+	b.tokens += tokens
+	b.mu.Unlock()
+}
+
+// agentCall describes the agents answering through nested tool calls
+type agentCall struct {
+	chain  []string // IDs of the agents answering, the turn's agent first
+	budget *agentCallBudget
+}
+
+type agentCallKey struct{}
+
+// withAgentCall returns a context in which agentID answers, called by the agents in ctx
+func withAgentCall(ctx context.Context, agentID string) context.Context {
+	call := &agentCall{chain: []string{agentID}, budget: &agentCallBudget{}}
+	if parent := agentCallFrom(ctx); parent != nil {
+		call.chain = append(append([]string(nil), parent.chain...), agentID)
+		call.budget = parent.budget
+	}
+	return context.WithValue(ctx, agentCallKey{}, call)
+}
+
+// agentCallFrom returns the agent call of ctx, nil outside of turns
+func agentCallFrom(ctx context.Context) *agentCall {
+	call, _ := ctx.Value(agentCallKey{}).(*agentCall)
+	return call
+}
+
+// calling reports whether agentID is answering in ctx
+func (c *agentCall) calling(agentID string) bool {
+	for _, id := range c.chain {
+		if id == agentID {
+			return true
+		}
+	}
+	return false
+}
+
+// agentTools returns the agent tools an agent may call in ctx by function name. None are
+// offered once calls are nested maxAgentToolDepth deep, nor tools calling an agent already
+// answering.
+func (s *ConversationServer) agentTools(ctx context.Context, agent *ent.Agent) ([]ai.Tool, map[string]*ent.Tool, error) {
+	if len(agent.Tools) == 0 {
+		return nil, nil, nil
+	}
+	call := agentCallFrom(ctx)
+	if call != nil && len(call.chain) > maxAgentToolDepth {
+		return nil, nil, nil
+	}
+
+	tools, err := s.toolRepo.ListByIDs(ctx, agent.Tools)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load tools: %w", err)
+	}
+
+	var fns []ai.Tool
+	byName := make(map[string]*ent.Tool)
+	for _, t := range tools {
+		if t.Type != "agent" || t.Implementation == agent.ID || call != nil && call.calling(t.Implementation) {
+			continue
+		}
+		name := ai.FunctionName(t.Name)
+		for i := 2; byName[name] != nil; i++ {
+			name = fmt.Sprintf("%s_%d", ai.FunctionName(t.Name), i)
+		}
+		byName[name] = t
+		fns = append(fns, ai.Tool{
+			Name:        name,
+			Description: t.Description,
+			Parameters:  agentToolParameters(),
+		})
+	}
+	return fns, byName, nil
+}
+
+// complete answers a plan's request without streaming. Models offered tools may call them
+// for up to maxToolRounds rounds before they must answer. The response counts the tokens of
+// every round and the calls made are returned as stored in message metadata.
+func (s *ConversationServer) complete(ctx context.Context, plan *replyPlan, conv *ent.Conversation) (*ai.ChatResponse, []interface{}, error) {
+	if agentCallFrom(ctx) == nil {
+		ctx = withAgentCall(ctx, plan.agent.ID)
+	}
+
+	request := plan.request
+	request.Messages = append([]ai.Message(nil), plan.request.Messages...)
+
+	var calls []interface{}
+	var promptTokens, completionTokens int
+	for round := 0; ; round++ {
+		if round == maxToolRounds {
+			request.Tools = nil
+		}
+
+		resp, err := s.aiManager.Chat(ctx, request)
+		if err != nil {
+			return nil, calls, err
+		}
+		promptTokens += resp.PromptTokens
+		completionTokens += resp.CompletionTokens
+
+		if len(resp.ToolCalls) == 0 || len(request.Tools) == 0 {
+			resp.PromptTokens = promptTokens
+			resp.CompletionTokens = completionTokens
+			resp.TokensUsed = promptTokens + completionTokens
+			return resp, calls, nil
+		}
+
+		request.Messages = append(request.Messages, ai.Message{
+			Role:      "assistant",
+			Content:   resp.Content,
+			ToolCalls: resp.ToolCalls,
+		})
+		for _, tc := range resp.ToolCalls {
+			output, record := s.callAgentTool(ctx, plan, conv, tc)
+			calls = append(calls, record)
+			request.Messages = append(request.Messages, ai.Message{
+				Role:       "tool",
+				Content:    output,
+				ToolCallID: tc.ID,
+			})
+		}
+		if ctx.Err() != nil {
+			return nil, calls, ctx.Err()
+		}
+	}
+}
+
+// callAgentTool runs the agent a tool call asks for, returning the result for the model and
+// the record of the call. Failures are reported to the model rather than failing the turn.
+func (s *ConversationServer) callAgentTool(ctx context.Context, plan *replyPlan, conv *ent.Conversation, tc ai.ToolCall) (string, map[string]interface{}) {
+	record := map[string]interface{}{
+		"tool_name": tc.Name,
+	}
+	fail := func(err error) (string, map[string]interface{}) {
+		record["error"] = err.Error()
+		return "Error: " + err.Error(), record
+	}
+
+	tool := plan.tools[tc.Name]
+	if tool == nil {
+		return fail(fmt.Errorf("unknown tool %q", tc.Name))
+	}
+	record["tool_id"] = tool.ID
+	record["agent_id"] = tool.Implementation
+
+	var args struct {
+		Input   string `json:"input"`
+		Context string `json:"context"`
+	}
+	if err := json.Unmarshal([]byte(tc.Arguments), &args); err != nil {
+		return fail(fmt.Errorf("invalid arguments: %v", err))
+	}
+	args.Input = strings.TrimSpace(args.Input)
+	record["input"] = args.Input
+	if args.Input == "" {
+		return fail(fmt.Errorf("input is required"))
+	}
+	if utf8.RuneCountInString(args.Input)+utf8.RuneCountInString(args.Context) > maxAgentToolInput {
+		return fail(fmt.Errorf("input and context exceed %d characters", maxAgentToolInput))
+	}
+
+	call := agentCallFrom(ctx)
+	if call.calling(tool.Implementation) {
+		return fail(fmt.Errorf("the agent is already answering, it cannot be called recursively"))
+	}
+	if err := call.budget.reserve(); err != nil {
+		return fail(err)
+	}
+
+	target, err := s.runnableAgent(ctx, tool.Implementation)
+	if err != nil {
+		return fail(err)
+	}
+	record["agent_name"] = target.Name
+
+	// The target answers in a sub-conversation of its own, on behalf of the same user
+	extraContext := ""
+	if args.Context != "" {
+		extraContext = "=== Context from the calling agent ===\n" + args.Context + "\n=== End of context ==="
+	}
+	resp, nested, err := s.answerEphemeral(withAgentCall(ctx, target.ID), target, conv, args.Input, extraContext)
+	if len(nested) > 0 {
+		record["tool_calls"] = nested
+	}
+	if err != nil {
+		s.logger.Warn("Agent tool call failed",
+			zap.String("tool_id", tool.ID),
+			zap.String("agent_id", target.ID),
+			zap.Error(err),
+		)
+		return fail(fmt.Errorf("the agent failed to answer"))
+	}
+	call.budget.spend(resp.PromptTokens + resp.CompletionTokens)
+
+	record["output"] = resp.Content
+	record["prompt_tokens"] = resp.PromptTokens
+	record["completion_tokens"] = resp.CompletionTokens
+	return resp.Content, record
+}
+
+// answerEphemeral has an agent answer input in an ephemeral sub-conversation on behalf of
+// conv's user, returning its answer and the tools it called. Nothing is stored.
+func (s *ConversationServer) answerEphemeral(ctx context.Context, agent *ent.Agent, conv *ent.Conversation, input, extraContext string) (*ai.ChatResponse, []interface{}, error) {
+	sub := &ent.Conversation{UserID: conv.UserID, Context: conv.Context}
+	userMessage := &ent.Message{Content: input}
+
+	var plan *replyPlan
+	var err error
+	if agent.Type == "multi-agent" {
+		plan, _, err = s.orchestrate(ctx, agent, sub, nil, userMessage)
+	} else {
+		plan, err = s.planReply(ctx, agent, sub, nil, userMessage, extraContext)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.complete(ctx, plan, sub)
+}
+
+// runnableAgent loads an agent to answer on behalf of the user in ctx, as published when
+// it has been
+func (s *ConversationServer) runnableAgent(ctx context.Context, id string) (*ent.Agent, error) {
+	agent, err := s.agentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("the agent no longer exists")
+	}
+	if err := s.checkAgentAccess(ctx, agent, permissionChat); err != nil {
+		return nil, fmt.Errorf("the agent is not shared with the user")
+	}
+	if agent.Status == "archived" {
+		return nil, fmt.Errorf("the agent is archived")
+	}
+	if agent.PublishedVersionID == "" {
+		return agent, nil
+	}
+
+	version, err := s.versionRepo.Get(ctx, agent.PublishedVersionID)
+	if err != nil {
+		return nil, fmt.Errorf("the published version of the agent is unavailable")
+	}
+	return applyAgentVersion(agent, version), nil
+}
@@ -0,0 +1,356 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Publishing an agent stores an immutable snapshot of its configuration. New conversations
+// are pinned to the published version, so later edits of the live agent only reach them
+// once they are published.
+
+// semverPattern matches MAJOR.MINOR.PATCH versions
+var semverPattern = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)$`)
+
+// agentField is a configuration field captured by agent versions
+type agentField struct {
+	name  string
+	value interface{}
+}
+
+// PublishAgent 发布 Agent 版本
+func (s *AgentServer) PublishAgent(ctx context.Context, req *pb.PublishAgentRequest) (*pb.AgentVersion, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Version != "" && !semverPattern.MatchString(req.Version) {
+		return nil, status.Errorf(codes.InvalidArgument, "version must be MAJOR.MINOR.PATCH: %s", req.Version)
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.Id, permissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if agent.Status == "archived" {
+		return nil, status.Error(codes.FailedPrecondition, "archived agents cannot be published, restore them to draft first")
+	}
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateAgent(ctx, agent); err != nil {
+		return nil, err
+	}
+
+	// Publishing without changes would only add a duplicate snapshot
+	if agent.PublishedVersionID != "" {
+		current, err := s.versionRepo.Get(ctx, agent.PublishedVersionID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load published version: %v", err)
+		}
+		if len(diffAgentFields(versionFields(current), agentFields(agent))) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "no changes since version %s", current.Version)
+		}
+	}
+
+	version := req.Version
+	if version == "" {
+		latest, err := s.versionRepo.Latest(ctx, agent.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load agent versions: %v", err)
+		}
+		version = nextAgentVersion(agent, latest)
+	}
+
+	published, _, err := s.versionRepo.Publish(ctx, &ent.AgentVersion{
+		ID:             uuid.New().String(),
+		AgentID:        agent.ID,
+		Version:        version,
+		Name:           agent.Name,
+		Description:    agent.Description,
+		Type:           agent.Type,
+		ModelConfig:    agent.ModelConfig,
+		Tools:          agent.Tools,
+		KnowledgeBases: agent.KnowledgeBases,
+		Members:        agent.Members,
+		PromptTemplate: agent.PromptTemplate,
+		Parameters:     agent.Parameters,
+		Notes:          req.Notes,
+		CreatedBy:      userID,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrVersionExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "version %s already exists", version)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to publish agent: %v", err)
+	}
+
+	pbVersion := entAgentVersionToProto(published)
+	pbVersion.Current = true
+	return pbVersion, nil
+}
+
+// ListAgentVersions 获取 Agent 版本列表
+func (s *AgentServer) ListAgentVersions(ctx context.Context, req *pb.ListAgentVersionsRequest) (*pb.ListAgentVersionsResponse, error) {
+	if req.AgentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent_id is required")
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.AgentId, permissionViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	versions, total, err := s.versionRepo.List(ctx, agent.ID, page, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list agent versions: %v", err)
+	}
+
+	items := make([]*pb.AgentVersion, len(versions))
+	for i, v := range versions {
+		items[i] = entAgentVersionToProto(v)
+		items[i].Current = v.ID == agent.PublishedVersionID
+	}
+
+	return &pb.ListAgentVersionsResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
+// RollbackAgent 回滚 Agent 到指定版本
+func (s *AgentServer) RollbackAgent(ctx context.Context, req *pb.RollbackAgentRequest) (*pb.Agent, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Version == "" {
+		return nil, status.Error(codes.InvalidArgument, "version is required")
+	}
+
+	agent, perm, err := s.loadAgent(ctx, req.Id, permissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if agent.Status == "archived" {
+		return nil, status.Error(codes.FailedPrecondition, "archived agents cannot be rolled back, restore them to draft first")
+	}
+	target, err := s.versionRepo.GetByVersion(ctx, req.Id, req.Version)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	// Tools, knowledge bases and members of the version may have been deleted since
+	if err := s.validateAgent(ctx, applyAgentVersion(agent, target)); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.versionRepo.Restore(ctx, target)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to roll back agent: %v", err)
+	}
+
+	return agentToProto(restored, perm), nil
+}
+
+// DiffAgentVersions 比较 Agent 版本
+func (s *AgentServer) DiffAgentVersions(ctx context.Context, req *pb.DiffAgentVersionsRequest) (*pb.DiffAgentVersionsResponse, error) {
+	if req.AgentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent_id is required")
+	}
+
+	agent, _, err := s.loadAgent(ctx, req.AgentId, permissionViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	var from *ent.AgentVersion
+	if req.FromVersion != "" {
+		from, err = s.versionRepo.GetByVersion(ctx, agent.ID, req.FromVersion)
+	} else if agent.PublishedVersionID != "" {
+		from, err = s.versionRepo.Get(ctx, agent.PublishedVersionID)
+	} else {
+		return nil, status.Error(codes.FailedPrecondition, "agent has not been published, from_version is required")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	resp := &pb.DiffAgentVersionsResponse{FromVersion: from.Version}
+	toFields := agentFields(agent)
+	if req.ToVersion != "" {
+		to, err := s.versionRepo.GetByVersion(ctx, agent.ID, req.ToVersion)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		resp.ToVersion = to.Version
+		toFields = versionFields(to)
+	}
+
+	resp.Changes = diffAgentFields(versionFields(from), toFields)
+	return resp, nil
+}
+
+// nextAgentVersion returns the version number of the next publication: the agent's
+// initial version at first, then the latest version with its minor version bumped
+func nextAgentVersion(agent *ent.Agent, latest *ent.AgentVersion) string {
+	if latest == nil {
+		if semverPattern.MatchString(agent.Version) {
+			return agent.Version
+		}
+		return "1.0.0"
+	}
+
+	m := semverPattern.FindStringSubmatch(latest.Version)
+	if m == nil {
+		return "1.0.0"
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	return fmt.Sprintf("%d.%d.0", major, minor+1)
+}
+
+// applyAgentVersion returns a copy of agent configured as in version v
+func applyAgentVersion(agent *ent.Agent, v *ent.AgentVersion) *ent.Agent {
+	pinned := *agent
+	pinned.Name = v.Name
+	pinned.Description = v.Description
+	pinned.Type = v.Type
+	pinned.ModelConfig = v.ModelConfig
+	pinned.Tools = v.Tools
+	pinned.KnowledgeBases = v.KnowledgeBases
+	pinned.Members = v.Members
+	pinned.PromptTemplate = v.PromptTemplate
+	pinned.Parameters = v.Parameters
+	pinned.Version = v.Version
+	return &pinned
+}
+
+// agentFields returns the versioned configuration of the live agent
+func agentFields(a *ent.Agent) []agentField {
+	return []agentField{
+		{"name", a.Name},
+		{"description", a.Description},
+		{"type", a.Type},
+		{"model_config", a.ModelConfig},
+		{"tools", a.Tools},
+		{"knowledge_bases", a.KnowledgeBases},
+		{"members", a.Members},
+		{"prompt_template", a.PromptTemplate},
+		{"parameters", a.Parameters},
+	}
+}
+
+// versionFields returns the configuration captured by an agent version
+func versionFields(v *ent.AgentVersion) []agentField {
+	return []agentField{
+		{"name", v.Name},
+		{"description", v.Description},
+		{"type", v.Type},
+		{"model_config", v.ModelConfig},
+		{"tools", v.Tools},
+		{"knowledge_bases", v.KnowledgeBases},
+		{"members", v.Members},
+		{"prompt_template", v.PromptTemplate},
+		{"parameters", v.Parameters},
+	}
+}
+
+// diffAgentFields lists the fields that differ between two configurations, in field order
+func diffAgentFields(from, to []agentField) []*pb.AgentFieldChange {
+	changes := []*pb.AgentFieldChange{}
+	for i := range from {
+		fromValue, toValue := fieldValue(from[i].value), fieldValue(to[i].value)
+		if !proto.Equal(fromValue, toValue) {
+			changes = append(changes, &pb.AgentFieldChange{
+				Field: from[i].name,
+				From:  fromValue,
+				To:    toValue,
+			})
+		}
+	}
+	return changes
+}
+
+// fieldValue converts a configuration value to a protobuf value; empty lists and maps are null
+func fieldValue(value interface{}) *structpb.Value {
+	switch v := value.(type) {
+	case []string:
+		if len(v) == 0 {
+			return structpb.NewNullValue()
+		}
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = item
+		}
+		value = list
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return structpb.NewNullValue()
+		}
+	}
+
+	pbValue, err := structpb.NewValue(value)
+	if err != nil {
+		return structpb.NewStringValue(fmt.Sprint(value))
+	}
+	return pbValue
+}
+
+// entAgentVersionToProto converts ent.AgentVersion to pb.AgentVersion
+func entAgentVersionToProto(v *ent.AgentVersion) *pb.AgentVersion {
+	pbVersion := &pb.AgentVersion{
+		Id:             v.ID,
+		AgentId:        v.AgentID,
+		Version:        v.Version,
+		Name:           v.Name,
+		Description:    v.Description,
+		Type:           v.Type,
+		Tools:          v.Tools,
+		KnowledgeBases: v.KnowledgeBases,
+		Members:        v.Members,
+		PromptTemplate: v.PromptTemplate,
+		Notes:          v.Notes,
+		CreatedBy:      v.CreatedBy,
+		CreatedAt:      timestamppb.New(v.CreatedAt),
+	}
+
+	// 设置可选字段
+	if v.ModelConfig != nil {
+		if modelConfig, err := structpb.NewStruct(v.ModelConfig); err == nil {
+			pbVersion.ModelConfig = modelConfig
+		}
+	}
+	if v.Parameters != nil {
+		if parameters, err := structpb.NewStruct(v.Parameters); err == nil {
+			pbVersion.Parameters = parameters
+		}
+	}
+
+	return pbVersion
+}
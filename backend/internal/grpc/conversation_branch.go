@@ -0,0 +1,252 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/model/ent"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Messages form a tree through their parent IDs: editing or regenerating a message adds a
+// sibling instead of overwriting it. The conversation's active message is the end of the
+// branch that is shown to the user and sent to the model.
+
+// EditMessage 编辑用户消息并在新分支上重新生成回复
+func (s *ConversationServer) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.SendMessageResponse, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+	if req.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	conv, stored, err := s.loadConversationMessages(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(ctx, conv, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	byID := indexMessages(stored)
+	target, ok := byID[req.MessageId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "message not found: %s", req.MessageId)
+	}
+	if target.Role != "user" {
+		return nil, status.Error(codes.InvalidArgument, "only user messages can be edited")
+	}
+
+	// The edited message becomes a sibling of the original
+	userMessage := &ent.Message{
+		ID:             uuid.New().String(),
+		ConversationID: conv.ID,
+		ParentID:       target.ParentID,
+		Role:           "user",
+		Content:        req.Content,
+		Metadata:       target.Metadata,
+		CreatedAt:      time.Now(),
+	}
+	if req.Metadata != nil {
+		userMessage.Metadata = req.Metadata.AsMap()
+	}
+
+	result, err := s.generateReply(ctx, conv, branchTo(byID, target.ParentID), userMessage, true, req.GenerationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.toProto(conv.ID), nil
+}
+
+// RegenerateMessage 在新分支上重新生成助手回复
+func (s *ConversationServer) RegenerateMessage(ctx context.Context, req *pb.RegenerateMessageRequest) (*pb.SendMessageResponse, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+
+	conv, stored, err := s.loadConversationMessages(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(ctx, conv, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	byID := indexMessages(stored)
+	target, ok := byID[req.MessageId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "message not found: %s", req.MessageId)
+	}
+	if target.Role != "assistant" {
+		return nil, status.Error(codes.InvalidArgument, "only assistant messages can be regenerated")
+	}
+	userMessage, ok := byID[target.ParentID]
+	if !ok || userMessage.Role != "user" {
+		return nil, status.Error(codes.FailedPrecondition, "message does not reply to a user message")
+	}
+
+	// The new reply becomes a sibling of the original
+	result, err := s.generateReply(ctx, conv, branchTo(byID, userMessage.ParentID), userMessage, false, req.GenerationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.toProto(conv.ID), nil
+}
+
+// ListMessageSiblings 获取消息的兄弟分支
+func (s *ConversationServer) ListMessageSiblings(ctx context.Context, req *pb.ListMessageSiblingsRequest) (*pb.ListMessageSiblingsResponse, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+
+	conv, stored, err := s.loadConversationMessages(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	target, ok := indexMessages(stored)[req.MessageId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "message not found: %s", req.MessageId)
+	}
+
+	onActive := map[string]bool{}
+	for _, msg := range activePath(stored, conv.ActiveMessageID) {
+		onActive[msg.ID] = true
+	}
+
+	siblings := []*ent.Message{}
+	activeIndex := -1
+	for _, msg := range stored {
+		if msg.ParentID != target.ParentID {
+			continue
+		}
+		if onActive[msg.ID] {
+			activeIndex = len(siblings)
+		}
+		siblings = append(siblings, msg)
+	}
+
+	return &pb.ListMessageSiblingsResponse{
+		Items:       entMessagesToProto(siblings),
+		ActiveIndex: int32(activeIndex),
+	}, nil
+}
+
+// SwitchBranch 切换对话的当前分支
+func (s *ConversationServer) SwitchBranch(ctx context.Context, req *pb.SwitchBranchRequest) (*pb.Conversation, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+
+	conv, stored, err := s.loadConversationMessages(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(ctx, conv, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	if _, ok := indexMessages(stored)[req.MessageId]; !ok {
+		return nil, status.Errorf(codes.NotFound, "message not found: %s", req.MessageId)
+	}
+
+	leaf := latestLeaf(stored, req.MessageId)
+	if _, err := s.convRepo.Update(ctx, req.ConversationId, conv.Version, map[string]interface{}{"active_message_id": leaf}); err != nil {
+		return nil, conversationWriteStatus(err, "switch branch")
+	}
+
+	updated, err := s.convRepo.GetWithMessages(ctx, req.ConversationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reload conversation: %v", err)
+	}
+
+	return entConversationToProto(updated), nil
+}
+
+// loadConversationMessages loads a conversation the caller may access and all of its messages in chronological order
+func (s *ConversationServer) loadConversationMessages(ctx context.Context, conversationID string) (*ent.Conversation, []*ent.Message, error) {
+	conv, err := s.loadConversation(ctx, conversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored, err := s.msgRepo.ListByConversation(ctx, conversationID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to load messages: %v", err)
+	}
+
+	return conv, stored, nil
+}
+
+// indexMessages maps messages by ID
+func indexMessages(messages []*ent.Message) map[string]*ent.Message {
+	byID := make(map[string]*ent.Message, len(messages))
+	for _, msg := range messages {
+		byID[msg.ID] = msg
+	}
+	return byID
+}
+
+// activePath returns the branch ending at activeID, oldest message first. Conversations
+// without a valid active message follow the branch of the most recent message.
+func activePath(messages []*ent.Message, activeID string) []*ent.Message {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	byID := indexMessages(messages)
+	if _, ok := byID[activeID]; !ok {
+		activeID = messages[len(messages)-1].ID
+	}
+	return branchTo(byID, activeID)
+}
+
+// branchTo returns the messages from the root of the tree down to id, oldest first
+func branchTo(byID map[string]*ent.Message, id string) []*ent.Message {
+	branch := []*ent.Message{}
+	for msg, ok := byID[id]; ok && len(branch) < len(byID); msg, ok = byID[msg.ParentID] {
+		branch = append(branch, msg)
+	}
+
+	// Reverse into chronological order
+	for i, j := 0, len(branch)-1; i < j; i, j = i+1, j-1 {
+		branch[i], branch[j] = branch[j], branch[i]
+	}
+	return branch
+}
+
+// latestLeaf follows the most recent reply from id down to the end of its branch
+func latestLeaf(messages []*ent.Message, id string) string {
+	latestChild := map[string]string{}
+	for _, msg := range messages {
+		// Messages are chronological, so later children replace earlier ones
+		latestChild[msg.ParentID] = msg.ID
+	}
+
+	for steps := 0; steps < len(messages); steps++ {
+		child, ok := latestChild[id]
+		if !ok {
+			break
+		}
+		id = child
+	}
+	return id
+}
@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Conversations carry a version that every write increments. Clients pass the version they
+// based a request on (expected_version or the If-Match header) to get a conflict instead of
+// overwriting a concurrent change, and an idempotency key (idempotency_key or the
+// Idempotency-Key header) to make retried sends safe.
+
+// maxIdempotencyKeyLength caps the length of idempotency keys
+const maxIdempotencyKeyLength = 255
+
+// incomingHeader returns the first value of a request metadata key forwarded by the gateway
+func incomingHeader(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// idempotencyKey returns the idempotency key of a request, from the request or the Idempotency-Key header
+func idempotencyKey(ctx context.Context, requested string) (string, error) {
+	key := requested
+	if key == "" {
+		key = strings.TrimSpace(incomingHeader(ctx, "idempotency-key"))
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "idempotency_key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	return key, nil
+}
+
+// expectedVersion returns the conversation version a request is based on, from the request
+// or the If-Match header, 0 when neither is set
+func expectedVersion(ctx context.Context, requested int64) (int64, error) {
+	if requested != 0 {
+		return requested, nil
+	}
+
+	value := incomingHeader(ctx, "if-match")
+	if value == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(value, "W/"), `"`), 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid If-Match version: %s", value)
+	}
+	return version, nil
+}
+
+// checkVersion fails with Aborted when conv is not at the version the request is based on
+func checkVersion(ctx context.Context, conv *ent.Conversation, requested int64) error {
+	expected, err := expectedVersion(ctx, requested)
+	if err != nil {
+		return err
+	}
+	if expected != 0 && expected != conv.Version {
+		return status.Errorf(codes.Aborted, "conversation is at version %d, expected %d", conv.Version, expected)
+	}
+	return nil
+}
+
+// conversationWriteStatus converts a failed conversation write to a gRPC status error
+func conversationWriteStatus(err error, action string) error {
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+}
+
+// maxReplyAppends caps the attempts to store a reply while the conversation keeps changing
+const maxReplyAppends = 3
+
+// waitForSend claims the in-flight slot of a send, waiting while another request holds it.
+// The returned function releases the slot.
+func (s *ConversationServer) waitForSend(ctx context.Context, inFlight string) (func(), error) {
+	done := make(chan struct{})
+	for {
+		running, busy := s.sending.LoadOrStore(inFlight, done)
+		if !busy {
+			break
+		}
+		select {
+		case <-running.(chan struct{}):
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	return func() {
+		s.sending.Delete(inFlight)
+		close(done)
+	}, nil
+}
+
+// appendReply stores a generated exchange against the version of conv it was generated from
+// and returns the new version. Writes that left the active branch alone in the meantime, such
+// as a rename, do not discard the reply: it is stored against the current version instead.
+func (s *ConversationServer) appendReply(ctx context.Context, conv *ent.Conversation, messages []*ent.Message) ([]*ent.Message, int64, error) {
+	version := conv.Version
+	for attempt := 1; ; attempt++ {
+		saved, err := s.msgRepo.Append(ctx, conv.ID, version, messages...)
+		if err == nil {
+			return saved, version + 1, nil
+		}
+		if !errors.Is(err, repository.ErrVersionConflict) || attempt == maxReplyAppends {
+			return nil, 0, err
+		}
+
+		current, getErr := s.convRepo.Get(ctx, conv.ID)
+		if getErr != nil || current.ActiveMessageID != conv.ActiveMessageID {
+			return nil, 0, err
+		}
+		version = current.Version
+	}
+}
+
+// replaySend returns the result of an earlier SendMessage with the same idempotency key,
+// or nil when the key was not used yet
+func replaySend(conv *ent.Conversation, stored []*ent.Message, key, content string) (*pb.SendMessageResponse, error) {
+	var userMessage *ent.Message
+	for _, msg := range stored {
+		if msg.IdempotencyKey == key {
+			userMessage = msg
+			break
+		}
+	}
+	if userMessage == nil {
+		return nil, nil
+	}
+	if userMessage.Content != content {
+		return nil, status.Error(codes.InvalidArgument, "idempotency_key was already used with different content")
+	}
+
+	resp := &pb.SendMessageResponse{
+		ConversationId:      conv.ID,
+		Messages:            []*pb.Message{entMessageToProto(userMessage)},
+		ConversationVersion: conv.Version,
+		Replayed:            true,
+	}
+
+	// Messages are chronological, so the first reply is the one of the original request
+	for _, msg := range stored {
+		if msg.ParentID == userMessage.ID && msg.Role == "assistant" {
+			resp.Messages = append(resp.Messages, entMessageToProto(msg))
+			resp.Cancelled = msg.Metadata["status"] == "cancelled"
+			break
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,308 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/auth"
+	"agent-platform/internal/export"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// exportLimit caps the conversations in a bulk export
+	exportLimit = 1000
+	// importMessageLimit caps the messages of an imported conversation
+	importMessageLimit = 1000
+)
+
+// exportAgent holds the agent details shared by the conversations of one agent
+type exportAgent struct {
+	agent *ent.Agent // nil when the agent was deleted
+	perm  agentPermission
+	// configs holds the agent as configured in each pinned version, "" for the live agent
+	configs map[string]*exportConfig
+}
+
+// exportConfig is an agent configuration conversations were held with
+type exportConfig struct {
+	agent *ent.Agent // nil when the pinned version was deleted
+	tools []*ent.Tool
+}
+
+// importRoles are the message roles accepted by ImportConversation
+var importRoles = map[string]bool{
+	"system":    true,
+	"user":      true,
+	"assistant": true,
+}
+
+// ExportConversation 导出对话
+func (s *ConversationServer) ExportConversation(ctx context.Context, req *pb.ExportConversationRequest) (*pb.ExportConversationResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	conv, err := s.convRepo.GetWithMessages(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "conversation not found: %v", err)
+	}
+	if err := checkConversationOwner(ctx, conv); err != nil {
+		return nil, err
+	}
+
+	transcript, err := s.transcript(ctx, conv, map[string]*exportAgent{})
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := export.Render(format, transcript)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export conversation: %v", err)
+	}
+
+	return &pb.ExportConversationResponse{
+		Filename:    fmt.Sprintf("conversation-%s.%s", conv.ID, format.Extension()),
+		ContentType: format.ContentType(),
+		Content:     string(content),
+		Count:       1,
+	}, nil
+}
+
+// ExportConversations 按 Agent 和时间范围批量导出对话，非管理员只能导出自己的对话
+func (s *ConversationServer) ExportConversations(ctx context.Context, req *pb.ExportConversationsRequest) (*pb.ExportConversationResponse, error) {
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := repository.ConversationFilter{
+		AgentID: req.AgentId,
+		UserID:  req.UserId,
+	}
+	if auth.GetUserRole(ctx) != "admin" {
+		userID, err := callerID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if req.UserId != "" && req.UserId != userID {
+			return nil, status.Error(codes.PermissionDenied, "cannot export the conversations of other users")
+		}
+		filter.UserID = userID
+	}
+	// 按 Agent 导出需要查看权限
+	if req.AgentId != "" {
+		agent, err := s.agentRepo.Get(ctx, req.AgentId)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
+		}
+		if err := s.checkAgentAccess(ctx, agent, permissionViewer); err != nil {
+			return nil, err
+		}
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+
+	conversations, err := s.convRepo.ListWithMessages(ctx, filter, exportLimit+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list conversations: %v", err)
+	}
+	if len(conversations) > exportLimit {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"more than %d conversations match, narrow the agent or date range", exportLimit)
+	}
+
+	// Agents and their tools are shared by many conversations, load them once
+	agents := map[string]*exportAgent{}
+	transcripts := make([]*export.Transcript, len(conversations))
+	for i, conv := range conversations {
+		transcripts[i], err = s.transcript(ctx, conv, agents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := export.RenderAll(format, transcripts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export conversations: %v", err)
+	}
+
+	return &pb.ExportConversationResponse{
+		Filename:    fmt.Sprintf("conversations-%s.%s", time.Now().Format("20060102-150405"), format.Extension()),
+		ContentType: format.ContentType(),
+		Content:     string(content),
+		Count:       int32(len(transcripts)),
+	}, nil
+}
+
+// ImportConversation 从 OpenAI 格式的消息列表导入对话
+func (s *ConversationServer) ImportConversation(ctx context.Context, req *pb.ImportConversationRequest) (*pb.Conversation, error) {
+	if req.AgentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "agent_id is required")
+	}
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "messages is required")
+	}
+	if len(req.Messages) > importMessageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d messages can be imported", importMessageLimit)
+	}
+	for i, msg := range req.Messages {
+		if !importRoles[msg.Role] {
+			return nil, status.Errorf(codes.InvalidArgument, "messages[%d]: invalid role: %s", i, msg.Role)
+		}
+	}
+
+	// Verify agent exists
+	agent, err := s.agentRepo.Get(ctx, req.AgentId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
+	}
+	if err := s.checkAgentAccess(ctx, agent, permissionChat); err != nil {
+		return nil, err
+	}
+	if err := checkAgentAvailable(agent, false); err != nil {
+		return nil, err
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entConv := &ent.Conversation{
+		ID:             uuid.New().String(),
+		AgentID:        req.AgentId,
+		UserID:         userID,
+		Title:          req.Title,
+		TitleSource:    "user",
+		Status:         "active",
+		Metadata:       map[string]interface{}{"source": "import"},
+		AgentVersionID: agent.PublishedVersionID,
+	}
+	if entConv.Title == "" {
+		entConv.Title = "Imported Conversation"
+		entConv.TitleSource = "default"
+	}
+	if req.Context != nil {
+		entConv.Context = req.Context.AsMap()
+	}
+
+	// Store the messages as a single branch, spaced apart to keep their order
+	base := time.Now()
+	messages := make([]*ent.Message, len(req.Messages))
+	parentID := ""
+	for i, msg := range req.Messages {
+		messages[i] = &ent.Message{
+			ID:             uuid.New().String(),
+			ConversationID: entConv.ID,
+			ParentID:       parentID,
+			Role:           msg.Role,
+			Content:        msg.Content,
+			CreatedAt:      base.Add(time.Duration(i) * time.Millisecond),
+		}
+		parentID = messages[i].ID
+	}
+
+	created, err := s.convRepo.CreateWithMessages(ctx, entConv, messages)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import conversation: %v", err)
+	}
+
+	conv, err := s.convRepo.GetWithMessages(ctx, created.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reload conversation: %v", err)
+	}
+
+	return entConversationToProto(conv), nil
+}
+
+// transcript prepares a conversation loaded with its messages for export, caching agent details in agents
+func (s *ConversationServer) transcript(ctx context.Context, conv *ent.Conversation, agents map[string]*exportAgent) (*export.Transcript, error) {
+	details, ok := agents[conv.AgentID]
+	if !ok {
+		details = &exportAgent{configs: make(map[string]*exportConfig)}
+		// Conversations of deleted agents are exported without a system prompt or tools
+		if agent, err := s.agentRepo.Get(ctx, conv.AgentID); err == nil {
+			details.agent = agent
+			if details.perm, err = agentPermissionFor(ctx, s.shareRepo, agent); err != nil {
+				return nil, err
+			}
+		}
+		agents[conv.AgentID] = details
+	}
+
+	t := &export.Transcript{
+		Conversation: conv,
+		Agent:        details.agent,
+		Messages:     activePath(conv.Edges.Messages, conv.ActiveMessageID),
+	}
+	if details.agent == nil || details.perm < permissionChat {
+		return t, nil
+	}
+
+	config, err := s.exportConfig(ctx, details, conv.AgentVersionID)
+	if err != nil {
+		return nil, err
+	}
+	if config.agent == nil {
+		return t, nil
+	}
+	t.Agent = config.agent
+	t.Tools = config.tools
+
+	// The prompt is part of the agent configuration, which only viewers may see. Knowledge
+	// base context is retrieved per message and is left out.
+	if details.perm >= permissionViewer {
+		prompt, err := s.systemPrompt(ctx, config.agent, conv, "")
+		if err != nil {
+			s.logger.Warn("Failed to render prompt template for export",
+				zap.String("conversation_id", conv.ID),
+				zap.Error(err),
+			)
+		} else {
+			t.SystemPrompt = prompt
+		}
+	}
+	return t, nil
+}
+
+// exportConfig returns the agent as configured for conversations pinned to versionID, or the
+// live agent when versionID is empty, together with its tools
+func (s *ConversationServer) exportConfig(ctx context.Context, details *exportAgent, versionID string) (*exportConfig, error) {
+	if config, ok := details.configs[versionID]; ok {
+		return config, nil
+	}
+
+	config := &exportConfig{agent: details.agent}
+	if versionID != "" {
+		config.agent = nil
+		if version, err := s.versionRepo.Get(ctx, versionID); err == nil {
+			config.agent = applyAgentVersion(details.agent, version)
+		}
+	}
+	if config.agent != nil && len(config.agent.Tools) > 0 {
+		tools, err := s.toolRepo.ListByIDs(ctx, config.agent.Tools)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load agent tools: %v", err)
+		}
+		config.tools = tools
+	}
+	details.configs[versionID] = config
+	return config, nil
+}
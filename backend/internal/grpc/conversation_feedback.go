@@ -0,0 +1,238 @@
+package grpc
+
+import (
+	"context"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/auth"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// feedbackRatings maps API ratings to stored values
+var feedbackRatings = map[string]int{
+	"up":   1,
+	"down": -1,
+}
+
+// feedbackReasons are the accepted feedback reasons
+var feedbackReasons = map[string]bool{
+	"inaccurate": true,
+	"unhelpful":  true,
+	"incomplete": true,
+	"harmful":    true,
+	"off_topic":  true,
+	"other":      true,
+}
+
+// SubmitFeedback 提交消息反馈
+func (s *ConversationServer) SubmitFeedback(ctx context.Context, req *pb.SubmitFeedbackRequest) (*pb.MessageFeedback, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+	rating, ok := feedbackRatings[req.Rating]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "rating must be up or down")
+	}
+	if req.Reason != "" && !feedbackReasons[req.Reason] {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reason: %s", req.Reason)
+	}
+
+	conv, err := s.loadConversation(ctx, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := s.msgRepo.Get(ctx, req.MessageId)
+	if err != nil || msg.ConversationID != conv.ID {
+		return nil, status.Errorf(codes.NotFound, "message not found: %s", req.MessageId)
+	}
+	if msg.Role != "assistant" {
+		return nil, status.Error(codes.InvalidArgument, "only assistant messages can be rated")
+	}
+
+	saved, err := s.feedbackRepo.Save(ctx, &ent.MessageFeedback{
+		ID:             uuid.New().String(),
+		MessageID:      msg.ID,
+		ConversationID: conv.ID,
+		AgentID:        conv.AgentID,
+		UserID:         userID,
+		Rating:         rating,
+		Reason:         req.Reason,
+		Comment:        req.Comment,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save feedback: %v", err)
+	}
+
+	return entFeedbackToProto(saved), nil
+}
+
+// DeleteFeedback 删除消息反馈
+func (s *ConversationServer) DeleteFeedback(ctx context.Context, req *pb.DeleteFeedbackRequest) (*emptypb.Empty, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if req.MessageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "message_id is required")
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.feedbackRepo.Delete(ctx, req.ConversationId, req.MessageId, userID); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListFeedback 获取消息反馈列表
+func (s *ConversationServer) ListFeedback(ctx context.Context, req *pb.ListFeedbackRequest) (*pb.ListFeedbackResponse, error) {
+	filter := repository.FeedbackFilter{
+		AgentID:        req.AgentId,
+		ConversationID: req.ConversationId,
+	}
+	if req.Rating != "" {
+		rating, ok := feedbackRatings[req.Rating]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "rating must be up or down")
+		}
+		filter.Rating = rating
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+	if err := s.scopeFeedback(ctx, &filter); err != nil {
+		return nil, err
+	}
+
+	// Set default pagination
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	feedback, total, err := s.feedbackRepo.List(ctx, page, pageSize, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list feedback: %v", err)
+	}
+
+	items := make([]*pb.MessageFeedback, len(feedback))
+	for i, f := range feedback {
+		items[i] = entFeedbackToProto(f)
+	}
+
+	return &pb.ListFeedbackResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
+// GetFeedbackStats 获取反馈统计
+func (s *ConversationServer) GetFeedbackStats(ctx context.Context, req *pb.GetFeedbackStatsRequest) (*pb.FeedbackStats, error) {
+	filter := repository.FeedbackFilter{
+		AgentID: req.AgentId,
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+	if err := s.scopeFeedback(ctx, &filter); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.feedbackRepo.Stats(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to aggregate feedback: %v", err)
+	}
+
+	pbStats := &pb.FeedbackStats{
+		Total:    int64(stats.Total),
+		Positive: int64(stats.Positive),
+		Negative: int64(stats.Negative),
+		Reasons:  make(map[string]int64, len(stats.Reasons)),
+	}
+	if stats.Total > 0 {
+		pbStats.SatisfactionRate = float64(stats.Positive) / float64(stats.Total)
+	}
+	for reason, count := range stats.Reasons {
+		pbStats.Reasons[reason] = int64(count)
+	}
+
+	return pbStats, nil
+}
+
+// scopeFeedback restricts a feedback filter to what the caller may read: all feedback on a
+// conversation they own or on an agent they can view, and otherwise only their own feedback.
+// Admins read all feedback.
+func (s *ConversationServer) scopeFeedback(ctx context.Context, filter *repository.FeedbackFilter) error {
+	if auth.GetUserRole(ctx) == "admin" {
+		return nil
+	}
+	if filter.ConversationID != "" {
+		_, err := s.loadConversation(ctx, filter.ConversationID)
+		return err
+	}
+	if filter.AgentID != "" {
+		agent, err := s.agentRepo.Get(ctx, filter.AgentID)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "agent not found: %v", err)
+		}
+		return s.checkAgentAccess(ctx, agent, permissionViewer)
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+	filter.UserID = userID
+	return nil
+}
+
+// entFeedbackToProto converts ent.MessageFeedback to pb.MessageFeedback
+func entFeedbackToProto(f *ent.MessageFeedback) *pb.MessageFeedback {
+	pbFeedback := &pb.MessageFeedback{
+		Id:             f.ID,
+		MessageId:      f.MessageID,
+		ConversationId: f.ConversationID,
+		AgentId:        f.AgentID,
+		UserId:         f.UserID,
+		Rating:         "down",
+		Reason:         f.Reason,
+		Comment:        f.Comment,
+		CreatedAt:      timestamppb.New(f.CreatedAt),
+		UpdatedAt:      timestamppb.New(f.UpdatedAt),
+	}
+	if f.Rating > 0 {
+		pbFeedback.Rating = "up"
+	}
+	if f.Edges.Message != nil {
+		pbFeedback.Message = entMessageToProto(f.Edges.Message)
+	}
+	return pbFeedback
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/ai"
+	"agent-platform/internal/model/ent"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// generation is a model call in progress that can be cancelled
+type generation struct {
+	conversationID string
+	cancel         context.CancelFunc
+}
+
+// replyResult is the outcome of generateReply
+type replyResult struct {
+	GenerationID string
+	Messages     []*ent.Message
+	Cancelled    bool  // The assistant message only holds the output produced before cancellation
+	Version      int64 // Conversation version after storing the messages
+}
+
+// toProto converts the result to a SendMessageResponse
+func (r *replyResult) toProto(conversationID string) *pb.SendMessageResponse {
+	return &pb.SendMessageResponse{
+		ConversationId:      conversationID,
+		Messages:            entMessagesToProto(r.Messages),
+		GenerationId:        r.GenerationID,
+		Cancelled:           r.Cancelled,
+		ConversationVersion: r.Version,
+	}
+}
+
+// CancelGeneration 取消正在进行的生成
+func (s *ConversationServer) CancelGeneration(ctx context.Context, req *pb.CancelGenerationRequest) (*pb.CancelGenerationResponse, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+
+	// Only the owner may cancel the generations of a conversation
+	if _, err := s.loadConversation(ctx, req.ConversationId); err != nil {
+		return nil, err
+	}
+
+	if req.GenerationId != "" {
+		value, ok := s.generations.Load(req.GenerationId)
+		if !ok || value.(*generation).conversationID != req.ConversationId {
+			return nil, status.Errorf(codes.NotFound, "generation not found: %s", req.GenerationId)
+		}
+		value.(*generation).cancel()
+		return &pb.CancelGenerationResponse{Cancelled: 1}, nil
+	}
+
+	cancelled := int32(0)
+	s.generations.Range(func(_, value interface{}) bool {
+		if gen := value.(*generation); gen.conversationID == req.ConversationId {
+			gen.cancel()
+			cancelled++
+		}
+		return true
+	})
+
+	return &pb.CancelGenerationResponse{Cancelled: cancelled}, nil
+}
+
+// startGeneration registers a cancellable generation under generationID, or a new ID when empty.
+// The returned context is cancelled by CancelGeneration or when ctx ends; finish must be called
+// once the generation is over.
+func (s *ConversationServer) startGeneration(ctx context.Context, conversationID, generationID string) (string, context.Context, func(), error) {
+	if generationID == "" {
+		generationID = uuid.New().String()
+	}
+
+	genCtx, cancel := context.WithCancel(ctx)
+	gen := &generation{conversationID: conversationID, cancel: cancel}
+	if _, exists := s.generations.LoadOrStore(generationID, gen); exists {
+		cancel()
+		return "", nil, nil, status.Errorf(codes.AlreadyExists, "generation already running: %s", generationID)
+	}
+
+	finish := func() {
+		s.generations.Delete(generationID)
+		cancel()
+	}
+	return generationID, genCtx, finish, nil
+}
+
+// streamReply streams a completion and returns the content received so far. When ctx is
+// cancelled the partial content is returned together with the cancellation error.
+func (s *ConversationServer) streamReply(ctx context.Context, request ai.ChatRequest) (string, error) {
+	contentChan, errChan := s.aiManager.ChatStream(ctx, request)
+
+	var content strings.Builder
+	for delta := range contentChan {
+		content.WriteString(delta)
+	}
+
+	if err := <-errChan; err != nil {
+		if ctx.Err() != nil {
+			s.logger.Info("Generation cancelled",
+				zap.String("model", request.Model),
+				zap.Int("partial_length", content.Len()),
+			)
+			return content.String(), ctx.Err()
+		}
+		return content.String(), err
+	}
+
+	return content.String(), nil
+}
@@ -1,47 +1,102 @@
 package grpc
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
 	pb "agent-platform/gen/go"
+	"agent-platform/internal/ai"
+	"agent-platform/internal/auth"
 	"agent-platform/internal/model/ent"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// conversationStatuses are the valid conversation statuses
+var conversationStatuses = map[string]bool{
+	"active":   true,
+	"ended":    true,
+	"archived": true,
+}
+
+// snippetRadius is the number of characters shown around a search match
+const snippetRadius = 60
+
+// loadConversation loads a conversation the caller may access. Conversations of other
+// users are reported as not found.
+func (s *ConversationServer) loadConversation(ctx context.Context, id string) (*ent.Conversation, error) {
+	conv, err := s.convRepo.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "conversation not found: %v", err)
+	}
+	if err := checkConversationOwner(ctx, conv); err != nil {
+		return nil, err
+	}
+	return conv, nil
+}
+
+// checkConversationOwner returns an error unless the caller owns a conversation or is an
+// admin
+func checkConversationOwner(ctx context.Context, conv *ent.Conversation) error {
+	if isCaller(ctx, conv.UserID) || auth.GetUserRole(ctx) == "admin" {
+		return nil
+	}
+	return status.Errorf(codes.NotFound, "conversation not found: %s", conv.ID)
+}
+
+// searchSnippet returns the part of content around the first case-insensitive match of query
+func searchSnippet(content, query string) string {
+	runes := []rune(content)
+	lower := strings.ToLower(content)
+
+	// Lowercasing keeps one rune per rune, so rune offsets in lower apply to content
+	match := 0
+	if idx := strings.Index(lower, strings.ToLower(query)); idx > 0 {
+		match = utf8.RuneCountInString(lower[:idx])
+	}
+
+	start := match - snippetRadius
+	if start < 0 {
+		start = 0
+	}
+	end := match + utf8.RuneCountInString(query) + snippetRadius
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(runes) {
+		snippet += "..."
+	}
+	return snippet
+}
+
 // entConversationToProto converts ent.Conversation to pb.Conversation
 func entConversationToProto(conv *ent.Conversation) *pb.Conversation {
 	pbConv := &pb.Conversation{
-		Id:            conv.ID,
-		AgentId:       conv.AgentID,
-		UserId:        conv.UserID,
-		Title:         conv.Title,
-		Status:        conv.Status,
-		CreatedAt:     timestamppb.New(conv.CreatedAt),
-		UpdatedAt:     timestamppb.New(conv.UpdatedAt),
-	}
-
-	// Convert messages
-	if conv.Messages != nil {
-		pbMessages := make([]*pb.Message, 0)
-		for _, msg := range conv.Messages {
-			if msgMap, ok := msg.(map[string]interface{}); ok {
-				pbMsg := &pb.Message{}
-				if id, ok := msgMap["id"].(string); ok {
-					pbMsg.Id = id
-				}
-				if role, ok := msgMap["role"].(string); ok {
-					pbMsg.Role = role
-				}
-				if content, ok := msgMap["content"].(string); ok {
-					pbMsg.Content = content
-				}
-				// Handle timestamp - could be Unix timestamp (int64) or time.Time
-				if _, ok := msgMap["timestamp"].(int64); ok {
-					pbMsg.Timestamp = timestamppb.New(conv.CreatedAt.Add(0)) // Use created time as base
-				}
-				pbMessages = append(pbMessages, pbMsg)
-			}
-		}
-		pbConv.Messages = pbMessages
+		Id:              conv.ID,
+		AgentId:         conv.AgentID,
+		UserId:          conv.UserID,
+		Title:           conv.Title,
+		Status:          conv.Status,
+		CreatedAt:       timestamppb.New(conv.CreatedAt),
+		UpdatedAt:       timestamppb.New(conv.UpdatedAt),
+		ActiveMessageId: conv.ActiveMessageID,
+		Version:         conv.Version,
+		AgentVersionId:  conv.AgentVersionID,
+	}
+
+	// Convert the active branch when messages were loaded with the conversation
+	if conv.Edges.Messages != nil {
+		pbConv.Messages = entMessagesToProto(activePath(conv.Edges.Messages, conv.ActiveMessageID))
 	}
 
 	// Set last message time if available
@@ -51,3 +106,194 @@ func entConversationToProto(conv *ent.Conversation) *pb.Conversation {
 
 	return pbConv
 }
+
+// entMessageToProto converts ent.Message to pb.Message
+func entMessageToProto(m *ent.Message) *pb.Message {
+	pbMsg := &pb.Message{
+		Id:               m.ID,
+		ConversationId:   m.ConversationID,
+		ParentId:         m.ParentID,
+		Role:             m.Role,
+		Content:          m.Content,
+		Model:            m.Model,
+		PromptTokens:     int32(m.PromptTokens),
+		CompletionTokens: int32(m.CompletionTokens),
+		Timestamp:        timestamppb.New(m.CreatedAt),
+		UpdatedAt:        timestamppb.New(m.UpdatedAt),
+	}
+	if m.Metadata != nil {
+		pbMsg.Metadata, _ = structpb.NewStruct(m.Metadata)
+	}
+	return pbMsg
+}
+
+// entMessagesToProto converts a list of ent.Message to pb.Message
+func entMessagesToProto(messages []*ent.Message) []*pb.Message {
+	pbMessages := make([]*pb.Message, len(messages))
+	for i, m := range messages {
+		pbMessages[i] = entMessageToProto(m)
+	}
+	return pbMessages
+}
+
+// contextConfig holds the context window settings of an agent
+type contextConfig struct {
+	ContextWindow  int // Total tokens available to the model
+	MaxTokens      int // Completion limit sent to the model (0 = provider default)
+	ReservedTokens int // Tokens kept free for the completion
+	Truncate       ai.TruncateOptions
+	Summary        summaryConfig
+	Memory         memoryConfig
+	TitleModel     string // Model used to title the conversation (empty = server default)
+}
+
+// summaryConfig holds the rolling summary settings of an agent
+type summaryConfig struct {
+	Enabled       bool
+	Model         string // Model used to summarize, defaults to the agent's model
+	TriggerTokens int    // Summarize once unsummarized history exceeds this many tokens
+	KeepRecent    int    // Most recent messages that are never summarized
+}
+
+// memoryConfig holds the long-term user memory settings of an agent
+type memoryConfig struct {
+	Enabled bool
+	Model   string // Model used to extract memories, defaults to the agent's model
+	TopK    int    // Memories recalled per message
+}
+
+// parseContextConfig reads the context window settings from an agent's model_config:
+//
+//	context_strategy        sliding_window, keep_first_and_last or token_budget (default)
+//	context_window          overrides the model's known context window
+//	max_tokens              completion limit, also reserved from the context window
+//	max_history_messages    history messages kept by sliding_window and keep_first_and_last
+//	keep_first_messages     leading messages kept by keep_first_and_last (default 2)
+//	summary_enabled         replace older turns with a rolling summary
+//	summary_model           model used for summarization (default: the agent's model)
+//	summary_trigger_tokens  unsummarized history size that triggers summarization (default: half the context window)
+//	summary_keep_recent     recent messages kept verbatim (default 6)
+//	memory_enabled          remember facts about the user across conversations
+//	memory_model            model used to extract memories (default: the agent's model)
+//	memory_top_k            memories recalled per message (default 5)
+//	title_model             model used to title conversations (default: TITLE_MODEL, then the agent's model)
+func parseContextConfig(model string, modelConfig map[string]interface{}) (*contextConfig, error) {
+	info := ai.GetModelInfo(model)
+	cfg := &contextConfig{
+		ContextWindow:  info.ContextWindow,
+		ReservedTokens: info.MaxOutputTokens,
+		Truncate: ai.TruncateOptions{
+			Strategy:  ai.StrategyTokenBudget,
+			KeepFirst: 2,
+		},
+		Summary: summaryConfig{
+			Model:      model,
+			KeepRecent: 6,
+		},
+		Memory: memoryConfig{
+			Model: model,
+			TopK:  5,
+		},
+	}
+	if modelConfig == nil {
+		cfg.Summary.TriggerTokens = cfg.ContextWindow / 2
+		return cfg, nil
+	}
+
+	if v, ok := modelConfig["context_strategy"]; ok {
+		name, _ := v.(string)
+		strategy, err := ai.ParseTruncationStrategy(name)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Truncate.Strategy = strategy
+	}
+
+	bools := []struct {
+		key    string
+		target *bool
+	}{
+		{"summary_enabled", &cfg.Summary.Enabled},
+		{"memory_enabled", &cfg.Memory.Enabled},
+	}
+	for _, item := range bools {
+		v, ok := modelConfig[item.key]
+		if !ok {
+			continue
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a boolean", item.key)
+		}
+		*item.target = b
+	}
+
+	models := []struct {
+		key    string
+		target *string
+	}{
+		{"summary_model", &cfg.Summary.Model},
+		{"memory_model", &cfg.Memory.Model},
+		{"title_model", &cfg.TitleModel},
+	}
+	for _, item := range models {
+		v, ok := modelConfig[item.key]
+		if !ok {
+			continue
+		}
+		name, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a string", item.key)
+		}
+		if name != "" {
+			*item.target = name
+		}
+	}
+
+	ints := []struct {
+		key    string
+		target *int
+	}{
+		{"context_window", &cfg.ContextWindow},
+		{"max_tokens", &cfg.MaxTokens},
+		{"max_history_messages", &cfg.Truncate.MaxMessages},
+		{"keep_first_messages", &cfg.Truncate.KeepFirst},
+		{"summary_trigger_tokens", &cfg.Summary.TriggerTokens},
+		{"summary_keep_recent", &cfg.Summary.KeepRecent},
+		{"memory_top_k", &cfg.Memory.TopK},
+	}
+	for _, item := range ints {
+		v, ok := modelConfig[item.key]
+		if !ok {
+			continue
+		}
+		n, ok := v.(float64)
+		if !ok || n < 0 || n != float64(int(n)) {
+			return nil, fmt.Errorf("%s must be a non-negative integer", item.key)
+		}
+		*item.target = int(n)
+	}
+
+	if cfg.MaxTokens > 0 {
+		cfg.ReservedTokens = cfg.MaxTokens
+	}
+	if cfg.Summary.TriggerTokens == 0 {
+		cfg.Summary.TriggerTokens = cfg.ContextWindow / 2
+	}
+
+	return cfg, nil
+}
+
+// historyFromMessages converts stored conversation messages to chat history
+func historyFromMessages(messages []*ent.Message) []ai.Message {
+	history := make([]ai.Message, 0, len(messages))
+	for _, msg := range messages {
+		if msg.Role != "" && msg.Content != "" {
+			history = append(history, ai.Message{
+				Role:    msg.Role,
+				Content: msg.Content,
+			})
+		}
+	}
+	return history
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"agent-platform/internal/ai"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// memoryRecallThreshold is the minimum similarity for a memory to be recalled
+	memoryRecallThreshold = 0.75
+	// memoryExtractionTimeout bounds a background memory extraction run
+	memoryExtractionTimeout = 2 * time.Minute
+	// knownMemoriesLimit caps the existing memories shown to the extraction model
+	knownMemoriesLimit = 50
+)
+
+// recallMemories returns the user's memories relevant to the message, formatted for the system prompt.
+// Recall is best effort: failures are logged and produce no context.
+func (s *ConversationServer) recallMemories(ctx context.Context, userID, agentID, query string, cfg memoryConfig) string {
+	if s.memoryStore == nil || !s.memoryStore.Enabled() || cfg.TopK == 0 {
+		return ""
+	}
+
+	results, err := s.memoryStore.Search(ctx, userID, agentID, query, cfg.TopK, memoryRecallThreshold)
+	if err != nil {
+		s.logger.Warn("Failed to recall memories",
+			zap.String("user_id", userID),
+			zap.String("agent_id", agentID),
+			zap.Error(err),
+		)
+		return ""
+	}
+	if len(results) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("=== What you remember about the user ===\n")
+	for _, result := range results {
+		b.WriteString("- ")
+		b.WriteString(result.Memory.Content)
+		b.WriteString("\n")
+	}
+	b.WriteString("=== End of memories ===\n")
+	b.WriteString("Use these memories when they are relevant, and do not mention them unless asked.")
+
+	return b.String()
+}
+
+// scheduleMemoryExtraction extracts facts about the user from an exchange in the background
+func (s *ConversationServer) scheduleMemoryExtraction(userID, agentID, conversationID string, exchange []ai.Message, cfg memoryConfig) {
+	if s.memoryStore == nil || !s.memoryStore.Enabled() || userID == "anonymous" {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), memoryExtractionTimeout)
+		defer cancel()
+
+		known, err := s.memoryStore.Contents(ctx, userID, agentID, knownMemoriesLimit)
+		if err != nil {
+			s.logger.Warn("Failed to load memories", zap.String("user_id", userID), zap.Error(err))
+			return
+		}
+
+		facts, err := s.extractor.Extract(ctx, cfg.Model, known, exchange)
+		if err != nil {
+			s.logger.Warn("Failed to extract memories",
+				zap.String("conversation_id", conversationID),
+				zap.Error(err),
+			)
+			return
+		}
+
+		added := 0
+		for _, fact := range facts {
+			_, created, err := s.memoryStore.Add(ctx, userID, agentID, fact, conversationID)
+			if err != nil {
+				s.logger.Warn("Failed to save memory", zap.String("user_id", userID), zap.Error(err))
+				continue
+			}
+			if created {
+				added++
+			}
+		}
+
+		if added > 0 {
+			s.logger.Info("Memories saved",
+				zap.String("user_id", userID),
+				zap.String("agent_id", agentID),
+				zap.Int("count", added),
+			)
+		}
+	}()
+}
@@ -0,0 +1,396 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"agent-platform/internal/ai"
+	"agent-platform/internal/model/ent"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Multi-agent agents answer through a supervisor, the conversation's agent, whose model decides
+// which of its member agents handle a turn. In route mode the chosen member answers the turn
+// with its own prompt, knowledge bases and memories. In delegate mode the supervisor splits
+// the turn into subtasks, members answer them and the supervisor composes the reply from
+// their answers. Replies record the delegation in the orchestration entry of their metadata.
+
+const (
+	orchestrationRoute    = "route"
+	orchestrationDelegate = "delegate"
+
+	// defaultMaxDelegations is the number of subtasks a turn is split into at most
+	defaultMaxDelegations = 3
+	// maxDelegationsLimit caps max_delegations
+	maxDelegationsLimit = 10
+	// routeHistoryMessages is the number of recent messages the supervisor routes by
+	routeHistoryMessages = 6
+)
+
+const routeSystemPrompt = `You coordinate a team of agents. Pick the agent best suited to answer the user's latest message.
+Respond with a JSON object only: {"agent": "<agent id>", "reason": "<one sentence>"}`
+
+const delegateSystemPrompt = `You coordinate a team of agents. Split the user's latest message into at most %d subtasks, each given to the agent best suited to it.
+Agents only see their own subtask, so write each one as self-contained instructions.
+Respond with a JSON object only: {"tasks": [{"agent": "<agent id>", "task": "<instructions>"}]}
+Respond with {"tasks": []} when you can answer without help.`
+
+// orchestrationConfig holds the settings of a multi-agent agent
+type orchestrationConfig struct {
+	Mode           string // route or delegate
+	MaxDelegations int    // Subtasks per turn in delegate mode
+}
+
+// parseOrchestrationConfig reads the settings of a multi-agent agent from its model_config:
+//
+//	orchestration    route (default): a member answers each turn; delegate: members answer subtasks
+//	max_delegations  subtasks per turn in delegate mode (default 3, at most 10)
+func parseOrchestrationConfig(modelConfig map[string]interface{}) (*orchestrationConfig, error) {
+	cfg := &orchestrationConfig{
+		Mode:           orchestrationRoute,
+		MaxDelegations: defaultMaxDelegations,
+	}
+
+	if v, ok := modelConfig["orchestration"]; ok {
+		mode, _ := v.(string)
+		if mode != orchestrationRoute && mode != orchestrationDelegate {
+			return nil, fmt.Errorf("orchestration must be %s or %s", orchestrationRoute, orchestrationDelegate)
+		}
+		cfg.Mode = mode
+	}
+
+	if v, ok := modelConfig["max_delegations"]; ok {
+		n, ok := v.(float64)
+		if !ok || n < 1 || n > maxDelegationsLimit || n != float64(int(n)) {
+			return nil, fmt.Errorf("max_delegations must be an integer between 1 and %d", maxDelegationsLimit)
+		}
+		cfg.MaxDelegations = int(n)
+	}
+
+	return cfg, nil
+}
+
+// orchestrate plans the reply of a multi-agent agent, returning the trace stored in the reply's metadata
+func (s *ConversationServer) orchestrate(ctx context.Context, supervisor *ent.Agent, conv *ent.Conversation, history []*ent.Message, userMessage *ent.Message) (*replyPlan, map[string]interface{}, error) {
+	cfg, err := parseOrchestrationConfig(supervisor.ModelConfig)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "invalid agent model_config: %v", err)
+	}
+
+	members, err := s.memberAgents(ctx, supervisor)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "multi-agent agent has no available members: %s", supervisor.ID)
+	}
+
+	if cfg.Mode == orchestrationDelegate {
+		return s.delegateTurn(ctx, supervisor, members, cfg, conv, history, userMessage)
+	}
+	return s.routeTurn(ctx, supervisor, members, conv, history, userMessage)
+}
+
+// routeTurn has the supervisor pick the member answering the turn
+func (s *ConversationServer) routeTurn(ctx context.Context, supervisor *ent.Agent, members []*ent.Agent, conv *ent.Conversation, history []*ent.Message, userMessage *ent.Message) (*replyPlan, map[string]interface{}, error) {
+	model, _ := agentModel(supervisor)
+	content, err := s.superviseTurn(ctx, supervisor, model, routeSystemPrompt, members, conv, history, userMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var decision struct {
+		Agent  string `json:"agent"`
+		Reason string `json:"reason"`
+	}
+	member, reason := members[0], ""
+	if err := decodeDecision(content, &decision); err != nil {
+		// A malformed decision should not fail the turn
+		s.logger.Warn("Supervisor returned an invalid routing decision, using the first member",
+			zap.String("agent_id", supervisor.ID),
+			zap.Error(err),
+		)
+		reason = fmt.Sprintf("invalid routing decision, defaulted to the first member: %v", err)
+	} else if chosen := findMember(members, decision.Agent); chosen == nil {
+		reason = fmt.Sprintf("unknown agent %q chosen, defaulted to the first member", decision.Agent)
+	} else {
+		member, reason = chosen, decision.Reason
+	}
+
+	plan, err := s.planReply(ctx, member, conv, history, userMessage, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return plan, map[string]interface{}{
+		"mode":             orchestrationRoute,
+		"supervisor_id":    supervisor.ID,
+		"supervisor_model": model,
+		"steps": []interface{}{map[string]interface{}{
+			"agent_id":   member.ID,
+			"agent_name": member.Name,
+			"reason":     reason,
+		}},
+	}, nil
+}
+
+// delegateTurn has members answer the subtasks the supervisor splits the turn into, then
+// plans the supervisor's reply composed from their answers
+func (s *ConversationServer) delegateTurn(ctx context.Context, supervisor *ent.Agent, members []*ent.Agent, cfg *orchestrationConfig, conv *ent.Conversation, history []*ent.Message, userMessage *ent.Message) (*replyPlan, map[string]interface{}, error) {
+	model, _ := agentModel(supervisor)
+	content, err := s.superviseTurn(ctx, supervisor, model, fmt.Sprintf(delegateSystemPrompt, cfg.MaxDelegations), members, conv, history, userMessage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var decision struct {
+		Tasks []struct {
+			Agent string `json:"agent"`
+			Task  string `json:"task"`
+		} `json:"tasks"`
+	}
+	if err := decodeDecision(content, &decision); err != nil {
+		// Without a plan the supervisor answers on its own
+		s.logger.Warn("Supervisor returned an invalid delegation plan, answering without members",
+			zap.String("agent_id", supervisor.ID),
+			zap.Error(err),
+		)
+	}
+
+	type subtask struct {
+		member *ent.Agent
+		task   string
+		answer string
+		prompt int
+		output int
+		calls  []interface{}
+		err    error
+	}
+	var subtasks []*subtask
+	for _, t := range decision.Tasks {
+		member := findMember(members, t.Agent)
+		task := strings.TrimSpace(t.Task)
+		if member == nil || task == "" {
+			continue
+		}
+		subtasks = append(subtasks, &subtask{member: member, task: task})
+		if len(subtasks) == cfg.MaxDelegations {
+			break
+		}
+	}
+
+	// Members work on their subtasks concurrently
+	var wg sync.WaitGroup
+	for _, st := range subtasks {
+		wg.Add(1)
+		go func(st *subtask) {
+			defer wg.Done()
+			st.answer, st.prompt, st.output, st.calls, st.err = s.runSubtask(ctx, st.member, conv, st.task)
+		}(st)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+
+	steps := make([]interface{}, 0, len(subtasks))
+	var answers strings.Builder
+	for _, st := range subtasks {
+		step := map[string]interface{}{
+			"agent_id":   st.member.ID,
+			"agent_name": st.member.Name,
+			"task":       st.task,
+		}
+		if len(st.calls) > 0 {
+			step["tool_calls"] = st.calls
+		}
+		if st.err != nil {
+			step["error"] = st.err.Error()
+			fmt.Fprintf(&answers, "\n[%s] %s\nThe agent failed to answer.\n", st.member.Name, st.task)
+		} else {
+			step["output"] = st.answer
+			step["prompt_tokens"] = st.prompt
+			step["completion_tokens"] = st.output
+			fmt.Fprintf(&answers, "\n[%s] %s\n%s\n", st.member.Name, st.task, st.answer)
+		}
+		steps = append(steps, step)
+	}
+
+	extraContext := ""
+	if len(subtasks) > 0 {
+		extraContext = "=== Answers from your team ===\n" + answers.String() +
+			"=== End of answers ===\n\nCompose your reply to the user from the answers above."
+	}
+	plan, err := s.planReply(ctx, supervisor, conv, history, userMessage, extraContext)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return plan, map[string]interface{}{
+		"mode":             orchestrationDelegate,
+		"supervisor_id":    supervisor.ID,
+		"supervisor_model": model,
+		"steps":            steps,
+	}, nil
+}
+
+// superviseTurn asks the supervisor's model for a decision about the turn, given the team,
+// the supervisor's own instructions and the recent conversation
+func (s *ConversationServer) superviseTurn(ctx context.Context, supervisor *ent.Agent, model, instructions string, members []*ent.Agent, conv *ent.Conversation, history []*ent.Message, userMessage *ent.Message) (string, error) {
+	systemPrompt, err := s.systemPrompt(ctx, supervisor, conv, "")
+	if err != nil {
+		return "", err
+	}
+
+	var prompt strings.Builder
+	prompt.WriteString(instructions)
+	if systemPrompt != "" {
+		prompt.WriteString("\n\nYour instructions:\n")
+		prompt.WriteString(systemPrompt)
+	}
+	prompt.WriteString("\n\nAgents:\n")
+	for _, m := range members {
+		fmt.Fprintf(&prompt, "- id: %s, name: %s", m.ID, m.Name)
+		if m.Description != "" {
+			fmt.Fprintf(&prompt, ", description: %s", m.Description)
+		}
+		prompt.WriteString("\n")
+	}
+
+	var turn strings.Builder
+	recent := historyFromMessages(history)
+	if len(recent) > routeHistoryMessages {
+		recent = recent[len(recent)-routeHistoryMessages:]
+	}
+	if len(recent) > 0 {
+		turn.WriteString("Conversation:\n")
+		for _, msg := range recent {
+			fmt.Fprintf(&turn, "%s: %s\n", msg.Role, msg.Content)
+		}
+		turn.WriteString("\n")
+	}
+	fmt.Fprintf(&turn, "Latest message:\n%s", userMessage.Content)
+
+	resp, err := s.aiManager.Chat(ctx, ai.ChatRequest{
+		Model: model,
+		Messages: []ai.Message{
+			{Role: "system", Content: prompt.String()},
+			{Role: "user", Content: turn.String()},
+		},
+		Temperature: 0,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", status.Errorf(codes.Internal, "supervisor model error: %v", err)
+	}
+	return resp.Content, nil
+}
+
+// runSubtask has a member answer a subtask as a new message, with its own prompt, knowledge
+// bases, memories and tools, returning the answer, its token counts and the tools it called
+func (s *ConversationServer) runSubtask(ctx context.Context, member *ent.Agent, conv *ent.Conversation, task string) (string, int, int, []interface{}, error) {
+	plan, err := s.planReply(ctx, member, conv, nil, &ent.Message{Content: task}, "")
+	if err != nil {
+		return "", 0, 0, nil, err
+	}
+
+	resp, calls, err := s.complete(ctx, plan, conv)
+	if err != nil {
+		s.logger.Warn("Member agent failed to answer subtask",
+			zap.String("agent_id", member.ID),
+			zap.Error(err),
+		)
+		return "", 0, 0, calls, err
+	}
+	return resp.Content, resp.PromptTokens, resp.CompletionTokens, calls, nil
+}
+
+// memberAgents loads the members of a multi-agent agent in their configured order, as published
+// when they have been. Members deleted or archived since, or no longer shared with the caller,
+// are left out.
+func (s *ConversationServer) memberAgents(ctx context.Context, supervisor *ent.Agent) ([]*ent.Agent, error) {
+	if len(supervisor.Members) == 0 {
+		return nil, nil
+	}
+
+	agents, err := s.agentRepo.ListByIDs(ctx, supervisor.Members)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load member agents: %v", err)
+	}
+
+	var versionIDs []string
+	byID := make(map[string]*ent.Agent, len(agents))
+	for _, a := range agents {
+		if a.Status == "archived" || a.Type == "multi-agent" {
+			continue
+		}
+		perm, err := agentPermissionFor(ctx, s.shareRepo, a)
+		if err != nil {
+			return nil, err
+		}
+		if perm < permissionChat {
+			continue
+		}
+		byID[a.ID] = a
+		if a.PublishedVersionID != "" {
+			versionIDs = append(versionIDs, a.PublishedVersionID)
+		}
+	}
+
+	if len(versionIDs) > 0 {
+		versions, err := s.versionRepo.ListByIDs(ctx, versionIDs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load agent versions: %v", err)
+		}
+		for _, v := range versions {
+			if a, ok := byID[v.AgentID]; ok && a.PublishedVersionID == v.ID {
+				byID[v.AgentID] = applyAgentVersion(a, v)
+			}
+		}
+	}
+
+	members := make([]*ent.Agent, 0, len(byID))
+	for _, id := range supervisor.Members {
+		if a, ok := byID[id]; ok {
+			members = append(members, a)
+			delete(byID, id)
+		}
+	}
+	return members, nil
+}
+
+// findMember returns the member with the given ID, or name as models sometimes answer with it
+func findMember(members []*ent.Agent, ref string) *ent.Agent {
+	ref = strings.TrimSpace(ref)
+	for _, m := range members {
+		if m.ID == ref {
+			return m
+		}
+	}
+	for _, m := range members {
+		if strings.EqualFold(m.Name, ref) {
+			return m
+		}
+	}
+	return nil
+}
+
+// decodeDecision decodes the JSON object in a supervisor's answer, tolerating surrounding
+// text such as code fences
+func decodeDecision(content string, v interface{}) error {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return errors.New("model did not return a JSON object")
+	}
+	return json.Unmarshal([]byte(content[start:end+1]), v)
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/prompt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// systemPrompt renders the agent's prompt template for a conversation. The knowledge base
+// context is placed where the template uses .knowledge, or appended when it does not.
+func (s *ConversationServer) systemPrompt(ctx context.Context, agent *ent.Agent, conv *ent.Conversation, knowledge string) (string, error) {
+	if agent.PromptTemplate == "" {
+		return knowledge, nil
+	}
+
+	tmpl, err := prompt.Parse(agent.PromptTemplate)
+	if err != nil {
+		// Templates saved before validation existed may not parse, keep using them verbatim
+		s.logger.Warn("Invalid prompt template, using it verbatim",
+			zap.String("agent_id", agent.ID),
+			zap.Error(err),
+		)
+		return agent.PromptTemplate + knowledge, nil
+	}
+
+	vars := prompt.Variables{
+		Agent: map[string]interface{}{
+			"name":        agent.Name,
+			"description": agent.Description,
+			"type":        agent.Type,
+		},
+		Params:    agent.Parameters,
+		Context:   conv.Context,
+		Knowledge: strings.TrimSpace(knowledge),
+	}
+	if tmpl.Uses("user") {
+		vars.User = s.promptUser(ctx, conv.UserID)
+	}
+
+	rendered, err := tmpl.Render(vars)
+	if err != nil {
+		var missing *prompt.MissingVariablesError
+		if errors.As(err, &missing) {
+			return "", status.Errorf(codes.FailedPrecondition, "agent prompt template: %v", err)
+		}
+		return "", status.Errorf(codes.Internal, "failed to render agent prompt template: %v", err)
+	}
+
+	if !tmpl.Uses("knowledge") {
+		rendered += knowledge
+	}
+	return rendered, nil
+}
+
+// promptUser returns the profile fields of a user available to prompt templates
+func (s *ConversationServer) promptUser(ctx context.Context, userID string) map[string]interface{} {
+	fields := map[string]interface{}{"id": userID}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		// Anonymous conversations have no user record
+		return fields
+	}
+
+	fields["username"] = user.Username
+	fields["email"] = user.Email
+	fields["role"] = user.Role
+	if user.Metadata != nil {
+		fields["metadata"] = user.Metadata
+	}
+	return fields
+}
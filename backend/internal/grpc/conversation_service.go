@@ -2,37 +2,69 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"sync"
 	"time"
 
 	pb "agent-platform/gen/go"
 	"agent-platform/internal/ai"
+	"agent-platform/internal/auth"
+	"agent-platform/internal/memory"
 	"agent-platform/internal/model/ent"
 	"agent-platform/internal/repository"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // ConversationServer gRPC Conversation 服务实现
 type ConversationServer struct {
 	pb.UnimplementedConversationServiceServer
-	client      *ent.Client
-	aiManager   *ai.Manager
-	convRepo    *repository.ConversationRepository
-	agentRepo   *repository.AgentRepository
-	kbServer    *KnowledgeBaseServer
+	client       *ent.Client
+	aiManager    *ai.Manager
+	convRepo     *repository.ConversationRepository
+	msgRepo      *repository.MessageRepository
+	agentRepo    *repository.AgentRepository
+	shareRepo    *repository.AgentShareRepository
+	versionRepo  *repository.AgentVersionRepository
+	toolRepo     *repository.ToolRepository
+	feedbackRepo *repository.FeedbackRepository
+	userRepo     *repository.UserRepository
+	kbServer     *KnowledgeBaseServer
+	summarizer   *memory.Summarizer
+	summarizing  sync.Map // conversation ID -> struct{}, guards concurrent summarization
+	memoryStore  *memory.Store
+	extractor    *memory.Extractor
+	titleModel   string
+	titling      sync.Map // conversation ID -> struct{}, guards concurrent titling
+	generations  sync.Map // generation ID -> *generation, model calls in progress
+	sending      sync.Map // conversation ID + idempotency key -> chan struct{} closed when the send finishes
+	logger       *zap.Logger
 }
 
 // NewConversationServer 创建 Conversation 服务实例
-func NewConversationServer(client *ent.Client, aiManager *ai.Manager, kbServer *KnowledgeBaseServer) *ConversationServer {
+func NewConversationServer(client *ent.Client, aiManager *ai.Manager, kbServer *KnowledgeBaseServer, memoryStore *memory.Store, titleModel string, logger *zap.Logger) *ConversationServer {
 	return &ConversationServer{
-		client:    client,
-		aiManager: aiManager,
-		convRepo:  repository.NewConversationRepository(client),
-		agentRepo: repository.NewAgentRepository(client),
-		kbServer:  kbServer,
+		client:       client,
+		aiManager:    aiManager,
+		convRepo:     repository.NewConversationRepository(client),
+		msgRepo:      repository.NewMessageRepository(client),
+		agentRepo:    repository.NewAgentRepository(client),
+		shareRepo:    repository.NewAgentShareRepository(client),
+		versionRepo:  repository.NewAgentVersionRepository(client),
+		toolRepo:     repository.NewToolRepository(client),
+		feedbackRepo: repository.NewFeedbackRepository(client),
+		userRepo:     repository.NewUserRepository(client),
+		kbServer:     kbServer,
+		summarizer:   memory.NewSummarizer(aiManager, logger),
+		memoryStore:  memoryStore,
+		extractor:    memory.NewExtractor(aiManager, logger),
+		titleModel:   titleModel,
+		logger:       logger,
 	}
 }
 
@@ -43,25 +75,49 @@ func (s *ConversationServer) CreateConversation(ctx context.Context, req *pb.Cre
 	}
 
 	// Verify agent exists
-	_, err := s.agentRepo.Get(ctx, req.AgentId)
+	agent, err := s.agentRepo.Get(ctx, req.AgentId)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
 	}
+	// Previews expose the draft configuration, so they need view permission
+	need := permissionChat
+	if req.Preview {
+		need = permissionViewer
+	}
+	if err := s.checkAgentAccess(ctx, agent, need); err != nil {
+		return nil, err
+	}
+	if err := checkAgentAvailable(agent, req.Preview); err != nil {
+		return nil, err
+	}
 
-	// Create conversation entity
+	// Create conversation entity, pinned to the agent's published version unless it is a
+	// preview of the agent's current configuration
 	convID := uuid.New().String()
 	title := req.Title
+	titleSource := "user"
 	if title == "" {
 		title = "New Conversation"
+		titleSource = "default"
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	entConv := &ent.Conversation{
-		ID:       convID,
-		AgentID:  req.AgentId,
-		UserID:   "anonymous", // TODO: get from context
-		Title:    title,
-		Messages: []interface{}{},
-		Status:   "active",
+		ID:             convID,
+		AgentID:        req.AgentId,
+		UserID:         userID,
+		Title:          title,
+		TitleSource:    titleSource,
+		Status:         "active",
+		AgentVersionID: agent.PublishedVersionID,
+	}
+	if req.Preview {
+		entConv.AgentVersionID = ""
+		entConv.Metadata = map[string]interface{}{"preview": true}
 	}
 
 	// Set context if provided
@@ -85,11 +141,14 @@ func (s *ConversationServer) GetConversation(ctx context.Context, req *pb.GetCon
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	// Get conversation from database
-	conv, err := s.convRepo.Get(ctx, req.Id)
+	// Get conversation with its messages from database
+	conv, err := s.convRepo.GetWithMessages(ctx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "conversation not found: %v", err)
 	}
+	if err := checkConversationOwner(ctx, conv); err != nil {
+		return nil, err
+	}
 
 	// Convert to protobuf
 	return entConversationToProto(conv), nil
@@ -107,9 +166,31 @@ func (s *ConversationServer) ListConversations(ctx context.Context, req *pb.List
 		pageSize = 20
 	}
 
+	filter := repository.ConversationFilter{
+		AgentID: req.AgentId,
+		UserID:  req.UserId,
+		Status:  req.Status,
+	}
+	// Users only list their own conversations, admins may filter by user
+	if auth.GetUserRole(ctx) != "admin" {
+		userID, err := callerID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if req.UserId != "" && req.UserId != userID {
+			return nil, status.Error(codes.PermissionDenied, "cannot list the conversations of other users")
+		}
+		filter.UserID = userID
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+
 	// List conversations from database
-	// Note: proto only has agent_id, so we pass empty strings for user_id and status
-	conversations, total, err := s.convRepo.List(ctx, page, pageSize, req.AgentId, "", "")
+	conversations, total, err := s.convRepo.List(ctx, page, pageSize, filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list conversations: %v", err)
 	}
@@ -128,6 +209,148 @@ func (s *ConversationServer) ListConversations(ctx context.Context, req *pb.List
 	}, nil
 }
 
+// UpdateConversation 更新对话
+func (s *ConversationServer) UpdateConversation(ctx context.Context, req *pb.UpdateConversationRequest) (*pb.Conversation, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Status != "" && !conversationStatuses[req.Status] {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", req.Status)
+	}
+
+	version, err := expectedVersion(ctx, req.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify conversation exists and belongs to the caller
+	if _, err := s.loadConversation(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	// Prepare update fields
+	updates := make(map[string]interface{})
+	if req.Title != "" {
+		updates["title"] = req.Title
+		updates["title_source"] = "user"
+	}
+	if req.Status != "" {
+		updates["status"] = req.Status
+	}
+	if req.Context != nil {
+		updates["context"] = req.Context.AsMap()
+	}
+
+	updated, err := s.convRepo.Update(ctx, req.Id, version, updates)
+	if err != nil {
+		return nil, conversationWriteStatus(err, "update conversation")
+	}
+
+	return entConversationToProto(updated), nil
+}
+
+// DeleteConversation 删除对话及其消息
+func (s *ConversationServer) DeleteConversation(ctx context.Context, req *pb.DeleteConversationRequest) (*emptypb.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	// Verify conversation exists and belongs to the caller
+	if _, err := s.loadConversation(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	// Messages are removed by the cascading foreign key
+	if err := s.convRepo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete conversation: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ArchiveConversations 批量归档当前用户的对话
+func (s *ConversationServer) ArchiveConversations(ctx context.Context, req *pb.ArchiveConversationsRequest) (*pb.ArchiveConversationsResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids is required")
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	archived, err := s.convRepo.SetStatus(ctx, userID, req.Ids, "archived")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to archive conversations: %v", err)
+	}
+
+	return &pb.ArchiveConversationsResponse{Archived: int64(archived)}, nil
+}
+
+// SearchConversations 搜索当前用户的对话
+func (s *ConversationServer) SearchConversations(ctx context.Context, req *pb.SearchConversationsRequest) (*pb.SearchConversationsResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	// Set default pagination
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.ConversationFilter{
+		AgentID: req.AgentId,
+		UserID:  userID,
+		Status:  req.Status,
+	}
+	conversations, total, err := s.convRepo.Search(ctx, query, page, pageSize, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search conversations: %v", err)
+	}
+
+	ids := make([]string, len(conversations))
+	for i, conv := range conversations {
+		ids[i] = conv.ID
+	}
+	matches, err := s.msgRepo.FirstMatching(ctx, ids, query)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search messages: %v", err)
+	}
+
+	// Show the first matching message, or the title when only the title matched
+	items := make([]*pb.ConversationSearchResult, len(conversations))
+	for i, conv := range conversations {
+		result := &pb.ConversationSearchResult{
+			Conversation: entConversationToProto(conv),
+		}
+		if m, ok := matches[conv.ID]; ok {
+			result.MessageId = m.ID
+			result.Snippet = searchSnippet(m.Content, query)
+		} else {
+			result.Snippet = conv.Title
+		}
+		items[i] = result
+	}
+
+	return &pb.SearchConversationsResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
 // SendMessage 发送消息
 func (s *ConversationServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
 	if req.ConversationId == "" {
@@ -136,45 +359,289 @@ func (s *ConversationServer) SendMessage(ctx context.Context, req *pb.SendMessag
 	if req.Content == "" {
 		return nil, status.Error(codes.InvalidArgument, "content is required")
 	}
+	key, err := idempotencyKey(ctx, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one request per idempotency key runs at a time, retries wait for it to finish and
+	// then replay its result
+	if key != "" {
+		finish, err := s.waitForSend(ctx, req.ConversationId+"/"+key)
+		if err != nil {
+			return nil, err
+		}
+		defer finish()
+	}
 
 	// Get conversation from database
-	conv, err := s.convRepo.Get(ctx, req.ConversationId)
+	conv, err := s.loadConversation(ctx, req.ConversationId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "conversation not found: %v", err)
+		return nil, err
+	}
+
+	// Load the stored conversation history and follow the active branch
+	stored, err := s.msgRepo.ListByConversation(ctx, conv.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load messages: %v", err)
+	}
+
+	// Replay retried requests instead of sending the message again
+	if key != "" {
+		replayed, err := replaySend(conv, stored, key, req.Content)
+		if err != nil || replayed != nil {
+			return replayed, err
+		}
+	}
+	if err := checkVersion(ctx, conv, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	path := activePath(stored, conv.ActiveMessageID)
+
+	// Create user message as a reply to the end of the active branch
+	userMessage := &ent.Message{
+		ID:             uuid.New().String(),
+		ConversationID: conv.ID,
+		Role:           "user",
+		Content:        req.Content,
+		IdempotencyKey: key,
+		CreatedAt:      time.Now(),
+	}
+	if len(path) > 0 {
+		userMessage.ParentID = path[len(path)-1].ID
+	}
+	if req.Metadata != nil {
+		userMessage.Metadata = req.Metadata.AsMap()
+	}
+
+	result, err := s.generateReply(ctx, conv, path, userMessage, true, req.GenerationId)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get agent
+	return result.toProto(conv.ID), nil
+}
+
+// checkAgentAccess returns an error unless the caller has at least the need permission on
+// an agent
+func (s *ConversationServer) checkAgentAccess(ctx context.Context, agent *ent.Agent, need agentPermission) error {
+	perm, err := agentPermissionFor(ctx, s.shareRepo, agent)
+	if err != nil {
+		return err
+	}
+	return checkAgentPermission(agent, perm, need)
+}
+
+// conversationAgent loads the agent of a conversation, configured as in the published
+// version the conversation is pinned to
+func (s *ConversationServer) conversationAgent(ctx context.Context, conv *ent.Conversation) (*ent.Agent, error) {
 	agent, err := s.agentRepo.Get(ctx, conv.AgentID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
 	}
+	if err := s.checkAgentAccess(ctx, agent, permissionChat); err != nil {
+		return nil, err
+	}
+	if agent.Status == "archived" {
+		return nil, status.Errorf(codes.FailedPrecondition, "agent is archived: %s", agent.ID)
+	}
+	if conv.AgentVersionID == "" {
+		return agent, nil
+	}
 
-	// Create user message
-	now := time.Now()
-	userMessage := &pb.Message{
-		Id:        uuid.New().String(),
-		Role:      "user",
-		Content:   req.Content,
-		Timestamp: timestamppb.New(now),
+	version, err := s.versionRepo.Get(ctx, conv.AgentVersionID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "pinned agent version unavailable: %v", err)
 	}
+	return applyAgentVersion(agent, version), nil
+}
 
-	// Build message history for AI
-	messages := []ai.Message{}
+// generateReply asks the agent's model to answer userMessage following history, the active
+// branch leading up to it. Multi-agent agents hand the turn to their members first. The user message
+// is stored first when saveUser is set, otherwise it must already exist. The generation can be
+// cancelled through generationID (generated when empty); the output produced until then is
+// stored. The messages are only stored if the active branch of conv was not changed in the
+// meantime. Errors are gRPC status errors.
+func (s *ConversationServer) generateReply(ctx context.Context, conv *ent.Conversation, history []*ent.Message, userMessage *ent.Message, saveUser bool, generationID string) (*replyResult, error) {
+	firstExchange := saveUser && len(history) == 0
+
+	// Get agent as configured in the version the conversation is pinned to
+	agent, err := s.conversationAgent(ctx, conv)
+	if err != nil {
+		return nil, err
+	}
+
+	generationID, genCtx, finish, err := s.startGeneration(ctx, conv.ID, generationID)
+	if err != nil {
+		return nil, err
+	}
+	defer finish()
+
+	// Agents called as tools during the turn share its limits
+	genCtx = withAgentCall(genCtx, agent.ID)
+
+	var plan *replyPlan
+	var trace map[string]interface{}
+	if agent.Type == "multi-agent" {
+		plan, trace, err = s.orchestrate(genCtx, agent, conv, history, userMessage)
+	} else {
+		plan, err = s.planReply(genCtx, agent, conv, history, userMessage, "")
+	}
+	if err != nil {
+		if genCtx.Err() != nil {
+			return nil, status.Error(codes.Canceled, "generation cancelled")
+		}
+		return nil, err
+	}
+	model := plan.request.Model
+
+	// Call AI service, streaming so that output produced before a cancellation is kept.
+	// Tool calls need complete responses, so agents offered tools do not stream.
+	promptTokens := ai.EstimateMessagesTokens(plan.request.Messages)
+	var content string
+	var completionTokens int
+	var toolCalls []interface{}
+	if len(plan.request.Tools) > 0 {
+		var resp *ai.ChatResponse
+		resp, toolCalls, err = s.complete(genCtx, plan, conv)
+		if resp != nil {
+			content = resp.Content
+			promptTokens, completionTokens = resp.PromptTokens, resp.CompletionTokens
+		}
+	} else {
+		content, err = s.streamReply(genCtx, plan.request)
+	}
+	if completionTokens == 0 {
+		// Streamed responses carry no usage, so token counts are estimated
+		completionTokens = ai.EstimateTokens(content)
+	}
+	cancelled := genCtx.Err() != nil
+	if err != nil && !cancelled {
+		return nil, status.Errorf(codes.Internal, "AI service error: %v", err)
+	}
+	if cancelled && content == "" {
+		return nil, status.Error(codes.Canceled, "generation cancelled")
+	}
+
+	// Keep saving when the client disconnected
+	saveCtx := context.WithoutCancel(ctx)
+
+	assistantMessage := &ent.Message{
+		ID:               uuid.New().String(),
+		ConversationID:   conv.ID,
+		ParentID:         userMessage.ID,
+		Role:             "assistant",
+		Content:          content,
+		Model:            model,
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		CreatedAt:        time.Now(),
+	}
+	if cancelled || trace != nil || len(toolCalls) > 0 {
+		assistantMessage.Metadata = map[string]interface{}{}
+	}
+	if len(toolCalls) > 0 {
+		assistantMessage.Metadata["tool_calls"] = toolCalls
+	}
+	if cancelled {
+		assistantMessage.Metadata["status"] = "cancelled"
+	}
+	if trace != nil {
+		assistantMessage.Metadata["orchestration"] = trace
+	}
+
+	toSave := []*ent.Message{assistantMessage}
+	if saveUser {
+		toSave = []*ent.Message{userMessage, assistantMessage}
+	}
+
+	// Store the exchange unless the active branch moved on, concurrent sends would otherwise
+	// reply to the same message and race for it
+	saved, version, err := s.appendReply(saveCtx, conv, toSave)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicateIdempotencyKey) {
+			return nil, status.Error(codes.Aborted, "a request with this idempotency key was already processed, retry to get its result")
+		}
+		return nil, conversationWriteStatus(err, "save messages")
+	}
+
+	// Summarize older turns in the background once the history grows too large
+	contextCfg := plan.contextCfg
+	if contextCfg.Summary.Enabled {
+		s.scheduleSummary(conv.ID, contextCfg.Summary)
+	}
+
+	// Title new conversations after the first reply
+	if firstExchange && conv.TitleSource == "default" {
+		s.scheduleTitle(conv.ID, s.titleModelFor(contextCfg, model), userMessage.Content, content)
+	}
+
+	// Extract long-term memories from complete exchanges in the background, remembered by
+	// the agent that answered
+	if contextCfg.Memory.Enabled && !cancelled {
+		s.scheduleMemoryExtraction(conv.UserID, plan.agent.ID, conv.ID, []ai.Message{plan.newMessage, {
+			Role:    "assistant",
+			Content: content,
+		}}, contextCfg.Memory)
+	}
+
+	return &replyResult{
+		GenerationID: generationID,
+		Messages:     saved,
+		Cancelled:    cancelled,
+		Version:      version,
+	}, nil
+}
+
+// replyPlan is the model request answering a user message on behalf of an agent
+type replyPlan struct {
+	agent      *ent.Agent // Agent answering, a member when a multi-agent agent routed the turn
+	request    ai.ChatRequest
+	contextCfg *contextConfig
+	newMessage ai.Message
+	tools      map[string]*ent.Tool // Agent tools offered to the model by function name
+}
+
+// agentModel returns the model and temperature an agent answers with
+func agentModel(agent *ent.Agent) (string, float32) {
+	model := "deepseek-ai/DeepSeek-V3" // Default to DeepSeek (更经济实惠)
+	temperature := float32(0.7)
+	if agent.ModelConfig != nil {
+		if m, ok := agent.ModelConfig["model"].(string); ok && m != "" {
+			model = m
+		}
+		if t, ok := agent.ModelConfig["temperature"].(float64); ok {
+			temperature = float32(t)
+		}
+	}
+	return model, temperature
+}
+
+// planReply builds the request asking agent's model to answer userMessage following history:
+// the rendered prompt with knowledge base context, recalled memories and extraContext, then
+// the summarized history fitted into the context window
+func (s *ConversationServer) planReply(ctx context.Context, agent *ent.Agent, conv *ent.Conversation, history []*ent.Message, userMessage *ent.Message, extraContext string) (*replyPlan, error) {
+	// Get model config from agent
+	model, temperature := agentModel(agent)
 
-	// Add system prompt with knowledge base context if agent has one
-	systemPrompt := ""
-	if agent.PromptTemplate != "" {
-		systemPrompt = agent.PromptTemplate
+	contextCfg, err := parseContextConfig(model, agent.ModelConfig)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid agent model_config: %v", err)
 	}
 
+	// Build message history for AI
+	messages := []ai.Message{}
+
 	// Retrieve knowledge base context if agent has knowledge bases configured
+	kbContext := ""
 	if len(agent.KnowledgeBases) > 0 && s.kbServer != nil {
 		kbContexts := []string{}
 		for _, kbID := range agent.KnowledgeBases {
 			// Search each knowledge base
 			searchResp, err := s.kbServer.SearchKnowledgeBase(ctx, &pb.SearchKnowledgeBaseRequest{
 				KnowledgeBaseId: kbID,
-				Query:           req.Content,
+				Query:           userMessage.Content,
 				TopK:            3,
 				Threshold:       0.7,
 			})
@@ -183,23 +650,48 @@ func (s *ConversationServer) SendMessage(ctx context.Context, req *pb.SendMessag
 			}
 		}
 
-		// Append knowledge base context to system prompt
+		// Format knowledge base context for the system prompt
 		if len(kbContexts) > 0 {
-			kbContext := "\n\n=== Knowledge Base Context ===\n"
+			kbContext = "\n\n=== Knowledge Base Context ===\n"
 			for i, ctx := range kbContexts {
 				kbContext += "\n[Knowledge Base " + agent.KnowledgeBases[i] + "]:\n" + ctx
 			}
 			kbContext += "\n=== End of Knowledge Base Context ===\n\n"
 			kbContext += "Please use the above knowledge base information to answer the user's question accurately. If the knowledge base contains relevant information, prioritize it in your response."
+		}
+	}
 
+	// Render the agent's prompt template with the knowledge base context
+	systemPrompt, err := s.systemPrompt(ctx, agent, conv, kbContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recall long-term memories about the user
+	if contextCfg.Memory.Enabled {
+		if memoryContext := s.recallMemories(ctx, conv.UserID, agent.ID, userMessage.Content, contextCfg.Memory); memoryContext != "" {
 			if systemPrompt != "" {
-				systemPrompt = systemPrompt + kbContext
+				systemPrompt = systemPrompt + "\n\n" + memoryContext
 			} else {
-				systemPrompt = kbContext
+				systemPrompt = memoryContext
 			}
 		}
 	}
 
+	if extraContext != "" {
+		if systemPrompt != "" {
+			systemPrompt = systemPrompt + "\n\n" + extraContext
+		} else {
+			systemPrompt = extraContext
+		}
+	}
+
+	// Offer the agents the agent may call as tools
+	tools, toolsByName, err := s.agentTools(ctx, agent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
 	// Add system prompt to messages
 	if systemPrompt != "" {
 		messages = append(messages, ai.Message{
@@ -208,84 +700,79 @@ func (s *ConversationServer) SendMessage(ctx context.Context, req *pb.SendMessag
 		})
 	}
 
-	// Add conversation history
-	if conv.Messages != nil {
-		for _, msg := range conv.Messages {
-			if msgMap, ok := msg.(map[string]interface{}); ok {
-				role, _ := msgMap["role"].(string)
-				content, _ := msgMap["content"].(string)
-				if role != "" && content != "" {
-					messages = append(messages, ai.Message{
-						Role:    role,
-						Content: content,
-					})
-				}
-			}
+	// Collect conversation history, replacing summarized turns with their summary
+	if summary := summaryFromMetadata(conv.Metadata); summary.Content != "" {
+		if covered := summary.coveredBy(history); covered >= 0 {
+			history = history[covered:]
+			messages = append(messages, ai.Message{
+				Role:    "system",
+				Content: "Summary of the earlier conversation:\n" + summary.Content,
+			})
 		}
 	}
 
-	// Add new user message
-	messages = append(messages, ai.Message{
+	newMessage := ai.Message{
 		Role:    "user",
-		Content: req.Content,
-	})
-
-	// Get model config from agent
-	model := "deepseek-ai/DeepSeek-V3" // Default to DeepSeek (更经济实惠)
-	temperature := float32(0.7)
-	if agent.ModelConfig != nil {
-		if m, ok := agent.ModelConfig["model"].(string); ok && m != "" {
-			model = m
-		}
-		if t, ok := agent.ModelConfig["temperature"].(float64); ok {
-			temperature = float32(t)
-		}
+		Content: userMessage.Content,
 	}
 
-	// Call AI service
-	aiResp, err := s.aiManager.Chat(ai.ChatRequest{
-		Model:       model,
-		Messages:    messages,
-		Temperature: temperature,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "AI service error: %v", err)
+	// Fit history into the context window left after the system prompt, the new message and the reserved output
+	budget := contextCfg.ContextWindow - contextCfg.ReservedTokens -
+		ai.EstimateMessagesTokens(messages) - ai.EstimateMessageTokens(newMessage) - estimateToolsTokens(tools)
+	if budget <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"message and system prompt exceed the context window of model %s (%d tokens)", model, contextCfg.ContextWindow)
 	}
+	contextCfg.Truncate.TokenBudget = budget
+
+	messages = append(messages, ai.TruncateHistory(historyFromMessages(history), contextCfg.Truncate)...)
+	messages = append(messages, newMessage)
+
+	return &replyPlan{
+		agent: agent,
+		request: ai.ChatRequest{
+			Model:       model,
+			Messages:    messages,
+			Temperature: temperature,
+			MaxTokens:   contextCfg.MaxTokens,
+			Tools:       tools,
+		},
+		contextCfg: contextCfg,
+		newMessage: newMessage,
+		tools:      toolsByName,
+	}, nil
+}
 
-	// Create assistant message
-	assistantMessage := &pb.Message{
-		Id:        uuid.New().String(),
-		Role:      "assistant",
-		Content:   aiResp.Content,
-		Timestamp: timestamppb.New(time.Now()),
+// ListMessages 获取对话消息列表
+func (s *ConversationServer) ListMessages(ctx context.Context, req *pb.ListMessagesRequest) (*pb.ListMessagesResponse, error) {
+	if req.ConversationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
 	}
 
-	// Save messages to conversation
-	userMsgMap := map[string]interface{}{
-		"id":        userMessage.Id,
-		"role":      userMessage.Role,
-		"content":   userMessage.Content,
-		"timestamp": userMessage.Timestamp.AsTime().Unix(),
+	// Set default pagination
+	page := req.Page
+	if page <= 0 {
+		page = 1
 	}
-	assistantMsgMap := map[string]interface{}{
-		"id":        assistantMessage.Id,
-		"role":      assistantMessage.Role,
-		"content":   assistantMessage.Content,
-		"timestamp": assistantMessage.Timestamp.AsTime().Unix(),
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
 	}
 
-	_, err = s.convRepo.AddMessage(ctx, req.ConversationId, userMsgMap)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to save user message: %v", err)
+	// Verify conversation exists and belongs to the caller
+	if _, err := s.loadConversation(ctx, req.ConversationId); err != nil {
+		return nil, err
 	}
 
-	_, err = s.convRepo.AddMessage(ctx, req.ConversationId, assistantMsgMap)
+	messages, total, err := s.msgRepo.List(ctx, req.ConversationId, page, pageSize)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to save assistant message: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list messages: %v", err)
 	}
 
-	return &pb.SendMessageResponse{
-		ConversationId: req.ConversationId,
-		Messages:       []*pb.Message{userMessage, assistantMessage},
+	return &pb.ListMessagesResponse{
+		Items:    entMessagesToProto(messages),
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
 	}, nil
 }
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"agent-platform/internal/ai"
+	"agent-platform/internal/model/ent"
+
+	"go.uber.org/zap"
+)
+
+// summaryTimeout bounds a background summarization run
+const summaryTimeout = 2 * time.Minute
+
+// conversationSummary is the rolling summary stored under "summary" in a conversation's metadata
+type conversationSummary struct {
+	Content       string
+	LastMessageID string // Last message folded into the summary
+	Model         string
+	UpdatedAt     time.Time
+}
+
+// summaryFromMetadata reads the rolling summary from conversation metadata
+func summaryFromMetadata(metadata map[string]interface{}) conversationSummary {
+	summary := conversationSummary{}
+	raw, ok := metadata["summary"].(map[string]interface{})
+	if !ok {
+		return summary
+	}
+
+	summary.Content, _ = raw["content"].(string)
+	summary.LastMessageID, _ = raw["last_message_id"].(string)
+	summary.Model, _ = raw["model"].(string)
+	if ts, ok := raw["updated_at"].(string); ok {
+		summary.UpdatedAt, _ = time.Parse(time.RFC3339, ts)
+	}
+
+	return summary
+}
+
+// toMap converts the summary to its metadata representation
+func (s conversationSummary) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"content":         s.Content,
+		"last_message_id": s.LastMessageID,
+		"model":           s.Model,
+		"updated_at":      s.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// coveredBy returns how many leading messages of a branch the summary covers, or -1 when
+// the branch does not pass through the last summarized message. Summaries written before
+// messages were stored individually count messages instead of naming the last one, they
+// are treated as stale and rebuilt.
+func (s conversationSummary) coveredBy(branch []*ent.Message) int {
+	if s.LastMessageID == "" {
+		if s.Content != "" {
+			return -1
+		}
+		return 0
+	}
+	for i, msg := range branch {
+		if msg.ID == s.LastMessageID {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// scheduleSummary starts a background summarization of a conversation unless one is already running
+func (s *ConversationServer) scheduleSummary(conversationID string, cfg summaryConfig) {
+	if _, running := s.summarizing.LoadOrStore(conversationID, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		defer s.summarizing.Delete(conversationID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), summaryTimeout)
+		defer cancel()
+
+		if err := s.summarizeConversation(ctx, conversationID, cfg); err != nil {
+			s.logger.Warn("Failed to summarize conversation",
+				zap.String("conversation_id", conversationID),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// summarizeConversation folds the oldest unsummarized messages into the rolling summary
+// when the unsummarized history exceeds the configured threshold
+func (s *ConversationServer) summarizeConversation(ctx context.Context, conversationID string, cfg summaryConfig) error {
+	conv, err := s.convRepo.Get(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	stored, err := s.msgRepo.ListByConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	branch := activePath(stored, conv.ActiveMessageID)
+	summary := summaryFromMetadata(conv.Metadata)
+	covered := summary.coveredBy(branch)
+	if covered < 0 {
+		// The active branch no longer contains the summarized messages, start over
+		summary = conversationSummary{}
+		covered = 0
+	}
+
+	pending := branch[covered:]
+	if len(pending) <= cfg.KeepRecent || ai.EstimateMessagesTokens(historyFromMessages(pending)) < cfg.TriggerTokens {
+		return nil
+	}
+
+	toSummarize := pending[:len(pending)-cfg.KeepRecent]
+	content, err := s.summarizer.Summarize(ctx, cfg.Model, summary.Content, historyFromMessages(toSummarize))
+	if err != nil {
+		return err
+	}
+
+	summary = conversationSummary{
+		Content:       content,
+		LastMessageID: toSummarize[len(toSummarize)-1].ID,
+		Model:         cfg.Model,
+		UpdatedAt:     time.Now(),
+	}
+
+	// Reload so metadata written while the model was running is preserved
+	conv, err = s.convRepo.Get(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	metadata := conv.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["summary"] = summary.toMap()
+
+	if _, err := s.convRepo.Update(ctx, conversationID, 0, map[string]interface{}{"metadata": metadata}); err != nil {
+		return fmt.Errorf("failed to save summary: %w", err)
+	}
+
+	s.logger.Info("Conversation summary updated",
+		zap.String("conversation_id", conversationID),
+		zap.String("last_message_id", summary.LastMessageID),
+	)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"agent-platform/internal/ai"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// titleTimeout bounds a background titling run
+	titleTimeout = time.Minute
+	// maxTitleLength caps generated titles, in characters
+	maxTitleLength = 50
+)
+
+const titleSystemPrompt = `Write a short title for the conversation below.
+Use at most six words, in the same language as the user's message.
+Respond with the title only, without quotes or trailing punctuation.`
+
+// titleModelFor picks the model used to title a conversation: the agent's title_model,
+// then the server's TITLE_MODEL, then the agent's own model
+func (s *ConversationServer) titleModelFor(cfg *contextConfig, agentModel string) string {
+	if cfg.TitleModel != "" {
+		return cfg.TitleModel
+	}
+	if s.titleModel != "" {
+		return s.titleModel
+	}
+	return agentModel
+}
+
+// scheduleTitle starts a background job that titles a conversation from its first exchange
+func (s *ConversationServer) scheduleTitle(conversationID, model, userContent, assistantContent string) {
+	if _, running := s.titling.LoadOrStore(conversationID, struct{}{}); running {
+		return
+	}
+
+	go func() {
+		defer s.titling.Delete(conversationID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), titleTimeout)
+		defer cancel()
+
+		if err := s.titleConversation(ctx, conversationID, model, userContent, assistantContent); err != nil {
+			s.logger.Warn("Failed to title conversation",
+				zap.String("conversation_id", conversationID),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// titleConversation asks the model for a title and stores it unless the user renamed the conversation meanwhile
+func (s *ConversationServer) titleConversation(ctx context.Context, conversationID, model, userContent, assistantContent string) error {
+	resp, err := s.aiManager.Chat(ctx, ai.ChatRequest{
+		Model: model,
+		Messages: []ai.Message{
+			{Role: "system", Content: titleSystemPrompt},
+			{Role: "user", Content: fmt.Sprintf("user: %s\nassistant: %s", userContent, assistantContent)},
+		},
+		Temperature: 0.3,
+		MaxTokens:   32,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate title: %w", err)
+	}
+
+	title := cleanTitle(resp.Content)
+	if title == "" {
+		return fmt.Errorf("model returned an empty title")
+	}
+
+	updated, err := s.convRepo.SetGeneratedTitle(ctx, conversationID, title)
+	if err != nil {
+		return err
+	}
+
+	if updated {
+		s.logger.Debug("Conversation titled",
+			zap.String("conversation_id", conversationID),
+			zap.String("title", title),
+		)
+	}
+
+	return nil
+}
+
+// cleanTitle keeps the first line of a generated title without surrounding quotes or punctuation
+func cleanTitle(raw string) string {
+	title := strings.TrimSpace(raw)
+	if i := strings.IndexAny(title, "\r\n"); i >= 0 {
+		title = title[:i]
+	}
+	title = strings.TrimPrefix(title, "Title:")
+	title = strings.Trim(title, " \t\"'`“”‘’「」《》*#")
+	title = strings.TrimRight(title, ".。!！?？,，;；:：")
+
+	runes := []rune(title)
+	if len(runes) > maxTitleLength {
+		title = strings.TrimSpace(string(runes[:maxTitleLength]))
+	}
+	return title
+}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	pb "agent-platform/gen/go"
@@ -196,3 +198,187 @@ func (s *KnowledgeBaseServer) SearchKnowledgeBase(ctx context.Context, req *pb.S
 		Context: context,
 	}, nil
 }
+
+// ListChunks 获取文档分块列表
+func (s *KnowledgeBaseServer) ListChunks(ctx context.Context, req *pb.ListChunksRequest) (*pb.ListChunksResponse, error) {
+	if req.KnowledgeBaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "knowledge_base_id is required")
+	}
+	if req.DocumentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "document_id is required")
+	}
+
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	chunks, total, err := s.kbMgr.ListChunks(ctx, req.KnowledgeBaseId, req.DocumentId, int((page-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list chunks: %v", err)
+	}
+
+	return &pb.ListChunksResponse{
+		Items:    chunksToProto(chunks),
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
+// GetChunk 获取分块详情及相邻分块
+func (s *KnowledgeBaseServer) GetChunk(ctx context.Context, req *pb.GetChunkRequest) (*pb.GetChunkResponse, error) {
+	if req.KnowledgeBaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "knowledge_base_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Neighbors < 0 {
+		return nil, status.Error(codes.InvalidArgument, "neighbors must not be negative")
+	}
+
+	chunk, before, after, err := s.kbMgr.GetChunk(ctx, req.KnowledgeBaseId, req.Id, int(req.Neighbors))
+	if err != nil {
+		return nil, chunkError(err, "get")
+	}
+
+	return &pb.GetChunkResponse{
+		Chunk:    chunkToProto(chunk),
+		Previous: chunksToProto(before),
+		Next:     chunksToProto(after),
+	}, nil
+}
+
+// UpdateChunk 更新分块内容并重新生成向量
+func (s *KnowledgeBaseServer) UpdateChunk(ctx context.Context, req *pb.UpdateChunkRequest) (*pb.Chunk, error) {
+	if req.KnowledgeBaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "knowledge_base_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	chunk, err := s.kbMgr.UpdateChunkContent(ctx, req.KnowledgeBaseId, req.Id, req.Content)
+	if err != nil {
+		return nil, chunkError(err, "update")
+	}
+
+	return chunkToProto(chunk), nil
+}
+
+// EnableChunk 启用分块，使其重新参与检索
+func (s *KnowledgeBaseServer) EnableChunk(ctx context.Context, req *pb.SetChunkEnabledRequest) (*pb.Chunk, error) {
+	return s.setChunkEnabled(ctx, req, true)
+}
+
+// DisableChunk 禁用分块，检索时跳过该分块
+func (s *KnowledgeBaseServer) DisableChunk(ctx context.Context, req *pb.SetChunkEnabledRequest) (*pb.Chunk, error) {
+	return s.setChunkEnabled(ctx, req, false)
+}
+
+func (s *KnowledgeBaseServer) setChunkEnabled(ctx context.Context, req *pb.SetChunkEnabledRequest, enabled bool) (*pb.Chunk, error) {
+	if req.KnowledgeBaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "knowledge_base_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	chunk, err := s.kbMgr.SetChunkEnabled(ctx, req.KnowledgeBaseId, req.Id, enabled)
+	if err != nil {
+		return nil, chunkError(err, "update")
+	}
+
+	return chunkToProto(chunk), nil
+}
+
+// PinChunk 置顶分块，检索时按 boost 系数提升分数
+func (s *KnowledgeBaseServer) PinChunk(ctx context.Context, req *pb.PinChunkRequest) (*pb.Chunk, error) {
+	if req.KnowledgeBaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "knowledge_base_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	boost := req.Boost
+	if boost == 0 {
+		boost = defaultChunkBoost
+	}
+	if boost < 0 {
+		return nil, status.Error(codes.InvalidArgument, "boost must be positive")
+	}
+
+	chunk, err := s.kbMgr.PinChunk(ctx, req.KnowledgeBaseId, req.Id, boost)
+	if err != nil {
+		return nil, chunkError(err, "pin")
+	}
+
+	return chunkToProto(chunk), nil
+}
+
+// UnpinChunk 取消置顶分块
+func (s *KnowledgeBaseServer) UnpinChunk(ctx context.Context, req *pb.UnpinChunkRequest) (*pb.Chunk, error) {
+	if req.KnowledgeBaseId == "" {
+		return nil, status.Error(codes.InvalidArgument, "knowledge_base_id is required")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	chunk, err := s.kbMgr.UnpinChunk(ctx, req.KnowledgeBaseId, req.Id)
+	if err != nil {
+		return nil, chunkError(err, "unpin")
+	}
+
+	return chunkToProto(chunk), nil
+}
+
+// defaultChunkBoost 置顶分块未指定 boost 时使用的默认系数
+const defaultChunkBoost = 1.5
+
+// chunkError 将分块操作错误转换为 gRPC 状态
+func chunkError(err error, action string) error {
+	if errors.Is(err, knowledge.ErrChunkNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to %s chunk: %v", action, err)
+}
+
+// chunkToProto 将 knowledge.Chunk 转换为 pb.Chunk
+func chunkToProto(chunk *knowledge.Chunk) *pb.Chunk {
+	metadata, _ := structpb.NewStruct(chunk.Metadata)
+	pbChunk := &pb.Chunk{
+		Id:              chunk.ID,
+		KnowledgeBaseId: chunk.KnowledgeBaseID,
+		DocumentId:      chunk.DocumentID,
+		ChunkIndex:      int32(chunk.Index),
+		Content:         chunk.Content,
+		Metadata:        metadata,
+		Enabled:         !chunk.Disabled,
+		Pinned:          chunk.Pinned,
+		Boost:           chunk.Boost,
+		CreatedAt:       timestamppb.New(chunk.CreatedAt),
+	}
+	if !chunk.UpdatedAt.IsZero() {
+		pbChunk.UpdatedAt = timestamppb.New(chunk.UpdatedAt)
+	}
+	return pbChunk
+}
+
+func chunksToProto(chunks []*knowledge.Chunk) []*pb.Chunk {
+	items := make([]*pb.Chunk, len(chunks))
+	for i, chunk := range chunks {
+		items[i] = chunkToProto(chunk)
+	}
+	return items
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/auth"
+	"agent-platform/internal/memory"
+	"agent-platform/internal/model/ent"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MemoryServer gRPC Memory 服务实现
+type MemoryServer struct {
+	pb.UnimplementedMemoryServiceServer
+	store *memory.Store
+}
+
+// NewMemoryServer 创建 Memory 服务实例
+func NewMemoryServer(store *memory.Store) *MemoryServer {
+	return &MemoryServer{
+		store: store,
+	}
+}
+
+// ListMemories 获取当前用户的记忆列表
+func (s *MemoryServer) ListMemories(ctx context.Context, req *pb.ListMemoriesRequest) (*pb.ListMemoriesResponse, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	memories, total, err := s.store.List(ctx, userID, req.AgentId, int((page-1)*pageSize), int(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memories: %v", err)
+	}
+
+	items := make([]*pb.Memory, len(memories))
+	for i, m := range memories {
+		items[i] = entMemoryToProto(m)
+	}
+
+	return &pb.ListMemoriesResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
+// DeleteMemory 删除当前用户的一条记忆
+func (s *MemoryServer) DeleteMemory(ctx context.Context, req *pb.DeleteMemoryRequest) (*emptypb.Empty, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.store.Delete(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, memory.ErrMemoryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete memory: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ClearMemories 清空当前用户的记忆
+func (s *MemoryServer) ClearMemories(ctx context.Context, req *pb.ClearMemoriesRequest) (*pb.ClearMemoriesResponse, error) {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	deleted, err := s.store.DeleteAll(ctx, userID, req.AgentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear memories: %v", err)
+	}
+
+	return &pb.ClearMemoriesResponse{Deleted: int64(deleted)}, nil
+}
+
+// entMemoryToProto converts ent.Memory to pb.Memory
+func entMemoryToProto(m *ent.Memory) *pb.Memory {
+	pbMemory := &pb.Memory{
+		Id:                   m.ID,
+		UserId:               m.UserID,
+		AgentId:              m.AgentID,
+		Content:              m.Content,
+		SourceConversationId: m.SourceConversationID,
+		CreatedAt:            timestamppb.New(m.CreatedAt),
+		UpdatedAt:            timestamppb.New(m.UpdatedAt),
+	}
+	if m.Metadata != nil {
+		pbMemory.Metadata, _ = structpb.NewStruct(m.Metadata)
+	}
+	return pbMemory
+}
@@ -2,10 +2,11 @@ package grpc
 
 import (
 	"context"
-	"time"
 
 	pb "agent-platform/gen/go"
+	"agent-platform/internal/auth"
 	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -15,16 +16,30 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// toolTypes are the supported tool types
+var toolTypes = map[string]bool{
+	"function": true,
+	"api":      true,
+	"plugin":   true,
+	"agent":    true,
+}
+
 // ToolServer gRPC Tool 服务实现
 type ToolServer struct {
 	pb.UnimplementedToolServiceServer
-	client *ent.Client
+	client    *ent.Client
+	repo      *repository.ToolRepository
+	agentRepo *repository.AgentRepository
+	shareRepo *repository.AgentShareRepository
 }
 
 // NewToolServer 创建 Tool 服务实例
 func NewToolServer(client *ent.Client) *ToolServer {
 	return &ToolServer{
-		client: client,
+		client:    client,
+		repo:      repository.NewToolRepository(client),
+		agentRepo: repository.NewAgentRepository(client),
+		shareRepo: repository.NewAgentShareRepository(client),
 	}
 }
 
@@ -33,60 +48,97 @@ func (s *ToolServer) CreateTool(ctx context.Context, req *pb.CreateToolRequest)
 	if req.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "name is required")
 	}
+	toolType := req.Type
+	if toolType == "" {
+		toolType = "function"
+	}
+	if !toolTypes[toolType] {
+		return nil, status.Errorf(codes.InvalidArgument, "type must be function, api, plugin or agent, got %q", req.Type)
+	}
 
-	now := timestamppb.New(time.Now())
-	tool := &pb.Tool{
-		Id:             uuid.New().String(),
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entTool := &ent.Tool{
+		ID:             uuid.New().String(),
 		Name:           req.Name,
 		Description:    req.Description,
-		Type:           req.Type,
-		Schema:         req.Schema,
+		Type:           toolType,
 		Implementation: req.Implementation,
 		Version:        "1.0.0",
-		IsPublic:       false,
-		CreatedBy:      "system", // TODO: 从 context 获取
+		CreatedBy:      userID,
 		Category:       req.Category,
 		Tags:           req.Tags,
-		CreatedAt:      now,
-		UpdatedAt:      now,
+	}
+	if req.Schema != nil {
+		entTool.Schema = req.Schema.AsMap()
 	}
 
-	return tool, nil
+	// Agent 工具调用 implementation 指定的 Agent，schema 自动生成
+	if toolType == "agent" {
+		if req.Schema != nil {
+			return nil, status.Error(codes.InvalidArgument, "the schema of agent tools is generated")
+		}
+		if req.Implementation == "" {
+			return nil, status.Error(codes.InvalidArgument, "implementation must be the ID of the agent to call")
+		}
+		target, err := s.agentRepo.Get(ctx, req.Implementation)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "agent not found: %v", err)
+		}
+		perm, err := agentPermissionFor(ctx, s.shareRepo, target)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkAgentPermission(target, perm, permissionChat); err != nil {
+			return nil, err
+		}
+		if err := checkAgentAvailable(target, true); err != nil {
+			return nil, err
+		}
+		if entTool.Description == "" {
+			entTool.Description = target.Description
+		}
+		entTool.Schema = agentToolSchema(entTool)
+	}
+
+	created, err := s.repo.Create(ctx, entTool)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create tool: %v", err)
+	}
+
+	return entToolToProto(created), nil
 }
 
 // ListTools 获取工具列表
 func (s *ToolServer) ListTools(ctx context.Context, req *pb.ListToolsRequest) (*pb.ListToolsResponse, error) {
-	now := timestamppb.New(time.Now())
-	tools := []*pb.Tool{
-		{
-			Id:          "tool-001",
-			Name:        "Web Search",
-			Description: "Search the web for information",
-			Type:        "api",
-			Category:    "search",
-			IsPublic:    true,
-			Version:     "1.0.0",
-			CreatedAt:   now,
-			UpdatedAt:   now,
-		},
-		{
-			Id:          "tool-002",
-			Name:        "Send Email",
-			Description: "Send emails to users",
-			Type:        "api",
-			Category:    "communication",
-			IsPublic:    true,
-			Version:     "1.0.0",
-			CreatedAt:   now,
-			UpdatedAt:   now,
-		},
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	tools, total, err := s.repo.List(ctx, page, pageSize, req.Type, req.Category, "", nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tools: %v", err)
+	}
+
+	items := make([]*pb.Tool, len(tools))
+	for i, t := range tools {
+		items[i] = entToolToProto(t)
 	}
 
 	return &pb.ListToolsResponse{
-		Items:    tools,
-		Page:     req.Page,
-		PageSize: req.PageSize,
-		Total:    2,
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
 	}, nil
 }
 
@@ -96,40 +148,12 @@ func (s *ToolServer) GetTool(ctx context.Context, req *pb.GetToolRequest) (*pb.T
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	// Mock schema
-	schema, _ := structpb.NewStruct(map[string]interface{}{
-		"type": "function",
-		"function": map[string]interface{}{
-			"name":        "web_search",
-			"description": "Search the web",
-			"parameters": map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"query": map[string]interface{}{
-						"type":        "string",
-						"description": "Search query",
-					},
-				},
-				"required": []string{"query"},
-			},
-		},
-	})
-
-	now := timestamppb.New(time.Now())
-	tool := &pb.Tool{
-		Id:          req.Id,
-		Name:        "Web Search",
-		Description: "Search the web for information",
-		Type:        "api",
-		Schema:      schema,
-		Category:    "search",
-		IsPublic:    true,
-		Version:     "1.0.0",
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	}
-
-	return tool, nil
+	tool, err := s.repo.Get(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tool not found: %v", err)
+	}
+
+	return entToolToProto(tool), nil
 }
 
 // DeleteTool 删除工具
@@ -138,7 +162,44 @@ func (s *ToolServer) DeleteTool(ctx context.Context, req *pb.DeleteToolRequest)
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	// TODO: 实际删除逻辑
+	tool, err := s.repo.Get(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tool not found: %v", err)
+	}
+	if !isCaller(ctx, tool.CreatedBy) && auth.GetUserRole(ctx) != "admin" {
+		return nil, status.Error(codes.PermissionDenied, "only the creator can delete a tool")
+	}
+
+	if err := s.repo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tool: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
+
+// entToolToProto converts ent.Tool to pb.Tool
+func entToolToProto(t *ent.Tool) *pb.Tool {
+	pbTool := &pb.Tool{
+		Id:             t.ID,
+		Name:           t.Name,
+		Description:    t.Description,
+		Type:           t.Type,
+		Implementation: t.Implementation,
+		Version:        t.Version,
+		IsPublic:       t.IsPublic,
+		CreatedBy:      t.CreatedBy,
+		Category:       t.Category,
+		Tags:           t.Tags,
+		CreatedAt:      timestamppb.New(t.CreatedAt),
+		UpdatedAt:      timestamppb.New(t.UpdatedAt),
+	}
+
+	// 设置可选字段
+	if t.Schema != nil {
+		if schema, err := structpb.NewStruct(t.Schema); err == nil {
+			pbTool.Schema = schema
+		}
+	}
+
+	return pbTool
+}
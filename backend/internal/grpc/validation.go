@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fieldViolations collects validation problems so that all of them are reported at once
+type fieldViolations []*errdetails.BadRequest_FieldViolation
+
+// add records a problem with a request field
+func (v *fieldViolations) add(field, format string, args ...interface{}) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// err returns an InvalidArgument status listing every problem in its message and as
+// BadRequest details, or nil when there are none
+func (v fieldViolations) err(subject string) error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	problems := make([]string, len(v))
+	for i, violation := range v {
+		problems[i] = violation.Field + ": " + violation.Description
+	}
+
+	st := status.Newf(codes.InvalidArgument, "invalid %s: %s", subject, strings.Join(problems, "; "))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
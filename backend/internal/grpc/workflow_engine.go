@@ -0,0 +1,566 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"agent-platform/internal/auth"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+	"agent-platform/internal/workflow"
+
+	"go.uber.org/zap"
+)
+
+// Workflow executions run in the background, one goroutine each, steps that are ready at the
+// same time running concurrently. Agent steps answer on behalf of the user who started the
+// execution, in ephemeral conversations. The state of every step is stored in the
+// execution's context after each batch of steps, so executions interrupted by a restart
+// resume where they stopped; steps that were running then run again.
+
+const (
+	// defaultMaxIterations is the number of times a step runs at most in an execution,
+	// bounding loops. Workflows may change it with max_iterations in their config.
+	defaultMaxIterations = 10
+	// maxIterationsLimit caps max_iterations
+	maxIterationsLimit = 100
+	// workflowStepTimeout bounds the time an agent step takes
+	workflowStepTimeout = 5 * time.Minute
+	// workflowSaveTimeout bounds storing the progress of an execution
+	workflowSaveTimeout = 10 * time.Second
+)
+
+// States of the steps of an execution. Steps without a state have not been reached yet.
+const (
+	stepPending   = "pending"
+	stepRunning   = "running"
+	stepCompleted = "completed"
+	stepSkipped   = "skipped"
+	stepFailed    = "failed"
+)
+
+// executionState is the state of an execution, stored as its context
+type executionState struct {
+	Steps map[string]*stepState `json:"steps"`
+}
+
+// stepState is the state of a step of an execution
+type stepState struct {
+	Status           string        `json:"status,omitempty"`
+	Output           interface{}   `json:"output,omitempty"`
+	Next             []string      `json:"next,omitempty"` // Steps the step continued with
+	Error            string        `json:"error,omitempty"`
+	Runs             int           `json:"runs,omitempty"`
+	PromptTokens     int           `json:"prompt_tokens,omitempty"`
+	CompletionTokens int           `json:"completion_tokens,omitempty"`
+	ToolCalls        []interface{} `json:"tool_calls,omitempty"`
+	StartedAt        *time.Time    `json:"started_at,omitempty"`
+	CompletedAt      *time.Time    `json:"completed_at,omitempty"`
+}
+
+// decodeExecutionState reads the state stored in an execution's context
+func decodeExecutionState(stored map[string]interface{}) (*executionState, error) {
+	state := &executionState{}
+	if stored != nil {
+		data, err := json.Marshal(stored)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, state); err != nil {
+			return nil, fmt.Errorf("invalid execution state: %w", err)
+		}
+	}
+	if state.Steps == nil {
+		state.Steps = map[string]*stepState{}
+	}
+	return state, nil
+}
+
+// toMap converts the state to the JSON stored as the execution's context
+func (st *executionState) toMap() map[string]interface{} {
+	data, _ := json.Marshal(st)
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+	return m
+}
+
+// status returns the state of a step, empty when it has not been reached
+func (st *executionState) status(id string) string {
+	if step := st.Steps[id]; step != nil {
+		return step.Status
+	}
+	return ""
+}
+
+// stepResult is the outcome of running a step
+type stepResult struct {
+	output           interface{}
+	next             []string
+	promptTokens     int
+	completionTokens int
+	toolCalls        []interface{}
+	err              error
+}
+
+// workflowEngine runs workflow executions
+type workflowEngine struct {
+	workflowRepo *repository.WorkflowRepository
+	execRepo     *repository.WorkflowExecutionRepository
+	userRepo     *repository.UserRepository
+	conv         *ConversationServer
+	logger       *zap.Logger
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc // Executions running here by ID
+}
+
+// newWorkflowEngine creates a workflow engine running agents through conv
+func newWorkflowEngine(client *ent.Client, conv *ConversationServer, logger *zap.Logger) *workflowEngine {
+	return &workflowEngine{
+		workflowRepo: repository.NewWorkflowRepository(client),
+		execRepo:     repository.NewWorkflowExecutionRepository(client),
+		userRepo:     repository.NewUserRepository(client),
+		conv:         conv,
+		logger:       logger,
+		running:      make(map[string]context.CancelFunc),
+	}
+}
+
+// start runs an execution in the background unless it is already running
+func (e *workflowEngine) start(executionID string) {
+	e.mu.Lock()
+	if _, running := e.running[executionID]; running {
+		e.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e.running[executionID] = cancel
+	e.mu.Unlock()
+
+	go func() {
+		defer func() {
+			e.mu.Lock()
+			delete(e.running, executionID)
+			e.mu.Unlock()
+			cancel()
+		}()
+
+		if err := e.run(ctx, executionID); err != nil {
+			e.logger.Error("Workflow execution stopped",
+				zap.String("execution_id", executionID),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// cancel stops an execution running here, the steps running are aborted
+func (e *workflowEngine) cancel(executionID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if cancel, running := e.running[executionID]; running {
+		cancel()
+	}
+}
+
+// resume starts the executions left pending or running, after a restart
+func (e *workflowEngine) resume(ctx context.Context) error {
+	executions, err := e.execRepo.ListUnfinished(ctx)
+	if err != nil {
+		return err
+	}
+	for _, execution := range executions {
+		e.logger.Info("Resuming workflow execution",
+			zap.String("execution_id", execution.ID),
+			zap.String("workflow_id", execution.WorkflowID),
+		)
+		e.start(execution.ID)
+	}
+	return nil
+}
+
+// run executes the steps of an execution until none is left to run. Errors are returned
+// when the outcome could not be stored; step failures fail the execution.
+func (e *workflowEngine) run(ctx context.Context, executionID string) error {
+	execution, err := e.execRepo.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	if execution.Status != "pending" && execution.Status != "running" {
+		return nil
+	}
+
+	state, err := decodeExecutionState(execution.Context)
+	if err != nil {
+		return e.fail(execution, &executionState{}, err)
+	}
+
+	wf, err := e.workflowRepo.Get(ctx, execution.WorkflowID)
+	if err != nil {
+		return e.fail(execution, state, err)
+	}
+	steps, err := workflow.ParseSteps(wf.Steps)
+	if err != nil {
+		return e.fail(execution, state, err)
+	}
+	graph := workflow.NewGraph(steps)
+	maxIterations := workflowMaxIterations(wf.Config)
+
+	// Steps run on behalf of the user who started the execution
+	ctx = e.asUser(ctx, execution.StartedBy)
+
+	// Steps running when the execution was interrupted run again
+	for _, step := range state.Steps {
+		if step.Status == stepRunning {
+			step.Status = stepPending
+		}
+	}
+
+	for {
+		resolveSteps(graph, state)
+
+		var ready []string
+		for _, id := range graph.Steps() {
+			if state.status(id) == stepPending {
+				ready = append(ready, id)
+			}
+		}
+		if len(ready) == 0 {
+			break
+		}
+
+		now := time.Now()
+		for _, id := range ready {
+			step := state.Steps[id]
+			step.Runs++
+			if step.Runs > maxIterations {
+				return e.fail(execution, state, fmt.Errorf("step %s ran more than %d times", id, maxIterations))
+			}
+			step.Status = stepRunning
+			step.StartedAt = &now
+			step.CompletedAt = nil
+		}
+		if saved, err := e.saveProgress(execution.ID, state, strings.Join(ready, ",")); err != nil || !saved {
+			return err
+		}
+
+		// Steps ready at the same time run concurrently
+		scope := executionScope(execution, wf, state)
+		results := make([]stepResult, len(ready))
+		var wg sync.WaitGroup
+		for i, id := range ready {
+			wg.Add(1)
+			go func(i int, step *workflow.Step) {
+				defer wg.Done()
+				results[i] = e.runStep(ctx, graph, step, state, scope, execution.StartedBy)
+			}(i, graph.Step(id))
+		}
+		wg.Wait()
+		if ctx.Err() != nil {
+			// Cancelled, the execution's status was set by CancelExecution
+			return nil
+		}
+
+		var failure error
+		for i, id := range ready {
+			result := results[i]
+			step := state.Steps[id]
+			completedAt := time.Now()
+			step.CompletedAt = &completedAt
+			step.PromptTokens, step.CompletionTokens = result.promptTokens, result.completionTokens
+			step.ToolCalls = result.toolCalls
+			if result.err != nil {
+				step.Status = stepFailed
+				step.Error = result.err.Error()
+				if failure == nil {
+					failure = fmt.Errorf("step %s failed: %w", id, result.err)
+				}
+				continue
+			}
+			step.Status = stepCompleted
+			step.Output = result.output
+			step.Next = result.next
+			step.Error = ""
+		}
+		if failure != nil {
+			return e.fail(execution, state, failure)
+		}
+
+		// Conditions leading back to earlier steps run them again
+		for _, id := range ready {
+			for _, next := range state.Steps[id].Next {
+				if graph.IsLoop(id, next) {
+					restartFrom(graph, state, next)
+				}
+			}
+		}
+	}
+
+	finished, err := e.finish(execution.ID, "completed", state, executionOutput(graph, state), "")
+	if err == nil && finished {
+		e.logger.Info("Workflow execution completed", zap.String("execution_id", execution.ID))
+	}
+	return err
+}
+
+// resolveSteps decides which of the steps not reached yet are pending or skipped. The entry
+// is pending first. Other steps are pending once every step leading to them has finished and
+// one of them continued with them, and skipped when none did.
+func resolveSteps(graph *workflow.Graph, state *executionState) {
+	for changed := true; changed; {
+		changed = false
+		for _, id := range graph.Steps() {
+			if state.status(id) != "" {
+				continue
+			}
+
+			preds := graph.Predecessors(id)
+			decided, activated := true, id == graph.Entry()
+			for _, pred := range preds {
+				switch state.status(pred) {
+				case stepCompleted:
+					if containsString(state.Steps[pred].Next, id) {
+						activated = true
+					}
+				case stepSkipped:
+				default:
+					decided = false
+				}
+			}
+			if !decided {
+				continue
+			}
+
+			step := state.Steps[id]
+			if step == nil {
+				step = &stepState{}
+				state.Steps[id] = step
+			}
+			if activated {
+				step.Status = stepPending
+			} else {
+				step.Status = stepSkipped
+			}
+			changed = true
+		}
+	}
+}
+
+// restartFrom clears the state of a step a loop leads back to and of the steps after it,
+// keeping their run counts, and marks the step pending
+func restartFrom(graph *workflow.Graph, state *executionState, id string) {
+	for _, downstream := range graph.Downstream(id) {
+		runs := 0
+		if step := state.Steps[downstream]; step != nil {
+			runs = step.Runs
+		}
+		state.Steps[downstream] = &stepState{Runs: runs}
+	}
+	state.Steps[id].Status = stepPending
+}
+
+// runStep runs a step of an execution
+func (e *workflowEngine) runStep(ctx context.Context, graph *workflow.Graph, step *workflow.Step, state *executionState, scope workflow.Scope, userID string) stepResult {
+	switch step.Type {
+	case workflow.TypeAgent:
+		return e.runAgentStep(ctx, graph, step, state, scope, userID)
+	case workflow.TypeCondition:
+		condition, err := workflow.ParseCondition(step.Condition)
+		if err != nil {
+			return stepResult{err: fmt.Errorf("invalid condition: %w", err)}
+		}
+		holds, err := condition.Evaluate(scope)
+		if err != nil {
+			return stepResult{err: fmt.Errorf("failed to evaluate condition: %w", err)}
+		}
+		next := condition.Else
+		if holds {
+			next = condition.Then
+		}
+		return stepResult{output: holds, next: next}
+	case workflow.TypeParallel:
+		return stepResult{next: step.NextSteps}
+	}
+	return stepResult{err: fmt.Errorf("unknown step type %q", step.Type)}
+}
+
+// runAgentStep has the step's agent answer the step's input. config.input is a template
+// of the input and config.inputs maps expressions to the values of a JSON input, the
+// answers of the steps leading to the step or the execution's input being the default. config.context renders additional instructions. The answer is the step's output,
+// decoded when config.output is json.
+func (e *workflowEngine) runAgentStep(ctx context.Context, graph *workflow.Graph, step *workflow.Step, state *executionState, scope workflow.Scope, userID string) stepResult {
+	input, err := stepInput(graph, step, state, scope)
+	if err != nil {
+		return stepResult{err: err}
+	}
+	extraContext := ""
+	if text := step.ConfigString("context"); text != "" {
+		if extraContext, err = scope.Render(text); err != nil {
+			return stepResult{err: fmt.Errorf("invalid config.context: %w", err)}
+		}
+	}
+
+	agent, err := e.conv.runnableAgent(ctx, step.AgentID)
+	if err != nil {
+		return stepResult{err: err}
+	}
+
+	stepCtx, cancel := context.WithTimeout(ctx, workflowStepTimeout)
+	defer cancel()
+	resp, calls, err := e.conv.answerEphemeral(stepCtx, agent, &ent.Conversation{UserID: userID}, input, extraContext)
+	if err != nil {
+		return stepResult{toolCalls: calls, err: fmt.Errorf("agent failed to answer: %w", err)}
+	}
+
+	result := stepResult{
+		output:           resp.Content,
+		next:             step.NextSteps,
+		promptTokens:     resp.PromptTokens,
+		completionTokens: resp.CompletionTokens,
+		toolCalls:        calls,
+	}
+	if step.ConfigString("output") == "json" {
+		var decoded interface{}
+		if err := decodeDecision(resp.Content, &decoded); err != nil {
+			result.err = fmt.Errorf("agent answer is not JSON: %w", err)
+			return result
+		}
+		result.output = decoded
+	}
+	return result
+}
+
+// stepInput renders the input of an agent step
+func stepInput(graph *workflow.Graph, step *workflow.Step, state *executionState, scope workflow.Scope) (string, error) {
+	if text := step.ConfigString("input"); text != "" {
+		input, err := scope.Render(text)
+		if err != nil {
+			return "", fmt.Errorf("invalid config.input: %w", err)
+		}
+		return input, nil
+	}
+	values, err := step.MapInputs(scope)
+	if err != nil {
+		return "", err
+	}
+	if values != nil {
+		return outputText(values), nil
+	}
+
+	var answers []string
+	for _, pred := range graph.Predecessors(step.ID) {
+		if state.status(pred) == stepCompleted && state.Steps[pred].Output != nil {
+			answers = append(answers, outputText(state.Steps[pred].Output))
+		}
+	}
+	if len(answers) > 0 {
+		return strings.Join(answers, "\n\n"), nil
+	}
+
+	input, _ := scope["input"].(map[string]interface{})
+	if text, ok := input["input"].(string); ok && len(input) == 1 {
+		return text, nil
+	}
+	return outputText(input), nil
+}
+
+// outputText formats an output as text, JSON unless it is a string
+func outputText(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// executionScope returns the scope step inputs and conditions are evaluated in
+func executionScope(execution *ent.WorkflowExecution, wf *ent.Workflow, state *executionState) workflow.Scope {
+	return workflow.NewScope(execution.Input, wf.Config, state.outputs())
+}
+
+// outputs returns the outputs of the completed steps by ID
+func (st *executionState) outputs() map[string]interface{} {
+	outputs := map[string]interface{}{}
+	for id, step := range st.Steps {
+		if step.Status == stepCompleted {
+			outputs[id] = step.Output
+		}
+	}
+	return outputs
+}
+
+// executionOutput collects the outputs of the completed steps that end the workflow, under
+// steps by step ID and as result when there is a single one
+func executionOutput(graph *workflow.Graph, state *executionState) map[string]interface{} {
+	outputs := map[string]interface{}{}
+	var last interface{}
+	for _, id := range graph.Steps() {
+		if graph.Terminal(id) && state.status(id) == stepCompleted {
+			outputs[id] = state.Steps[id].Output
+			last = state.Steps[id].Output
+		}
+	}
+
+	output := map[string]interface{}{"steps": outputs}
+	if len(outputs) == 1 {
+		output["result"] = last
+	}
+	return output
+}
+
+// workflowMaxIterations returns the number of times a step runs at most in executions of a
+// workflow
+func workflowMaxIterations(config map[string]interface{}) int {
+	if n, ok := config["max_iterations"].(float64); ok && n >= 1 && n <= maxIterationsLimit {
+		return int(n)
+	}
+	return defaultMaxIterations
+}
+
+// asUser returns a context acting as a user, with the role they have now
+func (e *workflowEngine) asUser(ctx context.Context, userID string) context.Context {
+	ctx = context.WithValue(ctx, auth.UserIDKey, userID)
+	if user, err := e.userRepo.GetByID(ctx, userID); err == nil {
+		ctx = context.WithValue(ctx, auth.RoleKey, user.Role)
+	}
+	return ctx
+}
+
+// saveProgress stores the state of an execution, reporting false when it was cancelled
+func (e *workflowEngine) saveProgress(executionID string, state *executionState, currentStep string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), workflowSaveTimeout)
+	defer cancel()
+	return e.execRepo.SaveProgress(ctx, executionID, state.toMap(), currentStep)
+}
+
+// finish stores the outcome of an execution, reporting false when it was cancelled
+func (e *workflowEngine) finish(executionID, status string, state *executionState, output map[string]interface{}, errMsg string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), workflowSaveTimeout)
+	defer cancel()
+	return e.execRepo.Finish(ctx, executionID, status, state.toMap(), output, errMsg)
+}
+
+// fail marks an execution failed with cause
+func (e *workflowEngine) fail(execution *ent.WorkflowExecution, state *executionState, cause error) error {
+	e.logger.Warn("Workflow execution failed",
+		zap.String("execution_id", execution.ID),
+		zap.String("workflow_id", execution.WorkflowID),
+		zap.Error(cause),
+	)
+	_, err := e.finish(execution.ID, "failed", state, nil, cause.Error())
+	return err
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,617 @@
+package grpc
+
+import (
+	"context"
+
+	pb "agent-platform/gen/go"
+	"agent-platform/internal/auth"
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/repository"
+	"agent-platform/internal/workflow"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// workflowStatuses are the statuses of a workflow
+var workflowStatuses = map[string]bool{
+	"draft":    true,
+	"active":   true,
+	"archived": true,
+}
+
+// WorkflowServer gRPC Workflow 服务实现
+type WorkflowServer struct {
+	pb.UnimplementedWorkflowServiceServer
+	client    *ent.Client
+	repo      *repository.WorkflowRepository
+	execRepo  *repository.WorkflowExecutionRepository
+	agentRepo *repository.AgentRepository
+	shareRepo *repository.AgentShareRepository
+	engine    *workflowEngine
+}
+
+// NewWorkflowServer 创建 Workflow 服务实例，Agent 步骤通过 convServer 调用 Agent
+func NewWorkflowServer(client *ent.Client, convServer *ConversationServer, logger *zap.Logger) *WorkflowServer {
+	return &WorkflowServer{
+		client:    client,
+		repo:      repository.NewWorkflowRepository(client),
+		execRepo:  repository.NewWorkflowExecutionRepository(client),
+		agentRepo: repository.NewAgentRepository(client),
+		shareRepo: repository.NewAgentShareRepository(client),
+		engine:    newWorkflowEngine(client, convServer, logger),
+	}
+}
+
+// ResumeExecutions 恢复服务重启前未完成的执行
+func (s *WorkflowServer) ResumeExecutions(ctx context.Context) error {
+	return s.engine.resume(ctx)
+}
+
+// CreateWorkflow 创建工作流
+func (s *WorkflowServer) CreateWorkflow(ctx context.Context, req *pb.CreateWorkflowRequest) (*pb.Workflow, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entWorkflow := &ent.Workflow{
+		ID:          uuid.New().String(),
+		Name:        req.Name,
+		Description: req.Description,
+		Steps:       workflowStepsToMaps(req.Steps),
+		Status:      "draft",
+		CreatedBy:   userID,
+	}
+	if req.Config != nil {
+		entWorkflow.Config = req.Config.AsMap()
+	}
+
+	if err := s.validateWorkflow(ctx, entWorkflow); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, entWorkflow)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create workflow: %v", err)
+	}
+
+	return entWorkflowToProto(created), nil
+}
+
+// ListWorkflows 获取工作流列表，管理员可查看所有用户的工作流
+func (s *WorkflowServer) ListWorkflows(ctx context.Context, req *pb.ListWorkflowsRequest) (*pb.ListWorkflowsResponse, error) {
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	createdBy := ""
+	if auth.GetUserRole(ctx) != "admin" {
+		userID, err := callerID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		createdBy = userID
+	}
+
+	workflows, total, err := s.repo.List(ctx, page, pageSize, createdBy, req.Status)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list workflows: %v", err)
+	}
+
+	items := make([]*pb.Workflow, len(workflows))
+	for i, w := range workflows {
+		items[i] = entWorkflowToProto(w)
+	}
+
+	return &pb.ListWorkflowsResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
+// GetWorkflow 获取工作流详情
+func (s *WorkflowServer) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (*pb.Workflow, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	w, err := s.loadWorkflow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return entWorkflowToProto(w), nil
+}
+
+// UpdateWorkflow 更新工作流
+func (s *WorkflowServer) UpdateWorkflow(ctx context.Context, req *pb.UpdateWorkflowRequest) (*pb.Workflow, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	existing, err := s.loadWorkflow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 准备更新字段，merged 为更新后的工作流，用于整体校验
+	updates := make(map[string]interface{})
+	merged := *existing
+	if req.Name != "" {
+		updates["name"] = req.Name
+		merged.Name = req.Name
+	}
+	if req.Description != "" {
+		updates["description"] = req.Description
+		merged.Description = req.Description
+	}
+	if req.Steps != nil {
+		steps := workflowStepsToMaps(req.Steps)
+		updates["steps"] = steps
+		merged.Steps = steps
+	}
+	if req.Config != nil {
+		updates["config"] = req.Config.AsMap()
+		merged.Config = req.Config.AsMap()
+	}
+	if req.Status != "" {
+		updates["status"] = req.Status
+		merged.Status = req.Status
+	}
+
+	if err := s.validateWorkflow(ctx, &merged); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, req.Id, updates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update workflow: %v", err)
+	}
+
+	return entWorkflowToProto(updated), nil
+}
+
+// DeleteWorkflow 删除工作流及其执行记录
+func (s *WorkflowServer) DeleteWorkflow(ctx context.Context, req *pb.DeleteWorkflowRequest) (*emptypb.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.loadWorkflow(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Delete(ctx, req.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete workflow: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ExecuteWorkflow 执行工作流
+func (s *WorkflowServer) ExecuteWorkflow(ctx context.Context, req *pb.ExecuteWorkflowRequest) (*pb.WorkflowExecution, error) {
+	if req.WorkflowId == "" {
+		return nil, status.Error(codes.InvalidArgument, "workflow_id is required")
+	}
+
+	w, err := s.loadWorkflow(ctx, req.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	if w.Status == "archived" {
+		return nil, status.Errorf(codes.FailedPrecondition, "workflow is archived: %s", w.ID)
+	}
+	if len(w.Steps) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "workflow has no steps: %s", w.ID)
+	}
+	if err := s.checkStepAgents(ctx, w); err != nil {
+		return nil, err
+	}
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := &ent.WorkflowExecution{
+		ID:         uuid.New().String(),
+		WorkflowID: w.ID,
+		Status:     "pending",
+		StartedBy:  userID,
+	}
+	if req.Input != nil {
+		execution.Input = req.Input.AsMap()
+	}
+
+	created, err := s.execRepo.Create(ctx, execution)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create execution: %v", err)
+	}
+
+	// 后台执行，进度通过 GetExecution 查询
+	s.engine.start(created.ID)
+
+	return entExecutionToProto(created), nil
+}
+
+// GetExecution 获取执行详情
+func (s *WorkflowServer) GetExecution(ctx context.Context, req *pb.GetExecutionRequest) (*pb.WorkflowExecution, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	e, err := s.loadExecution(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return entExecutionToProto(e), nil
+}
+
+// ListExecutions 获取执行列表，工作流的所有者可查看该工作流的所有执行
+func (s *WorkflowServer) ListExecutions(ctx context.Context, req *pb.ListExecutionsRequest) (*pb.ListExecutionsResponse, error) {
+	// 设置默认分页参数
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	// 非管理员只能查看自己启动的执行，或自己工作流的执行
+	startedBy := ""
+	if auth.GetUserRole(ctx) != "admin" {
+		userID, err := callerID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		startedBy = userID
+		if req.WorkflowId != "" {
+			if w, err := s.repo.Get(ctx, req.WorkflowId); err == nil && w.CreatedBy == startedBy {
+				startedBy = ""
+			}
+		}
+	}
+
+	executions, total, err := s.execRepo.List(ctx, page, pageSize, req.WorkflowId, startedBy, req.Status)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list executions: %v", err)
+	}
+
+	items := make([]*pb.WorkflowExecution, len(executions))
+	for i, e := range executions {
+		items[i] = entExecutionToProto(e)
+	}
+
+	return &pb.ListExecutionsResponse{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    int64(total),
+	}, nil
+}
+
+// CancelExecution 取消执行
+func (s *WorkflowServer) CancelExecution(ctx context.Context, req *pb.CancelExecutionRequest) (*pb.WorkflowExecution, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	e, err := s.loadExecution(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.execRepo.Cancel(ctx, e.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel execution: %v", err)
+	}
+	if !cancelled {
+		return nil, status.Errorf(codes.FailedPrecondition, "execution already finished: %s", e.ID)
+	}
+	s.engine.cancel(e.ID)
+
+	e, err = s.execRepo.Get(ctx, e.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load execution: %v", err)
+	}
+
+	return entExecutionToProto(e), nil
+}
+
+// EvaluateExpression 计算表达式，用于编写条件和输入映射时调试
+func (s *WorkflowServer) EvaluateExpression(ctx context.Context, req *pb.EvaluateExpressionRequest) (*pb.EvaluateExpressionResponse, error) {
+	if req.Expression == "" {
+		return nil, status.Error(codes.InvalidArgument, "expression is required")
+	}
+	expression, err := workflow.CompileExpression(req.Expression)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid expression: %v", err)
+	}
+
+	// 使用执行或工作流提供的值，请求中的值优先
+	var input, config, outputs map[string]interface{}
+	switch {
+	case req.ExecutionId != "":
+		e, err := s.loadExecution(ctx, req.ExecutionId)
+		if err != nil {
+			return nil, err
+		}
+		w, err := s.repo.Get(ctx, e.WorkflowID)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "workflow not found: %s", e.WorkflowID)
+		}
+		state, err := decodeExecutionState(e.Context)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		input, config, outputs = e.Input, w.Config, state.outputs()
+	case req.WorkflowId != "":
+		w, err := s.loadWorkflow(ctx, req.WorkflowId)
+		if err != nil {
+			return nil, err
+		}
+		config = w.Config
+	}
+	if req.Input != nil {
+		input = req.Input.AsMap()
+	}
+	if req.Steps != nil {
+		outputs = req.Steps.AsMap()
+	}
+	if req.Config != nil {
+		config = req.Config.AsMap()
+	}
+
+	result, err := expression.Evaluate(workflow.NewScope(input, config, outputs))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to evaluate expression: %v", err)
+	}
+	value, err := structpb.NewValue(result)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert result: %v", err)
+	}
+
+	return &pb.EvaluateExpressionResponse{
+		Value:      value,
+		Type:       workflow.TypeOf(result),
+		References: expression.References(),
+	}, nil
+}
+
+// ownsWorkflow reports whether the caller may manage a workflow: its creator or an admin
+func ownsWorkflow(ctx context.Context, w *ent.Workflow) bool {
+	return auth.GetUserRole(ctx) == "admin" || isCaller(ctx, w.CreatedBy)
+}
+
+// loadWorkflow loads a workflow the caller owns. Workflows of other users are reported as
+// not found.
+func (s *WorkflowServer) loadWorkflow(ctx context.Context, id string) (*ent.Workflow, error) {
+	w, err := s.repo.Get(ctx, id)
+	if err != nil || !ownsWorkflow(ctx, w) {
+		return nil, status.Errorf(codes.NotFound, "workflow not found: %s", id)
+	}
+	return w, nil
+}
+
+// loadExecution loads an execution the caller started, or of a workflow they own
+func (s *WorkflowServer) loadExecution(ctx context.Context, id string) (*ent.WorkflowExecution, error) {
+	e, err := s.execRepo.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "execution not found: %s", id)
+	}
+	if isCaller(ctx, e.StartedBy) || auth.GetUserRole(ctx) == "admin" {
+		return e, nil
+	}
+	if w, err := s.repo.Get(ctx, e.WorkflowID); err == nil && ownsWorkflow(ctx, w) {
+		return e, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "execution not found: %s", id)
+}
+
+// checkStepAgents checks that the caller may chat with the agents of a workflow's agent
+// steps, which run on their behalf
+func (s *WorkflowServer) checkStepAgents(ctx context.Context, w *ent.Workflow) error {
+	steps, err := workflow.ParseSteps(w.Steps)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	for _, step := range steps {
+		if step.Type != workflow.TypeAgent {
+			continue
+		}
+		agent, err := s.agentRepo.Get(ctx, step.AgentID)
+		if err != nil {
+			return status.Errorf(codes.FailedPrecondition, "agent of step %s not found: %s", step.ID, step.AgentID)
+		}
+		perm, err := agentPermissionFor(ctx, s.shareRepo, agent)
+		if err != nil {
+			return err
+		}
+		if err := checkAgentPermission(agent, perm, permissionChat); err != nil {
+			return status.Errorf(status.Code(err), "step %s: %s", step.ID, status.Convert(err).Message())
+		}
+		if agent.Status == "archived" {
+			return status.Errorf(codes.FailedPrecondition, "agent of step %s is archived: %s", step.ID, agent.ID)
+		}
+	}
+	return nil
+}
+
+// validateWorkflow checks a workflow's definition and the agents its steps call, reporting
+// every problem at once with the IDs of the steps concerned
+func (s *WorkflowServer) validateWorkflow(ctx context.Context, w *ent.Workflow) error {
+	var violations fieldViolations
+
+	if w.Name == "" {
+		violations.add("name", "is required")
+	}
+	if !workflowStatuses[w.Status] {
+		violations.add("status", "must be draft, active or archived, got %q", w.Status)
+	}
+	if v, ok := w.Config["max_iterations"]; ok {
+		n, ok := v.(float64)
+		if !ok || n < 1 || n > maxIterationsLimit || n != float64(int(n)) {
+			violations.add("config.max_iterations", "must be an integer between 1 and %d", maxIterationsLimit)
+		}
+	}
+
+	steps, err := workflow.ParseSteps(w.Steps)
+	if err != nil {
+		violations.add("steps", "%v", err)
+		return violations.err("workflow")
+	}
+	for _, problem := range workflow.Validate(steps, w.Config) {
+		violations.add(problem.Field, "%s", problem.Message)
+	}
+
+	// Agent steps must call existing agents
+	var agentIDs []string
+	for _, step := range steps {
+		if step.Type == workflow.TypeAgent && step.AgentID != "" {
+			agentIDs = append(agentIDs, step.AgentID)
+		}
+	}
+	if len(agentIDs) > 0 {
+		agents, err := s.agentRepo.ListByIDs(ctx, agentIDs)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load agents: %v", err)
+		}
+		found := make(map[string]bool, len(agents))
+		for _, a := range agents {
+			found[a.ID] = true
+		}
+		for _, step := range steps {
+			if step.Type == workflow.TypeAgent && step.AgentID != "" && !found[step.AgentID] {
+				violations.add("steps."+step.ID+".agent_id", "agent %q not found", step.AgentID)
+			}
+		}
+	}
+
+	return violations.err("workflow")
+}
+
+// workflowStepsToMaps converts workflow steps to the JSON stored with the workflow
+func workflowStepsToMaps(steps []*pb.WorkflowStep) []map[string]interface{} {
+	maps := make([]map[string]interface{}, len(steps))
+	for i, step := range steps {
+		m := map[string]interface{}{
+			"id":   step.Id,
+			"name": step.Name,
+			"type": step.Type,
+		}
+		if step.AgentId != "" {
+			m["agent_id"] = step.AgentId
+		}
+		if step.Config != nil {
+			m["config"] = step.Config.AsMap()
+		}
+		if len(step.NextSteps) > 0 {
+			next := make([]interface{}, len(step.NextSteps))
+			for j, id := range step.NextSteps {
+				next[j] = id
+			}
+			m["next_steps"] = next
+		}
+		if step.Condition != nil {
+			m["condition"] = step.Condition.AsMap()
+		}
+		maps[i] = m
+	}
+	return maps
+}
+
+// workflowStepsToProto converts the stored steps of a workflow to pb.WorkflowStep
+func workflowStepsToProto(steps []map[string]interface{}) []*pb.WorkflowStep {
+	pbSteps := make([]*pb.WorkflowStep, len(steps))
+	for i, m := range steps {
+		step := &pb.WorkflowStep{}
+		step.Id, _ = m["id"].(string)
+		step.Name, _ = m["name"].(string)
+		step.Type, _ = m["type"].(string)
+		step.AgentId, _ = m["agent_id"].(string)
+		if config, ok := m["config"].(map[string]interface{}); ok {
+			step.Config, _ = structpb.NewStruct(config)
+		}
+		if next, ok := m["next_steps"].([]interface{}); ok {
+			for _, id := range next {
+				if s, ok := id.(string); ok {
+					step.NextSteps = append(step.NextSteps, s)
+				}
+			}
+		}
+		if condition, ok := m["condition"].(map[string]interface{}); ok {
+			step.Condition, _ = structpb.NewStruct(condition)
+		}
+		pbSteps[i] = step
+	}
+	return pbSteps
+}
+
+// entWorkflowToProto converts ent.Workflow to pb.Workflow
+func entWorkflowToProto(w *ent.Workflow) *pb.Workflow {
+	pbWorkflow := &pb.Workflow{
+		Id:          w.ID,
+		Name:        w.Name,
+		Description: w.Description,
+		Steps:       workflowStepsToProto(w.Steps),
+		Status:      w.Status,
+		CreatedBy:   w.CreatedBy,
+		CreatedAt:   timestamppb.New(w.CreatedAt),
+		UpdatedAt:   timestamppb.New(w.UpdatedAt),
+	}
+
+	// 设置可选字段
+	if w.Config != nil {
+		if config, err := structpb.NewStruct(w.Config); err == nil {
+			pbWorkflow.Config = config
+		}
+	}
+
+	return pbWorkflow
+}
+
+// entExecutionToProto converts ent.WorkflowExecution to pb.WorkflowExecution
+func entExecutionToProto(e *ent.WorkflowExecution) *pb.WorkflowExecution {
+	pbExecution := &pb.WorkflowExecution{
+		Id:          e.ID,
+		WorkflowId:  e.WorkflowID,
+		Status:      e.Status,
+		CurrentStep: e.CurrentStep,
+		Error:       e.Error,
+		StartedBy:   e.StartedBy,
+		StartedAt:   timestamppb.New(e.StartedAt),
+		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	}
+
+	// 设置可选字段
+	if e.Input != nil {
+		pbExecution.Input, _ = structpb.NewStruct(e.Input)
+	}
+	if e.Output != nil {
+		pbExecution.Output, _ = structpb.NewStruct(e.Output)
+	}
+	if e.Context != nil {
+		pbExecution.Context, _ = structpb.NewStruct(e.Context)
+	}
+	if !e.CompletedAt.IsZero() {
+		pbExecution.CompletedAt = timestamppb.New(e.CompletedAt)
+	}
+
+	return pbExecution
+}
@@ -1,6 +1,7 @@
 package knowledge
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,6 +16,7 @@ type Manager struct {
 	chunker       *Chunker
 	embedder      *EmbeddingService
 	vectorStore   VectorStore
+	chunkStore    ChunkStore
 	documentStore *DocumentStore
 	logger        *zap.Logger
 }
@@ -46,6 +48,7 @@ func NewManager(client *ent.Client, dsn, apiKey, apiBase, embeddingModel string,
 		chunker:       chunker,
 		embedder:      embedder,
 		vectorStore:   vectorStore,
+		chunkStore:    vectorStore,
 		documentStore: documentStore,
 		logger:        logger,
 	}, nil
@@ -226,3 +229,77 @@ func (m *Manager) GetRelevantContext(kbID, query string, topK int) (string, erro
 
 	return context, nil
 }
+
+// ListChunks returns a page of a document's chunks in order, along with the total count
+func (m *Manager) ListChunks(ctx context.Context, kbID, docID string, offset, limit int) ([]*Chunk, int, error) {
+	return m.chunkStore.ListChunks(ctx, kbID, docID, offset, limit)
+}
+
+// GetChunk retrieves a chunk together with up to window neighboring chunks on each side
+func (m *Manager) GetChunk(ctx context.Context, kbID, chunkID string, window int) (chunk *Chunk, before, after []*Chunk, err error) {
+	chunk, err = m.chunkStore.GetChunk(ctx, kbID, chunkID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	before, after, err = m.chunkStore.GetNeighbors(ctx, chunk, window)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return chunk, before, after, nil
+}
+
+// UpdateChunkContent replaces the text of a chunk and re-embeds it
+func (m *Manager) UpdateChunkContent(ctx context.Context, kbID, chunkID, content string) (*Chunk, error) {
+	if m.embedder == nil {
+		return nil, fmt.Errorf("embedding service not available - please configure OPENAI_API_KEY")
+	}
+
+	chunk, err := m.chunkStore.GetChunk(ctx, kbID, chunkID)
+	if err != nil {
+		return nil, err
+	}
+
+	embedding, err := m.embedder.GenerateEmbedding(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-embed chunk: %w", err)
+	}
+
+	metadata := chunk.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["chunk_size"] = len(content)
+	metadata["edited"] = true
+
+	updated, err := m.chunkStore.UpdateContent(ctx, kbID, chunkID, content, embedding, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("Chunk content updated",
+		zap.String("kb_id", kbID),
+		zap.String("chunk_id", chunkID),
+	)
+
+	return updated, nil
+}
+
+// SetChunkEnabled includes or excludes a chunk from retrieval
+func (m *Manager) SetChunkEnabled(ctx context.Context, kbID, chunkID string, enabled bool) (*Chunk, error) {
+	return m.chunkStore.SetEnabled(ctx, kbID, chunkID, enabled)
+}
+
+// PinChunk pins a chunk so its retrieval score is multiplied by boost
+func (m *Manager) PinChunk(ctx context.Context, kbID, chunkID string, boost float64) (*Chunk, error) {
+	if boost <= 0 {
+		return nil, fmt.Errorf("boost must be positive, got %v", boost)
+	}
+	return m.chunkStore.SetPinned(ctx, kbID, chunkID, true, boost)
+}
+
+// UnpinChunk removes the pin and boost from a chunk
+func (m *Manager) UnpinChunk(ctx context.Context, kbID, chunkID string) (*Chunk, error) {
+	return m.chunkStore.SetPinned(ctx, kbID, chunkID, false, 1)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/documentchunk"
 
 	entsql "entgo.io/ent/dialect/sql"
 	"go.uber.org/zap"
@@ -110,6 +111,7 @@ func (s *PgVectorStore) Search(kbID string, queryEmbedding []float32, topK int,
 
 	// Use cosine distance (<=> operator in pgvector)
 	// Note: We're using embedding as JSON, so we need to cast it
+	// Disabled chunks are skipped; pinned chunks have their score multiplied by boost
 	query := `
 		SELECT
 			id,
@@ -119,12 +121,16 @@ func (s *PgVectorStore) Search(kbID string, queryEmbedding []float32, topK int,
 			content,
 			embedding,
 			metadata,
+			pinned,
+			boost,
 			created_at,
-			1 - (embedding::vector <=> $1::vector) as similarity
+			(1 - (embedding::vector <=> $1::vector)) *
+				CASE WHEN pinned AND boost > 0 THEN boost ELSE 1 END as score
 		FROM document_chunks
 		WHERE knowledge_base_id = $2
+		  AND enabled
 		  AND 1 - (embedding::vector <=> $1::vector) >= $3
-		ORDER BY embedding::vector <=> $1::vector
+		ORDER BY score DESC
 		LIMIT $4
 	`
 
@@ -144,8 +150,10 @@ func (s *PgVectorStore) Search(kbID string, queryEmbedding []float32, topK int,
 			content         string
 			embeddingBytes  []byte
 			metadataBytes   []byte
+			pinned          bool
+			boost           float64
 			createdAt       time.Time
-			similarity      float64
+			score           float64
 		)
 
 		err := rows.Scan(
@@ -156,8 +164,10 @@ func (s *PgVectorStore) Search(kbID string, queryEmbedding []float32, topK int,
 			&content,
 			&embeddingBytes,
 			&metadataBytes,
+			&pinned,
+			&boost,
 			&createdAt,
-			&similarity,
+			&score,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
@@ -180,17 +190,22 @@ func (s *PgVectorStore) Search(kbID string, queryEmbedding []float32, topK int,
 		}
 
 		chunk := &Chunk{
-			ID:         id,
-			DocumentID: documentID,
-			Index:      chunkIndex,
-			Content:    content,
-			Embedding:  embedding,
-			Metadata:   metadata,
+			ID:              id,
+			KnowledgeBaseID: knowledgeBaseID,
+			DocumentID:      documentID,
+			Index:           chunkIndex,
+			Content:         content,
+			Embedding:       embedding,
+			Metadata:        metadata,
+			Pinned:          pinned,
+			Boost:           boost,
+			CreatedAt:       createdAt,
 		}
 
 		results = append(results, &SearchResult{
-			Chunk: chunk,
-			Score: similarity,
+			Chunk:      chunk,
+			Score:      score,
+			DocumentID: documentID,
 		})
 	}
 
@@ -262,3 +277,158 @@ func (s *PgVectorStore) GetStats(kbID string) (int, error) {
 
 	return count, nil
 }
+
+// ListChunks returns a page of chunks for a document ordered by chunk index, along with the total count
+func (s *PgVectorStore) ListChunks(ctx context.Context, kbID, documentID string, offset, limit int) ([]*Chunk, int, error) {
+	query := s.client.DocumentChunk.Query().
+		Where(
+			documentchunk.KnowledgeBaseID(kbID),
+			documentchunk.DocumentID(documentID),
+		)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count chunks: %w", err)
+	}
+
+	rows, err := query.
+		Order(ent.Asc(documentchunk.FieldChunkIndex)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list chunks: %w", err)
+	}
+
+	chunks := make([]*Chunk, len(rows))
+	for i, row := range rows {
+		chunks[i] = entChunkToChunk(row)
+	}
+
+	return chunks, total, nil
+}
+
+// GetChunk retrieves a single chunk of a knowledge base
+func (s *PgVectorStore) GetChunk(ctx context.Context, kbID, chunkID string) (*Chunk, error) {
+	row, err := s.client.DocumentChunk.Query().
+		Where(
+			documentchunk.ID(chunkID),
+			documentchunk.KnowledgeBaseID(kbID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrChunkNotFound, chunkID)
+		}
+		return nil, fmt.Errorf("failed to get chunk: %w", err)
+	}
+
+	return entChunkToChunk(row), nil
+}
+
+// GetNeighbors returns the chunks of the same document within window positions of the given chunk,
+// split into those before and after it
+func (s *PgVectorStore) GetNeighbors(ctx context.Context, chunk *Chunk, window int) (before, after []*Chunk, err error) {
+	if window <= 0 {
+		return nil, nil, nil
+	}
+
+	rows, err := s.client.DocumentChunk.Query().
+		Where(
+			documentchunk.KnowledgeBaseID(chunk.KnowledgeBaseID),
+			documentchunk.DocumentID(chunk.DocumentID),
+			documentchunk.ChunkIndexGTE(chunk.Index-window),
+			documentchunk.ChunkIndexLTE(chunk.Index+window),
+			documentchunk.IDNEQ(chunk.ID),
+		).
+		Order(ent.Asc(documentchunk.FieldChunkIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get neighboring chunks: %w", err)
+	}
+
+	for _, row := range rows {
+		if row.ChunkIndex < chunk.Index {
+			before = append(before, entChunkToChunk(row))
+		} else {
+			after = append(after, entChunkToChunk(row))
+		}
+	}
+
+	return before, after, nil
+}
+
+// UpdateContent replaces the text and embedding of a chunk
+func (s *PgVectorStore) UpdateContent(ctx context.Context, kbID, chunkID, content string, embedding []float32, metadata map[string]interface{}) (*Chunk, error) {
+	update := s.client.DocumentChunk.UpdateOneID(chunkID).
+		Where(documentchunk.KnowledgeBaseID(kbID)).
+		SetContent(content).
+		SetMetadata(metadata).
+		SetUpdatedAt(time.Now())
+	if embedding != nil {
+		update = update.SetEmbedding(embedding)
+	}
+
+	return s.saveChunk(ctx, chunkID, update)
+}
+
+// SetEnabled includes or excludes a chunk from retrieval
+func (s *PgVectorStore) SetEnabled(ctx context.Context, kbID, chunkID string, enabled bool) (*Chunk, error) {
+	update := s.client.DocumentChunk.UpdateOneID(chunkID).
+		Where(documentchunk.KnowledgeBaseID(kbID)).
+		SetEnabled(enabled).
+		SetUpdatedAt(time.Now())
+
+	return s.saveChunk(ctx, chunkID, update)
+}
+
+// SetPinned pins a chunk with the given boost factor, or unpins it when pinned is false
+func (s *PgVectorStore) SetPinned(ctx context.Context, kbID, chunkID string, pinned bool, boost float64) (*Chunk, error) {
+	if !pinned {
+		boost = 1
+	}
+
+	update := s.client.DocumentChunk.UpdateOneID(chunkID).
+		Where(documentchunk.KnowledgeBaseID(kbID)).
+		SetPinned(pinned).
+		SetBoost(boost).
+		SetUpdatedAt(time.Now())
+
+	return s.saveChunk(ctx, chunkID, update)
+}
+
+// saveChunk executes a chunk update and converts the result
+func (s *PgVectorStore) saveChunk(ctx context.Context, chunkID string, update *ent.DocumentChunkUpdateOne) (*Chunk, error) {
+	row, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrChunkNotFound, chunkID)
+		}
+		return nil, fmt.Errorf("failed to update chunk: %w", err)
+	}
+
+	s.logger.Info("Updated chunk",
+		zap.String("chunk_id", chunkID),
+		zap.String("kb_id", row.KnowledgeBaseID),
+	)
+
+	return entChunkToChunk(row), nil
+}
+
+// entChunkToChunk converts an ent.DocumentChunk to a Chunk
+func entChunkToChunk(row *ent.DocumentChunk) *Chunk {
+	return &Chunk{
+		ID:              row.ID,
+		KnowledgeBaseID: row.KnowledgeBaseID,
+		DocumentID:      row.DocumentID,
+		Content:         row.Content,
+		Index:           row.ChunkIndex,
+		Metadata:        row.Metadata,
+		Embedding:       row.Embedding,
+		Disabled:        !row.Enabled,
+		Pinned:          row.Pinned,
+		Boost:           row.Boost,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+}
@@ -1,6 +1,12 @@
 package knowledge
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrChunkNotFound is returned when a chunk does not exist in the knowledge base
+var ErrChunkNotFound = errors.New("chunk not found")
 
 // Document represents a document in the knowledge base
 type Document struct {
@@ -22,6 +28,22 @@ type Chunk struct {
 	Index      int                    `json:"index"`
 	Metadata   map[string]interface{} `json:"metadata"`
 	Embedding  []float32              `json:"embedding,omitempty"`
+
+	// Curation state, set for chunks stored in a knowledge base
+	KnowledgeBaseID string    `json:"knowledge_base_id,omitempty"`
+	Disabled        bool      `json:"disabled,omitempty"` // excluded from retrieval
+	Pinned          bool      `json:"pinned,omitempty"`
+	Boost           float64   `json:"boost,omitempty"` // score multiplier for pinned chunks
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ScoreMultiplier returns the factor applied to the chunk's similarity score
+func (c *Chunk) ScoreMultiplier() float64 {
+	if c.Pinned && c.Boost > 0 {
+		return c.Boost
+	}
+	return 1
 }
 
 // SearchRequest represents a vector search request
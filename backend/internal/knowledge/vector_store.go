@@ -1,6 +1,7 @@
 package knowledge
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -17,6 +18,16 @@ type VectorStore interface {
 	GetStats(kbID string) (int, error)
 }
 
+// ChunkStore supports inspection and manual curation of stored chunks
+type ChunkStore interface {
+	ListChunks(ctx context.Context, kbID, documentID string, offset, limit int) ([]*Chunk, int, error)
+	GetChunk(ctx context.Context, kbID, chunkID string) (*Chunk, error)
+	GetNeighbors(ctx context.Context, chunk *Chunk, window int) (before, after []*Chunk, err error)
+	UpdateContent(ctx context.Context, kbID, chunkID, content string, embedding []float32, metadata map[string]interface{}) (*Chunk, error)
+	SetEnabled(ctx context.Context, kbID, chunkID string, enabled bool) (*Chunk, error)
+	SetPinned(ctx context.Context, kbID, chunkID string, pinned bool, boost float64) (*Chunk, error)
+}
+
 // InMemoryVectorStore is a simple in-memory vector store
 type InMemoryVectorStore struct {
 	mu     sync.RWMutex
@@ -65,7 +76,7 @@ func (s *InMemoryVectorStore) Search(kbID string, queryEmbedding []float32, topK
 	// Calculate similarities
 	results := make([]*SearchResult, 0)
 	for _, chunk := range chunks {
-		if chunk.Embedding == nil {
+		if chunk.Embedding == nil || chunk.Disabled {
 			continue
 		}
 
@@ -73,7 +84,7 @@ func (s *InMemoryVectorStore) Search(kbID string, queryEmbedding []float32, topK
 		if similarity >= threshold {
 			results = append(results, &SearchResult{
 				Chunk:      chunk,
-				Score:      similarity,
+				Score:      similarity * chunk.ScoreMultiplier(),
 				DocumentID: chunk.DocumentID,
 			})
 		}
@@ -0,0 +1,124 @@
+// Package manifest reads and writes declarative agent manifests, YAML documents that
+// describe an agent so that it can be reviewed and versioned outside the platform:
+//
+//	apiVersion: agent-platform/v1
+//	kind: Agent
+//	name: support-bot
+//	type: single
+//	folder: support
+//	tags: [support, faq]
+//	prompt: |
+//	  You are {{.agent.name}}, answer in {{.params.language}}.
+//	model_config:
+//	  model: gpt-4o-mini
+//	tools: [web_search]
+//	knowledge_bases: [Product docs]
+//	parameters:
+//	  language: English
+//
+// Agents are identified by name; tools, knowledge bases and the members of multi-agent agents are
+// referenced by name.
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// APIVersion is the manifest format version
+	APIVersion = "agent-platform/v1"
+	// KindAgent is the kind of agent manifests
+	KindAgent = "Agent"
+)
+
+// Agent is an agent manifest
+type Agent struct {
+	APIVersion     string                 `yaml:"apiVersion"`
+	Kind           string                 `yaml:"kind"`
+	Name           string                 `yaml:"name"`
+	Description    string                 `yaml:"description,omitempty"`
+	Type           string                 `yaml:"type,omitempty"`
+	Folder         string                 `yaml:"folder,omitempty"`
+	Tags           []string               `yaml:"tags,omitempty"`
+	Prompt         string                 `yaml:"prompt,omitempty"`
+	ModelConfig    map[string]interface{} `yaml:"model_config,omitempty"`
+	Tools          []string               `yaml:"tools,omitempty"`
+	KnowledgeBases []string               `yaml:"knowledge_bases,omitempty"`
+	Members        []string               `yaml:"members,omitempty"`
+	Parameters     map[string]interface{} `yaml:"parameters,omitempty"`
+}
+
+// ParseAgent decodes a single agent manifest. Unknown fields are rejected so that typos
+// do not go unnoticed, and maps are normalized to JSON types as stored by the platform.
+func ParseAgent(data []byte) (*Agent, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var m Agent
+	if err := decoder.Decode(&m); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("manifest is empty")
+		}
+		return nil, err
+	}
+	var extra interface{}
+	if err := decoder.Decode(&extra); !errors.Is(err, io.EOF) {
+		return nil, errors.New("manifest must contain a single document")
+	}
+
+	if m.APIVersion != APIVersion {
+		return nil, fmt.Errorf("unsupported apiVersion %q, expected %s", m.APIVersion, APIVersion)
+	}
+	if m.Kind != KindAgent {
+		return nil, fmt.Errorf("unsupported kind %q, expected %s", m.Kind, KindAgent)
+	}
+	if m.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	var err error
+	if m.ModelConfig, err = normalize(m.ModelConfig); err != nil {
+		return nil, fmt.Errorf("model_config: %w", err)
+	}
+	if m.Parameters, err = normalize(m.Parameters); err != nil {
+		return nil, fmt.Errorf("parameters: %w", err)
+	}
+	return &m, nil
+}
+
+// Marshal encodes the manifest as YAML
+func (m *Agent) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(m); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// normalize converts a decoded YAML map to the types encoding/json produces, so that
+// numbers are float64 like in values loaded from the database
+func normalize(m map[string]interface{}) (map[string]interface{}, error) {
+	if m == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
@@ -0,0 +1,83 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BuiltinPrefix starts the IDs of built-in templates
+const BuiltinPrefix = "builtin-"
+
+//go:embed templates/*.yaml
+var builtinFiles embed.FS
+
+// Template is a built-in agent template
+type Template struct {
+	// ID is BuiltinPrefix followed by the template's file name
+	ID string
+	// Version is derived from the template's content, so it changes when the template does
+	Version string
+	Agent   *Agent
+}
+
+var (
+	builtinOnce      sync.Once
+	builtinTemplates []*Template
+	builtinErr       error
+)
+
+// Builtins returns the built-in templates ordered by ID
+func Builtins() ([]*Template, error) {
+	builtinOnce.Do(func() {
+		builtinTemplates, builtinErr = loadBuiltins()
+	})
+	return builtinTemplates, builtinErr
+}
+
+// Builtin returns the built-in template with the given ID, nil when there is none
+func Builtin(id string) (*Template, error) {
+	templates, err := Builtins()
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range templates {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func loadBuiltins() ([]*Template, error) {
+	entries, err := builtinFiles.ReadDir("templates")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*Template, 0, len(entries))
+	for _, entry := range entries {
+		data, err := builtinFiles.ReadFile(path.Join("templates", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		agent, err := ParseAgent(data)
+		if err != nil {
+			return nil, fmt.Errorf("built-in template %s: %w", entry.Name(), err)
+		}
+		sum := sha256.Sum256(data)
+		templates = append(templates, &Template{
+			ID:      BuiltinPrefix + strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())),
+			Version: hex.EncodeToString(sum[:6]),
+			Agent:   agent,
+		})
+	}
+
+	sort.Slice(templates, func(i, j int) bool { return templates[i].ID < templates[j].ID })
+	return templates, nil
+}
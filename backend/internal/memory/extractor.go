@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"agent-platform/internal/ai"
+
+	"go.uber.org/zap"
+)
+
+const extractionSystemPrompt = `You extract long-term memories about the user from a conversation.
+Return facts worth remembering in future conversations: stable preferences, personal details the user shared,
+goals, ongoing projects and constraints. Ignore small talk, one-off requests and anything already known.
+Each fact must be a short, self-contained sentence in the language of the conversation.
+Respond with a JSON array of strings only, for example ["The user prefers concise answers."]. Respond with [] if there is nothing new.`
+
+// maxFactsPerExtraction limits how many facts a single extraction pass may add
+const maxFactsPerExtraction = 5
+
+// Extractor uses a model to extract memorable facts from conversation turns
+type Extractor struct {
+	aiManager *ai.Manager
+	logger    *zap.Logger
+}
+
+// NewExtractor creates a new memory extractor
+func NewExtractor(aiManager *ai.Manager, logger *zap.Logger) *Extractor {
+	return &Extractor{
+		aiManager: aiManager,
+		logger:    logger,
+	}
+}
+
+// Extract returns new facts about the user found in messages, given the facts already known
+func (e *Extractor) Extract(ctx context.Context, model string, known []string, messages []ai.Message) ([]string, error) {
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	var prompt strings.Builder
+	if len(known) > 0 {
+		prompt.WriteString("Already known:\n")
+		for _, fact := range known {
+			fmt.Fprintf(&prompt, "- %s\n", fact)
+		}
+		prompt.WriteString("\n")
+	}
+	prompt.WriteString("Conversation:\n")
+	for _, msg := range messages {
+		fmt.Fprintf(&prompt, "%s: %s\n", msg.Role, msg.Content)
+	}
+
+	resp, err := e.aiManager.Chat(ctx, ai.ChatRequest{
+		Model: model,
+		Messages: []ai.Message{
+			{Role: "system", Content: extractionSystemPrompt},
+			{Role: "user", Content: prompt.String()},
+		},
+		Temperature: 0.1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract memories: %w", err)
+	}
+
+	facts, err := parseFacts(resp.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	e.logger.Debug("Memories extracted",
+		zap.String("model", resp.Model),
+		zap.Int("facts", len(facts)),
+	)
+
+	return facts, nil
+}
+
+// parseFacts decodes the model's JSON array, tolerating surrounding text such as code fences
+func parseFacts(content string) ([]string, error) {
+	start := strings.Index(content, "[")
+	end := strings.LastIndex(content, "]")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("model did not return a JSON array")
+	}
+
+	var raw []string
+	if err := json.Unmarshal([]byte(content[start:end+1]), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse extracted memories: %w", err)
+	}
+
+	facts := make([]string, 0, len(raw))
+	for _, fact := range raw {
+		fact = strings.TrimSpace(fact)
+		if fact != "" {
+			facts = append(facts, fact)
+		}
+		if len(facts) == maxFactsPerExtraction {
+			break
+		}
+	}
+
+	return facts, nil
+}
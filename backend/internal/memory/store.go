@@ -0,0 +1,268 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"agent-platform/internal/model/ent"
+	entmemory "agent-platform/internal/model/ent/memory"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ErrMemoryNotFound is returned when a memory does not exist or belongs to another user
+var ErrMemoryNotFound = errors.New("memory not found")
+
+// duplicateThreshold is the similarity above which a new fact is considered already known
+const duplicateThreshold = 0.92
+
+// Embedder generates embeddings for text
+type Embedder interface {
+	GenerateEmbedding(text string) ([]float32, error)
+}
+
+// SearchResult is a memory with its similarity to the query
+type SearchResult struct {
+	Memory *ent.Memory
+	Score  float64
+}
+
+// Store persists long-term user memories with embeddings in PostgreSQL using pgvector
+type Store struct {
+	client   *ent.Client
+	db       *sql.DB
+	embedder Embedder
+	logger   *zap.Logger
+}
+
+// NewStore creates a new memory store. embedder may be nil, in which case memories
+// can be listed and deleted but not added or searched.
+func NewStore(client *ent.Client, dsn string, embedder Embedder, logger *zap.Logger) (*Store, error) {
+	// Open a separate database connection for raw SQL queries
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database connection: %w", err)
+	}
+
+	return &Store{
+		client:   client,
+		db:       db,
+		embedder: embedder,
+		logger:   logger,
+	}, nil
+}
+
+// Enabled reports whether memories can be added and searched
+func (s *Store) Enabled() bool {
+	return s.embedder != nil
+}
+
+// Add stores a fact for a user and agent. Facts that closely match an existing memory
+// are not stored again; the existing memory is returned with created set to false.
+func (s *Store) Add(ctx context.Context, userID, agentID, content, conversationID string) (memory *ent.Memory, created bool, err error) {
+	if s.embedder == nil {
+		return nil, false, fmt.Errorf("embedding service not available")
+	}
+
+	embedding, err := s.embedder.GenerateEmbedding(content)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to embed memory: %w", err)
+	}
+
+	similar, err := s.searchByEmbedding(ctx, userID, agentID, embedding, 1, duplicateThreshold)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(similar) > 0 {
+		return similar[0].Memory, false, nil
+	}
+
+	builder := s.client.Memory.Create().
+		SetID(uuid.New().String()).
+		SetUserID(userID).
+		SetAgentID(agentID).
+		SetContent(content).
+		SetEmbedding(embedding)
+	if conversationID != "" {
+		builder = builder.SetSourceConversationID(conversationID)
+	}
+
+	memory, err = builder.Save(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to store memory: %w", err)
+	}
+
+	s.logger.Debug("Stored memory",
+		zap.String("memory_id", memory.ID),
+		zap.String("user_id", userID),
+		zap.String("agent_id", agentID),
+	)
+
+	return memory, true, nil
+}
+
+// Search returns the user's memories for an agent most similar to the query
+func (s *Store) Search(ctx context.Context, userID, agentID, query string, topK int, threshold float64) ([]*SearchResult, error) {
+	if s.embedder == nil {
+		return nil, fmt.Errorf("embedding service not available")
+	}
+
+	embedding, err := s.embedder.GenerateEmbedding(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate query embedding: %w", err)
+	}
+
+	return s.searchByEmbedding(ctx, userID, agentID, embedding, topK, threshold)
+}
+
+// searchByEmbedding performs vector similarity search over a user's memories for an agent
+func (s *Store) searchByEmbedding(ctx context.Context, userID, agentID string, embedding []float32, topK int, threshold float64) ([]*SearchResult, error) {
+	embeddingJSON, err := json.Marshal(embedding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal query embedding: %w", err)
+	}
+
+	query := `
+		SELECT id, 1 - (embedding::vector <=> $1::vector) as similarity
+		FROM memories
+		WHERE user_id = $2
+		  AND agent_id = $3
+		  AND 1 - (embedding::vector <=> $1::vector) >= $4
+		ORDER BY embedding::vector <=> $1::vector
+		LIMIT $5
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, string(embeddingJSON), userID, agentID, threshold, topK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute memory search: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	scores := map[string]float64{}
+	for rows.Next() {
+		var (
+			id         string
+			similarity float64
+		)
+		if err := rows.Scan(&id, &similarity); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		ids = append(ids, id)
+		scores[id] = similarity
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	memories, err := s.client.Memory.Query().
+		Where(entmemory.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load memories: %w", err)
+	}
+
+	byID := make(map[string]*ent.Memory, len(memories))
+	for _, m := range memories {
+		byID[m.ID] = m
+	}
+
+	results := make([]*SearchResult, 0, len(ids))
+	for _, id := range ids {
+		if m, ok := byID[id]; ok {
+			results = append(results, &SearchResult{Memory: m, Score: scores[id]})
+		}
+	}
+
+	return results, nil
+}
+
+// List returns a page of a user's memories, newest first, optionally filtered by agent
+func (s *Store) List(ctx context.Context, userID, agentID string, offset, limit int) ([]*ent.Memory, int, error) {
+	query := s.client.Memory.Query().
+		Where(entmemory.UserID(userID))
+	if agentID != "" {
+		query = query.Where(entmemory.AgentID(agentID))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting memories: %w", err)
+	}
+
+	memories, err := query.
+		Order(ent.Desc(entmemory.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing memories: %w", err)
+	}
+
+	return memories, total, nil
+}
+
+// Contents returns the text of a user's most recent memories for an agent, newest first
+func (s *Store) Contents(ctx context.Context, userID, agentID string, limit int) ([]string, error) {
+	contents, err := s.client.Memory.Query().
+		Where(
+			entmemory.UserID(userID),
+			entmemory.AgentID(agentID),
+		).
+		Order(ent.Desc(entmemory.FieldCreatedAt)).
+		Limit(limit).
+		Select(entmemory.FieldContent).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing memories: %w", err)
+	}
+
+	return contents, nil
+}
+
+// Delete removes one of a user's memories
+func (s *Store) Delete(ctx context.Context, userID, id string) error {
+	n, err := s.client.Memory.Delete().
+		Where(
+			entmemory.ID(id),
+			entmemory.UserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed deleting memory: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", ErrMemoryNotFound, id)
+	}
+
+	return nil
+}
+
+// DeleteAll removes all of a user's memories, optionally only those for one agent
+func (s *Store) DeleteAll(ctx context.Context, userID, agentID string) (int, error) {
+	del := s.client.Memory.Delete().
+		Where(entmemory.UserID(userID))
+	if agentID != "" {
+		del = del.Where(entmemory.AgentID(agentID))
+	}
+
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed deleting memories: %w", err)
+	}
+
+	s.logger.Info("Deleted memories",
+		zap.String("user_id", userID),
+		zap.String("agent_id", agentID),
+		zap.Int("count", n),
+	)
+
+	return n, nil
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"agent-platform/internal/ai"
+
+	"go.uber.org/zap"
+)
+
+const summarySystemPrompt = `You maintain a running summary of a conversation between a user and an AI assistant.
+Merge the existing summary with the new messages into a single updated summary.
+Keep facts, decisions, user preferences, open questions and any details needed to continue the conversation.
+Write in the same language as the conversation. Respond with the summary only.`
+
+// Summarizer condenses older conversation turns into a rolling summary
+type Summarizer struct {
+	aiManager *ai.Manager
+	logger    *zap.Logger
+}
+
+// NewSummarizer creates a new summarizer
+func NewSummarizer(aiManager *ai.Manager, logger *zap.Logger) *Summarizer {
+	return &Summarizer{
+		aiManager: aiManager,
+		logger:    logger,
+	}
+}
+
+// Summarize merges previous (which may be empty) with messages and returns the new summary
+func (s *Summarizer) Summarize(ctx context.Context, model, previous string, messages []ai.Message) (string, error) {
+	if len(messages) == 0 {
+		return previous, nil
+	}
+
+	var transcript strings.Builder
+	if previous != "" {
+		transcript.WriteString("Existing summary:\n")
+		transcript.WriteString(previous)
+		transcript.WriteString("\n\n")
+	}
+	transcript.WriteString("New messages:\n")
+	for _, msg := range messages {
+		fmt.Fprintf(&transcript, "%s: %s\n", msg.Role, msg.Content)
+	}
+
+	resp, err := s.aiManager.Chat(ctx, ai.ChatRequest{
+		Model: model,
+		Messages: []ai.Message{
+			{Role: "system", Content: summarySystemPrompt},
+			{Role: "user", Content: transcript.String()},
+		},
+		Temperature: 0.3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to summarize conversation: %w", err)
+	}
+
+	summary := strings.TrimSpace(resp.Content)
+	if summary == "" {
+		return "", fmt.Errorf("model returned an empty summary")
+	}
+
+	s.logger.Debug("Conversation summarized",
+		zap.String("model", resp.Model),
+		zap.Int("messages", len(messages)),
+		zap.Int("summary_length", len(summary)),
+	)
+
+	return summary, nil
+}
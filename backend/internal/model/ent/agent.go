@@ -30,6 +30,8 @@ type Agent struct {
 	Tools []string `json:"tools,omitempty"`
 	// KnowledgeBases holds the value of the "knowledge_bases" field.
 	KnowledgeBases []string `json:"knowledge_bases,omitempty"`
+	// Agents a multi-agent agent's supervisor hands turns and subtasks to
+	Members []string `json:"members,omitempty"`
 	// PromptTemplate holds the value of the "prompt_template" field.
 	PromptTemplate string `json:"prompt_template,omitempty"`
 	// Parameters holds the value of the "parameters" field.
@@ -38,6 +40,8 @@ type Agent struct {
 	Status string `json:"status,omitempty"`
 	// Version holds the value of the "version" field.
 	Version string `json:"version,omitempty"`
+	// Agent version new conversations start on, empty until the agent is published
+	PublishedVersionID string `json:"published_version_id,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy string `json:"created_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -48,9 +52,15 @@ type Agent struct {
 	Tags []string `json:"tags,omitempty"`
 	// Folder holds the value of the "folder" field.
 	Folder string `json:"folder,omitempty"`
-	// IsPublic holds the value of the "is_public" field.
-	IsPublic     bool `json:"is_public,omitempty"`
-	selectValues sql.SelectValues
+	// Public published agents are listed in the marketplace, everyone can chat with them
+	IsPublic bool `json:"is_public,omitempty"`
+	// Public agents can be cloned by everyone, exposing their configuration
+	AllowClone bool `json:"allow_clone,omitempty"`
+	// Template or agent the agent was cloned from
+	TemplateID string `json:"template_id,omitempty"`
+	// Version of the template when it was cloned
+	TemplateVersion string `json:"template_version,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -58,11 +68,11 @@ func (*Agent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case agent.FieldModelConfig, agent.FieldTools, agent.FieldKnowledgeBases, agent.FieldParameters, agent.FieldTags:
+		case agent.FieldModelConfig, agent.FieldTools, agent.FieldKnowledgeBases, agent.FieldMembers, agent.FieldParameters, agent.FieldTags:
 			values[i] = new([]byte)
-		case agent.FieldIsPublic:
+		case agent.FieldIsPublic, agent.FieldAllowClone:
 			values[i] = new(sql.NullBool)
-		case agent.FieldID, agent.FieldName, agent.FieldDescription, agent.FieldType, agent.FieldPromptTemplate, agent.FieldStatus, agent.FieldVersion, agent.FieldCreatedBy, agent.FieldFolder:
+		case agent.FieldID, agent.FieldName, agent.FieldDescription, agent.FieldType, agent.FieldPromptTemplate, agent.FieldStatus, agent.FieldVersion, agent.FieldPublishedVersionID, agent.FieldCreatedBy, agent.FieldFolder, agent.FieldTemplateID, agent.FieldTemplateVersion:
 			values[i] = new(sql.NullString)
 		case agent.FieldCreatedAt, agent.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -129,6 +139,14 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field knowledge_bases: %w", err)
 				}
 			}
+		case agent.FieldMembers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field members", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Members); err != nil {
+					return fmt.Errorf("unmarshal field members: %w", err)
+				}
+			}
 		case agent.FieldPromptTemplate:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field prompt_template", values[i])
@@ -155,6 +173,12 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Version = value.String
 			}
+		case agent.FieldPublishedVersionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field published_version_id", values[i])
+			} else if value.Valid {
+				a.PublishedVersionID = value.String
+			}
 		case agent.FieldCreatedBy:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -193,6 +217,24 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.IsPublic = value.Bool
 			}
+		case agent.FieldAllowClone:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allow_clone", values[i])
+			} else if value.Valid {
+				a.AllowClone = value.Bool
+			}
+		case agent.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				a.TemplateID = value.String
+			}
+		case agent.FieldTemplateVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_version", values[i])
+			} else if value.Valid {
+				a.TemplateVersion = value.String
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -247,6 +289,9 @@ func (a *Agent) String() string {
 	builder.WriteString("knowledge_bases=")
 	builder.WriteString(fmt.Sprintf("%v", a.KnowledgeBases))
 	builder.WriteString(", ")
+	builder.WriteString("members=")
+	builder.WriteString(fmt.Sprintf("%v", a.Members))
+	builder.WriteString(", ")
 	builder.WriteString("prompt_template=")
 	builder.WriteString(a.PromptTemplate)
 	builder.WriteString(", ")
@@ -259,6 +304,9 @@ func (a *Agent) String() string {
 	builder.WriteString("version=")
 	builder.WriteString(a.Version)
 	builder.WriteString(", ")
+	builder.WriteString("published_version_id=")
+	builder.WriteString(a.PublishedVersionID)
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(a.CreatedBy)
 	builder.WriteString(", ")
@@ -276,6 +324,15 @@ func (a *Agent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", a.IsPublic))
+	builder.WriteString(", ")
+	builder.WriteString("allow_clone=")
+	builder.WriteString(fmt.Sprintf("%v", a.AllowClone))
+	builder.WriteString(", ")
+	builder.WriteString("template_id=")
+	builder.WriteString(a.TemplateID)
+	builder.WriteString(", ")
+	builder.WriteString("template_version=")
+	builder.WriteString(a.TemplateVersion)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,6 +25,8 @@ const (
 	FieldTools = "tools"
 	// FieldKnowledgeBases holds the string denoting the knowledge_bases field in the database.
 	FieldKnowledgeBases = "knowledge_bases"
+	// FieldMembers holds the string denoting the members field in the database.
+	FieldMembers = "members"
 	// FieldPromptTemplate holds the string denoting the prompt_template field in the database.
 	FieldPromptTemplate = "prompt_template"
 	// FieldParameters holds the string denoting the parameters field in the database.
@@ -33,6 +35,8 @@ const (
 	FieldStatus = "status"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
+	// FieldPublishedVersionID holds the string denoting the published_version_id field in the database.
+	FieldPublishedVersionID = "published_version_id"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -45,6 +49,12 @@ const (
 	FieldFolder = "folder"
 	// FieldIsPublic holds the string denoting the is_public field in the database.
 	FieldIsPublic = "is_public"
+	// FieldAllowClone holds the string denoting the allow_clone field in the database.
+	FieldAllowClone = "allow_clone"
+	// FieldTemplateID holds the string denoting the template_id field in the database.
+	FieldTemplateID = "template_id"
+	// FieldTemplateVersion holds the string denoting the template_version field in the database.
+	FieldTemplateVersion = "template_version"
 	// Table holds the table name of the agent in the database.
 	Table = "agents"
 )
@@ -58,16 +68,21 @@ var Columns = []string{
 	FieldModelConfig,
 	FieldTools,
 	FieldKnowledgeBases,
+	FieldMembers,
 	FieldPromptTemplate,
 	FieldParameters,
 	FieldStatus,
 	FieldVersion,
+	FieldPublishedVersionID,
 	FieldCreatedBy,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldTags,
 	FieldFolder,
 	FieldIsPublic,
+	FieldAllowClone,
+	FieldTemplateID,
+	FieldTemplateVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -99,6 +114,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultIsPublic holds the default value on creation for the "is_public" field.
 	DefaultIsPublic bool
+	// DefaultAllowClone holds the default value on creation for the "allow_clone" field.
+	DefaultAllowClone bool
 )
 
 // OrderOption defines the ordering options for the Agent queries.
@@ -139,6 +156,11 @@ func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
+// ByPublishedVersionID orders the results by the published_version_id field.
+func ByPublishedVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedVersionID, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -163,3 +185,18 @@ func ByFolder(opts ...sql.OrderTermOption) OrderOption {
 func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
 }
+
+// ByAllowClone orders the results by the allow_clone field.
+func ByAllowClone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowClone, opts...).ToFunc()
+}
+
+// ByTemplateID orders the results by the template_id field.
+func ByTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateID, opts...).ToFunc()
+}
+
+// ByTemplateVersion orders the results by the template_version field.
+func ByTemplateVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateVersion, opts...).ToFunc()
+}
@@ -94,6 +94,11 @@ func Version(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldVersion, v))
 }
 
+// PublishedVersionID applies equality check predicate on the "published_version_id" field. It's identical to PublishedVersionIDEQ.
+func PublishedVersionID(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldPublishedVersionID, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldCreatedBy, v))
@@ -119,6 +124,21 @@ func IsPublic(v bool) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldIsPublic, v))
 }
 
+// AllowClone applies equality check predicate on the "allow_clone" field. It's identical to AllowCloneEQ.
+func AllowClone(v bool) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldAllowClone, v))
+}
+
+// TemplateID applies equality check predicate on the "template_id" field. It's identical to TemplateIDEQ.
+func TemplateID(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldTemplateID, v))
+}
+
+// TemplateVersion applies equality check predicate on the "template_version" field. It's identical to TemplateVersionEQ.
+func TemplateVersion(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldName, v))
@@ -354,6 +374,16 @@ func KnowledgeBasesNotNil() predicate.Agent {
 	return predicate.Agent(sql.FieldNotNull(FieldKnowledgeBases))
 }
 
+// MembersIsNil applies the IsNil predicate on the "members" field.
+func MembersIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldMembers))
+}
+
+// MembersNotNil applies the NotNil predicate on the "members" field.
+func MembersNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldMembers))
+}
+
 // PromptTemplateEQ applies the EQ predicate on the "prompt_template" field.
 func PromptTemplateEQ(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldPromptTemplate, v))
@@ -569,6 +599,81 @@ func VersionContainsFold(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldContainsFold(FieldVersion, v))
 }
 
+// PublishedVersionIDEQ applies the EQ predicate on the "published_version_id" field.
+func PublishedVersionIDEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDNEQ applies the NEQ predicate on the "published_version_id" field.
+func PublishedVersionIDNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDIn applies the In predicate on the "published_version_id" field.
+func PublishedVersionIDIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldPublishedVersionID, vs...))
+}
+
+// PublishedVersionIDNotIn applies the NotIn predicate on the "published_version_id" field.
+func PublishedVersionIDNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldPublishedVersionID, vs...))
+}
+
+// PublishedVersionIDGT applies the GT predicate on the "published_version_id" field.
+func PublishedVersionIDGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDGTE applies the GTE predicate on the "published_version_id" field.
+func PublishedVersionIDGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDLT applies the LT predicate on the "published_version_id" field.
+func PublishedVersionIDLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDLTE applies the LTE predicate on the "published_version_id" field.
+func PublishedVersionIDLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDContains applies the Contains predicate on the "published_version_id" field.
+func PublishedVersionIDContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDHasPrefix applies the HasPrefix predicate on the "published_version_id" field.
+func PublishedVersionIDHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDHasSuffix applies the HasSuffix predicate on the "published_version_id" field.
+func PublishedVersionIDHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDIsNil applies the IsNil predicate on the "published_version_id" field.
+func PublishedVersionIDIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldPublishedVersionID))
+}
+
+// PublishedVersionIDNotNil applies the NotNil predicate on the "published_version_id" field.
+func PublishedVersionIDNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldPublishedVersionID))
+}
+
+// PublishedVersionIDEqualFold applies the EqualFold predicate on the "published_version_id" field.
+func PublishedVersionIDEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldPublishedVersionID, v))
+}
+
+// PublishedVersionIDContainsFold applies the ContainsFold predicate on the "published_version_id" field.
+func PublishedVersionIDContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldPublishedVersionID, v))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v string) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldCreatedBy, v))
@@ -809,6 +914,166 @@ func IsPublicNEQ(v bool) predicate.Agent {
 	return predicate.Agent(sql.FieldNEQ(FieldIsPublic, v))
 }
 
+// AllowCloneEQ applies the EQ predicate on the "allow_clone" field.
+func AllowCloneEQ(v bool) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldAllowClone, v))
+}
+
+// AllowCloneNEQ applies the NEQ predicate on the "allow_clone" field.
+func AllowCloneNEQ(v bool) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldAllowClone, v))
+}
+
+// TemplateIDEQ applies the EQ predicate on the "template_id" field.
+func TemplateIDEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldTemplateID, v))
+}
+
+// TemplateIDNEQ applies the NEQ predicate on the "template_id" field.
+func TemplateIDNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldTemplateID, v))
+}
+
+// TemplateIDIn applies the In predicate on the "template_id" field.
+func TemplateIDIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldTemplateID, vs...))
+}
+
+// TemplateIDNotIn applies the NotIn predicate on the "template_id" field.
+func TemplateIDNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldTemplateID, vs...))
+}
+
+// TemplateIDGT applies the GT predicate on the "template_id" field.
+func TemplateIDGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldTemplateID, v))
+}
+
+// TemplateIDGTE applies the GTE predicate on the "template_id" field.
+func TemplateIDGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldTemplateID, v))
+}
+
+// TemplateIDLT applies the LT predicate on the "template_id" field.
+func TemplateIDLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldTemplateID, v))
+}
+
+// TemplateIDLTE applies the LTE predicate on the "template_id" field.
+func TemplateIDLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldTemplateID, v))
+}
+
+// TemplateIDContains applies the Contains predicate on the "template_id" field.
+func TemplateIDContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldTemplateID, v))
+}
+
+// TemplateIDHasPrefix applies the HasPrefix predicate on the "template_id" field.
+func TemplateIDHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldTemplateID, v))
+}
+
+// TemplateIDHasSuffix applies the HasSuffix predicate on the "template_id" field.
+func TemplateIDHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldTemplateID, v))
+}
+
+// TemplateIDIsNil applies the IsNil predicate on the "template_id" field.
+func TemplateIDIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldTemplateID))
+}
+
+// TemplateIDNotNil applies the NotNil predicate on the "template_id" field.
+func TemplateIDNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldTemplateID))
+}
+
+// TemplateIDEqualFold applies the EqualFold predicate on the "template_id" field.
+func TemplateIDEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldTemplateID, v))
+}
+
+// TemplateIDContainsFold applies the ContainsFold predicate on the "template_id" field.
+func TemplateIDContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldTemplateID, v))
+}
+
+// TemplateVersionEQ applies the EQ predicate on the "template_version" field.
+func TemplateVersionEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionNEQ applies the NEQ predicate on the "template_version" field.
+func TemplateVersionNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIn applies the In predicate on the "template_version" field.
+func TemplateVersionIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionNotIn applies the NotIn predicate on the "template_version" field.
+func TemplateVersionNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionGT applies the GT predicate on the "template_version" field.
+func TemplateVersionGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionGTE applies the GTE predicate on the "template_version" field.
+func TemplateVersionGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLT applies the LT predicate on the "template_version" field.
+func TemplateVersionLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLTE applies the LTE predicate on the "template_version" field.
+func TemplateVersionLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionContains applies the Contains predicate on the "template_version" field.
+func TemplateVersionContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldTemplateVersion, v))
+}
+
+// TemplateVersionHasPrefix applies the HasPrefix predicate on the "template_version" field.
+func TemplateVersionHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldTemplateVersion, v))
+}
+
+// TemplateVersionHasSuffix applies the HasSuffix predicate on the "template_version" field.
+func TemplateVersionHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIsNil applies the IsNil predicate on the "template_version" field.
+func TemplateVersionIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldTemplateVersion))
+}
+
+// TemplateVersionNotNil applies the NotNil predicate on the "template_version" field.
+func TemplateVersionNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldTemplateVersion))
+}
+
+// TemplateVersionEqualFold applies the EqualFold predicate on the "template_version" field.
+func TemplateVersionEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldTemplateVersion, v))
+}
+
+// TemplateVersionContainsFold applies the ContainsFold predicate on the "template_version" field.
+func TemplateVersionContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldTemplateVersion, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Agent) predicate.Agent {
 	return predicate.Agent(sql.AndPredicates(predicates...))
@@ -72,6 +72,12 @@ func (ac *AgentCreate) SetKnowledgeBases(s []string) *AgentCreate {
 	return ac
 }
 
+// SetMembers sets the "members" field.
+func (ac *AgentCreate) SetMembers(s []string) *AgentCreate {
+	ac.mutation.SetMembers(s)
+	return ac
+}
+
 // SetPromptTemplate sets the "prompt_template" field.
 func (ac *AgentCreate) SetPromptTemplate(s string) *AgentCreate {
 	ac.mutation.SetPromptTemplate(s)
@@ -120,6 +126,20 @@ func (ac *AgentCreate) SetNillableVersion(s *string) *AgentCreate {
 	return ac
 }
 
+// SetPublishedVersionID sets the "published_version_id" field.
+func (ac *AgentCreate) SetPublishedVersionID(s string) *AgentCreate {
+	ac.mutation.SetPublishedVersionID(s)
+	return ac
+}
+
+// SetNillablePublishedVersionID sets the "published_version_id" field if the given value is not nil.
+func (ac *AgentCreate) SetNillablePublishedVersionID(s *string) *AgentCreate {
+	if s != nil {
+		ac.SetPublishedVersionID(*s)
+	}
+	return ac
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (ac *AgentCreate) SetCreatedBy(s string) *AgentCreate {
 	ac.mutation.SetCreatedBy(s)
@@ -188,6 +208,48 @@ func (ac *AgentCreate) SetNillableIsPublic(b *bool) *AgentCreate {
 	return ac
 }
 
+// SetAllowClone sets the "allow_clone" field.
+func (ac *AgentCreate) SetAllowClone(b bool) *AgentCreate {
+	ac.mutation.SetAllowClone(b)
+	return ac
+}
+
+// SetNillableAllowClone sets the "allow_clone" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableAllowClone(b *bool) *AgentCreate {
+	if b != nil {
+		ac.SetAllowClone(*b)
+	}
+	return ac
+}
+
+// SetTemplateID sets the "template_id" field.
+func (ac *AgentCreate) SetTemplateID(s string) *AgentCreate {
+	ac.mutation.SetTemplateID(s)
+	return ac
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableTemplateID(s *string) *AgentCreate {
+	if s != nil {
+		ac.SetTemplateID(*s)
+	}
+	return ac
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (ac *AgentCreate) SetTemplateVersion(s string) *AgentCreate {
+	ac.mutation.SetTemplateVersion(s)
+	return ac
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableTemplateVersion(s *string) *AgentCreate {
+	if s != nil {
+		ac.SetTemplateVersion(*s)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AgentCreate) SetID(s string) *AgentCreate {
 	ac.mutation.SetID(s)
@@ -253,6 +315,10 @@ func (ac *AgentCreate) defaults() {
 		v := agent.DefaultIsPublic
 		ac.mutation.SetIsPublic(v)
 	}
+	if _, ok := ac.mutation.AllowClone(); !ok {
+		v := agent.DefaultAllowClone
+		ac.mutation.SetAllowClone(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -291,6 +357,9 @@ func (ac *AgentCreate) check() error {
 	if _, ok := ac.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "Agent.is_public"`)}
 	}
+	if _, ok := ac.mutation.AllowClone(); !ok {
+		return &ValidationError{Name: "allow_clone", err: errors.New(`ent: missing required field "Agent.allow_clone"`)}
+	}
 	return nil
 }
 
@@ -350,6 +419,10 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldKnowledgeBases, field.TypeJSON, value)
 		_node.KnowledgeBases = value
 	}
+	if value, ok := ac.mutation.Members(); ok {
+		_spec.SetField(agent.FieldMembers, field.TypeJSON, value)
+		_node.Members = value
+	}
 	if value, ok := ac.mutation.PromptTemplate(); ok {
 		_spec.SetField(agent.FieldPromptTemplate, field.TypeString, value)
 		_node.PromptTemplate = value
@@ -366,6 +439,10 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldVersion, field.TypeString, value)
 		_node.Version = value
 	}
+	if value, ok := ac.mutation.PublishedVersionID(); ok {
+		_spec.SetField(agent.FieldPublishedVersionID, field.TypeString, value)
+		_node.PublishedVersionID = value
+	}
 	if value, ok := ac.mutation.CreatedBy(); ok {
 		_spec.SetField(agent.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
@@ -390,6 +467,18 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
 	}
+	if value, ok := ac.mutation.AllowClone(); ok {
+		_spec.SetField(agent.FieldAllowClone, field.TypeBool, value)
+		_node.AllowClone = value
+	}
+	if value, ok := ac.mutation.TemplateID(); ok {
+		_spec.SetField(agent.FieldTemplateID, field.TypeString, value)
+		_node.TemplateID = value
+	}
+	if value, ok := ac.mutation.TemplateVersion(); ok {
+		_spec.SetField(agent.FieldTemplateVersion, field.TypeString, value)
+		_node.TemplateVersion = value
+	}
 	return _node, _spec
 }
 
@@ -125,6 +125,24 @@ func (au *AgentUpdate) ClearKnowledgeBases() *AgentUpdate {
 	return au
 }
 
+// SetMembers sets the "members" field.
+func (au *AgentUpdate) SetMembers(s []string) *AgentUpdate {
+	au.mutation.SetMembers(s)
+	return au
+}
+
+// AppendMembers appends s to the "members" field.
+func (au *AgentUpdate) AppendMembers(s []string) *AgentUpdate {
+	au.mutation.AppendMembers(s)
+	return au
+}
+
+// ClearMembers clears the value of the "members" field.
+func (au *AgentUpdate) ClearMembers() *AgentUpdate {
+	au.mutation.ClearMembers()
+	return au
+}
+
 // SetPromptTemplate sets the "prompt_template" field.
 func (au *AgentUpdate) SetPromptTemplate(s string) *AgentUpdate {
 	au.mutation.SetPromptTemplate(s)
@@ -185,6 +203,26 @@ func (au *AgentUpdate) SetNillableVersion(s *string) *AgentUpdate {
 	return au
 }
 
+// SetPublishedVersionID sets the "published_version_id" field.
+func (au *AgentUpdate) SetPublishedVersionID(s string) *AgentUpdate {
+	au.mutation.SetPublishedVersionID(s)
+	return au
+}
+
+// SetNillablePublishedVersionID sets the "published_version_id" field if the given value is not nil.
+func (au *AgentUpdate) SetNillablePublishedVersionID(s *string) *AgentUpdate {
+	if s != nil {
+		au.SetPublishedVersionID(*s)
+	}
+	return au
+}
+
+// ClearPublishedVersionID clears the value of the "published_version_id" field.
+func (au *AgentUpdate) ClearPublishedVersionID() *AgentUpdate {
+	au.mutation.ClearPublishedVersionID()
+	return au
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (au *AgentUpdate) SetCreatedBy(s string) *AgentUpdate {
 	au.mutation.SetCreatedBy(s)
@@ -257,6 +295,20 @@ func (au *AgentUpdate) SetNillableIsPublic(b *bool) *AgentUpdate {
 	return au
 }
 
+// SetAllowClone sets the "allow_clone" field.
+func (au *AgentUpdate) SetAllowClone(b bool) *AgentUpdate {
+	au.mutation.SetAllowClone(b)
+	return au
+}
+
+// SetNillableAllowClone sets the "allow_clone" field if the given value is not nil.
+func (au *AgentUpdate) SetNillableAllowClone(b *bool) *AgentUpdate {
+	if b != nil {
+		au.SetAllowClone(*b)
+	}
+	return au
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (au *AgentUpdate) Mutation() *AgentMutation {
 	return au.mutation
@@ -365,6 +417,17 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if au.mutation.KnowledgeBasesCleared() {
 		_spec.ClearField(agent.FieldKnowledgeBases, field.TypeJSON)
 	}
+	if value, ok := au.mutation.Members(); ok {
+		_spec.SetField(agent.FieldMembers, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedMembers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldMembers, value)
+		})
+	}
+	if au.mutation.MembersCleared() {
+		_spec.ClearField(agent.FieldMembers, field.TypeJSON)
+	}
 	if value, ok := au.mutation.PromptTemplate(); ok {
 		_spec.SetField(agent.FieldPromptTemplate, field.TypeString, value)
 	}
@@ -383,6 +446,12 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Version(); ok {
 		_spec.SetField(agent.FieldVersion, field.TypeString, value)
 	}
+	if value, ok := au.mutation.PublishedVersionID(); ok {
+		_spec.SetField(agent.FieldPublishedVersionID, field.TypeString, value)
+	}
+	if au.mutation.PublishedVersionIDCleared() {
+		_spec.ClearField(agent.FieldPublishedVersionID, field.TypeString)
+	}
 	if value, ok := au.mutation.CreatedBy(); ok {
 		_spec.SetField(agent.FieldCreatedBy, field.TypeString, value)
 	}
@@ -409,6 +478,15 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.IsPublic(); ok {
 		_spec.SetField(agent.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.AllowClone(); ok {
+		_spec.SetField(agent.FieldAllowClone, field.TypeBool, value)
+	}
+	if au.mutation.TemplateIDCleared() {
+		_spec.ClearField(agent.FieldTemplateID, field.TypeString)
+	}
+	if au.mutation.TemplateVersionCleared() {
+		_spec.ClearField(agent.FieldTemplateVersion, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{agent.Label}
@@ -525,6 +603,24 @@ func (auo *AgentUpdateOne) ClearKnowledgeBases() *AgentUpdateOne {
 	return auo
 }
 
+// SetMembers sets the "members" field.
+func (auo *AgentUpdateOne) SetMembers(s []string) *AgentUpdateOne {
+	auo.mutation.SetMembers(s)
+	return auo
+}
+
+// AppendMembers appends s to the "members" field.
+func (auo *AgentUpdateOne) AppendMembers(s []string) *AgentUpdateOne {
+	auo.mutation.AppendMembers(s)
+	return auo
+}
+
+// ClearMembers clears the value of the "members" field.
+func (auo *AgentUpdateOne) ClearMembers() *AgentUpdateOne {
+	auo.mutation.ClearMembers()
+	return auo
+}
+
 // SetPromptTemplate sets the "prompt_template" field.
 func (auo *AgentUpdateOne) SetPromptTemplate(s string) *AgentUpdateOne {
 	auo.mutation.SetPromptTemplate(s)
@@ -585,6 +681,26 @@ func (auo *AgentUpdateOne) SetNillableVersion(s *string) *AgentUpdateOne {
 	return auo
 }
 
+// SetPublishedVersionID sets the "published_version_id" field.
+func (auo *AgentUpdateOne) SetPublishedVersionID(s string) *AgentUpdateOne {
+	auo.mutation.SetPublishedVersionID(s)
+	return auo
+}
+
+// SetNillablePublishedVersionID sets the "published_version_id" field if the given value is not nil.
+func (auo *AgentUpdateOne) SetNillablePublishedVersionID(s *string) *AgentUpdateOne {
+	if s != nil {
+		auo.SetPublishedVersionID(*s)
+	}
+	return auo
+}
+
+// ClearPublishedVersionID clears the value of the "published_version_id" field.
+func (auo *AgentUpdateOne) ClearPublishedVersionID() *AgentUpdateOne {
+	auo.mutation.ClearPublishedVersionID()
+	return auo
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (auo *AgentUpdateOne) SetCreatedBy(s string) *AgentUpdateOne {
 	auo.mutation.SetCreatedBy(s)
@@ -657,6 +773,20 @@ func (auo *AgentUpdateOne) SetNillableIsPublic(b *bool) *AgentUpdateOne {
 	return auo
 }
 
+// SetAllowClone sets the "allow_clone" field.
+func (auo *AgentUpdateOne) SetAllowClone(b bool) *AgentUpdateOne {
+	auo.mutation.SetAllowClone(b)
+	return auo
+}
+
+// SetNillableAllowClone sets the "allow_clone" field if the given value is not nil.
+func (auo *AgentUpdateOne) SetNillableAllowClone(b *bool) *AgentUpdateOne {
+	if b != nil {
+		auo.SetAllowClone(*b)
+	}
+	return auo
+}
+
 // Mutation returns the AgentMutation object of the builder.
 func (auo *AgentUpdateOne) Mutation() *AgentMutation {
 	return auo.mutation
@@ -795,6 +925,17 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if auo.mutation.KnowledgeBasesCleared() {
 		_spec.ClearField(agent.FieldKnowledgeBases, field.TypeJSON)
 	}
+	if value, ok := auo.mutation.Members(); ok {
+		_spec.SetField(agent.FieldMembers, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedMembers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldMembers, value)
+		})
+	}
+	if auo.mutation.MembersCleared() {
+		_spec.ClearField(agent.FieldMembers, field.TypeJSON)
+	}
 	if value, ok := auo.mutation.PromptTemplate(); ok {
 		_spec.SetField(agent.FieldPromptTemplate, field.TypeString, value)
 	}
@@ -813,6 +954,12 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if value, ok := auo.mutation.Version(); ok {
 		_spec.SetField(agent.FieldVersion, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.PublishedVersionID(); ok {
+		_spec.SetField(agent.FieldPublishedVersionID, field.TypeString, value)
+	}
+	if auo.mutation.PublishedVersionIDCleared() {
+		_spec.ClearField(agent.FieldPublishedVersionID, field.TypeString)
+	}
 	if value, ok := auo.mutation.CreatedBy(); ok {
 		_spec.SetField(agent.FieldCreatedBy, field.TypeString, value)
 	}
@@ -839,6 +986,15 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if value, ok := auo.mutation.IsPublic(); ok {
 		_spec.SetField(agent.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.AllowClone(); ok {
+		_spec.SetField(agent.FieldAllowClone, field.TypeBool, value)
+	}
+	if auo.mutation.TemplateIDCleared() {
+		_spec.ClearField(agent.FieldTemplateID, field.TypeString)
+	}
+	if auo.mutation.TemplateVersionCleared() {
+		_spec.ClearField(agent.FieldTemplateVersion, field.TypeString)
+	}
 	_node = &Agent{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
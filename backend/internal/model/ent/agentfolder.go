@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentfolder"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentFolder is the model entity for the AgentFolder schema.
+type AgentFolder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentFolder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentfolder.FieldID, agentfolder.FieldPath, agentfolder.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case agentfolder.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentFolder fields.
+func (af *AgentFolder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentfolder.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				af.ID = value.String
+			}
+		case agentfolder.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				af.Path = value.String
+			}
+		case agentfolder.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				af.CreatedBy = value.String
+			}
+		case agentfolder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				af.CreatedAt = value.Time
+			}
+		default:
+			af.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentFolder.
+// This includes values selected through modifiers, order, etc.
+func (af *AgentFolder) Value(name string) (ent.Value, error) {
+	return af.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AgentFolder.
+// Note that you need to call AgentFolder.Unwrap() before calling this method if this AgentFolder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (af *AgentFolder) Update() *AgentFolderUpdateOne {
+	return NewAgentFolderClient(af.config).UpdateOne(af)
+}
+
+// Unwrap unwraps the AgentFolder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (af *AgentFolder) Unwrap() *AgentFolder {
+	_tx, ok := af.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentFolder is not a transactional entity")
+	}
+	af.config.driver = _tx.drv
+	return af
+}
+
+// String implements the fmt.Stringer.
+func (af *AgentFolder) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentFolder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", af.ID))
+	builder.WriteString("path=")
+	builder.WriteString(af.Path)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(af.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(af.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentFolders is a parsable slice of AgentFolder.
+type AgentFolders []*AgentFolder
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentfolder"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentFolderCreate is the builder for creating a AgentFolder entity.
+type AgentFolderCreate struct {
+	config
+	mutation *AgentFolderMutation
+	hooks    []Hook
+}
+
+// SetPath sets the "path" field.
+func (afc *AgentFolderCreate) SetPath(s string) *AgentFolderCreate {
+	afc.mutation.SetPath(s)
+	return afc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (afc *AgentFolderCreate) SetCreatedBy(s string) *AgentFolderCreate {
+	afc.mutation.SetCreatedBy(s)
+	return afc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (afc *AgentFolderCreate) SetCreatedAt(t time.Time) *AgentFolderCreate {
+	afc.mutation.SetCreatedAt(t)
+	return afc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (afc *AgentFolderCreate) SetNillableCreatedAt(t *time.Time) *AgentFolderCreate {
+	if t != nil {
+		afc.SetCreatedAt(*t)
+	}
+	return afc
+}
+
+// SetID sets the "id" field.
+func (afc *AgentFolderCreate) SetID(s string) *AgentFolderCreate {
+	afc.mutation.SetID(s)
+	return afc
+}
+
+// Mutation returns the AgentFolderMutation object of the builder.
+func (afc *AgentFolderCreate) Mutation() *AgentFolderMutation {
+	return afc.mutation
+}
+
+// Save creates the AgentFolder in the database.
+func (afc *AgentFolderCreate) Save(ctx context.Context) (*AgentFolder, error) {
+	afc.defaults()
+	return withHooks(ctx, afc.sqlSave, afc.mutation, afc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (afc *AgentFolderCreate) SaveX(ctx context.Context) *AgentFolder {
+	v, err := afc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (afc *AgentFolderCreate) Exec(ctx context.Context) error {
+	_, err := afc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afc *AgentFolderCreate) ExecX(ctx context.Context) {
+	if err := afc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (afc *AgentFolderCreate) defaults() {
+	if _, ok := afc.mutation.CreatedAt(); !ok {
+		v := agentfolder.DefaultCreatedAt()
+		afc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afc *AgentFolderCreate) check() error {
+	if _, ok := afc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "AgentFolder.path"`)}
+	}
+	if v, ok := afc.mutation.Path(); ok {
+		if err := agentfolder.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AgentFolder.path": %w`, err)}
+		}
+	}
+	if _, ok := afc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "AgentFolder.created_by"`)}
+	}
+	if v, ok := afc.mutation.CreatedBy(); ok {
+		if err := agentfolder.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "AgentFolder.created_by": %w`, err)}
+		}
+	}
+	if _, ok := afc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AgentFolder.created_at"`)}
+	}
+	return nil
+}
+
+func (afc *AgentFolderCreate) sqlSave(ctx context.Context) (*AgentFolder, error) {
+	if err := afc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := afc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, afc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AgentFolder.ID type: %T", _spec.ID.Value)
+		}
+	}
+	afc.mutation.id = &_node.ID
+	afc.mutation.done = true
+	return _node, nil
+}
+
+func (afc *AgentFolderCreate) createSpec() (*AgentFolder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentFolder{config: afc.config}
+		_spec = sqlgraph.NewCreateSpec(agentfolder.Table, sqlgraph.NewFieldSpec(agentfolder.FieldID, field.TypeString))
+	)
+	if id, ok := afc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := afc.mutation.Path(); ok {
+		_spec.SetField(agentfolder.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := afc.mutation.CreatedBy(); ok {
+		_spec.SetField(agentfolder.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := afc.mutation.CreatedAt(); ok {
+		_spec.SetField(agentfolder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AgentFolderCreateBulk is the builder for creating many AgentFolder entities in bulk.
+type AgentFolderCreateBulk struct {
+	config
+	err      error
+	builders []*AgentFolderCreate
+}
+
+// Save creates the AgentFolder entities in the database.
+func (afcb *AgentFolderCreateBulk) Save(ctx context.Context) ([]*AgentFolder, error) {
+	if afcb.err != nil {
+		return nil, afcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(afcb.builders))
+	nodes := make([]*AgentFolder, len(afcb.builders))
+	mutators := make([]Mutator, len(afcb.builders))
+	for i := range afcb.builders {
+		func(i int, root context.Context) {
+			builder := afcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentFolderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, afcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, afcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, afcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afcb *AgentFolderCreateBulk) SaveX(ctx context.Context) []*AgentFolder {
+	v, err := afcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (afcb *AgentFolderCreateBulk) Exec(ctx context.Context) error {
+	_, err := afcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afcb *AgentFolderCreateBulk) ExecX(ctx context.Context) {
+	if err := afcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentFolderDelete is the builder for deleting a AgentFolder entity.
+type AgentFolderDelete struct {
+	config
+	hooks    []Hook
+	mutation *AgentFolderMutation
+}
+
+// Where appends a list predicates to the AgentFolderDelete builder.
+func (afd *AgentFolderDelete) Where(ps ...predicate.AgentFolder) *AgentFolderDelete {
+	afd.mutation.Where(ps...)
+	return afd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (afd *AgentFolderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, afd.sqlExec, afd.mutation, afd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afd *AgentFolderDelete) ExecX(ctx context.Context) int {
+	n, err := afd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (afd *AgentFolderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(agentfolder.Table, sqlgraph.NewFieldSpec(agentfolder.FieldID, field.TypeString))
+	if ps := afd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, afd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	afd.mutation.done = true
+	return affected, err
+}
+
+// AgentFolderDeleteOne is the builder for deleting a single AgentFolder entity.
+type AgentFolderDeleteOne struct {
+	afd *AgentFolderDelete
+}
+
+// Where appends a list predicates to the AgentFolderDelete builder.
+func (afdo *AgentFolderDeleteOne) Where(ps ...predicate.AgentFolder) *AgentFolderDeleteOne {
+	afdo.afd.mutation.Where(ps...)
+	return afdo
+}
+
+// Exec executes the deletion query.
+func (afdo *AgentFolderDeleteOne) Exec(ctx context.Context) error {
+	n, err := afdo.afd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{agentfolder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afdo *AgentFolderDeleteOne) ExecX(ctx context.Context) {
+	if err := afdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentFolderQuery is the builder for querying AgentFolder entities.
+type AgentFolderQuery struct {
+	config
+	ctx        *QueryContext
+	order      []agentfolder.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AgentFolder
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AgentFolderQuery builder.
+func (afq *AgentFolderQuery) Where(ps ...predicate.AgentFolder) *AgentFolderQuery {
+	afq.predicates = append(afq.predicates, ps...)
+	return afq
+}
+
+// Limit the number of records to be returned by this query.
+func (afq *AgentFolderQuery) Limit(limit int) *AgentFolderQuery {
+	afq.ctx.Limit = &limit
+	return afq
+}
+
+// Offset to start from.
+func (afq *AgentFolderQuery) Offset(offset int) *AgentFolderQuery {
+	afq.ctx.Offset = &offset
+	return afq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (afq *AgentFolderQuery) Unique(unique bool) *AgentFolderQuery {
+	afq.ctx.Unique = &unique
+	return afq
+}
+
+// Order specifies how the records should be ordered.
+func (afq *AgentFolderQuery) Order(o ...agentfolder.OrderOption) *AgentFolderQuery {
+	afq.order = append(afq.order, o...)
+	return afq
+}
+
+// First returns the first AgentFolder entity from the query.
+// Returns a *NotFoundError when no AgentFolder was found.
+func (afq *AgentFolderQuery) First(ctx context.Context) (*AgentFolder, error) {
+	nodes, err := afq.Limit(1).All(setContextOp(ctx, afq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{agentfolder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (afq *AgentFolderQuery) FirstX(ctx context.Context) *AgentFolder {
+	node, err := afq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AgentFolder ID from the query.
+// Returns a *NotFoundError when no AgentFolder ID was found.
+func (afq *AgentFolderQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = afq.Limit(1).IDs(setContextOp(ctx, afq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{agentfolder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (afq *AgentFolderQuery) FirstIDX(ctx context.Context) string {
+	id, err := afq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AgentFolder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AgentFolder entity is found.
+// Returns a *NotFoundError when no AgentFolder entities are found.
+func (afq *AgentFolderQuery) Only(ctx context.Context) (*AgentFolder, error) {
+	nodes, err := afq.Limit(2).All(setContextOp(ctx, afq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{agentfolder.Label}
+	default:
+		return nil, &NotSingularError{agentfolder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (afq *AgentFolderQuery) OnlyX(ctx context.Context) *AgentFolder {
+	node, err := afq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AgentFolder ID in the query.
+// Returns a *NotSingularError when more than one AgentFolder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (afq *AgentFolderQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = afq.Limit(2).IDs(setContextOp(ctx, afq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{agentfolder.Label}
+	default:
+		err = &NotSingularError{agentfolder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (afq *AgentFolderQuery) OnlyIDX(ctx context.Context) string {
+	id, err := afq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AgentFolders.
+func (afq *AgentFolderQuery) All(ctx context.Context) ([]*AgentFolder, error) {
+	ctx = setContextOp(ctx, afq.ctx, "All")
+	if err := afq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AgentFolder, *AgentFolderQuery]()
+	return withInterceptors[[]*AgentFolder](ctx, afq, qr, afq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (afq *AgentFolderQuery) AllX(ctx context.Context) []*AgentFolder {
+	nodes, err := afq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AgentFolder IDs.
+func (afq *AgentFolderQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if afq.ctx.Unique == nil && afq.path != nil {
+		afq.Unique(true)
+	}
+	ctx = setContextOp(ctx, afq.ctx, "IDs")
+	if err = afq.Select(agentfolder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (afq *AgentFolderQuery) IDsX(ctx context.Context) []string {
+	ids, err := afq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (afq *AgentFolderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, afq.ctx, "Count")
+	if err := afq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, afq, querierCount[*AgentFolderQuery](), afq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (afq *AgentFolderQuery) CountX(ctx context.Context) int {
+	count, err := afq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (afq *AgentFolderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, afq.ctx, "Exist")
+	switch _, err := afq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (afq *AgentFolderQuery) ExistX(ctx context.Context) bool {
+	exist, err := afq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AgentFolderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (afq *AgentFolderQuery) Clone() *AgentFolderQuery {
+	if afq == nil {
+		return nil
+	}
+	return &AgentFolderQuery{
+		config:     afq.config,
+		ctx:        afq.ctx.Clone(),
+		order:      append([]agentfolder.OrderOption{}, afq.order...),
+		inters:     append([]Interceptor{}, afq.inters...),
+		predicates: append([]predicate.AgentFolder{}, afq.predicates...),
+		// clone intermediate query.
+		sql:  afq.sql.Clone(),
+		path: afq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Path string `json:"path,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AgentFolder.Query().
+//		GroupBy(agentfolder.FieldPath).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (afq *AgentFolderQuery) GroupBy(field string, fields ...string) *AgentFolderGroupBy {
+	afq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AgentFolderGroupBy{build: afq}
+	grbuild.flds = &afq.ctx.Fields
+	grbuild.label = agentfolder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Path string `json:"path,omitempty"`
+//	}
+//
+//	client.AgentFolder.Query().
+//		Select(agentfolder.FieldPath).
+//		Scan(ctx, &v)
+func (afq *AgentFolderQuery) Select(fields ...string) *AgentFolderSelect {
+	afq.ctx.Fields = append(afq.ctx.Fields, fields...)
+	sbuild := &AgentFolderSelect{AgentFolderQuery: afq}
+	sbuild.label = agentfolder.Label
+	sbuild.flds, sbuild.scan = &afq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AgentFolderSelect configured with the given aggregations.
+func (afq *AgentFolderQuery) Aggregate(fns ...AggregateFunc) *AgentFolderSelect {
+	return afq.Select().Aggregate(fns...)
+}
+
+func (afq *AgentFolderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range afq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, afq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range afq.ctx.Fields {
+		if !agentfolder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if afq.path != nil {
+		prev, err := afq.path(ctx)
+		if err != nil {
+			return err
+		}
+		afq.sql = prev
+	}
+	return nil
+}
+
+func (afq *AgentFolderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AgentFolder, error) {
+	var (
+		nodes = []*AgentFolder{}
+		_spec = afq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AgentFolder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AgentFolder{config: afq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, afq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (afq *AgentFolderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := afq.querySpec()
+	_spec.Node.Columns = afq.ctx.Fields
+	if len(afq.ctx.Fields) > 0 {
+		_spec.Unique = afq.ctx.Unique != nil && *afq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, afq.driver, _spec)
+}
+
+func (afq *AgentFolderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(agentfolder.Table, agentfolder.Columns, sqlgraph.NewFieldSpec(agentfolder.FieldID, field.TypeString))
+	_spec.From = afq.sql
+	if unique := afq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if afq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := afq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentfolder.FieldID)
+		for i := range fields {
+			if fields[i] != agentfolder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := afq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := afq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := afq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := afq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (afq *AgentFolderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(afq.driver.Dialect())
+	t1 := builder.Table(agentfolder.Table)
+	columns := afq.ctx.Fields
+	if len(columns) == 0 {
+		columns = agentfolder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if afq.sql != nil {
+		selector = afq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if afq.ctx.Unique != nil && *afq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range afq.predicates {
+		p(selector)
+	}
+	for _, p := range afq.order {
+		p(selector)
+	}
+	if offset := afq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := afq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AgentFolderGroupBy is the group-by builder for AgentFolder entities.
+type AgentFolderGroupBy struct {
+	selector
+	build *AgentFolderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (afgb *AgentFolderGroupBy) Aggregate(fns ...AggregateFunc) *AgentFolderGroupBy {
+	afgb.fns = append(afgb.fns, fns...)
+	return afgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (afgb *AgentFolderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, afgb.build.ctx, "GroupBy")
+	if err := afgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AgentFolderQuery, *AgentFolderGroupBy](ctx, afgb.build, afgb, afgb.build.inters, v)
+}
+
+func (afgb *AgentFolderGroupBy) sqlScan(ctx context.Context, root *AgentFolderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(afgb.fns))
+	for _, fn := range afgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*afgb.flds)+len(afgb.fns))
+		for _, f := range *afgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*afgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := afgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AgentFolderSelect is the builder for selecting fields of AgentFolder entities.
+type AgentFolderSelect struct {
+	*AgentFolderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (afs *AgentFolderSelect) Aggregate(fns ...AggregateFunc) *AgentFolderSelect {
+	afs.fns = append(afs.fns, fns...)
+	return afs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (afs *AgentFolderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, afs.ctx, "Select")
+	if err := afs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AgentFolderQuery, *AgentFolderSelect](ctx, afs.AgentFolderQuery, afs, afs.inters, v)
+}
+
+func (afs *AgentFolderSelect) sqlScan(ctx context.Context, root *AgentFolderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(afs.fns))
+	for _, fn := range afs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*afs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := afs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
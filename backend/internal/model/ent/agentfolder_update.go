@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentFolderUpdate is the builder for updating AgentFolder entities.
+type AgentFolderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AgentFolderMutation
+}
+
+// Where appends a list predicates to the AgentFolderUpdate builder.
+func (afu *AgentFolderUpdate) Where(ps ...predicate.AgentFolder) *AgentFolderUpdate {
+	afu.mutation.Where(ps...)
+	return afu
+}
+
+// SetPath sets the "path" field.
+func (afu *AgentFolderUpdate) SetPath(s string) *AgentFolderUpdate {
+	afu.mutation.SetPath(s)
+	return afu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (afu *AgentFolderUpdate) SetNillablePath(s *string) *AgentFolderUpdate {
+	if s != nil {
+		afu.SetPath(*s)
+	}
+	return afu
+}
+
+// Mutation returns the AgentFolderMutation object of the builder.
+func (afu *AgentFolderUpdate) Mutation() *AgentFolderMutation {
+	return afu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (afu *AgentFolderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, afu.sqlSave, afu.mutation, afu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afu *AgentFolderUpdate) SaveX(ctx context.Context) int {
+	affected, err := afu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (afu *AgentFolderUpdate) Exec(ctx context.Context) error {
+	_, err := afu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afu *AgentFolderUpdate) ExecX(ctx context.Context) {
+	if err := afu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afu *AgentFolderUpdate) check() error {
+	if v, ok := afu.mutation.Path(); ok {
+		if err := agentfolder.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AgentFolder.path": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (afu *AgentFolderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := afu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentfolder.Table, agentfolder.Columns, sqlgraph.NewFieldSpec(agentfolder.FieldID, field.TypeString))
+	if ps := afu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := afu.mutation.Path(); ok {
+		_spec.SetField(agentfolder.FieldPath, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, afu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentfolder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	afu.mutation.done = true
+	return n, nil
+}
+
+// AgentFolderUpdateOne is the builder for updating a single AgentFolder entity.
+type AgentFolderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AgentFolderMutation
+}
+
+// SetPath sets the "path" field.
+func (afuo *AgentFolderUpdateOne) SetPath(s string) *AgentFolderUpdateOne {
+	afuo.mutation.SetPath(s)
+	return afuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (afuo *AgentFolderUpdateOne) SetNillablePath(s *string) *AgentFolderUpdateOne {
+	if s != nil {
+		afuo.SetPath(*s)
+	}
+	return afuo
+}
+
+// Mutation returns the AgentFolderMutation object of the builder.
+func (afuo *AgentFolderUpdateOne) Mutation() *AgentFolderMutation {
+	return afuo.mutation
+}
+
+// Where appends a list predicates to the AgentFolderUpdate builder.
+func (afuo *AgentFolderUpdateOne) Where(ps ...predicate.AgentFolder) *AgentFolderUpdateOne {
+	afuo.mutation.Where(ps...)
+	return afuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (afuo *AgentFolderUpdateOne) Select(field string, fields ...string) *AgentFolderUpdateOne {
+	afuo.fields = append([]string{field}, fields...)
+	return afuo
+}
+
+// Save executes the query and returns the updated AgentFolder entity.
+func (afuo *AgentFolderUpdateOne) Save(ctx context.Context) (*AgentFolder, error) {
+	return withHooks(ctx, afuo.sqlSave, afuo.mutation, afuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (afuo *AgentFolderUpdateOne) SaveX(ctx context.Context) *AgentFolder {
+	node, err := afuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (afuo *AgentFolderUpdateOne) Exec(ctx context.Context) error {
+	_, err := afuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (afuo *AgentFolderUpdateOne) ExecX(ctx context.Context) {
+	if err := afuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (afuo *AgentFolderUpdateOne) check() error {
+	if v, ok := afuo.mutation.Path(); ok {
+		if err := agentfolder.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AgentFolder.path": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (afuo *AgentFolderUpdateOne) sqlSave(ctx context.Context) (_node *AgentFolder, err error) {
+	if err := afuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentfolder.Table, agentfolder.Columns, sqlgraph.NewFieldSpec(agentfolder.FieldID, field.TypeString))
+	id, ok := afuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentFolder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := afuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentfolder.FieldID)
+		for _, f := range fields {
+			if !agentfolder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentfolder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := afuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := afuo.mutation.Path(); ok {
+		_spec.SetField(agentfolder.FieldPath, field.TypeString, value)
+	}
+	_node = &AgentFolder{config: afuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, afuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentfolder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	afuo.mutation.done = true
+	return _node, nil
+}
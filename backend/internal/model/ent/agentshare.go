@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentshare"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentShare is the model entity for the AgentShare schema.
+type AgentShare struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AgentID holds the value of the "agent_id" field.
+	AgentID string `json:"agent_id,omitempty"`
+	// PrincipalType holds the value of the "principal_type" field.
+	PrincipalType string `json:"principal_type,omitempty"`
+	// PrincipalID holds the value of the "principal_id" field.
+	PrincipalID string `json:"principal_id,omitempty"`
+	// Permission holds the value of the "permission" field.
+	Permission string `json:"permission,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentShare) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentshare.FieldID, agentshare.FieldAgentID, agentshare.FieldPrincipalType, agentshare.FieldPrincipalID, agentshare.FieldPermission, agentshare.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case agentshare.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentShare fields.
+func (as *AgentShare) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentshare.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				as.ID = value.String
+			}
+		case agentshare.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				as.AgentID = value.String
+			}
+		case agentshare.FieldPrincipalType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field principal_type", values[i])
+			} else if value.Valid {
+				as.PrincipalType = value.String
+			}
+		case agentshare.FieldPrincipalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field principal_id", values[i])
+			} else if value.Valid {
+				as.PrincipalID = value.String
+			}
+		case agentshare.FieldPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field permission", values[i])
+			} else if value.Valid {
+				as.Permission = value.String
+			}
+		case agentshare.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				as.CreatedBy = value.String
+			}
+		case agentshare.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				as.CreatedAt = value.Time
+			}
+		default:
+			as.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentShare.
+// This includes values selected through modifiers, order, etc.
+func (as *AgentShare) Value(name string) (ent.Value, error) {
+	return as.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AgentShare.
+// Note that you need to call AgentShare.Unwrap() before calling this method if this AgentShare
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (as *AgentShare) Update() *AgentShareUpdateOne {
+	return NewAgentShareClient(as.config).UpdateOne(as)
+}
+
+// Unwrap unwraps the AgentShare entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (as *AgentShare) Unwrap() *AgentShare {
+	_tx, ok := as.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentShare is not a transactional entity")
+	}
+	as.config.driver = _tx.drv
+	return as
+}
+
+// String implements the fmt.Stringer.
+func (as *AgentShare) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentShare(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", as.ID))
+	builder.WriteString("agent_id=")
+	builder.WriteString(as.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("principal_type=")
+	builder.WriteString(as.PrincipalType)
+	builder.WriteString(", ")
+	builder.WriteString("principal_id=")
+	builder.WriteString(as.PrincipalID)
+	builder.WriteString(", ")
+	builder.WriteString("permission=")
+	builder.WriteString(as.Permission)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(as.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(as.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentShares is a parsable slice of AgentShare.
+type AgentShares []*AgentShare
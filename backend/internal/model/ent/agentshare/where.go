@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentshare
+
+import (
+	"agent-platform/internal/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContainsFold(FieldID, id))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldAgentID, v))
+}
+
+// PrincipalType applies equality check predicate on the "principal_type" field. It's identical to PrincipalTypeEQ.
+func PrincipalType(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldPrincipalType, v))
+}
+
+// PrincipalID applies equality check predicate on the "principal_id" field. It's identical to PrincipalIDEQ.
+func PrincipalID(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldPrincipalID, v))
+}
+
+// Permission applies equality check predicate on the "permission" field. It's identical to PermissionEQ.
+func Permission(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldPermission, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// PrincipalTypeEQ applies the EQ predicate on the "principal_type" field.
+func PrincipalTypeEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldPrincipalType, v))
+}
+
+// PrincipalTypeNEQ applies the NEQ predicate on the "principal_type" field.
+func PrincipalTypeNEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldPrincipalType, v))
+}
+
+// PrincipalTypeIn applies the In predicate on the "principal_type" field.
+func PrincipalTypeIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldPrincipalType, vs...))
+}
+
+// PrincipalTypeNotIn applies the NotIn predicate on the "principal_type" field.
+func PrincipalTypeNotIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldPrincipalType, vs...))
+}
+
+// PrincipalTypeGT applies the GT predicate on the "principal_type" field.
+func PrincipalTypeGT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldPrincipalType, v))
+}
+
+// PrincipalTypeGTE applies the GTE predicate on the "principal_type" field.
+func PrincipalTypeGTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldPrincipalType, v))
+}
+
+// PrincipalTypeLT applies the LT predicate on the "principal_type" field.
+func PrincipalTypeLT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldPrincipalType, v))
+}
+
+// PrincipalTypeLTE applies the LTE predicate on the "principal_type" field.
+func PrincipalTypeLTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldPrincipalType, v))
+}
+
+// PrincipalTypeContains applies the Contains predicate on the "principal_type" field.
+func PrincipalTypeContains(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContains(FieldPrincipalType, v))
+}
+
+// PrincipalTypeHasPrefix applies the HasPrefix predicate on the "principal_type" field.
+func PrincipalTypeHasPrefix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasPrefix(FieldPrincipalType, v))
+}
+
+// PrincipalTypeHasSuffix applies the HasSuffix predicate on the "principal_type" field.
+func PrincipalTypeHasSuffix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasSuffix(FieldPrincipalType, v))
+}
+
+// PrincipalTypeEqualFold applies the EqualFold predicate on the "principal_type" field.
+func PrincipalTypeEqualFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEqualFold(FieldPrincipalType, v))
+}
+
+// PrincipalTypeContainsFold applies the ContainsFold predicate on the "principal_type" field.
+func PrincipalTypeContainsFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContainsFold(FieldPrincipalType, v))
+}
+
+// PrincipalIDEQ applies the EQ predicate on the "principal_id" field.
+func PrincipalIDEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldPrincipalID, v))
+}
+
+// PrincipalIDNEQ applies the NEQ predicate on the "principal_id" field.
+func PrincipalIDNEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldPrincipalID, v))
+}
+
+// PrincipalIDIn applies the In predicate on the "principal_id" field.
+func PrincipalIDIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldPrincipalID, vs...))
+}
+
+// PrincipalIDNotIn applies the NotIn predicate on the "principal_id" field.
+func PrincipalIDNotIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldPrincipalID, vs...))
+}
+
+// PrincipalIDGT applies the GT predicate on the "principal_id" field.
+func PrincipalIDGT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldPrincipalID, v))
+}
+
+// PrincipalIDGTE applies the GTE predicate on the "principal_id" field.
+func PrincipalIDGTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldPrincipalID, v))
+}
+
+// PrincipalIDLT applies the LT predicate on the "principal_id" field.
+func PrincipalIDLT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldPrincipalID, v))
+}
+
+// PrincipalIDLTE applies the LTE predicate on the "principal_id" field.
+func PrincipalIDLTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldPrincipalID, v))
+}
+
+// PrincipalIDContains applies the Contains predicate on the "principal_id" field.
+func PrincipalIDContains(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContains(FieldPrincipalID, v))
+}
+
+// PrincipalIDHasPrefix applies the HasPrefix predicate on the "principal_id" field.
+func PrincipalIDHasPrefix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasPrefix(FieldPrincipalID, v))
+}
+
+// PrincipalIDHasSuffix applies the HasSuffix predicate on the "principal_id" field.
+func PrincipalIDHasSuffix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasSuffix(FieldPrincipalID, v))
+}
+
+// PrincipalIDEqualFold applies the EqualFold predicate on the "principal_id" field.
+func PrincipalIDEqualFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEqualFold(FieldPrincipalID, v))
+}
+
+// PrincipalIDContainsFold applies the ContainsFold predicate on the "principal_id" field.
+func PrincipalIDContainsFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContainsFold(FieldPrincipalID, v))
+}
+
+// PermissionEQ applies the EQ predicate on the "permission" field.
+func PermissionEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldPermission, v))
+}
+
+// PermissionNEQ applies the NEQ predicate on the "permission" field.
+func PermissionNEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldPermission, v))
+}
+
+// PermissionIn applies the In predicate on the "permission" field.
+func PermissionIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldPermission, vs...))
+}
+
+// PermissionNotIn applies the NotIn predicate on the "permission" field.
+func PermissionNotIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldPermission, vs...))
+}
+
+// PermissionGT applies the GT predicate on the "permission" field.
+func PermissionGT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldPermission, v))
+}
+
+// PermissionGTE applies the GTE predicate on the "permission" field.
+func PermissionGTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldPermission, v))
+}
+
+// PermissionLT applies the LT predicate on the "permission" field.
+func PermissionLT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldPermission, v))
+}
+
+// PermissionLTE applies the LTE predicate on the "permission" field.
+func PermissionLTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldPermission, v))
+}
+
+// PermissionContains applies the Contains predicate on the "permission" field.
+func PermissionContains(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContains(FieldPermission, v))
+}
+
+// PermissionHasPrefix applies the HasPrefix predicate on the "permission" field.
+func PermissionHasPrefix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasPrefix(FieldPermission, v))
+}
+
+// PermissionHasSuffix applies the HasSuffix predicate on the "permission" field.
+func PermissionHasSuffix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasSuffix(FieldPermission, v))
+}
+
+// PermissionEqualFold applies the EqualFold predicate on the "permission" field.
+func PermissionEqualFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEqualFold(FieldPermission, v))
+}
+
+// PermissionContainsFold applies the ContainsFold predicate on the "permission" field.
+func PermissionContainsFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContainsFold(FieldPermission, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AgentShare {
+	return predicate.AgentShare(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AgentShare) predicate.AgentShare {
+	return predicate.AgentShare(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AgentShare) predicate.AgentShare {
+	return predicate.AgentShare(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AgentShare) predicate.AgentShare {
+	return predicate.AgentShare(sql.NotPredicates(p))
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentshare"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentShareCreate is the builder for creating a AgentShare entity.
+type AgentShareCreate struct {
+	config
+	mutation *AgentShareMutation
+	hooks    []Hook
+}
+
+// SetAgentID sets the "agent_id" field.
+func (asc *AgentShareCreate) SetAgentID(s string) *AgentShareCreate {
+	asc.mutation.SetAgentID(s)
+	return asc
+}
+
+// SetPrincipalType sets the "principal_type" field.
+func (asc *AgentShareCreate) SetPrincipalType(s string) *AgentShareCreate {
+	asc.mutation.SetPrincipalType(s)
+	return asc
+}
+
+// SetPrincipalID sets the "principal_id" field.
+func (asc *AgentShareCreate) SetPrincipalID(s string) *AgentShareCreate {
+	asc.mutation.SetPrincipalID(s)
+	return asc
+}
+
+// SetPermission sets the "permission" field.
+func (asc *AgentShareCreate) SetPermission(s string) *AgentShareCreate {
+	asc.mutation.SetPermission(s)
+	return asc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (asc *AgentShareCreate) SetCreatedBy(s string) *AgentShareCreate {
+	asc.mutation.SetCreatedBy(s)
+	return asc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (asc *AgentShareCreate) SetCreatedAt(t time.Time) *AgentShareCreate {
+	asc.mutation.SetCreatedAt(t)
+	return asc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (asc *AgentShareCreate) SetNillableCreatedAt(t *time.Time) *AgentShareCreate {
+	if t != nil {
+		asc.SetCreatedAt(*t)
+	}
+	return asc
+}
+
+// SetID sets the "id" field.
+func (asc *AgentShareCreate) SetID(s string) *AgentShareCreate {
+	asc.mutation.SetID(s)
+	return asc
+}
+
+// Mutation returns the AgentShareMutation object of the builder.
+func (asc *AgentShareCreate) Mutation() *AgentShareMutation {
+	return asc.mutation
+}
+
+// Save creates the AgentShare in the database.
+func (asc *AgentShareCreate) Save(ctx context.Context) (*AgentShare, error) {
+	asc.defaults()
+	return withHooks(ctx, asc.sqlSave, asc.mutation, asc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (asc *AgentShareCreate) SaveX(ctx context.Context) *AgentShare {
+	v, err := asc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (asc *AgentShareCreate) Exec(ctx context.Context) error {
+	_, err := asc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asc *AgentShareCreate) ExecX(ctx context.Context) {
+	if err := asc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (asc *AgentShareCreate) defaults() {
+	if _, ok := asc.mutation.CreatedAt(); !ok {
+		v := agentshare.DefaultCreatedAt()
+		asc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asc *AgentShareCreate) check() error {
+	if _, ok := asc.mutation.AgentID(); !ok {
+		return &ValidationError{Name: "agent_id", err: errors.New(`ent: missing required field "AgentShare.agent_id"`)}
+	}
+	if v, ok := asc.mutation.AgentID(); ok {
+		if err := agentshare.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "AgentShare.agent_id": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.PrincipalType(); !ok {
+		return &ValidationError{Name: "principal_type", err: errors.New(`ent: missing required field "AgentShare.principal_type"`)}
+	}
+	if v, ok := asc.mutation.PrincipalType(); ok {
+		if err := agentshare.PrincipalTypeValidator(v); err != nil {
+			return &ValidationError{Name: "principal_type", err: fmt.Errorf(`ent: validator failed for field "AgentShare.principal_type": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.PrincipalID(); !ok {
+		return &ValidationError{Name: "principal_id", err: errors.New(`ent: missing required field "AgentShare.principal_id"`)}
+	}
+	if v, ok := asc.mutation.PrincipalID(); ok {
+		if err := agentshare.PrincipalIDValidator(v); err != nil {
+			return &ValidationError{Name: "principal_id", err: fmt.Errorf(`ent: validator failed for field "AgentShare.principal_id": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.Permission(); !ok {
+		return &ValidationError{Name: "permission", err: errors.New(`ent: missing required field "AgentShare.permission"`)}
+	}
+	if v, ok := asc.mutation.Permission(); ok {
+		if err := agentshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "AgentShare.permission": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "AgentShare.created_by"`)}
+	}
+	if v, ok := asc.mutation.CreatedBy(); ok {
+		if err := agentshare.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "AgentShare.created_by": %w`, err)}
+		}
+	}
+	if _, ok := asc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AgentShare.created_at"`)}
+	}
+	return nil
+}
+
+func (asc *AgentShareCreate) sqlSave(ctx context.Context) (*AgentShare, error) {
+	if err := asc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := asc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, asc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AgentShare.ID type: %T", _spec.ID.Value)
+		}
+	}
+	asc.mutation.id = &_node.ID
+	asc.mutation.done = true
+	return _node, nil
+}
+
+func (asc *AgentShareCreate) createSpec() (*AgentShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentShare{config: asc.config}
+		_spec = sqlgraph.NewCreateSpec(agentshare.Table, sqlgraph.NewFieldSpec(agentshare.FieldID, field.TypeString))
+	)
+	if id, ok := asc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := asc.mutation.AgentID(); ok {
+		_spec.SetField(agentshare.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := asc.mutation.PrincipalType(); ok {
+		_spec.SetField(agentshare.FieldPrincipalType, field.TypeString, value)
+		_node.PrincipalType = value
+	}
+	if value, ok := asc.mutation.PrincipalID(); ok {
+		_spec.SetField(agentshare.FieldPrincipalID, field.TypeString, value)
+		_node.PrincipalID = value
+	}
+	if value, ok := asc.mutation.Permission(); ok {
+		_spec.SetField(agentshare.FieldPermission, field.TypeString, value)
+		_node.Permission = value
+	}
+	if value, ok := asc.mutation.CreatedBy(); ok {
+		_spec.SetField(agentshare.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := asc.mutation.CreatedAt(); ok {
+		_spec.SetField(agentshare.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AgentShareCreateBulk is the builder for creating many AgentShare entities in bulk.
+type AgentShareCreateBulk struct {
+	config
+	err      error
+	builders []*AgentShareCreate
+}
+
+// Save creates the AgentShare entities in the database.
+func (ascb *AgentShareCreateBulk) Save(ctx context.Context) ([]*AgentShare, error) {
+	if ascb.err != nil {
+		return nil, ascb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ascb.builders))
+	nodes := make([]*AgentShare, len(ascb.builders))
+	mutators := make([]Mutator, len(ascb.builders))
+	for i := range ascb.builders {
+		func(i int, root context.Context) {
+			builder := ascb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ascb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ascb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ascb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ascb *AgentShareCreateBulk) SaveX(ctx context.Context) []*AgentShare {
+	v, err := ascb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ascb *AgentShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := ascb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ascb *AgentShareCreateBulk) ExecX(ctx context.Context) {
+	if err := ascb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
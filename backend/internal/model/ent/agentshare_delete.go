@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentShareDelete is the builder for deleting a AgentShare entity.
+type AgentShareDelete struct {
+	config
+	hooks    []Hook
+	mutation *AgentShareMutation
+}
+
+// Where appends a list predicates to the AgentShareDelete builder.
+func (asd *AgentShareDelete) Where(ps ...predicate.AgentShare) *AgentShareDelete {
+	asd.mutation.Where(ps...)
+	return asd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (asd *AgentShareDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, asd.sqlExec, asd.mutation, asd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asd *AgentShareDelete) ExecX(ctx context.Context) int {
+	n, err := asd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (asd *AgentShareDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(agentshare.Table, sqlgraph.NewFieldSpec(agentshare.FieldID, field.TypeString))
+	if ps := asd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, asd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	asd.mutation.done = true
+	return affected, err
+}
+
+// AgentShareDeleteOne is the builder for deleting a single AgentShare entity.
+type AgentShareDeleteOne struct {
+	asd *AgentShareDelete
+}
+
+// Where appends a list predicates to the AgentShareDelete builder.
+func (asdo *AgentShareDeleteOne) Where(ps ...predicate.AgentShare) *AgentShareDeleteOne {
+	asdo.asd.mutation.Where(ps...)
+	return asdo
+}
+
+// Exec executes the deletion query.
+func (asdo *AgentShareDeleteOne) Exec(ctx context.Context) error {
+	n, err := asdo.asd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{agentshare.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asdo *AgentShareDeleteOne) ExecX(ctx context.Context) {
+	if err := asdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
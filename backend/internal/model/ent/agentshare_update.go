@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentShareUpdate is the builder for updating AgentShare entities.
+type AgentShareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AgentShareMutation
+}
+
+// Where appends a list predicates to the AgentShareUpdate builder.
+func (asu *AgentShareUpdate) Where(ps ...predicate.AgentShare) *AgentShareUpdate {
+	asu.mutation.Where(ps...)
+	return asu
+}
+
+// SetPermission sets the "permission" field.
+func (asu *AgentShareUpdate) SetPermission(s string) *AgentShareUpdate {
+	asu.mutation.SetPermission(s)
+	return asu
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (asu *AgentShareUpdate) SetNillablePermission(s *string) *AgentShareUpdate {
+	if s != nil {
+		asu.SetPermission(*s)
+	}
+	return asu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (asu *AgentShareUpdate) SetCreatedBy(s string) *AgentShareUpdate {
+	asu.mutation.SetCreatedBy(s)
+	return asu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (asu *AgentShareUpdate) SetNillableCreatedBy(s *string) *AgentShareUpdate {
+	if s != nil {
+		asu.SetCreatedBy(*s)
+	}
+	return asu
+}
+
+// Mutation returns the AgentShareMutation object of the builder.
+func (asu *AgentShareUpdate) Mutation() *AgentShareMutation {
+	return asu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (asu *AgentShareUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, asu.sqlSave, asu.mutation, asu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (asu *AgentShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := asu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (asu *AgentShareUpdate) Exec(ctx context.Context) error {
+	_, err := asu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asu *AgentShareUpdate) ExecX(ctx context.Context) {
+	if err := asu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asu *AgentShareUpdate) check() error {
+	if v, ok := asu.mutation.Permission(); ok {
+		if err := agentshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "AgentShare.permission": %w`, err)}
+		}
+	}
+	if v, ok := asu.mutation.CreatedBy(); ok {
+		if err := agentshare.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "AgentShare.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (asu *AgentShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := asu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentshare.Table, agentshare.Columns, sqlgraph.NewFieldSpec(agentshare.FieldID, field.TypeString))
+	if ps := asu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := asu.mutation.Permission(); ok {
+		_spec.SetField(agentshare.FieldPermission, field.TypeString, value)
+	}
+	if value, ok := asu.mutation.CreatedBy(); ok {
+		_spec.SetField(agentshare.FieldCreatedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, asu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	asu.mutation.done = true
+	return n, nil
+}
+
+// AgentShareUpdateOne is the builder for updating a single AgentShare entity.
+type AgentShareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AgentShareMutation
+}
+
+// SetPermission sets the "permission" field.
+func (asuo *AgentShareUpdateOne) SetPermission(s string) *AgentShareUpdateOne {
+	asuo.mutation.SetPermission(s)
+	return asuo
+}
+
+// SetNillablePermission sets the "permission" field if the given value is not nil.
+func (asuo *AgentShareUpdateOne) SetNillablePermission(s *string) *AgentShareUpdateOne {
+	if s != nil {
+		asuo.SetPermission(*s)
+	}
+	return asuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (asuo *AgentShareUpdateOne) SetCreatedBy(s string) *AgentShareUpdateOne {
+	asuo.mutation.SetCreatedBy(s)
+	return asuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (asuo *AgentShareUpdateOne) SetNillableCreatedBy(s *string) *AgentShareUpdateOne {
+	if s != nil {
+		asuo.SetCreatedBy(*s)
+	}
+	return asuo
+}
+
+// Mutation returns the AgentShareMutation object of the builder.
+func (asuo *AgentShareUpdateOne) Mutation() *AgentShareMutation {
+	return asuo.mutation
+}
+
+// Where appends a list predicates to the AgentShareUpdate builder.
+func (asuo *AgentShareUpdateOne) Where(ps ...predicate.AgentShare) *AgentShareUpdateOne {
+	asuo.mutation.Where(ps...)
+	return asuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (asuo *AgentShareUpdateOne) Select(field string, fields ...string) *AgentShareUpdateOne {
+	asuo.fields = append([]string{field}, fields...)
+	return asuo
+}
+
+// Save executes the query and returns the updated AgentShare entity.
+func (asuo *AgentShareUpdateOne) Save(ctx context.Context) (*AgentShare, error) {
+	return withHooks(ctx, asuo.sqlSave, asuo.mutation, asuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (asuo *AgentShareUpdateOne) SaveX(ctx context.Context) *AgentShare {
+	node, err := asuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (asuo *AgentShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := asuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (asuo *AgentShareUpdateOne) ExecX(ctx context.Context) {
+	if err := asuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (asuo *AgentShareUpdateOne) check() error {
+	if v, ok := asuo.mutation.Permission(); ok {
+		if err := agentshare.PermissionValidator(v); err != nil {
+			return &ValidationError{Name: "permission", err: fmt.Errorf(`ent: validator failed for field "AgentShare.permission": %w`, err)}
+		}
+	}
+	if v, ok := asuo.mutation.CreatedBy(); ok {
+		if err := agentshare.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "AgentShare.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (asuo *AgentShareUpdateOne) sqlSave(ctx context.Context) (_node *AgentShare, err error) {
+	if err := asuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentshare.Table, agentshare.Columns, sqlgraph.NewFieldSpec(agentshare.FieldID, field.TypeString))
+	id, ok := asuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := asuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentshare.FieldID)
+		for _, f := range fields {
+			if !agentshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := asuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := asuo.mutation.Permission(); ok {
+		_spec.SetField(agentshare.FieldPermission, field.TypeString, value)
+	}
+	if value, ok := asuo.mutation.CreatedBy(); ok {
+		_spec.SetField(agentshare.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &AgentShare{config: asuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, asuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	asuo.mutation.done = true
+	return _node, nil
+}
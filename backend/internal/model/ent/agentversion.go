@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentversion"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentVersion is the model entity for the AgentVersion schema.
+type AgentVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// AgentID holds the value of the "agent_id" field.
+	AgentID string `json:"agent_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// ModelConfig holds the value of the "model_config" field.
+	ModelConfig map[string]interface{} `json:"model_config,omitempty"`
+	// Tools holds the value of the "tools" field.
+	Tools []string `json:"tools,omitempty"`
+	// KnowledgeBases holds the value of the "knowledge_bases" field.
+	KnowledgeBases []string `json:"knowledge_bases,omitempty"`
+	// Members holds the value of the "members" field.
+	Members []string `json:"members,omitempty"`
+	// PromptTemplate holds the value of the "prompt_template" field.
+	PromptTemplate string `json:"prompt_template,omitempty"`
+	// Parameters holds the value of the "parameters" field.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	// Release notes given when publishing
+	Notes string `json:"notes,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentversion.FieldModelConfig, agentversion.FieldTools, agentversion.FieldKnowledgeBases, agentversion.FieldMembers, agentversion.FieldParameters:
+			values[i] = new([]byte)
+		case agentversion.FieldID, agentversion.FieldAgentID, agentversion.FieldVersion, agentversion.FieldName, agentversion.FieldDescription, agentversion.FieldType, agentversion.FieldPromptTemplate, agentversion.FieldNotes, agentversion.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case agentversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentVersion fields.
+func (av *AgentVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentversion.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				av.ID = value.String
+			}
+		case agentversion.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				av.AgentID = value.String
+			}
+		case agentversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				av.Version = value.String
+			}
+		case agentversion.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				av.Name = value.String
+			}
+		case agentversion.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				av.Description = value.String
+			}
+		case agentversion.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				av.Type = value.String
+			}
+		case agentversion.FieldModelConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model_config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &av.ModelConfig); err != nil {
+					return fmt.Errorf("unmarshal field model_config: %w", err)
+				}
+			}
+		case agentversion.FieldTools:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tools", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &av.Tools); err != nil {
+					return fmt.Errorf("unmarshal field tools: %w", err)
+				}
+			}
+		case agentversion.FieldKnowledgeBases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field knowledge_bases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &av.KnowledgeBases); err != nil {
+					return fmt.Errorf("unmarshal field knowledge_bases: %w", err)
+				}
+			}
+		case agentversion.FieldMembers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field members", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &av.Members); err != nil {
+					return fmt.Errorf("unmarshal field members: %w", err)
+				}
+			}
+		case agentversion.FieldPromptTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_template", values[i])
+			} else if value.Valid {
+				av.PromptTemplate = value.String
+			}
+		case agentversion.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &av.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case agentversion.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				av.Notes = value.String
+			}
+		case agentversion.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				av.CreatedBy = value.String
+			}
+		case agentversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				av.CreatedAt = value.Time
+			}
+		default:
+			av.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentVersion.
+// This includes values selected through modifiers, order, etc.
+func (av *AgentVersion) Value(name string) (ent.Value, error) {
+	return av.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AgentVersion.
+// Note that you need to call AgentVersion.Unwrap() before calling this method if this AgentVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (av *AgentVersion) Update() *AgentVersionUpdateOne {
+	return NewAgentVersionClient(av.config).UpdateOne(av)
+}
+
+// Unwrap unwraps the AgentVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (av *AgentVersion) Unwrap() *AgentVersion {
+	_tx, ok := av.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentVersion is not a transactional entity")
+	}
+	av.config.driver = _tx.drv
+	return av
+}
+
+// String implements the fmt.Stringer.
+func (av *AgentVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", av.ID))
+	builder.WriteString("agent_id=")
+	builder.WriteString(av.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(av.Version)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(av.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(av.Description)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(av.Type)
+	builder.WriteString(", ")
+	builder.WriteString("model_config=")
+	builder.WriteString(fmt.Sprintf("%v", av.ModelConfig))
+	builder.WriteString(", ")
+	builder.WriteString("tools=")
+	builder.WriteString(fmt.Sprintf("%v", av.Tools))
+	builder.WriteString(", ")
+	builder.WriteString("knowledge_bases=")
+	builder.WriteString(fmt.Sprintf("%v", av.KnowledgeBases))
+	builder.WriteString(", ")
+	builder.WriteString("members=")
+	builder.WriteString(fmt.Sprintf("%v", av.Members))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_template=")
+	builder.WriteString(av.PromptTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", av.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(av.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(av.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(av.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AgentVersions is a parsable slice of AgentVersion.
+type AgentVersions []*AgentVersion
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentversion"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentVersionCreate is the builder for creating a AgentVersion entity.
+type AgentVersionCreate struct {
+	config
+	mutation *AgentVersionMutation
+	hooks    []Hook
+}
+
+// SetAgentID sets the "agent_id" field.
+func (avc *AgentVersionCreate) SetAgentID(s string) *AgentVersionCreate {
+	avc.mutation.SetAgentID(s)
+	return avc
+}
+
+// SetVersion sets the "version" field.
+func (avc *AgentVersionCreate) SetVersion(s string) *AgentVersionCreate {
+	avc.mutation.SetVersion(s)
+	return avc
+}
+
+// SetName sets the "name" field.
+func (avc *AgentVersionCreate) SetName(s string) *AgentVersionCreate {
+	avc.mutation.SetName(s)
+	return avc
+}
+
+// SetDescription sets the "description" field.
+func (avc *AgentVersionCreate) SetDescription(s string) *AgentVersionCreate {
+	avc.mutation.SetDescription(s)
+	return avc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (avc *AgentVersionCreate) SetNillableDescription(s *string) *AgentVersionCreate {
+	if s != nil {
+		avc.SetDescription(*s)
+	}
+	return avc
+}
+
+// SetType sets the "type" field.
+func (avc *AgentVersionCreate) SetType(s string) *AgentVersionCreate {
+	avc.mutation.SetType(s)
+	return avc
+}
+
+// SetModelConfig sets the "model_config" field.
+func (avc *AgentVersionCreate) SetModelConfig(m map[string]interface{}) *AgentVersionCreate {
+	avc.mutation.SetModelConfig(m)
+	return avc
+}
+
+// SetTools sets the "tools" field.
+func (avc *AgentVersionCreate) SetTools(s []string) *AgentVersionCreate {
+	avc.mutation.SetTools(s)
+	return avc
+}
+
+// SetKnowledgeBases sets the "knowledge_bases" field.
+func (avc *AgentVersionCreate) SetKnowledgeBases(s []string) *AgentVersionCreate {
+	avc.mutation.SetKnowledgeBases(s)
+	return avc
+}
+
+// SetMembers sets the "members" field.
+func (avc *AgentVersionCreate) SetMembers(s []string) *AgentVersionCreate {
+	avc.mutation.SetMembers(s)
+	return avc
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (avc *AgentVersionCreate) SetPromptTemplate(s string) *AgentVersionCreate {
+	avc.mutation.SetPromptTemplate(s)
+	return avc
+}
+
+// SetNillablePromptTemplate sets the "prompt_template" field if the given value is not nil.
+func (avc *AgentVersionCreate) SetNillablePromptTemplate(s *string) *AgentVersionCreate {
+	if s != nil {
+		avc.SetPromptTemplate(*s)
+	}
+	return avc
+}
+
+// SetParameters sets the "parameters" field.
+func (avc *AgentVersionCreate) SetParameters(m map[string]interface{}) *AgentVersionCreate {
+	avc.mutation.SetParameters(m)
+	return avc
+}
+
+// SetNotes sets the "notes" field.
+func (avc *AgentVersionCreate) SetNotes(s string) *AgentVersionCreate {
+	avc.mutation.SetNotes(s)
+	return avc
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (avc *AgentVersionCreate) SetNillableNotes(s *string) *AgentVersionCreate {
+	if s != nil {
+		avc.SetNotes(*s)
+	}
+	return avc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (avc *AgentVersionCreate) SetCreatedBy(s string) *AgentVersionCreate {
+	avc.mutation.SetCreatedBy(s)
+	return avc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (avc *AgentVersionCreate) SetCreatedAt(t time.Time) *AgentVersionCreate {
+	avc.mutation.SetCreatedAt(t)
+	return avc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (avc *AgentVersionCreate) SetNillableCreatedAt(t *time.Time) *AgentVersionCreate {
+	if t != nil {
+		avc.SetCreatedAt(*t)
+	}
+	return avc
+}
+
+// SetID sets the "id" field.
+func (avc *AgentVersionCreate) SetID(s string) *AgentVersionCreate {
+	avc.mutation.SetID(s)
+	return avc
+}
+
+// Mutation returns the AgentVersionMutation object of the builder.
+func (avc *AgentVersionCreate) Mutation() *AgentVersionMutation {
+	return avc.mutation
+}
+
+// Save creates the AgentVersion in the database.
+func (avc *AgentVersionCreate) Save(ctx context.Context) (*AgentVersion, error) {
+	avc.defaults()
+	return withHooks(ctx, avc.sqlSave, avc.mutation, avc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (avc *AgentVersionCreate) SaveX(ctx context.Context) *AgentVersion {
+	v, err := avc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (avc *AgentVersionCreate) Exec(ctx context.Context) error {
+	_, err := avc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avc *AgentVersionCreate) ExecX(ctx context.Context) {
+	if err := avc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (avc *AgentVersionCreate) defaults() {
+	if _, ok := avc.mutation.CreatedAt(); !ok {
+		v := agentversion.DefaultCreatedAt()
+		avc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (avc *AgentVersionCreate) check() error {
+	if _, ok := avc.mutation.AgentID(); !ok {
+		return &ValidationError{Name: "agent_id", err: errors.New(`ent: missing required field "AgentVersion.agent_id"`)}
+	}
+	if v, ok := avc.mutation.AgentID(); ok {
+		if err := agentversion.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "AgentVersion.agent_id": %w`, err)}
+		}
+	}
+	if _, ok := avc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "AgentVersion.version"`)}
+	}
+	if v, ok := avc.mutation.Version(); ok {
+		if err := agentversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "AgentVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := avc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AgentVersion.name"`)}
+	}
+	if v, ok := avc.mutation.Name(); ok {
+		if err := agentversion.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AgentVersion.name": %w`, err)}
+		}
+	}
+	if _, ok := avc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AgentVersion.type"`)}
+	}
+	if _, ok := avc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "AgentVersion.created_by"`)}
+	}
+	if v, ok := avc.mutation.CreatedBy(); ok {
+		if err := agentversion.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "AgentVersion.created_by": %w`, err)}
+		}
+	}
+	if _, ok := avc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AgentVersion.created_at"`)}
+	}
+	return nil
+}
+
+func (avc *AgentVersionCreate) sqlSave(ctx context.Context) (*AgentVersion, error) {
+	if err := avc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := avc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, avc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AgentVersion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	avc.mutation.id = &_node.ID
+	avc.mutation.done = true
+	return _node, nil
+}
+
+func (avc *AgentVersionCreate) createSpec() (*AgentVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentVersion{config: avc.config}
+		_spec = sqlgraph.NewCreateSpec(agentversion.Table, sqlgraph.NewFieldSpec(agentversion.FieldID, field.TypeString))
+	)
+	if id, ok := avc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := avc.mutation.AgentID(); ok {
+		_spec.SetField(agentversion.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := avc.mutation.Version(); ok {
+		_spec.SetField(agentversion.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := avc.mutation.Name(); ok {
+		_spec.SetField(agentversion.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := avc.mutation.Description(); ok {
+		_spec.SetField(agentversion.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := avc.mutation.GetType(); ok {
+		_spec.SetField(agentversion.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := avc.mutation.ModelConfig(); ok {
+		_spec.SetField(agentversion.FieldModelConfig, field.TypeJSON, value)
+		_node.ModelConfig = value
+	}
+	if value, ok := avc.mutation.Tools(); ok {
+		_spec.SetField(agentversion.FieldTools, field.TypeJSON, value)
+		_node.Tools = value
+	}
+	if value, ok := avc.mutation.KnowledgeBases(); ok {
+		_spec.SetField(agentversion.FieldKnowledgeBases, field.TypeJSON, value)
+		_node.KnowledgeBases = value
+	}
+	if value, ok := avc.mutation.Members(); ok {
+		_spec.SetField(agentversion.FieldMembers, field.TypeJSON, value)
+		_node.Members = value
+	}
+	if value, ok := avc.mutation.PromptTemplate(); ok {
+		_spec.SetField(agentversion.FieldPromptTemplate, field.TypeString, value)
+		_node.PromptTemplate = value
+	}
+	if value, ok := avc.mutation.Parameters(); ok {
+		_spec.SetField(agentversion.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := avc.mutation.Notes(); ok {
+		_spec.SetField(agentversion.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := avc.mutation.CreatedBy(); ok {
+		_spec.SetField(agentversion.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := avc.mutation.CreatedAt(); ok {
+		_spec.SetField(agentversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AgentVersionCreateBulk is the builder for creating many AgentVersion entities in bulk.
+type AgentVersionCreateBulk struct {
+	config
+	err      error
+	builders []*AgentVersionCreate
+}
+
+// Save creates the AgentVersion entities in the database.
+func (avcb *AgentVersionCreateBulk) Save(ctx context.Context) ([]*AgentVersion, error) {
+	if avcb.err != nil {
+		return nil, avcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(avcb.builders))
+	nodes := make([]*AgentVersion, len(avcb.builders))
+	mutators := make([]Mutator, len(avcb.builders))
+	for i := range avcb.builders {
+		func(i int, root context.Context) {
+			builder := avcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, avcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, avcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, avcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (avcb *AgentVersionCreateBulk) SaveX(ctx context.Context) []*AgentVersion {
+	v, err := avcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (avcb *AgentVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := avcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avcb *AgentVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := avcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
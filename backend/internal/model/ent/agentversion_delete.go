@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentversion"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentVersionDelete is the builder for deleting a AgentVersion entity.
+type AgentVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *AgentVersionMutation
+}
+
+// Where appends a list predicates to the AgentVersionDelete builder.
+func (avd *AgentVersionDelete) Where(ps ...predicate.AgentVersion) *AgentVersionDelete {
+	avd.mutation.Where(ps...)
+	return avd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (avd *AgentVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, avd.sqlExec, avd.mutation, avd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avd *AgentVersionDelete) ExecX(ctx context.Context) int {
+	n, err := avd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (avd *AgentVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(agentversion.Table, sqlgraph.NewFieldSpec(agentversion.FieldID, field.TypeString))
+	if ps := avd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, avd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	avd.mutation.done = true
+	return affected, err
+}
+
+// AgentVersionDeleteOne is the builder for deleting a single AgentVersion entity.
+type AgentVersionDeleteOne struct {
+	avd *AgentVersionDelete
+}
+
+// Where appends a list predicates to the AgentVersionDelete builder.
+func (avdo *AgentVersionDeleteOne) Where(ps ...predicate.AgentVersion) *AgentVersionDeleteOne {
+	avdo.avd.mutation.Where(ps...)
+	return avdo
+}
+
+// Exec executes the deletion query.
+func (avdo *AgentVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := avdo.avd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{agentversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avdo *AgentVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := avdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
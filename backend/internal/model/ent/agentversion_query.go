@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentversion"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentVersionQuery is the builder for querying AgentVersion entities.
+type AgentVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []agentversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AgentVersion
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AgentVersionQuery builder.
+func (avq *AgentVersionQuery) Where(ps ...predicate.AgentVersion) *AgentVersionQuery {
+	avq.predicates = append(avq.predicates, ps...)
+	return avq
+}
+
+// Limit the number of records to be returned by this query.
+func (avq *AgentVersionQuery) Limit(limit int) *AgentVersionQuery {
+	avq.ctx.Limit = &limit
+	return avq
+}
+
+// Offset to start from.
+func (avq *AgentVersionQuery) Offset(offset int) *AgentVersionQuery {
+	avq.ctx.Offset = &offset
+	return avq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (avq *AgentVersionQuery) Unique(unique bool) *AgentVersionQuery {
+	avq.ctx.Unique = &unique
+	return avq
+}
+
+// Order specifies how the records should be ordered.
+func (avq *AgentVersionQuery) Order(o ...agentversion.OrderOption) *AgentVersionQuery {
+	avq.order = append(avq.order, o...)
+	return avq
+}
+
+// First returns the first AgentVersion entity from the query.
+// Returns a *NotFoundError when no AgentVersion was found.
+func (avq *AgentVersionQuery) First(ctx context.Context) (*AgentVersion, error) {
+	nodes, err := avq.Limit(1).All(setContextOp(ctx, avq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{agentversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (avq *AgentVersionQuery) FirstX(ctx context.Context) *AgentVersion {
+	node, err := avq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AgentVersion ID from the query.
+// Returns a *NotFoundError when no AgentVersion ID was found.
+func (avq *AgentVersionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = avq.Limit(1).IDs(setContextOp(ctx, avq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{agentversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (avq *AgentVersionQuery) FirstIDX(ctx context.Context) string {
+	id, err := avq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AgentVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AgentVersion entity is found.
+// Returns a *NotFoundError when no AgentVersion entities are found.
+func (avq *AgentVersionQuery) Only(ctx context.Context) (*AgentVersion, error) {
+	nodes, err := avq.Limit(2).All(setContextOp(ctx, avq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{agentversion.Label}
+	default:
+		return nil, &NotSingularError{agentversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (avq *AgentVersionQuery) OnlyX(ctx context.Context) *AgentVersion {
+	node, err := avq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AgentVersion ID in the query.
+// Returns a *NotSingularError when more than one AgentVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (avq *AgentVersionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = avq.Limit(2).IDs(setContextOp(ctx, avq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{agentversion.Label}
+	default:
+		err = &NotSingularError{agentversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (avq *AgentVersionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := avq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AgentVersions.
+func (avq *AgentVersionQuery) All(ctx context.Context) ([]*AgentVersion, error) {
+	ctx = setContextOp(ctx, avq.ctx, "All")
+	if err := avq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AgentVersion, *AgentVersionQuery]()
+	return withInterceptors[[]*AgentVersion](ctx, avq, qr, avq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (avq *AgentVersionQuery) AllX(ctx context.Context) []*AgentVersion {
+	nodes, err := avq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AgentVersion IDs.
+func (avq *AgentVersionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if avq.ctx.Unique == nil && avq.path != nil {
+		avq.Unique(true)
+	}
+	ctx = setContextOp(ctx, avq.ctx, "IDs")
+	if err = avq.Select(agentversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (avq *AgentVersionQuery) IDsX(ctx context.Context) []string {
+	ids, err := avq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (avq *AgentVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, avq.ctx, "Count")
+	if err := avq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, avq, querierCount[*AgentVersionQuery](), avq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (avq *AgentVersionQuery) CountX(ctx context.Context) int {
+	count, err := avq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (avq *AgentVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, avq.ctx, "Exist")
+	switch _, err := avq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (avq *AgentVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := avq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AgentVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (avq *AgentVersionQuery) Clone() *AgentVersionQuery {
+	if avq == nil {
+		return nil
+	}
+	return &AgentVersionQuery{
+		config:     avq.config,
+		ctx:        avq.ctx.Clone(),
+		order:      append([]agentversion.OrderOption{}, avq.order...),
+		inters:     append([]Interceptor{}, avq.inters...),
+		predicates: append([]predicate.AgentVersion{}, avq.predicates...),
+		// clone intermediate query.
+		sql:  avq.sql.Clone(),
+		path: avq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AgentID string `json:"agent_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AgentVersion.Query().
+//		GroupBy(agentversion.FieldAgentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (avq *AgentVersionQuery) GroupBy(field string, fields ...string) *AgentVersionGroupBy {
+	avq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AgentVersionGroupBy{build: avq}
+	grbuild.flds = &avq.ctx.Fields
+	grbuild.label = agentversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AgentID string `json:"agent_id,omitempty"`
+//	}
+//
+//	client.AgentVersion.Query().
+//		Select(agentversion.FieldAgentID).
+//		Scan(ctx, &v)
+func (avq *AgentVersionQuery) Select(fields ...string) *AgentVersionSelect {
+	avq.ctx.Fields = append(avq.ctx.Fields, fields...)
+	sbuild := &AgentVersionSelect{AgentVersionQuery: avq}
+	sbuild.label = agentversion.Label
+	sbuild.flds, sbuild.scan = &avq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AgentVersionSelect configured with the given aggregations.
+func (avq *AgentVersionQuery) Aggregate(fns ...AggregateFunc) *AgentVersionSelect {
+	return avq.Select().Aggregate(fns...)
+}
+
+func (avq *AgentVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range avq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, avq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range avq.ctx.Fields {
+		if !agentversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if avq.path != nil {
+		prev, err := avq.path(ctx)
+		if err != nil {
+			return err
+		}
+		avq.sql = prev
+	}
+	return nil
+}
+
+func (avq *AgentVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AgentVersion, error) {
+	var (
+		nodes = []*AgentVersion{}
+		_spec = avq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AgentVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AgentVersion{config: avq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, avq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (avq *AgentVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := avq.querySpec()
+	_spec.Node.Columns = avq.ctx.Fields
+	if len(avq.ctx.Fields) > 0 {
+		_spec.Unique = avq.ctx.Unique != nil && *avq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, avq.driver, _spec)
+}
+
+func (avq *AgentVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(agentversion.Table, agentversion.Columns, sqlgraph.NewFieldSpec(agentversion.FieldID, field.TypeString))
+	_spec.From = avq.sql
+	if unique := avq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if avq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := avq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentversion.FieldID)
+		for i := range fields {
+			if fields[i] != agentversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := avq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := avq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := avq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := avq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (avq *AgentVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(avq.driver.Dialect())
+	t1 := builder.Table(agentversion.Table)
+	columns := avq.ctx.Fields
+	if len(columns) == 0 {
+		columns = agentversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if avq.sql != nil {
+		selector = avq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if avq.ctx.Unique != nil && *avq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range avq.predicates {
+		p(selector)
+	}
+	for _, p := range avq.order {
+		p(selector)
+	}
+	if offset := avq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := avq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AgentVersionGroupBy is the group-by builder for AgentVersion entities.
+type AgentVersionGroupBy struct {
+	selector
+	build *AgentVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (avgb *AgentVersionGroupBy) Aggregate(fns ...AggregateFunc) *AgentVersionGroupBy {
+	avgb.fns = append(avgb.fns, fns...)
+	return avgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (avgb *AgentVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, avgb.build.ctx, "GroupBy")
+	if err := avgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AgentVersionQuery, *AgentVersionGroupBy](ctx, avgb.build, avgb, avgb.build.inters, v)
+}
+
+func (avgb *AgentVersionGroupBy) sqlScan(ctx context.Context, root *AgentVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(avgb.fns))
+	for _, fn := range avgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*avgb.flds)+len(avgb.fns))
+		for _, f := range *avgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*avgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := avgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AgentVersionSelect is the builder for selecting fields of AgentVersion entities.
+type AgentVersionSelect struct {
+	*AgentVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (avs *AgentVersionSelect) Aggregate(fns ...AggregateFunc) *AgentVersionSelect {
+	avs.fns = append(avs.fns, fns...)
+	return avs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (avs *AgentVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, avs.ctx, "Select")
+	if err := avs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AgentVersionQuery, *AgentVersionSelect](ctx, avs.AgentVersionQuery, avs, avs.inters, v)
+}
+
+func (avs *AgentVersionSelect) sqlScan(ctx context.Context, root *AgentVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(avs.fns))
+	for _, fn := range avs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*avs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := avs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/agentversion"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentVersionUpdate is the builder for updating AgentVersion entities.
+type AgentVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AgentVersionMutation
+}
+
+// Where appends a list predicates to the AgentVersionUpdate builder.
+func (avu *AgentVersionUpdate) Where(ps ...predicate.AgentVersion) *AgentVersionUpdate {
+	avu.mutation.Where(ps...)
+	return avu
+}
+
+// Mutation returns the AgentVersionMutation object of the builder.
+func (avu *AgentVersionUpdate) Mutation() *AgentVersionMutation {
+	return avu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (avu *AgentVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, avu.sqlSave, avu.mutation, avu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (avu *AgentVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := avu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (avu *AgentVersionUpdate) Exec(ctx context.Context) error {
+	_, err := avu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avu *AgentVersionUpdate) ExecX(ctx context.Context) {
+	if err := avu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (avu *AgentVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(agentversion.Table, agentversion.Columns, sqlgraph.NewFieldSpec(agentversion.FieldID, field.TypeString))
+	if ps := avu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if avu.mutation.DescriptionCleared() {
+		_spec.ClearField(agentversion.FieldDescription, field.TypeString)
+	}
+	if avu.mutation.ModelConfigCleared() {
+		_spec.ClearField(agentversion.FieldModelConfig, field.TypeJSON)
+	}
+	if avu.mutation.ToolsCleared() {
+		_spec.ClearField(agentversion.FieldTools, field.TypeJSON)
+	}
+	if avu.mutation.KnowledgeBasesCleared() {
+		_spec.ClearField(agentversion.FieldKnowledgeBases, field.TypeJSON)
+	}
+	if avu.mutation.MembersCleared() {
+		_spec.ClearField(agentversion.FieldMembers, field.TypeJSON)
+	}
+	if avu.mutation.PromptTemplateCleared() {
+		_spec.ClearField(agentversion.FieldPromptTemplate, field.TypeString)
+	}
+	if avu.mutation.ParametersCleared() {
+		_spec.ClearField(agentversion.FieldParameters, field.TypeJSON)
+	}
+	if avu.mutation.NotesCleared() {
+		_spec.ClearField(agentversion.FieldNotes, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, avu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	avu.mutation.done = true
+	return n, nil
+}
+
+// AgentVersionUpdateOne is the builder for updating a single AgentVersion entity.
+type AgentVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AgentVersionMutation
+}
+
+// Mutation returns the AgentVersionMutation object of the builder.
+func (avuo *AgentVersionUpdateOne) Mutation() *AgentVersionMutation {
+	return avuo.mutation
+}
+
+// Where appends a list predicates to the AgentVersionUpdate builder.
+func (avuo *AgentVersionUpdateOne) Where(ps ...predicate.AgentVersion) *AgentVersionUpdateOne {
+	avuo.mutation.Where(ps...)
+	return avuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (avuo *AgentVersionUpdateOne) Select(field string, fields ...string) *AgentVersionUpdateOne {
+	avuo.fields = append([]string{field}, fields...)
+	return avuo
+}
+
+// Save executes the query and returns the updated AgentVersion entity.
+func (avuo *AgentVersionUpdateOne) Save(ctx context.Context) (*AgentVersion, error) {
+	return withHooks(ctx, avuo.sqlSave, avuo.mutation, avuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (avuo *AgentVersionUpdateOne) SaveX(ctx context.Context) *AgentVersion {
+	node, err := avuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (avuo *AgentVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := avuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (avuo *AgentVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := avuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (avuo *AgentVersionUpdateOne) sqlSave(ctx context.Context) (_node *AgentVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(agentversion.Table, agentversion.Columns, sqlgraph.NewFieldSpec(agentversion.FieldID, field.TypeString))
+	id, ok := avuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := avuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentversion.FieldID)
+		for _, f := range fields {
+			if !agentversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := avuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if avuo.mutation.DescriptionCleared() {
+		_spec.ClearField(agentversion.FieldDescription, field.TypeString)
+	}
+	if avuo.mutation.ModelConfigCleared() {
+		_spec.ClearField(agentversion.FieldModelConfig, field.TypeJSON)
+	}
+	if avuo.mutation.ToolsCleared() {
+		_spec.ClearField(agentversion.FieldTools, field.TypeJSON)
+	}
+	if avuo.mutation.KnowledgeBasesCleared() {
+		_spec.ClearField(agentversion.FieldKnowledgeBases, field.TypeJSON)
+	}
+	if avuo.mutation.MembersCleared() {
+		_spec.ClearField(agentversion.FieldMembers, field.TypeJSON)
+	}
+	if avuo.mutation.PromptTemplateCleared() {
+		_spec.ClearField(agentversion.FieldPromptTemplate, field.TypeString)
+	}
+	if avuo.mutation.ParametersCleared() {
+		_spec.ClearField(agentversion.FieldParameters, field.TypeJSON)
+	}
+	if avuo.mutation.NotesCleared() {
+		_spec.ClearField(agentversion.FieldNotes, field.TypeString)
+	}
+	_node = &AgentVersion{config: avuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, avuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	avuo.mutation.done = true
+	return _node, nil
+}
@@ -12,9 +12,15 @@ import (
 	"agent-platform/internal/model/ent/migrate"
 
 	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/agentversion"
 	"agent-platform/internal/model/ent/conversation"
 	"agent-platform/internal/model/ent/documentchunk"
 	"agent-platform/internal/model/ent/knowledgebase"
+	"agent-platform/internal/model/ent/memory"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
 	"agent-platform/internal/model/ent/tool"
 	"agent-platform/internal/model/ent/user"
 	"agent-platform/internal/model/ent/workflow"
@@ -23,6 +29,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,12 +39,24 @@ type Client struct {
 	Schema *migrate.Schema
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AgentFolder is the client for interacting with the AgentFolder builders.
+	AgentFolder *AgentFolderClient
+	// AgentShare is the client for interacting with the AgentShare builders.
+	AgentShare *AgentShareClient
+	// AgentVersion is the client for interacting with the AgentVersion builders.
+	AgentVersion *AgentVersionClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
 	// DocumentChunk is the client for interacting with the DocumentChunk builders.
 	DocumentChunk *DocumentChunkClient
 	// KnowledgeBase is the client for interacting with the KnowledgeBase builders.
 	KnowledgeBase *KnowledgeBaseClient
+	// Memory is the client for interacting with the Memory builders.
+	Memory *MemoryClient
+	// Message is the client for interacting with the Message builders.
+	Message *MessageClient
+	// MessageFeedback is the client for interacting with the MessageFeedback builders.
+	MessageFeedback *MessageFeedbackClient
 	// Tool is the client for interacting with the Tool builders.
 	Tool *ToolClient
 	// User is the client for interacting with the User builders.
@@ -58,9 +77,15 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Agent = NewAgentClient(c.config)
+	c.AgentFolder = NewAgentFolderClient(c.config)
+	c.AgentShare = NewAgentShareClient(c.config)
+	c.AgentVersion = NewAgentVersionClient(c.config)
 	c.Conversation = NewConversationClient(c.config)
 	c.DocumentChunk = NewDocumentChunkClient(c.config)
 	c.KnowledgeBase = NewKnowledgeBaseClient(c.config)
+	c.Memory = NewMemoryClient(c.config)
+	c.Message = NewMessageClient(c.config)
+	c.MessageFeedback = NewMessageFeedbackClient(c.config)
 	c.Tool = NewToolClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Workflow = NewWorkflowClient(c.config)
@@ -158,9 +183,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:               ctx,
 		config:            cfg,
 		Agent:             NewAgentClient(cfg),
+		AgentFolder:       NewAgentFolderClient(cfg),
+		AgentShare:        NewAgentShareClient(cfg),
+		AgentVersion:      NewAgentVersionClient(cfg),
 		Conversation:      NewConversationClient(cfg),
 		DocumentChunk:     NewDocumentChunkClient(cfg),
 		KnowledgeBase:     NewKnowledgeBaseClient(cfg),
+		Memory:            NewMemoryClient(cfg),
+		Message:           NewMessageClient(cfg),
+		MessageFeedback:   NewMessageFeedbackClient(cfg),
 		Tool:              NewToolClient(cfg),
 		User:              NewUserClient(cfg),
 		Workflow:          NewWorkflowClient(cfg),
@@ -185,9 +216,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:               ctx,
 		config:            cfg,
 		Agent:             NewAgentClient(cfg),
+		AgentFolder:       NewAgentFolderClient(cfg),
+		AgentShare:        NewAgentShareClient(cfg),
+		AgentVersion:      NewAgentVersionClient(cfg),
 		Conversation:      NewConversationClient(cfg),
 		DocumentChunk:     NewDocumentChunkClient(cfg),
 		KnowledgeBase:     NewKnowledgeBaseClient(cfg),
+		Memory:            NewMemoryClient(cfg),
+		Message:           NewMessageClient(cfg),
+		MessageFeedback:   NewMessageFeedbackClient(cfg),
 		Tool:              NewToolClient(cfg),
 		User:              NewUserClient(cfg),
 		Workflow:          NewWorkflowClient(cfg),
@@ -221,8 +258,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Agent, c.Conversation, c.DocumentChunk, c.KnowledgeBase, c.Tool, c.User,
-		c.Workflow, c.WorkflowExecution,
+		c.Agent, c.AgentFolder, c.AgentShare, c.AgentVersion, c.Conversation,
+		c.DocumentChunk, c.KnowledgeBase, c.Memory, c.Message, c.MessageFeedback,
+		c.Tool, c.User, c.Workflow, c.WorkflowExecution,
 	} {
 		n.Use(hooks...)
 	}
@@ -232,8 +270,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Agent, c.Conversation, c.DocumentChunk, c.KnowledgeBase, c.Tool, c.User,
-		c.Workflow, c.WorkflowExecution,
+		c.Agent, c.AgentFolder, c.AgentShare, c.AgentVersion, c.Conversation,
+		c.DocumentChunk, c.KnowledgeBase, c.Memory, c.Message, c.MessageFeedback,
+		c.Tool, c.User, c.Workflow, c.WorkflowExecution,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -244,12 +283,24 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AgentMutation:
 		return c.Agent.mutate(ctx, m)
+	case *AgentFolderMutation:
+		return c.AgentFolder.mutate(ctx, m)
+	case *AgentShareMutation:
+		return c.AgentShare.mutate(ctx, m)
+	case *AgentVersionMutation:
+		return c.AgentVersion.mutate(ctx, m)
 	case *ConversationMutation:
 		return c.Conversation.mutate(ctx, m)
 	case *DocumentChunkMutation:
 		return c.DocumentChunk.mutate(ctx, m)
 	case *KnowledgeBaseMutation:
 		return c.KnowledgeBase.mutate(ctx, m)
+	case *MemoryMutation:
+		return c.Memory.mutate(ctx, m)
+	case *MessageMutation:
+		return c.Message.mutate(ctx, m)
+	case *MessageFeedbackMutation:
+		return c.MessageFeedback.mutate(ctx, m)
 	case *ToolMutation:
 		return c.Tool.mutate(ctx, m)
 	case *UserMutation:
@@ -396,6 +447,405 @@ func (c *AgentClient) mutate(ctx context.Context, m *AgentMutation) (Value, erro
 	}
 }
 
+// AgentFolderClient is a client for the AgentFolder schema.
+type AgentFolderClient struct {
+	config
+}
+
+// NewAgentFolderClient returns a client for the AgentFolder from the given config.
+func NewAgentFolderClient(c config) *AgentFolderClient {
+	return &AgentFolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `agentfolder.Hooks(f(g(h())))`.
+func (c *AgentFolderClient) Use(hooks ...Hook) {
+	c.hooks.AgentFolder = append(c.hooks.AgentFolder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `agentfolder.Intercept(f(g(h())))`.
+func (c *AgentFolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AgentFolder = append(c.inters.AgentFolder, interceptors...)
+}
+
+// Create returns a builder for creating a AgentFolder entity.
+func (c *AgentFolderClient) Create() *AgentFolderCreate {
+	mutation := newAgentFolderMutation(c.config, OpCreate)
+	return &AgentFolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AgentFolder entities.
+func (c *AgentFolderClient) CreateBulk(builders ...*AgentFolderCreate) *AgentFolderCreateBulk {
+	return &AgentFolderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AgentFolderClient) MapCreateBulk(slice any, setFunc func(*AgentFolderCreate, int)) *AgentFolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AgentFolderCreateBulk{err: fmt.Errorf("calling to AgentFolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AgentFolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AgentFolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AgentFolder.
+func (c *AgentFolderClient) Update() *AgentFolderUpdate {
+	mutation := newAgentFolderMutation(c.config, OpUpdate)
+	return &AgentFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AgentFolderClient) UpdateOne(af *AgentFolder) *AgentFolderUpdateOne {
+	mutation := newAgentFolderMutation(c.config, OpUpdateOne, withAgentFolder(af))
+	return &AgentFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AgentFolderClient) UpdateOneID(id string) *AgentFolderUpdateOne {
+	mutation := newAgentFolderMutation(c.config, OpUpdateOne, withAgentFolderID(id))
+	return &AgentFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AgentFolder.
+func (c *AgentFolderClient) Delete() *AgentFolderDelete {
+	mutation := newAgentFolderMutation(c.config, OpDelete)
+	return &AgentFolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AgentFolderClient) DeleteOne(af *AgentFolder) *AgentFolderDeleteOne {
+	return c.DeleteOneID(af.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AgentFolderClient) DeleteOneID(id string) *AgentFolderDeleteOne {
+	builder := c.Delete().Where(agentfolder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AgentFolderDeleteOne{builder}
+}
+
+// Query returns a query builder for AgentFolder.
+func (c *AgentFolderClient) Query() *AgentFolderQuery {
+	return &AgentFolderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAgentFolder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AgentFolder entity by its id.
+func (c *AgentFolderClient) Get(ctx context.Context, id string) (*AgentFolder, error) {
+	return c.Query().Where(agentfolder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AgentFolderClient) GetX(ctx context.Context, id string) *AgentFolder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AgentFolderClient) Hooks() []Hook {
+	return c.hooks.AgentFolder
+}
+
+// Interceptors returns the client interceptors.
+func (c *AgentFolderClient) Interceptors() []Interceptor {
+	return c.inters.AgentFolder
+}
+
+func (c *AgentFolderClient) mutate(ctx context.Context, m *AgentFolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AgentFolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AgentFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AgentFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AgentFolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AgentFolder mutation op: %q", m.Op())
+	}
+}
+
+// AgentShareClient is a client for the AgentShare schema.
+type AgentShareClient struct {
+	config
+}
+
+// NewAgentShareClient returns a client for the AgentShare from the given config.
+func NewAgentShareClient(c config) *AgentShareClient {
+	return &AgentShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `agentshare.Hooks(f(g(h())))`.
+func (c *AgentShareClient) Use(hooks ...Hook) {
+	c.hooks.AgentShare = append(c.hooks.AgentShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `agentshare.Intercept(f(g(h())))`.
+func (c *AgentShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AgentShare = append(c.inters.AgentShare, interceptors...)
+}
+
+// Create returns a builder for creating a AgentShare entity.
+func (c *AgentShareClient) Create() *AgentShareCreate {
+	mutation := newAgentShareMutation(c.config, OpCreate)
+	return &AgentShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AgentShare entities.
+func (c *AgentShareClient) CreateBulk(builders ...*AgentShareCreate) *AgentShareCreateBulk {
+	return &AgentShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AgentShareClient) MapCreateBulk(slice any, setFunc func(*AgentShareCreate, int)) *AgentShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AgentShareCreateBulk{err: fmt.Errorf("calling to AgentShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AgentShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AgentShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AgentShare.
+func (c *AgentShareClient) Update() *AgentShareUpdate {
+	mutation := newAgentShareMutation(c.config, OpUpdate)
+	return &AgentShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AgentShareClient) UpdateOne(as *AgentShare) *AgentShareUpdateOne {
+	mutation := newAgentShareMutation(c.config, OpUpdateOne, withAgentShare(as))
+	return &AgentShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AgentShareClient) UpdateOneID(id string) *AgentShareUpdateOne {
+	mutation := newAgentShareMutation(c.config, OpUpdateOne, withAgentShareID(id))
+	return &AgentShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AgentShare.
+func (c *AgentShareClient) Delete() *AgentShareDelete {
+	mutation := newAgentShareMutation(c.config, OpDelete)
+	return &AgentShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AgentShareClient) DeleteOne(as *AgentShare) *AgentShareDeleteOne {
+	return c.DeleteOneID(as.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AgentShareClient) DeleteOneID(id string) *AgentShareDeleteOne {
+	builder := c.Delete().Where(agentshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AgentShareDeleteOne{builder}
+}
+
+// Query returns a query builder for AgentShare.
+func (c *AgentShareClient) Query() *AgentShareQuery {
+	return &AgentShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAgentShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AgentShare entity by its id.
+func (c *AgentShareClient) Get(ctx context.Context, id string) (*AgentShare, error) {
+	return c.Query().Where(agentshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AgentShareClient) GetX(ctx context.Context, id string) *AgentShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AgentShareClient) Hooks() []Hook {
+	return c.hooks.AgentShare
+}
+
+// Interceptors returns the client interceptors.
+func (c *AgentShareClient) Interceptors() []Interceptor {
+	return c.inters.AgentShare
+}
+
+func (c *AgentShareClient) mutate(ctx context.Context, m *AgentShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AgentShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AgentShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AgentShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AgentShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AgentShare mutation op: %q", m.Op())
+	}
+}
+
+// AgentVersionClient is a client for the AgentVersion schema.
+type AgentVersionClient struct {
+	config
+}
+
+// NewAgentVersionClient returns a client for the AgentVersion from the given config.
+func NewAgentVersionClient(c config) *AgentVersionClient {
+	return &AgentVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `agentversion.Hooks(f(g(h())))`.
+func (c *AgentVersionClient) Use(hooks ...Hook) {
+	c.hooks.AgentVersion = append(c.hooks.AgentVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `agentversion.Intercept(f(g(h())))`.
+func (c *AgentVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AgentVersion = append(c.inters.AgentVersion, interceptors...)
+}
+
+// Create returns a builder for creating a AgentVersion entity.
+func (c *AgentVersionClient) Create() *AgentVersionCreate {
+	mutation := newAgentVersionMutation(c.config, OpCreate)
+	return &AgentVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AgentVersion entities.
+func (c *AgentVersionClient) CreateBulk(builders ...*AgentVersionCreate) *AgentVersionCreateBulk {
+	return &AgentVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AgentVersionClient) MapCreateBulk(slice any, setFunc func(*AgentVersionCreate, int)) *AgentVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AgentVersionCreateBulk{err: fmt.Errorf("calling to AgentVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AgentVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AgentVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AgentVersion.
+func (c *AgentVersionClient) Update() *AgentVersionUpdate {
+	mutation := newAgentVersionMutation(c.config, OpUpdate)
+	return &AgentVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AgentVersionClient) UpdateOne(av *AgentVersion) *AgentVersionUpdateOne {
+	mutation := newAgentVersionMutation(c.config, OpUpdateOne, withAgentVersion(av))
+	return &AgentVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AgentVersionClient) UpdateOneID(id string) *AgentVersionUpdateOne {
+	mutation := newAgentVersionMutation(c.config, OpUpdateOne, withAgentVersionID(id))
+	return &AgentVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AgentVersion.
+func (c *AgentVersionClient) Delete() *AgentVersionDelete {
+	mutation := newAgentVersionMutation(c.config, OpDelete)
+	return &AgentVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AgentVersionClient) DeleteOne(av *AgentVersion) *AgentVersionDeleteOne {
+	return c.DeleteOneID(av.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AgentVersionClient) DeleteOneID(id string) *AgentVersionDeleteOne {
+	builder := c.Delete().Where(agentversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AgentVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for AgentVersion.
+func (c *AgentVersionClient) Query() *AgentVersionQuery {
+	return &AgentVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAgentVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AgentVersion entity by its id.
+func (c *AgentVersionClient) Get(ctx context.Context, id string) (*AgentVersion, error) {
+	return c.Query().Where(agentversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AgentVersionClient) GetX(ctx context.Context, id string) *AgentVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AgentVersionClient) Hooks() []Hook {
+	return c.hooks.AgentVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *AgentVersionClient) Interceptors() []Interceptor {
+	return c.inters.AgentVersion
+}
+
+func (c *AgentVersionClient) mutate(ctx context.Context, m *AgentVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AgentVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AgentVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AgentVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AgentVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AgentVersion mutation op: %q", m.Op())
+	}
+}
+
 // ConversationClient is a client for the Conversation schema.
 type ConversationClient struct {
 	config
@@ -504,6 +954,22 @@ func (c *ConversationClient) GetX(ctx context.Context, id string) *Conversation
 	return obj
 }
 
+// QueryMessages queries the messages edge of a Conversation.
+func (c *ConversationClient) QueryMessages(co *Conversation) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversation.Table, conversation.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, conversation.MessagesTable, conversation.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ConversationClient) Hooks() []Hook {
 	return c.hooks.Conversation
@@ -795,6 +1261,453 @@ func (c *KnowledgeBaseClient) mutate(ctx context.Context, m *KnowledgeBaseMutati
 	}
 }
 
+// MemoryClient is a client for the Memory schema.
+type MemoryClient struct {
+	config
+}
+
+// NewMemoryClient returns a client for the Memory from the given config.
+func NewMemoryClient(c config) *MemoryClient {
+	return &MemoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `memory.Hooks(f(g(h())))`.
+func (c *MemoryClient) Use(hooks ...Hook) {
+	c.hooks.Memory = append(c.hooks.Memory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `memory.Intercept(f(g(h())))`.
+func (c *MemoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Memory = append(c.inters.Memory, interceptors...)
+}
+
+// Create returns a builder for creating a Memory entity.
+func (c *MemoryClient) Create() *MemoryCreate {
+	mutation := newMemoryMutation(c.config, OpCreate)
+	return &MemoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Memory entities.
+func (c *MemoryClient) CreateBulk(builders ...*MemoryCreate) *MemoryCreateBulk {
+	return &MemoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MemoryClient) MapCreateBulk(slice any, setFunc func(*MemoryCreate, int)) *MemoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MemoryCreateBulk{err: fmt.Errorf("calling to MemoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MemoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MemoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Memory.
+func (c *MemoryClient) Update() *MemoryUpdate {
+	mutation := newMemoryMutation(c.config, OpUpdate)
+	return &MemoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MemoryClient) UpdateOne(m *Memory) *MemoryUpdateOne {
+	mutation := newMemoryMutation(c.config, OpUpdateOne, withMemory(m))
+	return &MemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MemoryClient) UpdateOneID(id string) *MemoryUpdateOne {
+	mutation := newMemoryMutation(c.config, OpUpdateOne, withMemoryID(id))
+	return &MemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Memory.
+func (c *MemoryClient) Delete() *MemoryDelete {
+	mutation := newMemoryMutation(c.config, OpDelete)
+	return &MemoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MemoryClient) DeleteOne(m *Memory) *MemoryDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MemoryClient) DeleteOneID(id string) *MemoryDeleteOne {
+	builder := c.Delete().Where(memory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MemoryDeleteOne{builder}
+}
+
+// Query returns a query builder for Memory.
+func (c *MemoryClient) Query() *MemoryQuery {
+	return &MemoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMemory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Memory entity by its id.
+func (c *MemoryClient) Get(ctx context.Context, id string) (*Memory, error) {
+	return c.Query().Where(memory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MemoryClient) GetX(ctx context.Context, id string) *Memory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MemoryClient) Hooks() []Hook {
+	return c.hooks.Memory
+}
+
+// Interceptors returns the client interceptors.
+func (c *MemoryClient) Interceptors() []Interceptor {
+	return c.inters.Memory
+}
+
+func (c *MemoryClient) mutate(ctx context.Context, m *MemoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MemoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MemoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MemoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Memory mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id string) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id string) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id string) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConversation queries the conversation edge of a Message.
+func (c *MessageClient) QueryConversation(m *Message) *ConversationQuery {
+	query := (&ConversationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(conversation.Table, conversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ConversationTable, message.ConversationColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFeedback queries the feedback edge of a Message.
+func (c *MessageClient) QueryFeedback(m *Message) *MessageFeedbackQuery {
+	query := (&MessageFeedbackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagefeedback.Table, messagefeedback.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.FeedbackTable, message.FeedbackColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// MessageFeedbackClient is a client for the MessageFeedback schema.
+type MessageFeedbackClient struct {
+	config
+}
+
+// NewMessageFeedbackClient returns a client for the MessageFeedback from the given config.
+func NewMessageFeedbackClient(c config) *MessageFeedbackClient {
+	return &MessageFeedbackClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagefeedback.Hooks(f(g(h())))`.
+func (c *MessageFeedbackClient) Use(hooks ...Hook) {
+	c.hooks.MessageFeedback = append(c.hooks.MessageFeedback, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagefeedback.Intercept(f(g(h())))`.
+func (c *MessageFeedbackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageFeedback = append(c.inters.MessageFeedback, interceptors...)
+}
+
+// Create returns a builder for creating a MessageFeedback entity.
+func (c *MessageFeedbackClient) Create() *MessageFeedbackCreate {
+	mutation := newMessageFeedbackMutation(c.config, OpCreate)
+	return &MessageFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageFeedback entities.
+func (c *MessageFeedbackClient) CreateBulk(builders ...*MessageFeedbackCreate) *MessageFeedbackCreateBulk {
+	return &MessageFeedbackCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageFeedbackClient) MapCreateBulk(slice any, setFunc func(*MessageFeedbackCreate, int)) *MessageFeedbackCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageFeedbackCreateBulk{err: fmt.Errorf("calling to MessageFeedbackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageFeedbackCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageFeedbackCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageFeedback.
+func (c *MessageFeedbackClient) Update() *MessageFeedbackUpdate {
+	mutation := newMessageFeedbackMutation(c.config, OpUpdate)
+	return &MessageFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageFeedbackClient) UpdateOne(mf *MessageFeedback) *MessageFeedbackUpdateOne {
+	mutation := newMessageFeedbackMutation(c.config, OpUpdateOne, withMessageFeedback(mf))
+	return &MessageFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageFeedbackClient) UpdateOneID(id string) *MessageFeedbackUpdateOne {
+	mutation := newMessageFeedbackMutation(c.config, OpUpdateOne, withMessageFeedbackID(id))
+	return &MessageFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageFeedback.
+func (c *MessageFeedbackClient) Delete() *MessageFeedbackDelete {
+	mutation := newMessageFeedbackMutation(c.config, OpDelete)
+	return &MessageFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageFeedbackClient) DeleteOne(mf *MessageFeedback) *MessageFeedbackDeleteOne {
+	return c.DeleteOneID(mf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageFeedbackClient) DeleteOneID(id string) *MessageFeedbackDeleteOne {
+	builder := c.Delete().Where(messagefeedback.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageFeedbackDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageFeedback.
+func (c *MessageFeedbackClient) Query() *MessageFeedbackQuery {
+	return &MessageFeedbackQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageFeedback},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageFeedback entity by its id.
+func (c *MessageFeedbackClient) Get(ctx context.Context, id string) (*MessageFeedback, error) {
+	return c.Query().Where(messagefeedback.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageFeedbackClient) GetX(ctx context.Context, id string) *MessageFeedback {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageFeedback.
+func (c *MessageFeedbackClient) QueryMessage(mf *MessageFeedback) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mf.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagefeedback.Table, messagefeedback.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagefeedback.MessageTable, messagefeedback.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(mf.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageFeedbackClient) Hooks() []Hook {
+	return c.hooks.MessageFeedback
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageFeedbackClient) Interceptors() []Interceptor {
+	return c.inters.MessageFeedback
+}
+
+func (c *MessageFeedbackClient) mutate(ctx context.Context, m *MessageFeedbackMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageFeedbackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageFeedbackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageFeedbackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageFeedbackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageFeedback mutation op: %q", m.Op())
+	}
+}
+
 // ToolClient is a client for the Tool schema.
 type ToolClient struct {
 	config
@@ -1330,11 +2243,13 @@ func (c *WorkflowExecutionClient) mutate(ctx context.Context, m *WorkflowExecuti
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Agent, Conversation, DocumentChunk, KnowledgeBase, Tool, User, Workflow,
+		Agent, AgentFolder, AgentShare, AgentVersion, Conversation, DocumentChunk,
+		KnowledgeBase, Memory, Message, MessageFeedback, Tool, User, Workflow,
 		WorkflowExecution []ent.Hook
 	}
 	inters struct {
-		Agent, Conversation, DocumentChunk, KnowledgeBase, Tool, User, Workflow,
+		Agent, AgentFolder, AgentShare, AgentVersion, Conversation, DocumentChunk,
+		KnowledgeBase, Memory, Message, MessageFeedback, Tool, User, Workflow,
 		WorkflowExecution []ent.Interceptor
 	}
 )
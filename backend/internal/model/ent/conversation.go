@@ -24,8 +24,10 @@ type Conversation struct {
 	UserID string `json:"user_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
-	// Messages holds the value of the "messages" field.
-	Messages []interface{} `json:"messages,omitempty"`
+	// default, user or generated; only default titles are replaced by generated ones
+	TitleSource string `json:"title_source,omitempty"`
+	// Deprecated: messages are stored in the messages table, this column is only read to migrate old conversations
+	LegacyMessages []interface{} `json:"legacy_messages,omitempty"`
 	// Context holds the value of the "context" field.
 	Context map[string]interface{} `json:"context,omitempty"`
 	// Metadata holds the value of the "metadata" field.
@@ -38,7 +40,34 @@ type Conversation struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// LastMessageAt holds the value of the "last_message_at" field.
 	LastMessageAt time.Time `json:"last_message_at,omitempty"`
-	selectValues  sql.SelectValues
+	// Last message of the active branch, new messages reply to it
+	ActiveMessageID string `json:"active_message_id,omitempty"`
+	// Published agent version the conversation is pinned to, empty to follow the live agent
+	AgentVersionID string `json:"agent_version_id,omitempty"`
+	// Incremented by every change to the messages, active branch, title, status or context; background updates such as generated titles and summaries keep it
+	Version int64 `json:"version,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConversationQuery when eager-loading is set.
+	Edges        ConversationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ConversationEdges holds the relations/edges for other nodes in the graph.
+type ConversationEdges struct {
+	// Messages holds the value of the messages edge.
+	Messages []*Message `json:"messages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConversationEdges) MessagesOrErr() ([]*Message, error) {
+	if e.loadedTypes[0] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -46,9 +75,11 @@ func (*Conversation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case conversation.FieldMessages, conversation.FieldContext, conversation.FieldMetadata:
+		case conversation.FieldLegacyMessages, conversation.FieldContext, conversation.FieldMetadata:
 			values[i] = new([]byte)
-		case conversation.FieldID, conversation.FieldAgentID, conversation.FieldUserID, conversation.FieldTitle, conversation.FieldStatus:
+		case conversation.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case conversation.FieldID, conversation.FieldAgentID, conversation.FieldUserID, conversation.FieldTitle, conversation.FieldTitleSource, conversation.FieldStatus, conversation.FieldActiveMessageID, conversation.FieldAgentVersionID:
 			values[i] = new(sql.NullString)
 		case conversation.FieldCreatedAt, conversation.FieldUpdatedAt, conversation.FieldLastMessageAt:
 			values[i] = new(sql.NullTime)
@@ -91,12 +122,18 @@ func (c *Conversation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Title = value.String
 			}
-		case conversation.FieldMessages:
+		case conversation.FieldTitleSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_source", values[i])
+			} else if value.Valid {
+				c.TitleSource = value.String
+			}
+		case conversation.FieldLegacyMessages:
 			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field messages", values[i])
+				return fmt.Errorf("unexpected type %T for field legacy_messages", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &c.Messages); err != nil {
-					return fmt.Errorf("unmarshal field messages: %w", err)
+				if err := json.Unmarshal(*value, &c.LegacyMessages); err != nil {
+					return fmt.Errorf("unmarshal field legacy_messages: %w", err)
 				}
 			}
 		case conversation.FieldContext:
@@ -139,6 +176,24 @@ func (c *Conversation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.LastMessageAt = value.Time
 			}
+		case conversation.FieldActiveMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field active_message_id", values[i])
+			} else if value.Valid {
+				c.ActiveMessageID = value.String
+			}
+		case conversation.FieldAgentVersionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_version_id", values[i])
+			} else if value.Valid {
+				c.AgentVersionID = value.String
+			}
+		case conversation.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				c.Version = value.Int64
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -152,6 +207,11 @@ func (c *Conversation) Value(name string) (ent.Value, error) {
 	return c.selectValues.Get(name)
 }
 
+// QueryMessages queries the "messages" edge of the Conversation entity.
+func (c *Conversation) QueryMessages() *MessageQuery {
+	return NewConversationClient(c.config).QueryMessages(c)
+}
+
 // Update returns a builder for updating this Conversation.
 // Note that you need to call Conversation.Unwrap() before calling this method if this Conversation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -184,8 +244,11 @@ func (c *Conversation) String() string {
 	builder.WriteString("title=")
 	builder.WriteString(c.Title)
 	builder.WriteString(", ")
-	builder.WriteString("messages=")
-	builder.WriteString(fmt.Sprintf("%v", c.Messages))
+	builder.WriteString("title_source=")
+	builder.WriteString(c.TitleSource)
+	builder.WriteString(", ")
+	builder.WriteString("legacy_messages=")
+	builder.WriteString(fmt.Sprintf("%v", c.LegacyMessages))
 	builder.WriteString(", ")
 	builder.WriteString("context=")
 	builder.WriteString(fmt.Sprintf("%v", c.Context))
@@ -204,6 +267,15 @@ func (c *Conversation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_message_at=")
 	builder.WriteString(c.LastMessageAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("active_message_id=")
+	builder.WriteString(c.ActiveMessageID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_version_id=")
+	builder.WriteString(c.AgentVersionID)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", c.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
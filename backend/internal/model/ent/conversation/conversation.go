@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -19,8 +20,10 @@ const (
 	FieldUserID = "user_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
-	// FieldMessages holds the string denoting the messages field in the database.
-	FieldMessages = "messages"
+	// FieldTitleSource holds the string denoting the title_source field in the database.
+	FieldTitleSource = "title_source"
+	// FieldLegacyMessages holds the string denoting the legacy_messages field in the database.
+	FieldLegacyMessages = "messages"
 	// FieldContext holds the string denoting the context field in the database.
 	FieldContext = "context"
 	// FieldMetadata holds the string denoting the metadata field in the database.
@@ -33,8 +36,23 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldLastMessageAt holds the string denoting the last_message_at field in the database.
 	FieldLastMessageAt = "last_message_at"
+	// FieldActiveMessageID holds the string denoting the active_message_id field in the database.
+	FieldActiveMessageID = "active_message_id"
+	// FieldAgentVersionID holds the string denoting the agent_version_id field in the database.
+	FieldAgentVersionID = "agent_version_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// EdgeMessages holds the string denoting the messages edge name in mutations.
+	EdgeMessages = "messages"
 	// Table holds the table name of the conversation in the database.
 	Table = "conversations"
+	// MessagesTable is the table that holds the messages relation/edge.
+	MessagesTable = "messages"
+	// MessagesInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	MessagesInverseTable = "messages"
+	// MessagesColumn is the table column denoting the messages relation/edge.
+	MessagesColumn = "conversation_id"
 )
 
 // Columns holds all SQL columns for conversation fields.
@@ -43,13 +61,17 @@ var Columns = []string{
 	FieldAgentID,
 	FieldUserID,
 	FieldTitle,
-	FieldMessages,
+	FieldTitleSource,
+	FieldLegacyMessages,
 	FieldContext,
 	FieldMetadata,
 	FieldStatus,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldLastMessageAt,
+	FieldActiveMessageID,
+	FieldAgentVersionID,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +89,8 @@ var (
 	AgentIDValidator func(string) error
 	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	UserIDValidator func(string) error
+	// DefaultTitleSource holds the default value on creation for the "title_source" field.
+	DefaultTitleSource string
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -75,6 +99,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
 )
 
 // OrderOption defines the ordering options for the Conversation queries.
@@ -100,6 +126,11 @@ func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// ByTitleSource orders the results by the title_source field.
+func ByTitleSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleSource, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -119,3 +150,39 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByLastMessageAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastMessageAt, opts...).ToFunc()
 }
+
+// ByActiveMessageID orders the results by the active_message_id field.
+func ByActiveMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActiveMessageID, opts...).ToFunc()
+}
+
+// ByAgentVersionID orders the results by the agent_version_id field.
+func ByAgentVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentVersionID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByMessagesCount orders the results by messages count.
+func ByMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessagesStep(), opts...)
+	}
+}
+
+// ByMessages orders the results by messages terms.
+func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -79,6 +80,11 @@ func Title(v string) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldTitle, v))
 }
 
+// TitleSource applies equality check predicate on the "title_source" field. It's identical to TitleSourceEQ.
+func TitleSource(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldTitleSource, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldStatus, v))
@@ -99,6 +105,21 @@ func LastMessageAt(v time.Time) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldLastMessageAt, v))
 }
 
+// ActiveMessageID applies equality check predicate on the "active_message_id" field. It's identical to ActiveMessageIDEQ.
+func ActiveMessageID(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldActiveMessageID, v))
+}
+
+// AgentVersionID applies equality check predicate on the "agent_version_id" field. It's identical to AgentVersionIDEQ.
+func AgentVersionID(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldAgentVersionID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldVersion, v))
+}
+
 // AgentIDEQ applies the EQ predicate on the "agent_id" field.
 func AgentIDEQ(v string) predicate.Conversation {
 	return predicate.Conversation(sql.FieldEQ(FieldAgentID, v))
@@ -304,14 +325,79 @@ func TitleContainsFold(v string) predicate.Conversation {
 	return predicate.Conversation(sql.FieldContainsFold(FieldTitle, v))
 }
 
-// MessagesIsNil applies the IsNil predicate on the "messages" field.
-func MessagesIsNil() predicate.Conversation {
-	return predicate.Conversation(sql.FieldIsNull(FieldMessages))
+// TitleSourceEQ applies the EQ predicate on the "title_source" field.
+func TitleSourceEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldTitleSource, v))
 }
 
-// MessagesNotNil applies the NotNil predicate on the "messages" field.
-func MessagesNotNil() predicate.Conversation {
-	return predicate.Conversation(sql.FieldNotNull(FieldMessages))
+// TitleSourceNEQ applies the NEQ predicate on the "title_source" field.
+func TitleSourceNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldTitleSource, v))
+}
+
+// TitleSourceIn applies the In predicate on the "title_source" field.
+func TitleSourceIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldTitleSource, vs...))
+}
+
+// TitleSourceNotIn applies the NotIn predicate on the "title_source" field.
+func TitleSourceNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldTitleSource, vs...))
+}
+
+// TitleSourceGT applies the GT predicate on the "title_source" field.
+func TitleSourceGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldTitleSource, v))
+}
+
+// TitleSourceGTE applies the GTE predicate on the "title_source" field.
+func TitleSourceGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldTitleSource, v))
+}
+
+// TitleSourceLT applies the LT predicate on the "title_source" field.
+func TitleSourceLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldTitleSource, v))
+}
+
+// TitleSourceLTE applies the LTE predicate on the "title_source" field.
+func TitleSourceLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldTitleSource, v))
+}
+
+// TitleSourceContains applies the Contains predicate on the "title_source" field.
+func TitleSourceContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldTitleSource, v))
+}
+
+// TitleSourceHasPrefix applies the HasPrefix predicate on the "title_source" field.
+func TitleSourceHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldTitleSource, v))
+}
+
+// TitleSourceHasSuffix applies the HasSuffix predicate on the "title_source" field.
+func TitleSourceHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldTitleSource, v))
+}
+
+// TitleSourceEqualFold applies the EqualFold predicate on the "title_source" field.
+func TitleSourceEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldTitleSource, v))
+}
+
+// TitleSourceContainsFold applies the ContainsFold predicate on the "title_source" field.
+func TitleSourceContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldTitleSource, v))
+}
+
+// LegacyMessagesIsNil applies the IsNil predicate on the "legacy_messages" field.
+func LegacyMessagesIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldLegacyMessages))
+}
+
+// LegacyMessagesNotNil applies the NotNil predicate on the "legacy_messages" field.
+func LegacyMessagesNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldLegacyMessages))
 }
 
 // ContextIsNil applies the IsNil predicate on the "context" field.
@@ -529,6 +615,219 @@ func LastMessageAtNotNil() predicate.Conversation {
 	return predicate.Conversation(sql.FieldNotNull(FieldLastMessageAt))
 }
 
+// ActiveMessageIDEQ applies the EQ predicate on the "active_message_id" field.
+func ActiveMessageIDEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDNEQ applies the NEQ predicate on the "active_message_id" field.
+func ActiveMessageIDNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDIn applies the In predicate on the "active_message_id" field.
+func ActiveMessageIDIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldActiveMessageID, vs...))
+}
+
+// ActiveMessageIDNotIn applies the NotIn predicate on the "active_message_id" field.
+func ActiveMessageIDNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldActiveMessageID, vs...))
+}
+
+// ActiveMessageIDGT applies the GT predicate on the "active_message_id" field.
+func ActiveMessageIDGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDGTE applies the GTE predicate on the "active_message_id" field.
+func ActiveMessageIDGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDLT applies the LT predicate on the "active_message_id" field.
+func ActiveMessageIDLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDLTE applies the LTE predicate on the "active_message_id" field.
+func ActiveMessageIDLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDContains applies the Contains predicate on the "active_message_id" field.
+func ActiveMessageIDContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDHasPrefix applies the HasPrefix predicate on the "active_message_id" field.
+func ActiveMessageIDHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDHasSuffix applies the HasSuffix predicate on the "active_message_id" field.
+func ActiveMessageIDHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDIsNil applies the IsNil predicate on the "active_message_id" field.
+func ActiveMessageIDIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldActiveMessageID))
+}
+
+// ActiveMessageIDNotNil applies the NotNil predicate on the "active_message_id" field.
+func ActiveMessageIDNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldActiveMessageID))
+}
+
+// ActiveMessageIDEqualFold applies the EqualFold predicate on the "active_message_id" field.
+func ActiveMessageIDEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldActiveMessageID, v))
+}
+
+// ActiveMessageIDContainsFold applies the ContainsFold predicate on the "active_message_id" field.
+func ActiveMessageIDContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldActiveMessageID, v))
+}
+
+// AgentVersionIDEQ applies the EQ predicate on the "agent_version_id" field.
+func AgentVersionIDEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDNEQ applies the NEQ predicate on the "agent_version_id" field.
+func AgentVersionIDNEQ(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDIn applies the In predicate on the "agent_version_id" field.
+func AgentVersionIDIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldAgentVersionID, vs...))
+}
+
+// AgentVersionIDNotIn applies the NotIn predicate on the "agent_version_id" field.
+func AgentVersionIDNotIn(vs ...string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldAgentVersionID, vs...))
+}
+
+// AgentVersionIDGT applies the GT predicate on the "agent_version_id" field.
+func AgentVersionIDGT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDGTE applies the GTE predicate on the "agent_version_id" field.
+func AgentVersionIDGTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDLT applies the LT predicate on the "agent_version_id" field.
+func AgentVersionIDLT(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDLTE applies the LTE predicate on the "agent_version_id" field.
+func AgentVersionIDLTE(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDContains applies the Contains predicate on the "agent_version_id" field.
+func AgentVersionIDContains(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContains(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDHasPrefix applies the HasPrefix predicate on the "agent_version_id" field.
+func AgentVersionIDHasPrefix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasPrefix(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDHasSuffix applies the HasSuffix predicate on the "agent_version_id" field.
+func AgentVersionIDHasSuffix(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldHasSuffix(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDIsNil applies the IsNil predicate on the "agent_version_id" field.
+func AgentVersionIDIsNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldIsNull(FieldAgentVersionID))
+}
+
+// AgentVersionIDNotNil applies the NotNil predicate on the "agent_version_id" field.
+func AgentVersionIDNotNil() predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotNull(FieldAgentVersionID))
+}
+
+// AgentVersionIDEqualFold applies the EqualFold predicate on the "agent_version_id" field.
+func AgentVersionIDEqualFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEqualFold(FieldAgentVersionID, v))
+}
+
+// AgentVersionIDContainsFold applies the ContainsFold predicate on the "agent_version_id" field.
+func AgentVersionIDContainsFold(v string) predicate.Conversation {
+	return predicate.Conversation(sql.FieldContainsFold(FieldAgentVersionID, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int64) predicate.Conversation {
+	return predicate.Conversation(sql.FieldLTE(FieldVersion, v))
+}
+
+// HasMessages applies the HasEdge predicate on the "messages" edge.
+func HasMessages() predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessagesWith applies the HasEdge predicate on the "messages" edge with a given conditions (other predicates).
+func HasMessagesWith(preds ...predicate.Message) predicate.Conversation {
+	return predicate.Conversation(func(s *sql.Selector) {
+		step := newMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Conversation) predicate.Conversation {
 	return predicate.Conversation(sql.AndPredicates(predicates...))
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"agent-platform/internal/model/ent/conversation"
+	"agent-platform/internal/model/ent/message"
 	"context"
 	"errors"
 	"fmt"
@@ -46,9 +47,23 @@ func (cc *ConversationCreate) SetNillableTitle(s *string) *ConversationCreate {
 	return cc
 }
 
-// SetMessages sets the "messages" field.
-func (cc *ConversationCreate) SetMessages(i []interface{}) *ConversationCreate {
-	cc.mutation.SetMessages(i)
+// SetTitleSource sets the "title_source" field.
+func (cc *ConversationCreate) SetTitleSource(s string) *ConversationCreate {
+	cc.mutation.SetTitleSource(s)
+	return cc
+}
+
+// SetNillableTitleSource sets the "title_source" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableTitleSource(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetTitleSource(*s)
+	}
+	return cc
+}
+
+// SetLegacyMessages sets the "legacy_messages" field.
+func (cc *ConversationCreate) SetLegacyMessages(i []interface{}) *ConversationCreate {
+	cc.mutation.SetLegacyMessages(i)
 	return cc
 }
 
@@ -120,12 +135,69 @@ func (cc *ConversationCreate) SetNillableLastMessageAt(t *time.Time) *Conversati
 	return cc
 }
 
+// SetActiveMessageID sets the "active_message_id" field.
+func (cc *ConversationCreate) SetActiveMessageID(s string) *ConversationCreate {
+	cc.mutation.SetActiveMessageID(s)
+	return cc
+}
+
+// SetNillableActiveMessageID sets the "active_message_id" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableActiveMessageID(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetActiveMessageID(*s)
+	}
+	return cc
+}
+
+// SetAgentVersionID sets the "agent_version_id" field.
+func (cc *ConversationCreate) SetAgentVersionID(s string) *ConversationCreate {
+	cc.mutation.SetAgentVersionID(s)
+	return cc
+}
+
+// SetNillableAgentVersionID sets the "agent_version_id" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableAgentVersionID(s *string) *ConversationCreate {
+	if s != nil {
+		cc.SetAgentVersionID(*s)
+	}
+	return cc
+}
+
+// SetVersion sets the "version" field.
+func (cc *ConversationCreate) SetVersion(i int64) *ConversationCreate {
+	cc.mutation.SetVersion(i)
+	return cc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cc *ConversationCreate) SetNillableVersion(i *int64) *ConversationCreate {
+	if i != nil {
+		cc.SetVersion(*i)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *ConversationCreate) SetID(s string) *ConversationCreate {
 	cc.mutation.SetID(s)
 	return cc
 }
 
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (cc *ConversationCreate) AddMessageIDs(ids ...string) *ConversationCreate {
+	cc.mutation.AddMessageIDs(ids...)
+	return cc
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (cc *ConversationCreate) AddMessages(m ...*Message) *ConversationCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cc.AddMessageIDs(ids...)
+}
+
 // Mutation returns the ConversationMutation object of the builder.
 func (cc *ConversationCreate) Mutation() *ConversationMutation {
 	return cc.mutation
@@ -161,6 +233,10 @@ func (cc *ConversationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *ConversationCreate) defaults() {
+	if _, ok := cc.mutation.TitleSource(); !ok {
+		v := conversation.DefaultTitleSource
+		cc.mutation.SetTitleSource(v)
+	}
 	if _, ok := cc.mutation.Status(); !ok {
 		v := conversation.DefaultStatus
 		cc.mutation.SetStatus(v)
@@ -173,6 +249,10 @@ func (cc *ConversationCreate) defaults() {
 		v := conversation.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := cc.mutation.Version(); !ok {
+		v := conversation.DefaultVersion
+		cc.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -193,6 +273,9 @@ func (cc *ConversationCreate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Conversation.user_id": %w`, err)}
 		}
 	}
+	if _, ok := cc.mutation.TitleSource(); !ok {
+		return &ValidationError{Name: "title_source", err: errors.New(`ent: missing required field "Conversation.title_source"`)}
+	}
 	if _, ok := cc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Conversation.status"`)}
 	}
@@ -202,6 +285,9 @@ func (cc *ConversationCreate) check() error {
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Conversation.updated_at"`)}
 	}
+	if _, ok := cc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Conversation.version"`)}
+	}
 	return nil
 }
 
@@ -249,9 +335,13 @@ func (cc *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec)
 		_spec.SetField(conversation.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
-	if value, ok := cc.mutation.Messages(); ok {
-		_spec.SetField(conversation.FieldMessages, field.TypeJSON, value)
-		_node.Messages = value
+	if value, ok := cc.mutation.TitleSource(); ok {
+		_spec.SetField(conversation.FieldTitleSource, field.TypeString, value)
+		_node.TitleSource = value
+	}
+	if value, ok := cc.mutation.LegacyMessages(); ok {
+		_spec.SetField(conversation.FieldLegacyMessages, field.TypeJSON, value)
+		_node.LegacyMessages = value
 	}
 	if value, ok := cc.mutation.Context(); ok {
 		_spec.SetField(conversation.FieldContext, field.TypeJSON, value)
@@ -277,6 +367,34 @@ func (cc *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec)
 		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
 		_node.LastMessageAt = value
 	}
+	if value, ok := cc.mutation.ActiveMessageID(); ok {
+		_spec.SetField(conversation.FieldActiveMessageID, field.TypeString, value)
+		_node.ActiveMessageID = value
+	}
+	if value, ok := cc.mutation.AgentVersionID(); ok {
+		_spec.SetField(conversation.FieldAgentVersionID, field.TypeString, value)
+		_node.AgentVersionID = value
+	}
+	if value, ok := cc.mutation.Version(); ok {
+		_spec.SetField(conversation.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
+	if nodes := cc.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
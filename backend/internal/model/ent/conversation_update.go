@@ -4,6 +4,7 @@ package ent
 
 import (
 	"agent-platform/internal/model/ent/conversation"
+	"agent-platform/internal/model/ent/message"
 	"agent-platform/internal/model/ent/predicate"
 	"context"
 	"errors"
@@ -77,21 +78,35 @@ func (cu *ConversationUpdate) ClearTitle() *ConversationUpdate {
 	return cu
 }
 
-// SetMessages sets the "messages" field.
-func (cu *ConversationUpdate) SetMessages(i []interface{}) *ConversationUpdate {
-	cu.mutation.SetMessages(i)
+// SetTitleSource sets the "title_source" field.
+func (cu *ConversationUpdate) SetTitleSource(s string) *ConversationUpdate {
+	cu.mutation.SetTitleSource(s)
 	return cu
 }
 
-// AppendMessages appends i to the "messages" field.
-func (cu *ConversationUpdate) AppendMessages(i []interface{}) *ConversationUpdate {
-	cu.mutation.AppendMessages(i)
+// SetNillableTitleSource sets the "title_source" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableTitleSource(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetTitleSource(*s)
+	}
 	return cu
 }
 
-// ClearMessages clears the value of the "messages" field.
-func (cu *ConversationUpdate) ClearMessages() *ConversationUpdate {
-	cu.mutation.ClearMessages()
+// SetLegacyMessages sets the "legacy_messages" field.
+func (cu *ConversationUpdate) SetLegacyMessages(i []interface{}) *ConversationUpdate {
+	cu.mutation.SetLegacyMessages(i)
+	return cu
+}
+
+// AppendLegacyMessages appends i to the "legacy_messages" field.
+func (cu *ConversationUpdate) AppendLegacyMessages(i []interface{}) *ConversationUpdate {
+	cu.mutation.AppendLegacyMessages(i)
+	return cu
+}
+
+// ClearLegacyMessages clears the value of the "legacy_messages" field.
+func (cu *ConversationUpdate) ClearLegacyMessages() *ConversationUpdate {
+	cu.mutation.ClearLegacyMessages()
 	return cu
 }
 
@@ -159,11 +174,88 @@ func (cu *ConversationUpdate) ClearLastMessageAt() *ConversationUpdate {
 	return cu
 }
 
+// SetActiveMessageID sets the "active_message_id" field.
+func (cu *ConversationUpdate) SetActiveMessageID(s string) *ConversationUpdate {
+	cu.mutation.SetActiveMessageID(s)
+	return cu
+}
+
+// SetNillableActiveMessageID sets the "active_message_id" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableActiveMessageID(s *string) *ConversationUpdate {
+	if s != nil {
+		cu.SetActiveMessageID(*s)
+	}
+	return cu
+}
+
+// ClearActiveMessageID clears the value of the "active_message_id" field.
+func (cu *ConversationUpdate) ClearActiveMessageID() *ConversationUpdate {
+	cu.mutation.ClearActiveMessageID()
+	return cu
+}
+
+// SetVersion sets the "version" field.
+func (cu *ConversationUpdate) SetVersion(i int64) *ConversationUpdate {
+	cu.mutation.ResetVersion()
+	cu.mutation.SetVersion(i)
+	return cu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cu *ConversationUpdate) SetNillableVersion(i *int64) *ConversationUpdate {
+	if i != nil {
+		cu.SetVersion(*i)
+	}
+	return cu
+}
+
+// AddVersion adds i to the "version" field.
+func (cu *ConversationUpdate) AddVersion(i int64) *ConversationUpdate {
+	cu.mutation.AddVersion(i)
+	return cu
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (cu *ConversationUpdate) AddMessageIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.AddMessageIDs(ids...)
+	return cu
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (cu *ConversationUpdate) AddMessages(m ...*Message) *ConversationUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cu.AddMessageIDs(ids...)
+}
+
 // Mutation returns the ConversationMutation object of the builder.
 func (cu *ConversationUpdate) Mutation() *ConversationMutation {
 	return cu.mutation
 }
 
+// ClearMessages clears all "messages" edges to the Message entity.
+func (cu *ConversationUpdate) ClearMessages() *ConversationUpdate {
+	cu.mutation.ClearMessages()
+	return cu
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (cu *ConversationUpdate) RemoveMessageIDs(ids ...string) *ConversationUpdate {
+	cu.mutation.RemoveMessageIDs(ids...)
+	return cu
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (cu *ConversationUpdate) RemoveMessages(m ...*Message) *ConversationUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cu.RemoveMessageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ConversationUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -239,16 +331,19 @@ func (cu *ConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.TitleCleared() {
 		_spec.ClearField(conversation.FieldTitle, field.TypeString)
 	}
-	if value, ok := cu.mutation.Messages(); ok {
-		_spec.SetField(conversation.FieldMessages, field.TypeJSON, value)
+	if value, ok := cu.mutation.TitleSource(); ok {
+		_spec.SetField(conversation.FieldTitleSource, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.LegacyMessages(); ok {
+		_spec.SetField(conversation.FieldLegacyMessages, field.TypeJSON, value)
 	}
-	if value, ok := cu.mutation.AppendedMessages(); ok {
+	if value, ok := cu.mutation.AppendedLegacyMessages(); ok {
 		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, conversation.FieldMessages, value)
+			sqljson.Append(u, conversation.FieldLegacyMessages, value)
 		})
 	}
-	if cu.mutation.MessagesCleared() {
-		_spec.ClearField(conversation.FieldMessages, field.TypeJSON)
+	if cu.mutation.LegacyMessagesCleared() {
+		_spec.ClearField(conversation.FieldLegacyMessages, field.TypeJSON)
 	}
 	if value, ok := cu.mutation.Context(); ok {
 		_spec.SetField(conversation.FieldContext, field.TypeJSON, value)
@@ -274,6 +369,66 @@ func (cu *ConversationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.LastMessageAtCleared() {
 		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
 	}
+	if value, ok := cu.mutation.ActiveMessageID(); ok {
+		_spec.SetField(conversation.FieldActiveMessageID, field.TypeString, value)
+	}
+	if cu.mutation.ActiveMessageIDCleared() {
+		_spec.ClearField(conversation.FieldActiveMessageID, field.TypeString)
+	}
+	if cu.mutation.AgentVersionIDCleared() {
+		_spec.ClearField(conversation.FieldAgentVersionID, field.TypeString)
+	}
+	if value, ok := cu.mutation.Version(); ok {
+		_spec.SetField(conversation.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedVersion(); ok {
+		_spec.AddField(conversation.FieldVersion, field.TypeInt64, value)
+	}
+	if cu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !cu.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{conversation.Label}
@@ -342,21 +497,35 @@ func (cuo *ConversationUpdateOne) ClearTitle() *ConversationUpdateOne {
 	return cuo
 }
 
-// SetMessages sets the "messages" field.
-func (cuo *ConversationUpdateOne) SetMessages(i []interface{}) *ConversationUpdateOne {
-	cuo.mutation.SetMessages(i)
+// SetTitleSource sets the "title_source" field.
+func (cuo *ConversationUpdateOne) SetTitleSource(s string) *ConversationUpdateOne {
+	cuo.mutation.SetTitleSource(s)
 	return cuo
 }
 
-// AppendMessages appends i to the "messages" field.
-func (cuo *ConversationUpdateOne) AppendMessages(i []interface{}) *ConversationUpdateOne {
-	cuo.mutation.AppendMessages(i)
+// SetNillableTitleSource sets the "title_source" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableTitleSource(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetTitleSource(*s)
+	}
 	return cuo
 }
 
-// ClearMessages clears the value of the "messages" field.
-func (cuo *ConversationUpdateOne) ClearMessages() *ConversationUpdateOne {
-	cuo.mutation.ClearMessages()
+// SetLegacyMessages sets the "legacy_messages" field.
+func (cuo *ConversationUpdateOne) SetLegacyMessages(i []interface{}) *ConversationUpdateOne {
+	cuo.mutation.SetLegacyMessages(i)
+	return cuo
+}
+
+// AppendLegacyMessages appends i to the "legacy_messages" field.
+func (cuo *ConversationUpdateOne) AppendLegacyMessages(i []interface{}) *ConversationUpdateOne {
+	cuo.mutation.AppendLegacyMessages(i)
+	return cuo
+}
+
+// ClearLegacyMessages clears the value of the "legacy_messages" field.
+func (cuo *ConversationUpdateOne) ClearLegacyMessages() *ConversationUpdateOne {
+	cuo.mutation.ClearLegacyMessages()
 	return cuo
 }
 
@@ -424,11 +593,88 @@ func (cuo *ConversationUpdateOne) ClearLastMessageAt() *ConversationUpdateOne {
 	return cuo
 }
 
+// SetActiveMessageID sets the "active_message_id" field.
+func (cuo *ConversationUpdateOne) SetActiveMessageID(s string) *ConversationUpdateOne {
+	cuo.mutation.SetActiveMessageID(s)
+	return cuo
+}
+
+// SetNillableActiveMessageID sets the "active_message_id" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableActiveMessageID(s *string) *ConversationUpdateOne {
+	if s != nil {
+		cuo.SetActiveMessageID(*s)
+	}
+	return cuo
+}
+
+// ClearActiveMessageID clears the value of the "active_message_id" field.
+func (cuo *ConversationUpdateOne) ClearActiveMessageID() *ConversationUpdateOne {
+	cuo.mutation.ClearActiveMessageID()
+	return cuo
+}
+
+// SetVersion sets the "version" field.
+func (cuo *ConversationUpdateOne) SetVersion(i int64) *ConversationUpdateOne {
+	cuo.mutation.ResetVersion()
+	cuo.mutation.SetVersion(i)
+	return cuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cuo *ConversationUpdateOne) SetNillableVersion(i *int64) *ConversationUpdateOne {
+	if i != nil {
+		cuo.SetVersion(*i)
+	}
+	return cuo
+}
+
+// AddVersion adds i to the "version" field.
+func (cuo *ConversationUpdateOne) AddVersion(i int64) *ConversationUpdateOne {
+	cuo.mutation.AddVersion(i)
+	return cuo
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (cuo *ConversationUpdateOne) AddMessageIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.AddMessageIDs(ids...)
+	return cuo
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (cuo *ConversationUpdateOne) AddMessages(m ...*Message) *ConversationUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cuo.AddMessageIDs(ids...)
+}
+
 // Mutation returns the ConversationMutation object of the builder.
 func (cuo *ConversationUpdateOne) Mutation() *ConversationMutation {
 	return cuo.mutation
 }
 
+// ClearMessages clears all "messages" edges to the Message entity.
+func (cuo *ConversationUpdateOne) ClearMessages() *ConversationUpdateOne {
+	cuo.mutation.ClearMessages()
+	return cuo
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (cuo *ConversationUpdateOne) RemoveMessageIDs(ids ...string) *ConversationUpdateOne {
+	cuo.mutation.RemoveMessageIDs(ids...)
+	return cuo
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (cuo *ConversationUpdateOne) RemoveMessages(m ...*Message) *ConversationUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return cuo.RemoveMessageIDs(ids...)
+}
+
 // Where appends a list predicates to the ConversationUpdate builder.
 func (cuo *ConversationUpdateOne) Where(ps ...predicate.Conversation) *ConversationUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -534,16 +780,19 @@ func (cuo *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversat
 	if cuo.mutation.TitleCleared() {
 		_spec.ClearField(conversation.FieldTitle, field.TypeString)
 	}
-	if value, ok := cuo.mutation.Messages(); ok {
-		_spec.SetField(conversation.FieldMessages, field.TypeJSON, value)
+	if value, ok := cuo.mutation.TitleSource(); ok {
+		_spec.SetField(conversation.FieldTitleSource, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.LegacyMessages(); ok {
+		_spec.SetField(conversation.FieldLegacyMessages, field.TypeJSON, value)
 	}
-	if value, ok := cuo.mutation.AppendedMessages(); ok {
+	if value, ok := cuo.mutation.AppendedLegacyMessages(); ok {
 		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, conversation.FieldMessages, value)
+			sqljson.Append(u, conversation.FieldLegacyMessages, value)
 		})
 	}
-	if cuo.mutation.MessagesCleared() {
-		_spec.ClearField(conversation.FieldMessages, field.TypeJSON)
+	if cuo.mutation.LegacyMessagesCleared() {
+		_spec.ClearField(conversation.FieldLegacyMessages, field.TypeJSON)
 	}
 	if value, ok := cuo.mutation.Context(); ok {
 		_spec.SetField(conversation.FieldContext, field.TypeJSON, value)
@@ -569,6 +818,66 @@ func (cuo *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversat
 	if cuo.mutation.LastMessageAtCleared() {
 		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
 	}
+	if value, ok := cuo.mutation.ActiveMessageID(); ok {
+		_spec.SetField(conversation.FieldActiveMessageID, field.TypeString, value)
+	}
+	if cuo.mutation.ActiveMessageIDCleared() {
+		_spec.ClearField(conversation.FieldActiveMessageID, field.TypeString)
+	}
+	if cuo.mutation.AgentVersionIDCleared() {
+		_spec.ClearField(conversation.FieldAgentVersionID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Version(); ok {
+		_spec.SetField(conversation.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedVersion(); ok {
+		_spec.AddField(conversation.FieldVersion, field.TypeInt64, value)
+	}
+	if cuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !cuo.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   conversation.MessagesTable,
+			Columns: []string{conversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Conversation{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -30,8 +30,16 @@ type DocumentChunk struct {
 	Embedding []float32 `json:"embedding,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Disabled chunks are excluded from retrieval
+	Enabled bool `json:"enabled,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned bool `json:"pinned,omitempty"`
+	// Score multiplier applied to pinned chunks during retrieval
+	Boost float64 `json:"boost,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -42,11 +50,15 @@ func (*DocumentChunk) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case documentchunk.FieldEmbedding, documentchunk.FieldMetadata:
 			values[i] = new([]byte)
+		case documentchunk.FieldEnabled, documentchunk.FieldPinned:
+			values[i] = new(sql.NullBool)
+		case documentchunk.FieldBoost:
+			values[i] = new(sql.NullFloat64)
 		case documentchunk.FieldChunkIndex:
 			values[i] = new(sql.NullInt64)
 		case documentchunk.FieldID, documentchunk.FieldKnowledgeBaseID, documentchunk.FieldDocumentID, documentchunk.FieldContent:
 			values[i] = new(sql.NullString)
-		case documentchunk.FieldCreatedAt:
+		case documentchunk.FieldCreatedAt, documentchunk.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -109,12 +121,36 @@ func (dc *DocumentChunk) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case documentchunk.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				dc.Enabled = value.Bool
+			}
+		case documentchunk.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				dc.Pinned = value.Bool
+			}
+		case documentchunk.FieldBoost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field boost", values[i])
+			} else if value.Valid {
+				dc.Boost = value.Float64
+			}
 		case documentchunk.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				dc.CreatedAt = value.Time
 			}
+		case documentchunk.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dc.UpdatedAt = value.Time
+			}
 		default:
 			dc.selectValues.Set(columns[i], values[i])
 		}
@@ -169,8 +205,20 @@ func (dc *DocumentChunk) String() string {
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", dc.Metadata))
 	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Pinned))
+	builder.WriteString(", ")
+	builder.WriteString("boost=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Boost))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(dc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dc.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -84,11 +84,31 @@ func Content(v string) predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.FieldEQ(FieldContent, v))
 }
 
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v bool) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldPinned, v))
+}
+
+// Boost applies equality check predicate on the "boost" field. It's identical to BoostEQ.
+func Boost(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldBoost, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // KnowledgeBaseIDEQ applies the EQ predicate on the "knowledge_base_id" field.
 func KnowledgeBaseIDEQ(v string) predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.FieldEQ(FieldKnowledgeBaseID, v))
@@ -344,6 +364,66 @@ func MetadataNotNil() predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.FieldNotNull(FieldMetadata))
 }
 
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v bool) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldPinned, v))
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v bool) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNEQ(FieldPinned, v))
+}
+
+// BoostEQ applies the EQ predicate on the "boost" field.
+func BoostEQ(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldBoost, v))
+}
+
+// BoostNEQ applies the NEQ predicate on the "boost" field.
+func BoostNEQ(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNEQ(FieldBoost, v))
+}
+
+// BoostIn applies the In predicate on the "boost" field.
+func BoostIn(vs ...float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldIn(FieldBoost, vs...))
+}
+
+// BoostNotIn applies the NotIn predicate on the "boost" field.
+func BoostNotIn(vs ...float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNotIn(FieldBoost, vs...))
+}
+
+// BoostGT applies the GT predicate on the "boost" field.
+func BoostGT(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldGT(FieldBoost, v))
+}
+
+// BoostGTE applies the GTE predicate on the "boost" field.
+func BoostGTE(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldGTE(FieldBoost, v))
+}
+
+// BoostLT applies the LT predicate on the "boost" field.
+func BoostLT(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldLT(FieldBoost, v))
+}
+
+// BoostLTE applies the LTE predicate on the "boost" field.
+func BoostLTE(v float64) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldLTE(FieldBoost, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.FieldEQ(FieldCreatedAt, v))
@@ -384,6 +464,56 @@ func CreatedAtLTE(v time.Time) predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.DocumentChunk {
+	return predicate.DocumentChunk(sql.FieldNotNull(FieldUpdatedAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DocumentChunk) predicate.DocumentChunk {
 	return predicate.DocumentChunk(sql.AndPredicates(predicates...))
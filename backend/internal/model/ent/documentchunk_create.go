@@ -64,12 +64,68 @@ func (dcc *DocumentChunkCreate) SetMetadata(m map[string]interface{}) *DocumentC
 	return dcc
 }
 
+// SetEnabled sets the "enabled" field.
+func (dcc *DocumentChunkCreate) SetEnabled(b bool) *DocumentChunkCreate {
+	dcc.mutation.SetEnabled(b)
+	return dcc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dcc *DocumentChunkCreate) SetNillableEnabled(b *bool) *DocumentChunkCreate {
+	if b != nil {
+		dcc.SetEnabled(*b)
+	}
+	return dcc
+}
+
+// SetPinned sets the "pinned" field.
+func (dcc *DocumentChunkCreate) SetPinned(b bool) *DocumentChunkCreate {
+	dcc.mutation.SetPinned(b)
+	return dcc
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (dcc *DocumentChunkCreate) SetNillablePinned(b *bool) *DocumentChunkCreate {
+	if b != nil {
+		dcc.SetPinned(*b)
+	}
+	return dcc
+}
+
+// SetBoost sets the "boost" field.
+func (dcc *DocumentChunkCreate) SetBoost(f float64) *DocumentChunkCreate {
+	dcc.mutation.SetBoost(f)
+	return dcc
+}
+
+// SetNillableBoost sets the "boost" field if the given value is not nil.
+func (dcc *DocumentChunkCreate) SetNillableBoost(f *float64) *DocumentChunkCreate {
+	if f != nil {
+		dcc.SetBoost(*f)
+	}
+	return dcc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (dcc *DocumentChunkCreate) SetCreatedAt(t time.Time) *DocumentChunkCreate {
 	dcc.mutation.SetCreatedAt(t)
 	return dcc
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (dcc *DocumentChunkCreate) SetUpdatedAt(t time.Time) *DocumentChunkCreate {
+	dcc.mutation.SetUpdatedAt(t)
+	return dcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dcc *DocumentChunkCreate) SetNillableUpdatedAt(t *time.Time) *DocumentChunkCreate {
+	if t != nil {
+		dcc.SetUpdatedAt(*t)
+	}
+	return dcc
+}
+
 // SetID sets the "id" field.
 func (dcc *DocumentChunkCreate) SetID(s string) *DocumentChunkCreate {
 	dcc.mutation.SetID(s)
@@ -115,6 +171,18 @@ func (dcc *DocumentChunkCreate) defaults() {
 		v := documentchunk.DefaultChunkIndex
 		dcc.mutation.SetChunkIndex(v)
 	}
+	if _, ok := dcc.mutation.Enabled(); !ok {
+		v := documentchunk.DefaultEnabled
+		dcc.mutation.SetEnabled(v)
+	}
+	if _, ok := dcc.mutation.Pinned(); !ok {
+		v := documentchunk.DefaultPinned
+		dcc.mutation.SetPinned(v)
+	}
+	if _, ok := dcc.mutation.Boost(); !ok {
+		v := documentchunk.DefaultBoost
+		dcc.mutation.SetBoost(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -146,6 +214,15 @@ func (dcc *DocumentChunkCreate) check() error {
 			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "DocumentChunk.content": %w`, err)}
 		}
 	}
+	if _, ok := dcc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "DocumentChunk.enabled"`)}
+	}
+	if _, ok := dcc.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "DocumentChunk.pinned"`)}
+	}
+	if _, ok := dcc.mutation.Boost(); !ok {
+		return &ValidationError{Name: "boost", err: errors.New(`ent: missing required field "DocumentChunk.boost"`)}
+	}
 	if _, ok := dcc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DocumentChunk.created_at"`)}
 	}
@@ -208,10 +285,26 @@ func (dcc *DocumentChunkCreate) createSpec() (*DocumentChunk, *sqlgraph.CreateSp
 		_spec.SetField(documentchunk.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := dcc.mutation.Enabled(); ok {
+		_spec.SetField(documentchunk.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := dcc.mutation.Pinned(); ok {
+		_spec.SetField(documentchunk.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
+	if value, ok := dcc.mutation.Boost(); ok {
+		_spec.SetField(documentchunk.FieldBoost, field.TypeFloat64, value)
+		_node.Boost = value
+	}
 	if value, ok := dcc.mutation.CreatedAt(); ok {
 		_spec.SetField(documentchunk.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := dcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentchunk.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	return _node, _spec
 }
 
@@ -122,6 +122,55 @@ func (dcu *DocumentChunkUpdate) ClearMetadata() *DocumentChunkUpdate {
 	return dcu
 }
 
+// SetEnabled sets the "enabled" field.
+func (dcu *DocumentChunkUpdate) SetEnabled(b bool) *DocumentChunkUpdate {
+	dcu.mutation.SetEnabled(b)
+	return dcu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dcu *DocumentChunkUpdate) SetNillableEnabled(b *bool) *DocumentChunkUpdate {
+	if b != nil {
+		dcu.SetEnabled(*b)
+	}
+	return dcu
+}
+
+// SetPinned sets the "pinned" field.
+func (dcu *DocumentChunkUpdate) SetPinned(b bool) *DocumentChunkUpdate {
+	dcu.mutation.SetPinned(b)
+	return dcu
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (dcu *DocumentChunkUpdate) SetNillablePinned(b *bool) *DocumentChunkUpdate {
+	if b != nil {
+		dcu.SetPinned(*b)
+	}
+	return dcu
+}
+
+// SetBoost sets the "boost" field.
+func (dcu *DocumentChunkUpdate) SetBoost(f float64) *DocumentChunkUpdate {
+	dcu.mutation.ResetBoost()
+	dcu.mutation.SetBoost(f)
+	return dcu
+}
+
+// SetNillableBoost sets the "boost" field if the given value is not nil.
+func (dcu *DocumentChunkUpdate) SetNillableBoost(f *float64) *DocumentChunkUpdate {
+	if f != nil {
+		dcu.SetBoost(*f)
+	}
+	return dcu
+}
+
+// AddBoost adds f to the "boost" field.
+func (dcu *DocumentChunkUpdate) AddBoost(f float64) *DocumentChunkUpdate {
+	dcu.mutation.AddBoost(f)
+	return dcu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (dcu *DocumentChunkUpdate) SetCreatedAt(t time.Time) *DocumentChunkUpdate {
 	dcu.mutation.SetCreatedAt(t)
@@ -136,6 +185,26 @@ func (dcu *DocumentChunkUpdate) SetNillableCreatedAt(t *time.Time) *DocumentChun
 	return dcu
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (dcu *DocumentChunkUpdate) SetUpdatedAt(t time.Time) *DocumentChunkUpdate {
+	dcu.mutation.SetUpdatedAt(t)
+	return dcu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dcu *DocumentChunkUpdate) SetNillableUpdatedAt(t *time.Time) *DocumentChunkUpdate {
+	if t != nil {
+		dcu.SetUpdatedAt(*t)
+	}
+	return dcu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (dcu *DocumentChunkUpdate) ClearUpdatedAt() *DocumentChunkUpdate {
+	dcu.mutation.ClearUpdatedAt()
+	return dcu
+}
+
 // Mutation returns the DocumentChunkMutation object of the builder.
 func (dcu *DocumentChunkUpdate) Mutation() *DocumentChunkMutation {
 	return dcu.mutation
@@ -232,9 +301,27 @@ func (dcu *DocumentChunkUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if dcu.mutation.MetadataCleared() {
 		_spec.ClearField(documentchunk.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := dcu.mutation.Enabled(); ok {
+		_spec.SetField(documentchunk.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := dcu.mutation.Pinned(); ok {
+		_spec.SetField(documentchunk.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := dcu.mutation.Boost(); ok {
+		_spec.SetField(documentchunk.FieldBoost, field.TypeFloat64, value)
+	}
+	if value, ok := dcu.mutation.AddedBoost(); ok {
+		_spec.AddField(documentchunk.FieldBoost, field.TypeFloat64, value)
+	}
 	if value, ok := dcu.mutation.CreatedAt(); ok {
 		_spec.SetField(documentchunk.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := dcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentchunk.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if dcu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(documentchunk.FieldUpdatedAt, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, dcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{documentchunk.Label}
@@ -348,6 +435,55 @@ func (dcuo *DocumentChunkUpdateOne) ClearMetadata() *DocumentChunkUpdateOne {
 	return dcuo
 }
 
+// SetEnabled sets the "enabled" field.
+func (dcuo *DocumentChunkUpdateOne) SetEnabled(b bool) *DocumentChunkUpdateOne {
+	dcuo.mutation.SetEnabled(b)
+	return dcuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (dcuo *DocumentChunkUpdateOne) SetNillableEnabled(b *bool) *DocumentChunkUpdateOne {
+	if b != nil {
+		dcuo.SetEnabled(*b)
+	}
+	return dcuo
+}
+
+// SetPinned sets the "pinned" field.
+func (dcuo *DocumentChunkUpdateOne) SetPinned(b bool) *DocumentChunkUpdateOne {
+	dcuo.mutation.SetPinned(b)
+	return dcuo
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (dcuo *DocumentChunkUpdateOne) SetNillablePinned(b *bool) *DocumentChunkUpdateOne {
+	if b != nil {
+		dcuo.SetPinned(*b)
+	}
+	return dcuo
+}
+
+// SetBoost sets the "boost" field.
+func (dcuo *DocumentChunkUpdateOne) SetBoost(f float64) *DocumentChunkUpdateOne {
+	dcuo.mutation.ResetBoost()
+	dcuo.mutation.SetBoost(f)
+	return dcuo
+}
+
+// SetNillableBoost sets the "boost" field if the given value is not nil.
+func (dcuo *DocumentChunkUpdateOne) SetNillableBoost(f *float64) *DocumentChunkUpdateOne {
+	if f != nil {
+		dcuo.SetBoost(*f)
+	}
+	return dcuo
+}
+
+// AddBoost adds f to the "boost" field.
+func (dcuo *DocumentChunkUpdateOne) AddBoost(f float64) *DocumentChunkUpdateOne {
+	dcuo.mutation.AddBoost(f)
+	return dcuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (dcuo *DocumentChunkUpdateOne) SetCreatedAt(t time.Time) *DocumentChunkUpdateOne {
 	dcuo.mutation.SetCreatedAt(t)
@@ -362,6 +498,26 @@ func (dcuo *DocumentChunkUpdateOne) SetNillableCreatedAt(t *time.Time) *Document
 	return dcuo
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (dcuo *DocumentChunkUpdateOne) SetUpdatedAt(t time.Time) *DocumentChunkUpdateOne {
+	dcuo.mutation.SetUpdatedAt(t)
+	return dcuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dcuo *DocumentChunkUpdateOne) SetNillableUpdatedAt(t *time.Time) *DocumentChunkUpdateOne {
+	if t != nil {
+		dcuo.SetUpdatedAt(*t)
+	}
+	return dcuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (dcuo *DocumentChunkUpdateOne) ClearUpdatedAt() *DocumentChunkUpdateOne {
+	dcuo.mutation.ClearUpdatedAt()
+	return dcuo
+}
+
 // Mutation returns the DocumentChunkMutation object of the builder.
 func (dcuo *DocumentChunkUpdateOne) Mutation() *DocumentChunkMutation {
 	return dcuo.mutation
@@ -488,9 +644,27 @@ func (dcuo *DocumentChunkUpdateOne) sqlSave(ctx context.Context) (_node *Documen
 	if dcuo.mutation.MetadataCleared() {
 		_spec.ClearField(documentchunk.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := dcuo.mutation.Enabled(); ok {
+		_spec.SetField(documentchunk.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := dcuo.mutation.Pinned(); ok {
+		_spec.SetField(documentchunk.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := dcuo.mutation.Boost(); ok {
+		_spec.SetField(documentchunk.FieldBoost, field.TypeFloat64, value)
+	}
+	if value, ok := dcuo.mutation.AddedBoost(); ok {
+		_spec.AddField(documentchunk.FieldBoost, field.TypeFloat64, value)
+	}
 	if value, ok := dcuo.mutation.CreatedAt(); ok {
 		_spec.SetField(documentchunk.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := dcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentchunk.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if dcuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(documentchunk.FieldUpdatedAt, field.TypeTime)
+	}
 	_node = &DocumentChunk{config: dcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -4,9 +4,15 @@ package ent
 
 import (
 	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/agentversion"
 	"agent-platform/internal/model/ent/conversation"
 	"agent-platform/internal/model/ent/documentchunk"
 	"agent-platform/internal/model/ent/knowledgebase"
+	"agent-platform/internal/model/ent/memory"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
 	"agent-platform/internal/model/ent/tool"
 	"agent-platform/internal/model/ent/user"
 	"agent-platform/internal/model/ent/workflow"
@@ -81,9 +87,15 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			agent.Table:             agent.ValidColumn,
+			agentfolder.Table:       agentfolder.ValidColumn,
+			agentshare.Table:        agentshare.ValidColumn,
+			agentversion.Table:      agentversion.ValidColumn,
 			conversation.Table:      conversation.ValidColumn,
 			documentchunk.Table:     documentchunk.ValidColumn,
 			knowledgebase.Table:     knowledgebase.ValidColumn,
+			memory.Table:            memory.ValidColumn,
+			message.Table:           message.ValidColumn,
+			messagefeedback.Table:   messagefeedback.ValidColumn,
 			tool.Table:              tool.ValidColumn,
 			user.Table:              user.ValidColumn,
 			workflow.Table:          workflow.ValidColumn,
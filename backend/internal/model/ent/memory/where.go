@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package memory
+
+import (
+	"agent-platform/internal/model/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Memory {
+	return predicate.Memory(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldUserID, v))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldAgentID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldContent, v))
+}
+
+// SourceConversationID applies equality check predicate on the "source_conversation_id" field. It's identical to SourceConversationIDEQ.
+func SourceConversationID(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldSourceConversationID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContainsFold(FieldContent, v))
+}
+
+// EmbeddingIsNil applies the IsNil predicate on the "embedding" field.
+func EmbeddingIsNil() predicate.Memory {
+	return predicate.Memory(sql.FieldIsNull(FieldEmbedding))
+}
+
+// EmbeddingNotNil applies the NotNil predicate on the "embedding" field.
+func EmbeddingNotNil() predicate.Memory {
+	return predicate.Memory(sql.FieldNotNull(FieldEmbedding))
+}
+
+// SourceConversationIDEQ applies the EQ predicate on the "source_conversation_id" field.
+func SourceConversationIDEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDNEQ applies the NEQ predicate on the "source_conversation_id" field.
+func SourceConversationIDNEQ(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDIn applies the In predicate on the "source_conversation_id" field.
+func SourceConversationIDIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldSourceConversationID, vs...))
+}
+
+// SourceConversationIDNotIn applies the NotIn predicate on the "source_conversation_id" field.
+func SourceConversationIDNotIn(vs ...string) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldSourceConversationID, vs...))
+}
+
+// SourceConversationIDGT applies the GT predicate on the "source_conversation_id" field.
+func SourceConversationIDGT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDGTE applies the GTE predicate on the "source_conversation_id" field.
+func SourceConversationIDGTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDLT applies the LT predicate on the "source_conversation_id" field.
+func SourceConversationIDLT(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDLTE applies the LTE predicate on the "source_conversation_id" field.
+func SourceConversationIDLTE(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDContains applies the Contains predicate on the "source_conversation_id" field.
+func SourceConversationIDContains(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContains(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDHasPrefix applies the HasPrefix predicate on the "source_conversation_id" field.
+func SourceConversationIDHasPrefix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasPrefix(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDHasSuffix applies the HasSuffix predicate on the "source_conversation_id" field.
+func SourceConversationIDHasSuffix(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldHasSuffix(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDIsNil applies the IsNil predicate on the "source_conversation_id" field.
+func SourceConversationIDIsNil() predicate.Memory {
+	return predicate.Memory(sql.FieldIsNull(FieldSourceConversationID))
+}
+
+// SourceConversationIDNotNil applies the NotNil predicate on the "source_conversation_id" field.
+func SourceConversationIDNotNil() predicate.Memory {
+	return predicate.Memory(sql.FieldNotNull(FieldSourceConversationID))
+}
+
+// SourceConversationIDEqualFold applies the EqualFold predicate on the "source_conversation_id" field.
+func SourceConversationIDEqualFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldEqualFold(FieldSourceConversationID, v))
+}
+
+// SourceConversationIDContainsFold applies the ContainsFold predicate on the "source_conversation_id" field.
+func SourceConversationIDContainsFold(v string) predicate.Memory {
+	return predicate.Memory(sql.FieldContainsFold(FieldSourceConversationID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Memory {
+	return predicate.Memory(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Memory {
+	return predicate.Memory(sql.FieldNotNull(FieldMetadata))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Memory {
+	return predicate.Memory(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Memory) predicate.Memory {
+	return predicate.Memory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Memory) predicate.Memory {
+	return predicate.Memory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Memory) predicate.Memory {
+	return predicate.Memory(sql.NotPredicates(p))
+}
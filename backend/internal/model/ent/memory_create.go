@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/memory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MemoryCreate is the builder for creating a Memory entity.
+type MemoryCreate struct {
+	config
+	mutation *MemoryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (mc *MemoryCreate) SetUserID(s string) *MemoryCreate {
+	mc.mutation.SetUserID(s)
+	return mc
+}
+
+// SetAgentID sets the "agent_id" field.
+func (mc *MemoryCreate) SetAgentID(s string) *MemoryCreate {
+	mc.mutation.SetAgentID(s)
+	return mc
+}
+
+// SetContent sets the "content" field.
+func (mc *MemoryCreate) SetContent(s string) *MemoryCreate {
+	mc.mutation.SetContent(s)
+	return mc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (mc *MemoryCreate) SetEmbedding(f []float32) *MemoryCreate {
+	mc.mutation.SetEmbedding(f)
+	return mc
+}
+
+// SetSourceConversationID sets the "source_conversation_id" field.
+func (mc *MemoryCreate) SetSourceConversationID(s string) *MemoryCreate {
+	mc.mutation.SetSourceConversationID(s)
+	return mc
+}
+
+// SetNillableSourceConversationID sets the "source_conversation_id" field if the given value is not nil.
+func (mc *MemoryCreate) SetNillableSourceConversationID(s *string) *MemoryCreate {
+	if s != nil {
+		mc.SetSourceConversationID(*s)
+	}
+	return mc
+}
+
+// SetMetadata sets the "metadata" field.
+func (mc *MemoryCreate) SetMetadata(m map[string]interface{}) *MemoryCreate {
+	mc.mutation.SetMetadata(m)
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MemoryCreate) SetCreatedAt(t time.Time) *MemoryCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MemoryCreate) SetNillableCreatedAt(t *time.Time) *MemoryCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MemoryCreate) SetUpdatedAt(t time.Time) *MemoryCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MemoryCreate) SetNillableUpdatedAt(t *time.Time) *MemoryCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *MemoryCreate) SetID(s string) *MemoryCreate {
+	mc.mutation.SetID(s)
+	return mc
+}
+
+// Mutation returns the MemoryMutation object of the builder.
+func (mc *MemoryCreate) Mutation() *MemoryMutation {
+	return mc.mutation
+}
+
+// Save creates the Memory in the database.
+func (mc *MemoryCreate) Save(ctx context.Context) (*Memory, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MemoryCreate) SaveX(ctx context.Context) *Memory {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MemoryCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MemoryCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MemoryCreate) defaults() {
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := memory.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := memory.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MemoryCreate) check() error {
+	if _, ok := mc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Memory.user_id"`)}
+	}
+	if v, ok := mc.mutation.UserID(); ok {
+		if err := memory.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Memory.user_id": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.AgentID(); !ok {
+		return &ValidationError{Name: "agent_id", err: errors.New(`ent: missing required field "Memory.agent_id"`)}
+	}
+	if v, ok := mc.mutation.AgentID(); ok {
+		if err := memory.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "Memory.agent_id": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Memory.content"`)}
+	}
+	if v, ok := mc.mutation.Content(); ok {
+		if err := memory.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Memory.content": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Memory.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Memory.updated_at"`)}
+	}
+	return nil
+}
+
+func (mc *MemoryCreate) sqlSave(ctx context.Context) (*Memory, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Memory.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MemoryCreate) createSpec() (*Memory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Memory{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(memory.Table, sqlgraph.NewFieldSpec(memory.FieldID, field.TypeString))
+	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.UserID(); ok {
+		_spec.SetField(memory.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := mc.mutation.AgentID(); ok {
+		_spec.SetField(memory.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := mc.mutation.Content(); ok {
+		_spec.SetField(memory.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := mc.mutation.Embedding(); ok {
+		_spec.SetField(memory.FieldEmbedding, field.TypeJSON, value)
+		_node.Embedding = value
+	}
+	if value, ok := mc.mutation.SourceConversationID(); ok {
+		_spec.SetField(memory.FieldSourceConversationID, field.TypeString, value)
+		_node.SourceConversationID = value
+	}
+	if value, ok := mc.mutation.Metadata(); ok {
+		_spec.SetField(memory.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(memory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(memory.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// MemoryCreateBulk is the builder for creating many Memory entities in bulk.
+type MemoryCreateBulk struct {
+	config
+	err      error
+	builders []*MemoryCreate
+}
+
+// Save creates the Memory entities in the database.
+func (mcb *MemoryCreateBulk) Save(ctx context.Context) ([]*Memory, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Memory, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MemoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MemoryCreateBulk) SaveX(ctx context.Context) []*Memory {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MemoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MemoryCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/memory"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MemoryUpdate is the builder for updating Memory entities.
+type MemoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MemoryMutation
+}
+
+// Where appends a list predicates to the MemoryUpdate builder.
+func (mu *MemoryUpdate) Where(ps ...predicate.Memory) *MemoryUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUserID sets the "user_id" field.
+func (mu *MemoryUpdate) SetUserID(s string) *MemoryUpdate {
+	mu.mutation.SetUserID(s)
+	return mu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mu *MemoryUpdate) SetNillableUserID(s *string) *MemoryUpdate {
+	if s != nil {
+		mu.SetUserID(*s)
+	}
+	return mu
+}
+
+// SetAgentID sets the "agent_id" field.
+func (mu *MemoryUpdate) SetAgentID(s string) *MemoryUpdate {
+	mu.mutation.SetAgentID(s)
+	return mu
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (mu *MemoryUpdate) SetNillableAgentID(s *string) *MemoryUpdate {
+	if s != nil {
+		mu.SetAgentID(*s)
+	}
+	return mu
+}
+
+// SetContent sets the "content" field.
+func (mu *MemoryUpdate) SetContent(s string) *MemoryUpdate {
+	mu.mutation.SetContent(s)
+	return mu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (mu *MemoryUpdate) SetNillableContent(s *string) *MemoryUpdate {
+	if s != nil {
+		mu.SetContent(*s)
+	}
+	return mu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (mu *MemoryUpdate) SetEmbedding(f []float32) *MemoryUpdate {
+	mu.mutation.SetEmbedding(f)
+	return mu
+}
+
+// AppendEmbedding appends f to the "embedding" field.
+func (mu *MemoryUpdate) AppendEmbedding(f []float32) *MemoryUpdate {
+	mu.mutation.AppendEmbedding(f)
+	return mu
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (mu *MemoryUpdate) ClearEmbedding() *MemoryUpdate {
+	mu.mutation.ClearEmbedding()
+	return mu
+}
+
+// SetSourceConversationID sets the "source_conversation_id" field.
+func (mu *MemoryUpdate) SetSourceConversationID(s string) *MemoryUpdate {
+	mu.mutation.SetSourceConversationID(s)
+	return mu
+}
+
+// SetNillableSourceConversationID sets the "source_conversation_id" field if the given value is not nil.
+func (mu *MemoryUpdate) SetNillableSourceConversationID(s *string) *MemoryUpdate {
+	if s != nil {
+		mu.SetSourceConversationID(*s)
+	}
+	return mu
+}
+
+// ClearSourceConversationID clears the value of the "source_conversation_id" field.
+func (mu *MemoryUpdate) ClearSourceConversationID() *MemoryUpdate {
+	mu.mutation.ClearSourceConversationID()
+	return mu
+}
+
+// SetMetadata sets the "metadata" field.
+func (mu *MemoryUpdate) SetMetadata(m map[string]interface{}) *MemoryUpdate {
+	mu.mutation.SetMetadata(m)
+	return mu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (mu *MemoryUpdate) ClearMetadata() *MemoryUpdate {
+	mu.mutation.ClearMetadata()
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MemoryUpdate) SetUpdatedAt(t time.Time) *MemoryUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// Mutation returns the MemoryMutation object of the builder.
+func (mu *MemoryUpdate) Mutation() *MemoryMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MemoryUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MemoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MemoryUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MemoryUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MemoryUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := memory.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MemoryUpdate) check() error {
+	if v, ok := mu.mutation.UserID(); ok {
+		if err := memory.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Memory.user_id": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.AgentID(); ok {
+		if err := memory.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "Memory.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Content(); ok {
+		if err := memory.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Memory.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *MemoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(memory.Table, memory.Columns, sqlgraph.NewFieldSpec(memory.FieldID, field.TypeString))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UserID(); ok {
+		_spec.SetField(memory.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.AgentID(); ok {
+		_spec.SetField(memory.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Content(); ok {
+		_spec.SetField(memory.FieldContent, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Embedding(); ok {
+		_spec.SetField(memory.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memory.FieldEmbedding, value)
+		})
+	}
+	if mu.mutation.EmbeddingCleared() {
+		_spec.ClearField(memory.FieldEmbedding, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.SourceConversationID(); ok {
+		_spec.SetField(memory.FieldSourceConversationID, field.TypeString, value)
+	}
+	if mu.mutation.SourceConversationIDCleared() {
+		_spec.ClearField(memory.FieldSourceConversationID, field.TypeString)
+	}
+	if value, ok := mu.mutation.Metadata(); ok {
+		_spec.SetField(memory.FieldMetadata, field.TypeJSON, value)
+	}
+	if mu.mutation.MetadataCleared() {
+		_spec.ClearField(memory.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(memory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MemoryUpdateOne is the builder for updating a single Memory entity.
+type MemoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MemoryMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (muo *MemoryUpdateOne) SetUserID(s string) *MemoryUpdateOne {
+	muo.mutation.SetUserID(s)
+	return muo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (muo *MemoryUpdateOne) SetNillableUserID(s *string) *MemoryUpdateOne {
+	if s != nil {
+		muo.SetUserID(*s)
+	}
+	return muo
+}
+
+// SetAgentID sets the "agent_id" field.
+func (muo *MemoryUpdateOne) SetAgentID(s string) *MemoryUpdateOne {
+	muo.mutation.SetAgentID(s)
+	return muo
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (muo *MemoryUpdateOne) SetNillableAgentID(s *string) *MemoryUpdateOne {
+	if s != nil {
+		muo.SetAgentID(*s)
+	}
+	return muo
+}
+
+// SetContent sets the "content" field.
+func (muo *MemoryUpdateOne) SetContent(s string) *MemoryUpdateOne {
+	muo.mutation.SetContent(s)
+	return muo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (muo *MemoryUpdateOne) SetNillableContent(s *string) *MemoryUpdateOne {
+	if s != nil {
+		muo.SetContent(*s)
+	}
+	return muo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (muo *MemoryUpdateOne) SetEmbedding(f []float32) *MemoryUpdateOne {
+	muo.mutation.SetEmbedding(f)
+	return muo
+}
+
+// AppendEmbedding appends f to the "embedding" field.
+func (muo *MemoryUpdateOne) AppendEmbedding(f []float32) *MemoryUpdateOne {
+	muo.mutation.AppendEmbedding(f)
+	return muo
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (muo *MemoryUpdateOne) ClearEmbedding() *MemoryUpdateOne {
+	muo.mutation.ClearEmbedding()
+	return muo
+}
+
+// SetSourceConversationID sets the "source_conversation_id" field.
+func (muo *MemoryUpdateOne) SetSourceConversationID(s string) *MemoryUpdateOne {
+	muo.mutation.SetSourceConversationID(s)
+	return muo
+}
+
+// SetNillableSourceConversationID sets the "source_conversation_id" field if the given value is not nil.
+func (muo *MemoryUpdateOne) SetNillableSourceConversationID(s *string) *MemoryUpdateOne {
+	if s != nil {
+		muo.SetSourceConversationID(*s)
+	}
+	return muo
+}
+
+// ClearSourceConversationID clears the value of the "source_conversation_id" field.
+func (muo *MemoryUpdateOne) ClearSourceConversationID() *MemoryUpdateOne {
+	muo.mutation.ClearSourceConversationID()
+	return muo
+}
+
+// SetMetadata sets the "metadata" field.
+func (muo *MemoryUpdateOne) SetMetadata(m map[string]interface{}) *MemoryUpdateOne {
+	muo.mutation.SetMetadata(m)
+	return muo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (muo *MemoryUpdateOne) ClearMetadata() *MemoryUpdateOne {
+	muo.mutation.ClearMetadata()
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MemoryUpdateOne) SetUpdatedAt(t time.Time) *MemoryUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// Mutation returns the MemoryMutation object of the builder.
+func (muo *MemoryUpdateOne) Mutation() *MemoryMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the MemoryUpdate builder.
+func (muo *MemoryUpdateOne) Where(ps ...predicate.Memory) *MemoryUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MemoryUpdateOne) Select(field string, fields ...string) *MemoryUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Memory entity.
+func (muo *MemoryUpdateOne) Save(ctx context.Context) (*Memory, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MemoryUpdateOne) SaveX(ctx context.Context) *Memory {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MemoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MemoryUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MemoryUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := memory.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MemoryUpdateOne) check() error {
+	if v, ok := muo.mutation.UserID(); ok {
+		if err := memory.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Memory.user_id": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.AgentID(); ok {
+		if err := memory.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "Memory.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Content(); ok {
+		if err := memory.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Memory.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *MemoryUpdateOne) sqlSave(ctx context.Context) (_node *Memory, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(memory.Table, memory.Columns, sqlgraph.NewFieldSpec(memory.FieldID, field.TypeString))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Memory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, memory.FieldID)
+		for _, f := range fields {
+			if !memory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != memory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UserID(); ok {
+		_spec.SetField(memory.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.AgentID(); ok {
+		_spec.SetField(memory.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Content(); ok {
+		_spec.SetField(memory.FieldContent, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Embedding(); ok {
+		_spec.SetField(memory.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, memory.FieldEmbedding, value)
+		})
+	}
+	if muo.mutation.EmbeddingCleared() {
+		_spec.ClearField(memory.FieldEmbedding, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.SourceConversationID(); ok {
+		_spec.SetField(memory.FieldSourceConversationID, field.TypeString, value)
+	}
+	if muo.mutation.SourceConversationIDCleared() {
+		_spec.ClearField(memory.FieldSourceConversationID, field.TypeString)
+	}
+	if value, ok := muo.mutation.Metadata(); ok {
+		_spec.SetField(memory.FieldMetadata, field.TypeJSON, value)
+	}
+	if muo.mutation.MetadataCleared() {
+		_spec.ClearField(memory.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(memory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Memory{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{memory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
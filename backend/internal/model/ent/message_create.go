@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/conversation"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageCreate is the builder for creating a Message entity.
+type MessageCreate struct {
+	config
+	mutation *MessageMutation
+	hooks    []Hook
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mc *MessageCreate) SetConversationID(s string) *MessageCreate {
+	mc.mutation.SetConversationID(s)
+	return mc
+}
+
+// SetParentID sets the "parent_id" field.
+func (mc *MessageCreate) SetParentID(s string) *MessageCreate {
+	mc.mutation.SetParentID(s)
+	return mc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableParentID(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetParentID(*s)
+	}
+	return mc
+}
+
+// SetRole sets the "role" field.
+func (mc *MessageCreate) SetRole(s string) *MessageCreate {
+	mc.mutation.SetRole(s)
+	return mc
+}
+
+// SetContent sets the "content" field.
+func (mc *MessageCreate) SetContent(s string) *MessageCreate {
+	mc.mutation.SetContent(s)
+	return mc
+}
+
+// SetMetadata sets the "metadata" field.
+func (mc *MessageCreate) SetMetadata(m map[string]interface{}) *MessageCreate {
+	mc.mutation.SetMetadata(m)
+	return mc
+}
+
+// SetModel sets the "model" field.
+func (mc *MessageCreate) SetModel(s string) *MessageCreate {
+	mc.mutation.SetModel(s)
+	return mc
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableModel(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetModel(*s)
+	}
+	return mc
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (mc *MessageCreate) SetPromptTokens(i int) *MessageCreate {
+	mc.mutation.SetPromptTokens(i)
+	return mc
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (mc *MessageCreate) SetNillablePromptTokens(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetPromptTokens(*i)
+	}
+	return mc
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (mc *MessageCreate) SetCompletionTokens(i int) *MessageCreate {
+	mc.mutation.SetCompletionTokens(i)
+	return mc
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableCompletionTokens(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetCompletionTokens(*i)
+	}
+	return mc
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (mc *MessageCreate) SetIdempotencyKey(s string) *MessageCreate {
+	mc.mutation.SetIdempotencyKey(s)
+	return mc
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableIdempotencyKey(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetIdempotencyKey(*s)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MessageCreate) SetCreatedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableCreatedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MessageCreate) SetUpdatedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableUpdatedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *MessageCreate) SetID(s string) *MessageCreate {
+	mc.mutation.SetID(s)
+	return mc
+}
+
+// SetConversation sets the "conversation" edge to the Conversation entity.
+func (mc *MessageCreate) SetConversation(c *Conversation) *MessageCreate {
+	return mc.SetConversationID(c.ID)
+}
+
+// AddFeedbackIDs adds the "feedback" edge to the MessageFeedback entity by IDs.
+func (mc *MessageCreate) AddFeedbackIDs(ids ...string) *MessageCreate {
+	mc.mutation.AddFeedbackIDs(ids...)
+	return mc
+}
+
+// AddFeedback adds the "feedback" edges to the MessageFeedback entity.
+func (mc *MessageCreate) AddFeedback(m ...*MessageFeedback) *MessageCreate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mc.AddFeedbackIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (mc *MessageCreate) Mutation() *MessageMutation {
+	return mc.mutation
+}
+
+// Save creates the Message in the database.
+func (mc *MessageCreate) Save(ctx context.Context) (*Message, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MessageCreate) SaveX(ctx context.Context) *Message {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MessageCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MessageCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MessageCreate) defaults() {
+	if _, ok := mc.mutation.PromptTokens(); !ok {
+		v := message.DefaultPromptTokens
+		mc.mutation.SetPromptTokens(v)
+	}
+	if _, ok := mc.mutation.CompletionTokens(); !ok {
+		v := message.DefaultCompletionTokens
+		mc.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := message.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := message.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MessageCreate) check() error {
+	if _, ok := mc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "Message.conversation_id"`)}
+	}
+	if v, ok := mc.mutation.ConversationID(); ok {
+		if err := message.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "Message.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Message.role"`)}
+	}
+	if v, ok := mc.mutation.Role(); ok {
+		if err := message.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Message.role": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Message.content"`)}
+	}
+	if _, ok := mc.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "Message.prompt_tokens"`)}
+	}
+	if _, ok := mc.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "Message.completion_tokens"`)}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Message.updated_at"`)}
+	}
+	if _, ok := mc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "Message.conversation"`)}
+	}
+	return nil
+}
+
+func (mc *MessageCreate) sqlSave(ctx context.Context) (*Message, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Message.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Message{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(message.Table, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.ParentID(); ok {
+		_spec.SetField(message.FieldParentID, field.TypeString, value)
+		_node.ParentID = value
+	}
+	if value, ok := mc.mutation.Role(); ok {
+		_spec.SetField(message.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := mc.mutation.Content(); ok {
+		_spec.SetField(message.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := mc.mutation.Metadata(); ok {
+		_spec.SetField(message.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := mc.mutation.Model(); ok {
+		_spec.SetField(message.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := mc.mutation.PromptTokens(); ok {
+		_spec.SetField(message.FieldPromptTokens, field.TypeInt, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := mc.mutation.CompletionTokens(); ok {
+		_spec.SetField(message.FieldCompletionTokens, field.TypeInt, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := mc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(message.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := mc.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   message.ConversationTable,
+			Columns: []string{message.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mc.mutation.FeedbackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageCreateBulk is the builder for creating many Message entities in bulk.
+type MessageCreateBulk struct {
+	config
+	err      error
+	builders []*MessageCreate
+}
+
+// Save creates the Message entities in the database.
+func (mcb *MessageCreateBulk) Save(ctx context.Context) ([]*Message, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Message, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MessageCreateBulk) SaveX(ctx context.Context) []*Message {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MessageCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
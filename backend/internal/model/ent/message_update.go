@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageUpdate is the builder for updating Message entities.
+type MessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (mu *MessageUpdate) Where(ps ...predicate.Message) *MessageUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetParentID sets the "parent_id" field.
+func (mu *MessageUpdate) SetParentID(s string) *MessageUpdate {
+	mu.mutation.SetParentID(s)
+	return mu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableParentID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetParentID(*s)
+	}
+	return mu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (mu *MessageUpdate) ClearParentID() *MessageUpdate {
+	mu.mutation.ClearParentID()
+	return mu
+}
+
+// SetRole sets the "role" field.
+func (mu *MessageUpdate) SetRole(s string) *MessageUpdate {
+	mu.mutation.SetRole(s)
+	return mu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableRole(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetRole(*s)
+	}
+	return mu
+}
+
+// SetContent sets the "content" field.
+func (mu *MessageUpdate) SetContent(s string) *MessageUpdate {
+	mu.mutation.SetContent(s)
+	return mu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableContent(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetContent(*s)
+	}
+	return mu
+}
+
+// SetMetadata sets the "metadata" field.
+func (mu *MessageUpdate) SetMetadata(m map[string]interface{}) *MessageUpdate {
+	mu.mutation.SetMetadata(m)
+	return mu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (mu *MessageUpdate) ClearMetadata() *MessageUpdate {
+	mu.mutation.ClearMetadata()
+	return mu
+}
+
+// SetModel sets the "model" field.
+func (mu *MessageUpdate) SetModel(s string) *MessageUpdate {
+	mu.mutation.SetModel(s)
+	return mu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableModel(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetModel(*s)
+	}
+	return mu
+}
+
+// ClearModel clears the value of the "model" field.
+func (mu *MessageUpdate) ClearModel() *MessageUpdate {
+	mu.mutation.ClearModel()
+	return mu
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (mu *MessageUpdate) SetPromptTokens(i int) *MessageUpdate {
+	mu.mutation.ResetPromptTokens()
+	mu.mutation.SetPromptTokens(i)
+	return mu
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillablePromptTokens(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetPromptTokens(*i)
+	}
+	return mu
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (mu *MessageUpdate) AddPromptTokens(i int) *MessageUpdate {
+	mu.mutation.AddPromptTokens(i)
+	return mu
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (mu *MessageUpdate) SetCompletionTokens(i int) *MessageUpdate {
+	mu.mutation.ResetCompletionTokens()
+	mu.mutation.SetCompletionTokens(i)
+	return mu
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableCompletionTokens(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetCompletionTokens(*i)
+	}
+	return mu
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (mu *MessageUpdate) AddCompletionTokens(i int) *MessageUpdate {
+	mu.mutation.AddCompletionTokens(i)
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MessageUpdate) SetUpdatedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// AddFeedbackIDs adds the "feedback" edge to the MessageFeedback entity by IDs.
+func (mu *MessageUpdate) AddFeedbackIDs(ids ...string) *MessageUpdate {
+	mu.mutation.AddFeedbackIDs(ids...)
+	return mu
+}
+
+// AddFeedback adds the "feedback" edges to the MessageFeedback entity.
+func (mu *MessageUpdate) AddFeedback(m ...*MessageFeedback) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddFeedbackIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (mu *MessageUpdate) Mutation() *MessageMutation {
+	return mu.mutation
+}
+
+// ClearFeedback clears all "feedback" edges to the MessageFeedback entity.
+func (mu *MessageUpdate) ClearFeedback() *MessageUpdate {
+	mu.mutation.ClearFeedback()
+	return mu
+}
+
+// RemoveFeedbackIDs removes the "feedback" edge to MessageFeedback entities by IDs.
+func (mu *MessageUpdate) RemoveFeedbackIDs(ids ...string) *MessageUpdate {
+	mu.mutation.RemoveFeedbackIDs(ids...)
+	return mu
+}
+
+// RemoveFeedback removes "feedback" edges to MessageFeedback entities.
+func (mu *MessageUpdate) RemoveFeedback(m ...*MessageFeedback) *MessageUpdate {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveFeedbackIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MessageUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MessageUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MessageUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MessageUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MessageUpdate) check() error {
+	if v, ok := mu.mutation.Role(); ok {
+		if err := message.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Message.role": %w`, err)}
+		}
+	}
+	if _, ok := mu.mutation.ConversationID(); mu.mutation.ConversationCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Message.conversation"`)
+	}
+	return nil
+}
+
+func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.ParentID(); ok {
+		_spec.SetField(message.FieldParentID, field.TypeString, value)
+	}
+	if mu.mutation.ParentIDCleared() {
+		_spec.ClearField(message.FieldParentID, field.TypeString)
+	}
+	if value, ok := mu.mutation.Role(); ok {
+		_spec.SetField(message.FieldRole, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Content(); ok {
+		_spec.SetField(message.FieldContent, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Metadata(); ok {
+		_spec.SetField(message.FieldMetadata, field.TypeJSON, value)
+	}
+	if mu.mutation.MetadataCleared() {
+		_spec.ClearField(message.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.Model(); ok {
+		_spec.SetField(message.FieldModel, field.TypeString, value)
+	}
+	if mu.mutation.ModelCleared() {
+		_spec.ClearField(message.FieldModel, field.TypeString)
+	}
+	if value, ok := mu.mutation.PromptTokens(); ok {
+		_spec.SetField(message.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(message.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.CompletionTokens(); ok {
+		_spec.SetField(message.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(message.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if mu.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(message.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mu.mutation.FeedbackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedFeedbackIDs(); len(nodes) > 0 && !mu.mutation.FeedbackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.FeedbackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageMutation
+}
+
+// SetParentID sets the "parent_id" field.
+func (muo *MessageUpdateOne) SetParentID(s string) *MessageUpdateOne {
+	muo.mutation.SetParentID(s)
+	return muo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableParentID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetParentID(*s)
+	}
+	return muo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (muo *MessageUpdateOne) ClearParentID() *MessageUpdateOne {
+	muo.mutation.ClearParentID()
+	return muo
+}
+
+// SetRole sets the "role" field.
+func (muo *MessageUpdateOne) SetRole(s string) *MessageUpdateOne {
+	muo.mutation.SetRole(s)
+	return muo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableRole(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetRole(*s)
+	}
+	return muo
+}
+
+// SetContent sets the "content" field.
+func (muo *MessageUpdateOne) SetContent(s string) *MessageUpdateOne {
+	muo.mutation.SetContent(s)
+	return muo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableContent(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetContent(*s)
+	}
+	return muo
+}
+
+// SetMetadata sets the "metadata" field.
+func (muo *MessageUpdateOne) SetMetadata(m map[string]interface{}) *MessageUpdateOne {
+	muo.mutation.SetMetadata(m)
+	return muo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (muo *MessageUpdateOne) ClearMetadata() *MessageUpdateOne {
+	muo.mutation.ClearMetadata()
+	return muo
+}
+
+// SetModel sets the "model" field.
+func (muo *MessageUpdateOne) SetModel(s string) *MessageUpdateOne {
+	muo.mutation.SetModel(s)
+	return muo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableModel(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetModel(*s)
+	}
+	return muo
+}
+
+// ClearModel clears the value of the "model" field.
+func (muo *MessageUpdateOne) ClearModel() *MessageUpdateOne {
+	muo.mutation.ClearModel()
+	return muo
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (muo *MessageUpdateOne) SetPromptTokens(i int) *MessageUpdateOne {
+	muo.mutation.ResetPromptTokens()
+	muo.mutation.SetPromptTokens(i)
+	return muo
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillablePromptTokens(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetPromptTokens(*i)
+	}
+	return muo
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (muo *MessageUpdateOne) AddPromptTokens(i int) *MessageUpdateOne {
+	muo.mutation.AddPromptTokens(i)
+	return muo
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (muo *MessageUpdateOne) SetCompletionTokens(i int) *MessageUpdateOne {
+	muo.mutation.ResetCompletionTokens()
+	muo.mutation.SetCompletionTokens(i)
+	return muo
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableCompletionTokens(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetCompletionTokens(*i)
+	}
+	return muo
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (muo *MessageUpdateOne) AddCompletionTokens(i int) *MessageUpdateOne {
+	muo.mutation.AddCompletionTokens(i)
+	return muo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MessageUpdateOne) SetUpdatedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// AddFeedbackIDs adds the "feedback" edge to the MessageFeedback entity by IDs.
+func (muo *MessageUpdateOne) AddFeedbackIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.AddFeedbackIDs(ids...)
+	return muo
+}
+
+// AddFeedback adds the "feedback" edges to the MessageFeedback entity.
+func (muo *MessageUpdateOne) AddFeedback(m ...*MessageFeedback) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddFeedbackIDs(ids...)
+}
+
+// Mutation returns the MessageMutation object of the builder.
+func (muo *MessageUpdateOne) Mutation() *MessageMutation {
+	return muo.mutation
+}
+
+// ClearFeedback clears all "feedback" edges to the MessageFeedback entity.
+func (muo *MessageUpdateOne) ClearFeedback() *MessageUpdateOne {
+	muo.mutation.ClearFeedback()
+	return muo
+}
+
+// RemoveFeedbackIDs removes the "feedback" edge to MessageFeedback entities by IDs.
+func (muo *MessageUpdateOne) RemoveFeedbackIDs(ids ...string) *MessageUpdateOne {
+	muo.mutation.RemoveFeedbackIDs(ids...)
+	return muo
+}
+
+// RemoveFeedback removes "feedback" edges to MessageFeedback entities.
+func (muo *MessageUpdateOne) RemoveFeedback(m ...*MessageFeedback) *MessageUpdateOne {
+	ids := make([]string, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveFeedbackIDs(ids...)
+}
+
+// Where appends a list predicates to the MessageUpdate builder.
+func (muo *MessageUpdateOne) Where(ps ...predicate.Message) *MessageUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MessageUpdateOne) Select(field string, fields ...string) *MessageUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Message entity.
+func (muo *MessageUpdateOne) Save(ctx context.Context) (*Message, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MessageUpdateOne) SaveX(ctx context.Context) *Message {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MessageUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MessageUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := message.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MessageUpdateOne) check() error {
+	if v, ok := muo.mutation.Role(); ok {
+		if err := message.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Message.role": %w`, err)}
+		}
+	}
+	if _, ok := muo.mutation.ConversationID(); muo.mutation.ConversationCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Message.conversation"`)
+	}
+	return nil
+}
+
+func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(message.Table, message.Columns, sqlgraph.NewFieldSpec(message.FieldID, field.TypeString))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Message.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, message.FieldID)
+		for _, f := range fields {
+			if !message.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != message.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.ParentID(); ok {
+		_spec.SetField(message.FieldParentID, field.TypeString, value)
+	}
+	if muo.mutation.ParentIDCleared() {
+		_spec.ClearField(message.FieldParentID, field.TypeString)
+	}
+	if value, ok := muo.mutation.Role(); ok {
+		_spec.SetField(message.FieldRole, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Content(); ok {
+		_spec.SetField(message.FieldContent, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Metadata(); ok {
+		_spec.SetField(message.FieldMetadata, field.TypeJSON, value)
+	}
+	if muo.mutation.MetadataCleared() {
+		_spec.ClearField(message.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.Model(); ok {
+		_spec.SetField(message.FieldModel, field.TypeString, value)
+	}
+	if muo.mutation.ModelCleared() {
+		_spec.ClearField(message.FieldModel, field.TypeString)
+	}
+	if value, ok := muo.mutation.PromptTokens(); ok {
+		_spec.SetField(message.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(message.FieldPromptTokens, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.CompletionTokens(); ok {
+		_spec.SetField(message.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(message.FieldCompletionTokens, field.TypeInt, value)
+	}
+	if muo.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(message.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(message.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if muo.mutation.FeedbackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedFeedbackIDs(); len(nodes) > 0 && !muo.mutation.FeedbackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.FeedbackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.FeedbackTable,
+			Columns: []string{message.FeedbackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Message{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageFeedback is the model entity for the MessageFeedback schema.
+type MessageFeedback struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID string `json:"message_id,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID string `json:"conversation_id,omitempty"`
+	// Agent that generated the message, copied for per-agent queries
+	AgentID string `json:"agent_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// 1 = thumbs up, -1 = thumbs down
+	Rating int `json:"rating,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Comment holds the value of the "comment" field.
+	Comment string `json:"comment,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MessageFeedbackQuery when eager-loading is set.
+	Edges        MessageFeedbackEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MessageFeedbackEdges holds the relations/edges for other nodes in the graph.
+type MessageFeedbackEdges struct {
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageFeedbackEdges) MessageOrErr() (*Message, error) {
+	if e.loadedTypes[0] {
+		if e.Message == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: message.Label}
+		}
+		return e.Message, nil
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageFeedback) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagefeedback.FieldRating:
+			values[i] = new(sql.NullInt64)
+		case messagefeedback.FieldID, messagefeedback.FieldMessageID, messagefeedback.FieldConversationID, messagefeedback.FieldAgentID, messagefeedback.FieldUserID, messagefeedback.FieldReason, messagefeedback.FieldComment:
+			values[i] = new(sql.NullString)
+		case messagefeedback.FieldCreatedAt, messagefeedback.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageFeedback fields.
+func (mf *MessageFeedback) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagefeedback.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				mf.ID = value.String
+			}
+		case messagefeedback.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				mf.MessageID = value.String
+			}
+		case messagefeedback.FieldConversationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				mf.ConversationID = value.String
+			}
+		case messagefeedback.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				mf.AgentID = value.String
+			}
+		case messagefeedback.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				mf.UserID = value.String
+			}
+		case messagefeedback.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				mf.Rating = int(value.Int64)
+			}
+		case messagefeedback.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				mf.Reason = value.String
+			}
+		case messagefeedback.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				mf.Comment = value.String
+			}
+		case messagefeedback.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mf.CreatedAt = value.Time
+			}
+		case messagefeedback.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mf.UpdatedAt = value.Time
+			}
+		default:
+			mf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MessageFeedback.
+// This includes values selected through modifiers, order, etc.
+func (mf *MessageFeedback) Value(name string) (ent.Value, error) {
+	return mf.selectValues.Get(name)
+}
+
+// QueryMessage queries the "message" edge of the MessageFeedback entity.
+func (mf *MessageFeedback) QueryMessage() *MessageQuery {
+	return NewMessageFeedbackClient(mf.config).QueryMessage(mf)
+}
+
+// Update returns a builder for updating this MessageFeedback.
+// Note that you need to call MessageFeedback.Unwrap() before calling this method if this MessageFeedback
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mf *MessageFeedback) Update() *MessageFeedbackUpdateOne {
+	return NewMessageFeedbackClient(mf.config).UpdateOne(mf)
+}
+
+// Unwrap unwraps the MessageFeedback entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mf *MessageFeedback) Unwrap() *MessageFeedback {
+	_tx, ok := mf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageFeedback is not a transactional entity")
+	}
+	mf.config.driver = _tx.drv
+	return mf
+}
+
+// String implements the fmt.Stringer.
+func (mf *MessageFeedback) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageFeedback(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mf.ID))
+	builder.WriteString("message_id=")
+	builder.WriteString(mf.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(mf.ConversationID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(mf.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(mf.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(mf.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("comment=")
+	builder.WriteString(mf.Comment)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mf.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageFeedbacks is a parsable slice of MessageFeedback.
+type MessageFeedbacks []*MessageFeedback
@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageFeedbackCreate is the builder for creating a MessageFeedback entity.
+type MessageFeedbackCreate struct {
+	config
+	mutation *MessageFeedbackMutation
+	hooks    []Hook
+}
+
+// SetMessageID sets the "message_id" field.
+func (mfc *MessageFeedbackCreate) SetMessageID(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetMessageID(s)
+	return mfc
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mfc *MessageFeedbackCreate) SetConversationID(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetConversationID(s)
+	return mfc
+}
+
+// SetAgentID sets the "agent_id" field.
+func (mfc *MessageFeedbackCreate) SetAgentID(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetAgentID(s)
+	return mfc
+}
+
+// SetUserID sets the "user_id" field.
+func (mfc *MessageFeedbackCreate) SetUserID(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetUserID(s)
+	return mfc
+}
+
+// SetRating sets the "rating" field.
+func (mfc *MessageFeedbackCreate) SetRating(i int) *MessageFeedbackCreate {
+	mfc.mutation.SetRating(i)
+	return mfc
+}
+
+// SetReason sets the "reason" field.
+func (mfc *MessageFeedbackCreate) SetReason(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetReason(s)
+	return mfc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mfc *MessageFeedbackCreate) SetNillableReason(s *string) *MessageFeedbackCreate {
+	if s != nil {
+		mfc.SetReason(*s)
+	}
+	return mfc
+}
+
+// SetComment sets the "comment" field.
+func (mfc *MessageFeedbackCreate) SetComment(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetComment(s)
+	return mfc
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (mfc *MessageFeedbackCreate) SetNillableComment(s *string) *MessageFeedbackCreate {
+	if s != nil {
+		mfc.SetComment(*s)
+	}
+	return mfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mfc *MessageFeedbackCreate) SetCreatedAt(t time.Time) *MessageFeedbackCreate {
+	mfc.mutation.SetCreatedAt(t)
+	return mfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mfc *MessageFeedbackCreate) SetNillableCreatedAt(t *time.Time) *MessageFeedbackCreate {
+	if t != nil {
+		mfc.SetCreatedAt(*t)
+	}
+	return mfc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mfc *MessageFeedbackCreate) SetUpdatedAt(t time.Time) *MessageFeedbackCreate {
+	mfc.mutation.SetUpdatedAt(t)
+	return mfc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mfc *MessageFeedbackCreate) SetNillableUpdatedAt(t *time.Time) *MessageFeedbackCreate {
+	if t != nil {
+		mfc.SetUpdatedAt(*t)
+	}
+	return mfc
+}
+
+// SetID sets the "id" field.
+func (mfc *MessageFeedbackCreate) SetID(s string) *MessageFeedbackCreate {
+	mfc.mutation.SetID(s)
+	return mfc
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (mfc *MessageFeedbackCreate) SetMessage(m *Message) *MessageFeedbackCreate {
+	return mfc.SetMessageID(m.ID)
+}
+
+// Mutation returns the MessageFeedbackMutation object of the builder.
+func (mfc *MessageFeedbackCreate) Mutation() *MessageFeedbackMutation {
+	return mfc.mutation
+}
+
+// Save creates the MessageFeedback in the database.
+func (mfc *MessageFeedbackCreate) Save(ctx context.Context) (*MessageFeedback, error) {
+	mfc.defaults()
+	return withHooks(ctx, mfc.sqlSave, mfc.mutation, mfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfc *MessageFeedbackCreate) SaveX(ctx context.Context) *MessageFeedback {
+	v, err := mfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfc *MessageFeedbackCreate) Exec(ctx context.Context) error {
+	_, err := mfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfc *MessageFeedbackCreate) ExecX(ctx context.Context) {
+	if err := mfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mfc *MessageFeedbackCreate) defaults() {
+	if _, ok := mfc.mutation.CreatedAt(); !ok {
+		v := messagefeedback.DefaultCreatedAt()
+		mfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mfc.mutation.UpdatedAt(); !ok {
+		v := messagefeedback.DefaultUpdatedAt()
+		mfc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfc *MessageFeedbackCreate) check() error {
+	if _, ok := mfc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageFeedback.message_id"`)}
+	}
+	if v, ok := mfc.mutation.MessageID(); ok {
+		if err := messagefeedback.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.message_id": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "MessageFeedback.conversation_id"`)}
+	}
+	if v, ok := mfc.mutation.ConversationID(); ok {
+		if err := messagefeedback.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.AgentID(); !ok {
+		return &ValidationError{Name: "agent_id", err: errors.New(`ent: missing required field "MessageFeedback.agent_id"`)}
+	}
+	if v, ok := mfc.mutation.AgentID(); ok {
+		if err := messagefeedback.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.agent_id": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MessageFeedback.user_id"`)}
+	}
+	if v, ok := mfc.mutation.UserID(); ok {
+		if err := messagefeedback.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.user_id": %w`, err)}
+		}
+	}
+	if _, ok := mfc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "MessageFeedback.rating"`)}
+	}
+	if _, ok := mfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageFeedback.created_at"`)}
+	}
+	if _, ok := mfc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageFeedback.updated_at"`)}
+	}
+	if _, ok := mfc.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageFeedback.message"`)}
+	}
+	return nil
+}
+
+func (mfc *MessageFeedbackCreate) sqlSave(ctx context.Context) (*MessageFeedback, error) {
+	if err := mfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected MessageFeedback.ID type: %T", _spec.ID.Value)
+		}
+	}
+	mfc.mutation.id = &_node.ID
+	mfc.mutation.done = true
+	return _node, nil
+}
+
+func (mfc *MessageFeedbackCreate) createSpec() (*MessageFeedback, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageFeedback{config: mfc.config}
+		_spec = sqlgraph.NewCreateSpec(messagefeedback.Table, sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString))
+	)
+	if id, ok := mfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mfc.mutation.ConversationID(); ok {
+		_spec.SetField(messagefeedback.FieldConversationID, field.TypeString, value)
+		_node.ConversationID = value
+	}
+	if value, ok := mfc.mutation.AgentID(); ok {
+		_spec.SetField(messagefeedback.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := mfc.mutation.UserID(); ok {
+		_spec.SetField(messagefeedback.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := mfc.mutation.Rating(); ok {
+		_spec.SetField(messagefeedback.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := mfc.mutation.Reason(); ok {
+		_spec.SetField(messagefeedback.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := mfc.mutation.Comment(); ok {
+		_spec.SetField(messagefeedback.FieldComment, field.TypeString, value)
+		_node.Comment = value
+	}
+	if value, ok := mfc.mutation.CreatedAt(); ok {
+		_spec.SetField(messagefeedback.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mfc.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagefeedback.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := mfc.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagefeedback.MessageTable,
+			Columns: []string{messagefeedback.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MessageFeedbackCreateBulk is the builder for creating many MessageFeedback entities in bulk.
+type MessageFeedbackCreateBulk struct {
+	config
+	err      error
+	builders []*MessageFeedbackCreate
+}
+
+// Save creates the MessageFeedback entities in the database.
+func (mfcb *MessageFeedbackCreateBulk) Save(ctx context.Context) ([]*MessageFeedback, error) {
+	if mfcb.err != nil {
+		return nil, mfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mfcb.builders))
+	nodes := make([]*MessageFeedback, len(mfcb.builders))
+	mutators := make([]Mutator, len(mfcb.builders))
+	for i := range mfcb.builders {
+		func(i int, root context.Context) {
+			builder := mfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageFeedbackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfcb *MessageFeedbackCreateBulk) SaveX(ctx context.Context) []*MessageFeedback {
+	v, err := mfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfcb *MessageFeedbackCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfcb *MessageFeedbackCreateBulk) ExecX(ctx context.Context) {
+	if err := mfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
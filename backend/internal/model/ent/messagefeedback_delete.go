@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/messagefeedback"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageFeedbackDelete is the builder for deleting a MessageFeedback entity.
+type MessageFeedbackDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageFeedbackMutation
+}
+
+// Where appends a list predicates to the MessageFeedbackDelete builder.
+func (mfd *MessageFeedbackDelete) Where(ps ...predicate.MessageFeedback) *MessageFeedbackDelete {
+	mfd.mutation.Where(ps...)
+	return mfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfd *MessageFeedbackDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfd.sqlExec, mfd.mutation, mfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfd *MessageFeedbackDelete) ExecX(ctx context.Context) int {
+	n, err := mfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfd *MessageFeedbackDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(messagefeedback.Table, sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString))
+	if ps := mfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mfd.mutation.done = true
+	return affected, err
+}
+
+// MessageFeedbackDeleteOne is the builder for deleting a single MessageFeedback entity.
+type MessageFeedbackDeleteOne struct {
+	mfd *MessageFeedbackDelete
+}
+
+// Where appends a list predicates to the MessageFeedbackDelete builder.
+func (mfdo *MessageFeedbackDeleteOne) Where(ps ...predicate.MessageFeedback) *MessageFeedbackDeleteOne {
+	mfdo.mfd.mutation.Where(ps...)
+	return mfdo
+}
+
+// Exec executes the deletion query.
+func (mfdo *MessageFeedbackDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfdo.mfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagefeedback.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfdo *MessageFeedbackDeleteOne) ExecX(ctx context.Context) {
+	if err := mfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageFeedbackQuery is the builder for querying MessageFeedback entities.
+type MessageFeedbackQuery struct {
+	config
+	ctx         *QueryContext
+	order       []messagefeedback.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.MessageFeedback
+	withMessage *MessageQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageFeedbackQuery builder.
+func (mfq *MessageFeedbackQuery) Where(ps ...predicate.MessageFeedback) *MessageFeedbackQuery {
+	mfq.predicates = append(mfq.predicates, ps...)
+	return mfq
+}
+
+// Limit the number of records to be returned by this query.
+func (mfq *MessageFeedbackQuery) Limit(limit int) *MessageFeedbackQuery {
+	mfq.ctx.Limit = &limit
+	return mfq
+}
+
+// Offset to start from.
+func (mfq *MessageFeedbackQuery) Offset(offset int) *MessageFeedbackQuery {
+	mfq.ctx.Offset = &offset
+	return mfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfq *MessageFeedbackQuery) Unique(unique bool) *MessageFeedbackQuery {
+	mfq.ctx.Unique = &unique
+	return mfq
+}
+
+// Order specifies how the records should be ordered.
+func (mfq *MessageFeedbackQuery) Order(o ...messagefeedback.OrderOption) *MessageFeedbackQuery {
+	mfq.order = append(mfq.order, o...)
+	return mfq
+}
+
+// QueryMessage chains the current query on the "message" edge.
+func (mfq *MessageFeedbackQuery) QueryMessage() *MessageQuery {
+	query := (&MessageClient{config: mfq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mfq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagefeedback.Table, messagefeedback.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagefeedback.MessageTable, messagefeedback.MessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mfq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MessageFeedback entity from the query.
+// Returns a *NotFoundError when no MessageFeedback was found.
+func (mfq *MessageFeedbackQuery) First(ctx context.Context) (*MessageFeedback, error) {
+	nodes, err := mfq.Limit(1).All(setContextOp(ctx, mfq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagefeedback.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) FirstX(ctx context.Context) *MessageFeedback {
+	node, err := mfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageFeedback ID from the query.
+// Returns a *NotFoundError when no MessageFeedback ID was found.
+func (mfq *MessageFeedbackQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mfq.Limit(1).IDs(setContextOp(ctx, mfq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagefeedback.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) FirstIDX(ctx context.Context) string {
+	id, err := mfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageFeedback entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MessageFeedback entity is found.
+// Returns a *NotFoundError when no MessageFeedback entities are found.
+func (mfq *MessageFeedbackQuery) Only(ctx context.Context) (*MessageFeedback, error) {
+	nodes, err := mfq.Limit(2).All(setContextOp(ctx, mfq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagefeedback.Label}
+	default:
+		return nil, &NotSingularError{messagefeedback.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) OnlyX(ctx context.Context) *MessageFeedback {
+	node, err := mfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageFeedback ID in the query.
+// Returns a *NotSingularError when more than one MessageFeedback ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfq *MessageFeedbackQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = mfq.Limit(2).IDs(setContextOp(ctx, mfq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagefeedback.Label}
+	default:
+		err = &NotSingularError{messagefeedback.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) OnlyIDX(ctx context.Context) string {
+	id, err := mfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageFeedbacks.
+func (mfq *MessageFeedbackQuery) All(ctx context.Context) ([]*MessageFeedback, error) {
+	ctx = setContextOp(ctx, mfq.ctx, "All")
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MessageFeedback, *MessageFeedbackQuery]()
+	return withInterceptors[[]*MessageFeedback](ctx, mfq, qr, mfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) AllX(ctx context.Context) []*MessageFeedback {
+	nodes, err := mfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageFeedback IDs.
+func (mfq *MessageFeedbackQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if mfq.ctx.Unique == nil && mfq.path != nil {
+		mfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mfq.ctx, "IDs")
+	if err = mfq.Select(messagefeedback.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) IDsX(ctx context.Context) []string {
+	ids, err := mfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfq *MessageFeedbackQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mfq.ctx, "Count")
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mfq, querierCount[*MessageFeedbackQuery](), mfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) CountX(ctx context.Context) int {
+	count, err := mfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfq *MessageFeedbackQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mfq.ctx, "Exist")
+	switch _, err := mfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfq *MessageFeedbackQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageFeedbackQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfq *MessageFeedbackQuery) Clone() *MessageFeedbackQuery {
+	if mfq == nil {
+		return nil
+	}
+	return &MessageFeedbackQuery{
+		config:      mfq.config,
+		ctx:         mfq.ctx.Clone(),
+		order:       append([]messagefeedback.OrderOption{}, mfq.order...),
+		inters:      append([]Interceptor{}, mfq.inters...),
+		predicates:  append([]predicate.MessageFeedback{}, mfq.predicates...),
+		withMessage: mfq.withMessage.Clone(),
+		// clone intermediate query.
+		sql:  mfq.sql.Clone(),
+		path: mfq.path,
+	}
+}
+
+// WithMessage tells the query-builder to eager-load the nodes that are connected to
+// the "message" edge. The optional arguments are used to configure the query builder of the edge.
+func (mfq *MessageFeedbackQuery) WithMessage(opts ...func(*MessageQuery)) *MessageFeedbackQuery {
+	query := (&MessageClient{config: mfq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mfq.withMessage = query
+	return mfq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID string `json:"message_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageFeedback.Query().
+//		GroupBy(messagefeedback.FieldMessageID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mfq *MessageFeedbackQuery) GroupBy(field string, fields ...string) *MessageFeedbackGroupBy {
+	mfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MessageFeedbackGroupBy{build: mfq}
+	grbuild.flds = &mfq.ctx.Fields
+	grbuild.label = messagefeedback.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		MessageID string `json:"message_id,omitempty"`
+//	}
+//
+//	client.MessageFeedback.Query().
+//		Select(messagefeedback.FieldMessageID).
+//		Scan(ctx, &v)
+func (mfq *MessageFeedbackQuery) Select(fields ...string) *MessageFeedbackSelect {
+	mfq.ctx.Fields = append(mfq.ctx.Fields, fields...)
+	sbuild := &MessageFeedbackSelect{MessageFeedbackQuery: mfq}
+	sbuild.label = messagefeedback.Label
+	sbuild.flds, sbuild.scan = &mfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MessageFeedbackSelect configured with the given aggregations.
+func (mfq *MessageFeedbackQuery) Aggregate(fns ...AggregateFunc) *MessageFeedbackSelect {
+	return mfq.Select().Aggregate(fns...)
+}
+
+func (mfq *MessageFeedbackQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mfq.ctx.Fields {
+		if !messagefeedback.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mfq.path != nil {
+		prev, err := mfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfq.sql = prev
+	}
+	return nil
+}
+
+func (mfq *MessageFeedbackQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MessageFeedback, error) {
+	var (
+		nodes       = []*MessageFeedback{}
+		_spec       = mfq.querySpec()
+		loadedTypes = [1]bool{
+			mfq.withMessage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MessageFeedback).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MessageFeedback{config: mfq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mfq.withMessage; query != nil {
+		if err := mfq.loadMessage(ctx, query, nodes, nil,
+			func(n *MessageFeedback, e *Message) { n.Edges.Message = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mfq *MessageFeedbackQuery) loadMessage(ctx context.Context, query *MessageQuery, nodes []*MessageFeedback, init func(*MessageFeedback), assign func(*MessageFeedback, *Message)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MessageFeedback)
+	for i := range nodes {
+		fk := nodes[i].MessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mfq *MessageFeedbackQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfq.querySpec()
+	_spec.Node.Columns = mfq.ctx.Fields
+	if len(mfq.ctx.Fields) > 0 {
+		_spec.Unique = mfq.ctx.Unique != nil && *mfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mfq.driver, _spec)
+}
+
+func (mfq *MessageFeedbackQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(messagefeedback.Table, messagefeedback.Columns, sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString))
+	_spec.From = mfq.sql
+	if unique := mfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagefeedback.FieldID)
+		for i := range fields {
+			if fields[i] != messagefeedback.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mfq.withMessage != nil {
+			_spec.Node.AddColumnOnce(messagefeedback.FieldMessageID)
+		}
+	}
+	if ps := mfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfq *MessageFeedbackQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfq.driver.Dialect())
+	t1 := builder.Table(messagefeedback.Table)
+	columns := mfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = messagefeedback.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfq.sql != nil {
+		selector = mfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfq.ctx.Unique != nil && *mfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mfq.predicates {
+		p(selector)
+	}
+	for _, p := range mfq.order {
+		p(selector)
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageFeedbackGroupBy is the group-by builder for MessageFeedback entities.
+type MessageFeedbackGroupBy struct {
+	selector
+	build *MessageFeedbackQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfgb *MessageFeedbackGroupBy) Aggregate(fns ...AggregateFunc) *MessageFeedbackGroupBy {
+	mfgb.fns = append(mfgb.fns, fns...)
+	return mfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfgb *MessageFeedbackGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfgb.build.ctx, "GroupBy")
+	if err := mfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageFeedbackQuery, *MessageFeedbackGroupBy](ctx, mfgb.build, mfgb, mfgb.build.inters, v)
+}
+
+func (mfgb *MessageFeedbackGroupBy) sqlScan(ctx context.Context, root *MessageFeedbackQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mfgb.fns))
+	for _, fn := range mfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mfgb.flds)+len(mfgb.fns))
+		for _, f := range *mfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MessageFeedbackSelect is the builder for selecting fields of MessageFeedback entities.
+type MessageFeedbackSelect struct {
+	*MessageFeedbackQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfs *MessageFeedbackSelect) Aggregate(fns ...AggregateFunc) *MessageFeedbackSelect {
+	mfs.fns = append(mfs.fns, fns...)
+	return mfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfs *MessageFeedbackSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfs.ctx, "Select")
+	if err := mfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MessageFeedbackQuery, *MessageFeedbackSelect](ctx, mfs.MessageFeedbackQuery, mfs, mfs.inters, v)
+}
+
+func (mfs *MessageFeedbackSelect) sqlScan(ctx context.Context, root *MessageFeedbackQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mfs.fns))
+	for _, fn := range mfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
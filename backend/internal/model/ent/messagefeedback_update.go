@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"agent-platform/internal/model/ent/messagefeedback"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageFeedbackUpdate is the builder for updating MessageFeedback entities.
+type MessageFeedbackUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageFeedbackMutation
+}
+
+// Where appends a list predicates to the MessageFeedbackUpdate builder.
+func (mfu *MessageFeedbackUpdate) Where(ps ...predicate.MessageFeedback) *MessageFeedbackUpdate {
+	mfu.mutation.Where(ps...)
+	return mfu
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mfu *MessageFeedbackUpdate) SetConversationID(s string) *MessageFeedbackUpdate {
+	mfu.mutation.SetConversationID(s)
+	return mfu
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (mfu *MessageFeedbackUpdate) SetNillableConversationID(s *string) *MessageFeedbackUpdate {
+	if s != nil {
+		mfu.SetConversationID(*s)
+	}
+	return mfu
+}
+
+// SetAgentID sets the "agent_id" field.
+func (mfu *MessageFeedbackUpdate) SetAgentID(s string) *MessageFeedbackUpdate {
+	mfu.mutation.SetAgentID(s)
+	return mfu
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (mfu *MessageFeedbackUpdate) SetNillableAgentID(s *string) *MessageFeedbackUpdate {
+	if s != nil {
+		mfu.SetAgentID(*s)
+	}
+	return mfu
+}
+
+// SetUserID sets the "user_id" field.
+func (mfu *MessageFeedbackUpdate) SetUserID(s string) *MessageFeedbackUpdate {
+	mfu.mutation.SetUserID(s)
+	return mfu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mfu *MessageFeedbackUpdate) SetNillableUserID(s *string) *MessageFeedbackUpdate {
+	if s != nil {
+		mfu.SetUserID(*s)
+	}
+	return mfu
+}
+
+// SetRating sets the "rating" field.
+func (mfu *MessageFeedbackUpdate) SetRating(i int) *MessageFeedbackUpdate {
+	mfu.mutation.ResetRating()
+	mfu.mutation.SetRating(i)
+	return mfu
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (mfu *MessageFeedbackUpdate) SetNillableRating(i *int) *MessageFeedbackUpdate {
+	if i != nil {
+		mfu.SetRating(*i)
+	}
+	return mfu
+}
+
+// AddRating adds i to the "rating" field.
+func (mfu *MessageFeedbackUpdate) AddRating(i int) *MessageFeedbackUpdate {
+	mfu.mutation.AddRating(i)
+	return mfu
+}
+
+// SetReason sets the "reason" field.
+func (mfu *MessageFeedbackUpdate) SetReason(s string) *MessageFeedbackUpdate {
+	mfu.mutation.SetReason(s)
+	return mfu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mfu *MessageFeedbackUpdate) SetNillableReason(s *string) *MessageFeedbackUpdate {
+	if s != nil {
+		mfu.SetReason(*s)
+	}
+	return mfu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (mfu *MessageFeedbackUpdate) ClearReason() *MessageFeedbackUpdate {
+	mfu.mutation.ClearReason()
+	return mfu
+}
+
+// SetComment sets the "comment" field.
+func (mfu *MessageFeedbackUpdate) SetComment(s string) *MessageFeedbackUpdate {
+	mfu.mutation.SetComment(s)
+	return mfu
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (mfu *MessageFeedbackUpdate) SetNillableComment(s *string) *MessageFeedbackUpdate {
+	if s != nil {
+		mfu.SetComment(*s)
+	}
+	return mfu
+}
+
+// ClearComment clears the value of the "comment" field.
+func (mfu *MessageFeedbackUpdate) ClearComment() *MessageFeedbackUpdate {
+	mfu.mutation.ClearComment()
+	return mfu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mfu *MessageFeedbackUpdate) SetUpdatedAt(t time.Time) *MessageFeedbackUpdate {
+	mfu.mutation.SetUpdatedAt(t)
+	return mfu
+}
+
+// Mutation returns the MessageFeedbackMutation object of the builder.
+func (mfu *MessageFeedbackUpdate) Mutation() *MessageFeedbackMutation {
+	return mfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfu *MessageFeedbackUpdate) Save(ctx context.Context) (int, error) {
+	mfu.defaults()
+	return withHooks(ctx, mfu.sqlSave, mfu.mutation, mfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfu *MessageFeedbackUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfu *MessageFeedbackUpdate) Exec(ctx context.Context) error {
+	_, err := mfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfu *MessageFeedbackUpdate) ExecX(ctx context.Context) {
+	if err := mfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mfu *MessageFeedbackUpdate) defaults() {
+	if _, ok := mfu.mutation.UpdatedAt(); !ok {
+		v := messagefeedback.UpdateDefaultUpdatedAt()
+		mfu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfu *MessageFeedbackUpdate) check() error {
+	if v, ok := mfu.mutation.ConversationID(); ok {
+		if err := messagefeedback.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := mfu.mutation.AgentID(); ok {
+		if err := messagefeedback.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := mfu.mutation.UserID(); ok {
+		if err := messagefeedback.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.user_id": %w`, err)}
+		}
+	}
+	if _, ok := mfu.mutation.MessageID(); mfu.mutation.MessageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MessageFeedback.message"`)
+	}
+	return nil
+}
+
+func (mfu *MessageFeedbackUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagefeedback.Table, messagefeedback.Columns, sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString))
+	if ps := mfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfu.mutation.ConversationID(); ok {
+		_spec.SetField(messagefeedback.FieldConversationID, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.AgentID(); ok {
+		_spec.SetField(messagefeedback.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.UserID(); ok {
+		_spec.SetField(messagefeedback.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.Rating(); ok {
+		_spec.SetField(messagefeedback.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.AddedRating(); ok {
+		_spec.AddField(messagefeedback.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := mfu.mutation.Reason(); ok {
+		_spec.SetField(messagefeedback.FieldReason, field.TypeString, value)
+	}
+	if mfu.mutation.ReasonCleared() {
+		_spec.ClearField(messagefeedback.FieldReason, field.TypeString)
+	}
+	if value, ok := mfu.mutation.Comment(); ok {
+		_spec.SetField(messagefeedback.FieldComment, field.TypeString, value)
+	}
+	if mfu.mutation.CommentCleared() {
+		_spec.ClearField(messagefeedback.FieldComment, field.TypeString)
+	}
+	if value, ok := mfu.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagefeedback.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagefeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mfu.mutation.done = true
+	return n, nil
+}
+
+// MessageFeedbackUpdateOne is the builder for updating a single MessageFeedback entity.
+type MessageFeedbackUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageFeedbackMutation
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (mfuo *MessageFeedbackUpdateOne) SetConversationID(s string) *MessageFeedbackUpdateOne {
+	mfuo.mutation.SetConversationID(s)
+	return mfuo
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (mfuo *MessageFeedbackUpdateOne) SetNillableConversationID(s *string) *MessageFeedbackUpdateOne {
+	if s != nil {
+		mfuo.SetConversationID(*s)
+	}
+	return mfuo
+}
+
+// SetAgentID sets the "agent_id" field.
+func (mfuo *MessageFeedbackUpdateOne) SetAgentID(s string) *MessageFeedbackUpdateOne {
+	mfuo.mutation.SetAgentID(s)
+	return mfuo
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (mfuo *MessageFeedbackUpdateOne) SetNillableAgentID(s *string) *MessageFeedbackUpdateOne {
+	if s != nil {
+		mfuo.SetAgentID(*s)
+	}
+	return mfuo
+}
+
+// SetUserID sets the "user_id" field.
+func (mfuo *MessageFeedbackUpdateOne) SetUserID(s string) *MessageFeedbackUpdateOne {
+	mfuo.mutation.SetUserID(s)
+	return mfuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mfuo *MessageFeedbackUpdateOne) SetNillableUserID(s *string) *MessageFeedbackUpdateOne {
+	if s != nil {
+		mfuo.SetUserID(*s)
+	}
+	return mfuo
+}
+
+// SetRating sets the "rating" field.
+func (mfuo *MessageFeedbackUpdateOne) SetRating(i int) *MessageFeedbackUpdateOne {
+	mfuo.mutation.ResetRating()
+	mfuo.mutation.SetRating(i)
+	return mfuo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (mfuo *MessageFeedbackUpdateOne) SetNillableRating(i *int) *MessageFeedbackUpdateOne {
+	if i != nil {
+		mfuo.SetRating(*i)
+	}
+	return mfuo
+}
+
+// AddRating adds i to the "rating" field.
+func (mfuo *MessageFeedbackUpdateOne) AddRating(i int) *MessageFeedbackUpdateOne {
+	mfuo.mutation.AddRating(i)
+	return mfuo
+}
+
+// SetReason sets the "reason" field.
+func (mfuo *MessageFeedbackUpdateOne) SetReason(s string) *MessageFeedbackUpdateOne {
+	mfuo.mutation.SetReason(s)
+	return mfuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mfuo *MessageFeedbackUpdateOne) SetNillableReason(s *string) *MessageFeedbackUpdateOne {
+	if s != nil {
+		mfuo.SetReason(*s)
+	}
+	return mfuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (mfuo *MessageFeedbackUpdateOne) ClearReason() *MessageFeedbackUpdateOne {
+	mfuo.mutation.ClearReason()
+	return mfuo
+}
+
+// SetComment sets the "comment" field.
+func (mfuo *MessageFeedbackUpdateOne) SetComment(s string) *MessageFeedbackUpdateOne {
+	mfuo.mutation.SetComment(s)
+	return mfuo
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (mfuo *MessageFeedbackUpdateOne) SetNillableComment(s *string) *MessageFeedbackUpdateOne {
+	if s != nil {
+		mfuo.SetComment(*s)
+	}
+	return mfuo
+}
+
+// ClearComment clears the value of the "comment" field.
+func (mfuo *MessageFeedbackUpdateOne) ClearComment() *MessageFeedbackUpdateOne {
+	mfuo.mutation.ClearComment()
+	return mfuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mfuo *MessageFeedbackUpdateOne) SetUpdatedAt(t time.Time) *MessageFeedbackUpdateOne {
+	mfuo.mutation.SetUpdatedAt(t)
+	return mfuo
+}
+
+// Mutation returns the MessageFeedbackMutation object of the builder.
+func (mfuo *MessageFeedbackUpdateOne) Mutation() *MessageFeedbackMutation {
+	return mfuo.mutation
+}
+
+// Where appends a list predicates to the MessageFeedbackUpdate builder.
+func (mfuo *MessageFeedbackUpdateOne) Where(ps ...predicate.MessageFeedback) *MessageFeedbackUpdateOne {
+	mfuo.mutation.Where(ps...)
+	return mfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfuo *MessageFeedbackUpdateOne) Select(field string, fields ...string) *MessageFeedbackUpdateOne {
+	mfuo.fields = append([]string{field}, fields...)
+	return mfuo
+}
+
+// Save executes the query and returns the updated MessageFeedback entity.
+func (mfuo *MessageFeedbackUpdateOne) Save(ctx context.Context) (*MessageFeedback, error) {
+	mfuo.defaults()
+	return withHooks(ctx, mfuo.sqlSave, mfuo.mutation, mfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfuo *MessageFeedbackUpdateOne) SaveX(ctx context.Context) *MessageFeedback {
+	node, err := mfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfuo *MessageFeedbackUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfuo *MessageFeedbackUpdateOne) ExecX(ctx context.Context) {
+	if err := mfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mfuo *MessageFeedbackUpdateOne) defaults() {
+	if _, ok := mfuo.mutation.UpdatedAt(); !ok {
+		v := messagefeedback.UpdateDefaultUpdatedAt()
+		mfuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfuo *MessageFeedbackUpdateOne) check() error {
+	if v, ok := mfuo.mutation.ConversationID(); ok {
+		if err := messagefeedback.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := mfuo.mutation.AgentID(); ok {
+		if err := messagefeedback.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := mfuo.mutation.UserID(); ok {
+		if err := messagefeedback.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MessageFeedback.user_id": %w`, err)}
+		}
+	}
+	if _, ok := mfuo.mutation.MessageID(); mfuo.mutation.MessageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MessageFeedback.message"`)
+	}
+	return nil
+}
+
+func (mfuo *MessageFeedbackUpdateOne) sqlSave(ctx context.Context) (_node *MessageFeedback, err error) {
+	if err := mfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagefeedback.Table, messagefeedback.Columns, sqlgraph.NewFieldSpec(messagefeedback.FieldID, field.TypeString))
+	id, ok := mfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageFeedback.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagefeedback.FieldID)
+		for _, f := range fields {
+			if !messagefeedback.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagefeedback.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfuo.mutation.ConversationID(); ok {
+		_spec.SetField(messagefeedback.FieldConversationID, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.AgentID(); ok {
+		_spec.SetField(messagefeedback.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.UserID(); ok {
+		_spec.SetField(messagefeedback.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.Rating(); ok {
+		_spec.SetField(messagefeedback.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.AddedRating(); ok {
+		_spec.AddField(messagefeedback.FieldRating, field.TypeInt, value)
+	}
+	if value, ok := mfuo.mutation.Reason(); ok {
+		_spec.SetField(messagefeedback.FieldReason, field.TypeString, value)
+	}
+	if mfuo.mutation.ReasonCleared() {
+		_spec.ClearField(messagefeedback.FieldReason, field.TypeString)
+	}
+	if value, ok := mfuo.mutation.Comment(); ok {
+		_spec.SetField(messagefeedback.FieldComment, field.TypeString, value)
+	}
+	if mfuo.mutation.CommentCleared() {
+		_spec.ClearField(messagefeedback.FieldComment, field.TypeString)
+	}
+	if value, ok := mfuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagefeedback.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MessageFeedback{config: mfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagefeedback.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mfuo.mutation.done = true
+	return _node, nil
+}
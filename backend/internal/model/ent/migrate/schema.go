@@ -17,16 +17,21 @@ var (
 		{Name: "model_config", Type: field.TypeJSON, Nullable: true},
 		{Name: "tools", Type: field.TypeJSON, Nullable: true},
 		{Name: "knowledge_bases", Type: field.TypeJSON, Nullable: true},
+		{Name: "members", Type: field.TypeJSON, Nullable: true},
 		{Name: "prompt_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "draft"},
 		{Name: "version", Type: field.TypeString, Default: "1.0.0"},
+		{Name: "published_version_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "folder", Type: field.TypeString, Nullable: true},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "allow_clone", Type: field.TypeBool, Default: false},
+		{Name: "template_id", Type: field.TypeString, Nullable: true},
+		{Name: "template_version", Type: field.TypeString, Nullable: true},
 	}
 	// AgentsTable holds the schema information for the "agents" table.
 	AgentsTable = &schema.Table{
@@ -37,17 +42,101 @@ var (
 			{
 				Name:    "agent_created_by",
 				Unique:  false,
-				Columns: []*schema.Column{AgentsColumns[11]},
+				Columns: []*schema.Column{AgentsColumns[13]},
 			},
 			{
 				Name:    "agent_status",
 				Unique:  false,
-				Columns: []*schema.Column{AgentsColumns[9]},
+				Columns: []*schema.Column{AgentsColumns[10]},
 			},
 			{
 				Name:    "agent_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{AgentsColumns[12]},
+				Columns: []*schema.Column{AgentsColumns[14]},
+			},
+		},
+	}
+	// AgentFoldersColumns holds the columns for the "agent_folders" table.
+	AgentFoldersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AgentFoldersTable holds the schema information for the "agent_folders" table.
+	AgentFoldersTable = &schema.Table{
+		Name:       "agent_folders",
+		Columns:    AgentFoldersColumns,
+		PrimaryKey: []*schema.Column{AgentFoldersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentfolder_created_by_path",
+				Unique:  true,
+				Columns: []*schema.Column{AgentFoldersColumns[2], AgentFoldersColumns[1]},
+			},
+		},
+	}
+	// AgentSharesColumns holds the columns for the "agent_shares" table.
+	AgentSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "principal_type", Type: field.TypeString},
+		{Name: "principal_id", Type: field.TypeString},
+		{Name: "permission", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AgentSharesTable holds the schema information for the "agent_shares" table.
+	AgentSharesTable = &schema.Table{
+		Name:       "agent_shares",
+		Columns:    AgentSharesColumns,
+		PrimaryKey: []*schema.Column{AgentSharesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentshare_agent_id_principal_type_principal_id",
+				Unique:  true,
+				Columns: []*schema.Column{AgentSharesColumns[1], AgentSharesColumns[2], AgentSharesColumns[3]},
+			},
+			{
+				Name:    "agentshare_principal_type_principal_id",
+				Unique:  false,
+				Columns: []*schema.Column{AgentSharesColumns[2], AgentSharesColumns[3]},
+			},
+		},
+	}
+	// AgentVersionsColumns holds the columns for the "agent_versions" table.
+	AgentVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "type", Type: field.TypeString},
+		{Name: "model_config", Type: field.TypeJSON, Nullable: true},
+		{Name: "tools", Type: field.TypeJSON, Nullable: true},
+		{Name: "knowledge_bases", Type: field.TypeJSON, Nullable: true},
+		{Name: "members", Type: field.TypeJSON, Nullable: true},
+		{Name: "prompt_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AgentVersionsTable holds the schema information for the "agent_versions" table.
+	AgentVersionsTable = &schema.Table{
+		Name:       "agent_versions",
+		Columns:    AgentVersionsColumns,
+		PrimaryKey: []*schema.Column{AgentVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentversion_agent_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{AgentVersionsColumns[1], AgentVersionsColumns[2]},
+			},
+			{
+				Name:    "agentversion_agent_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AgentVersionsColumns[1], AgentVersionsColumns[14]},
 			},
 		},
 	}
@@ -57,6 +146,7 @@ var (
 		{Name: "agent_id", Type: field.TypeString},
 		{Name: "user_id", Type: field.TypeString},
 		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "title_source", Type: field.TypeString, Default: "default"},
 		{Name: "messages", Type: field.TypeJSON, Nullable: true},
 		{Name: "context", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
@@ -64,6 +154,9 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
+		{Name: "active_message_id", Type: field.TypeString, Nullable: true},
+		{Name: "agent_version_id", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
 	}
 	// ConversationsTable holds the schema information for the "conversations" table.
 	ConversationsTable = &schema.Table{
@@ -84,12 +177,12 @@ var (
 			{
 				Name:    "conversation_status",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationsColumns[7]},
+				Columns: []*schema.Column{ConversationsColumns[8]},
 			},
 			{
 				Name:    "conversation_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ConversationsColumns[8]},
+				Columns: []*schema.Column{ConversationsColumns[9]},
 			},
 		},
 	}
@@ -102,7 +195,11 @@ var (
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "embedding", Type: field.TypeJSON, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
+		{Name: "boost", Type: field.TypeFloat64, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
 	// DocumentChunksTable holds the schema information for the "document_chunks" table.
 	DocumentChunksTable = &schema.Table{
@@ -166,6 +263,121 @@ var (
 			},
 		},
 	}
+	// MemoriesColumns holds the columns for the "memories" table.
+	MemoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "embedding", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_conversation_id", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// MemoriesTable holds the schema information for the "memories" table.
+	MemoriesTable = &schema.Table{
+		Name:       "memories",
+		Columns:    MemoriesColumns,
+		PrimaryKey: []*schema.Column{MemoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "memory_user_id_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{MemoriesColumns[1], MemoriesColumns[2]},
+			},
+			{
+				Name:    "memory_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MemoriesColumns[7]},
+			},
+		},
+	}
+	// MessagesColumns holds the columns for the "messages" table.
+	MessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "prompt_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "conversation_id", Type: field.TypeString},
+	}
+	// MessagesTable holds the schema information for the "messages" table.
+	MessagesTable = &schema.Table{
+		Name:       "messages",
+		Columns:    MessagesColumns,
+		PrimaryKey: []*schema.Column{MessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "messages_conversations_messages",
+				Columns:    []*schema.Column{MessagesColumns[11]},
+				RefColumns: []*schema.Column{ConversationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "message_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[11], MessagesColumns[9]},
+			},
+			{
+				Name:    "message_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[1]},
+			},
+			{
+				Name:    "message_conversation_id_idempotency_key",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[11], MessagesColumns[8]},
+			},
+		},
+	}
+	// MessageFeedbacksColumns holds the columns for the "message_feedbacks" table.
+	MessageFeedbacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "conversation_id", Type: field.TypeString},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "rating", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeString},
+	}
+	// MessageFeedbacksTable holds the schema information for the "message_feedbacks" table.
+	MessageFeedbacksTable = &schema.Table{
+		Name:       "message_feedbacks",
+		Columns:    MessageFeedbacksColumns,
+		PrimaryKey: []*schema.Column{MessageFeedbacksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_feedbacks_messages_feedback",
+				Columns:    []*schema.Column{MessageFeedbacksColumns[9]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagefeedback_message_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessageFeedbacksColumns[9], MessageFeedbacksColumns[3]},
+			},
+			{
+				Name:    "messagefeedback_agent_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageFeedbacksColumns[2], MessageFeedbacksColumns[7]},
+			},
+		},
+	}
 	// ToolsColumns holds the columns for the "tools" table.
 	ToolsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -312,9 +524,15 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AgentsTable,
+		AgentFoldersTable,
+		AgentSharesTable,
+		AgentVersionsTable,
 		ConversationsTable,
 		DocumentChunksTable,
 		KnowledgeBasesTable,
+		MemoriesTable,
+		MessagesTable,
+		MessageFeedbacksTable,
 		ToolsTable,
 		UsersTable,
 		WorkflowsTable,
@@ -323,4 +541,6 @@ var (
 )
 
 func init() {
+	MessagesTable.ForeignKeys[0].RefTable = ConversationsTable
+	MessageFeedbacksTable.ForeignKeys[0].RefTable = MessagesTable
 }
@@ -4,9 +4,15 @@ package ent
 
 import (
 	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/agentversion"
 	"agent-platform/internal/model/ent/conversation"
 	"agent-platform/internal/model/ent/documentchunk"
 	"agent-platform/internal/model/ent/knowledgebase"
+	"agent-platform/internal/model/ent/memory"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
 	"agent-platform/internal/model/ent/predicate"
 	"agent-platform/internal/model/ent/tool"
 	"agent-platform/internal/model/ent/user"
@@ -32,9 +38,15 @@ const (
 
 	// Node types.
 	TypeAgent             = "Agent"
+	TypeAgentFolder       = "AgentFolder"
+	TypeAgentShare        = "AgentShare"
+	TypeAgentVersion      = "AgentVersion"
 	TypeConversation      = "Conversation"
 	TypeDocumentChunk     = "DocumentChunk"
 	TypeKnowledgeBase     = "KnowledgeBase"
+	TypeMemory            = "Memory"
+	TypeMessage           = "Message"
+	TypeMessageFeedback   = "MessageFeedback"
 	TypeTool              = "Tool"
 	TypeUser              = "User"
 	TypeWorkflow          = "Workflow"
@@ -55,10 +67,13 @@ type AgentMutation struct {
 	appendtools           []string
 	knowledge_bases       *[]string
 	appendknowledge_bases []string
+	members               *[]string
+	appendmembers         []string
 	prompt_template       *string
 	parameters            *map[string]interface{}
 	status                *string
 	version               *string
+	published_version_id  *string
 	created_by            *string
 	created_at            *time.Time
 	updated_at            *time.Time
@@ -66,6 +81,9 @@ type AgentMutation struct {
 	appendtags            []string
 	folder                *string
 	is_public             *bool
+	allow_clone           *bool
+	template_id           *string
+	template_version      *string
 	clearedFields         map[string]struct{}
 	done                  bool
 	oldValue              func(context.Context) (*Agent, error)
@@ -476,6 +494,71 @@ func (m *AgentMutation) ResetKnowledgeBases() {
 	delete(m.clearedFields, agent.FieldKnowledgeBases)
 }
 
+// SetMembers sets the "members" field.
+func (m *AgentMutation) SetMembers(s []string) {
+	m.members = &s
+	m.appendmembers = nil
+}
+
+// Members returns the value of the "members" field in the mutation.
+func (m *AgentMutation) Members() (r []string, exists bool) {
+	v := m.members
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMembers returns the old "members" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldMembers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMembers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMembers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMembers: %w", err)
+	}
+	return oldValue.Members, nil
+}
+
+// AppendMembers adds s to the "members" field.
+func (m *AgentMutation) AppendMembers(s []string) {
+	m.appendmembers = append(m.appendmembers, s...)
+}
+
+// AppendedMembers returns the list of values that were appended to the "members" field in this mutation.
+func (m *AgentMutation) AppendedMembers() ([]string, bool) {
+	if len(m.appendmembers) == 0 {
+		return nil, false
+	}
+	return m.appendmembers, true
+}
+
+// ClearMembers clears the value of the "members" field.
+func (m *AgentMutation) ClearMembers() {
+	m.members = nil
+	m.appendmembers = nil
+	m.clearedFields[agent.FieldMembers] = struct{}{}
+}
+
+// MembersCleared returns if the "members" field was cleared in this mutation.
+func (m *AgentMutation) MembersCleared() bool {
+	_, ok := m.clearedFields[agent.FieldMembers]
+	return ok
+}
+
+// ResetMembers resets all changes to the "members" field.
+func (m *AgentMutation) ResetMembers() {
+	m.members = nil
+	m.appendmembers = nil
+	delete(m.clearedFields, agent.FieldMembers)
+}
+
 // SetPromptTemplate sets the "prompt_template" field.
 func (m *AgentMutation) SetPromptTemplate(s string) {
 	m.prompt_template = &s
@@ -646,6 +729,55 @@ func (m *AgentMutation) ResetVersion() {
 	m.version = nil
 }
 
+// SetPublishedVersionID sets the "published_version_id" field.
+func (m *AgentMutation) SetPublishedVersionID(s string) {
+	m.published_version_id = &s
+}
+
+// PublishedVersionID returns the value of the "published_version_id" field in the mutation.
+func (m *AgentMutation) PublishedVersionID() (r string, exists bool) {
+	v := m.published_version_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedVersionID returns the old "published_version_id" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldPublishedVersionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedVersionID: %w", err)
+	}
+	return oldValue.PublishedVersionID, nil
+}
+
+// ClearPublishedVersionID clears the value of the "published_version_id" field.
+func (m *AgentMutation) ClearPublishedVersionID() {
+	m.published_version_id = nil
+	m.clearedFields[agent.FieldPublishedVersionID] = struct{}{}
+}
+
+// PublishedVersionIDCleared returns if the "published_version_id" field was cleared in this mutation.
+func (m *AgentMutation) PublishedVersionIDCleared() bool {
+	_, ok := m.clearedFields[agent.FieldPublishedVersionID]
+	return ok
+}
+
+// ResetPublishedVersionID resets all changes to the "published_version_id" field.
+func (m *AgentMutation) ResetPublishedVersionID() {
+	m.published_version_id = nil
+	delete(m.clearedFields, agent.FieldPublishedVersionID)
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (m *AgentMutation) SetCreatedBy(s string) {
 	m.created_by = &s
@@ -904,6 +1036,140 @@ func (m *AgentMutation) ResetIsPublic() {
 	m.is_public = nil
 }
 
+// SetAllowClone sets the "allow_clone" field.
+func (m *AgentMutation) SetAllowClone(b bool) {
+	m.allow_clone = &b
+}
+
+// AllowClone returns the value of the "allow_clone" field in the mutation.
+func (m *AgentMutation) AllowClone() (r bool, exists bool) {
+	v := m.allow_clone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowClone returns the old "allow_clone" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldAllowClone(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowClone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowClone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowClone: %w", err)
+	}
+	return oldValue.AllowClone, nil
+}
+
+// ResetAllowClone resets all changes to the "allow_clone" field.
+func (m *AgentMutation) ResetAllowClone() {
+	m.allow_clone = nil
+}
+
+// SetTemplateID sets the "template_id" field.
+func (m *AgentMutation) SetTemplateID(s string) {
+	m.template_id = &s
+}
+
+// TemplateID returns the value of the "template_id" field in the mutation.
+func (m *AgentMutation) TemplateID() (r string, exists bool) {
+	v := m.template_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateID returns the old "template_id" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldTemplateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateID: %w", err)
+	}
+	return oldValue.TemplateID, nil
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (m *AgentMutation) ClearTemplateID() {
+	m.template_id = nil
+	m.clearedFields[agent.FieldTemplateID] = struct{}{}
+}
+
+// TemplateIDCleared returns if the "template_id" field was cleared in this mutation.
+func (m *AgentMutation) TemplateIDCleared() bool {
+	_, ok := m.clearedFields[agent.FieldTemplateID]
+	return ok
+}
+
+// ResetTemplateID resets all changes to the "template_id" field.
+func (m *AgentMutation) ResetTemplateID() {
+	m.template_id = nil
+	delete(m.clearedFields, agent.FieldTemplateID)
+}
+
+// SetTemplateVersion sets the "template_version" field.
+func (m *AgentMutation) SetTemplateVersion(s string) {
+	m.template_version = &s
+}
+
+// TemplateVersion returns the value of the "template_version" field in the mutation.
+func (m *AgentMutation) TemplateVersion() (r string, exists bool) {
+	v := m.template_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateVersion returns the old "template_version" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldTemplateVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateVersion: %w", err)
+	}
+	return oldValue.TemplateVersion, nil
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (m *AgentMutation) ClearTemplateVersion() {
+	m.template_version = nil
+	m.clearedFields[agent.FieldTemplateVersion] = struct{}{}
+}
+
+// TemplateVersionCleared returns if the "template_version" field was cleared in this mutation.
+func (m *AgentMutation) TemplateVersionCleared() bool {
+	_, ok := m.clearedFields[agent.FieldTemplateVersion]
+	return ok
+}
+
+// ResetTemplateVersion resets all changes to the "template_version" field.
+func (m *AgentMutation) ResetTemplateVersion() {
+	m.template_version = nil
+	delete(m.clearedFields, agent.FieldTemplateVersion)
+}
+
 // Where appends a list predicates to the AgentMutation builder.
 func (m *AgentMutation) Where(ps ...predicate.Agent) {
 	m.predicates = append(m.predicates, ps...)
@@ -938,7 +1204,7 @@ func (m *AgentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AgentMutation) Fields() []string {
-	fields := make([]string, 0, 16)
+	fields := make([]string, 0, 21)
 	if m.name != nil {
 		fields = append(fields, agent.FieldName)
 	}
@@ -957,6 +1223,9 @@ func (m *AgentMutation) Fields() []string {
 	if m.knowledge_bases != nil {
 		fields = append(fields, agent.FieldKnowledgeBases)
 	}
+	if m.members != nil {
+		fields = append(fields, agent.FieldMembers)
+	}
 	if m.prompt_template != nil {
 		fields = append(fields, agent.FieldPromptTemplate)
 	}
@@ -969,6 +1238,9 @@ func (m *AgentMutation) Fields() []string {
 	if m.version != nil {
 		fields = append(fields, agent.FieldVersion)
 	}
+	if m.published_version_id != nil {
+		fields = append(fields, agent.FieldPublishedVersionID)
+	}
 	if m.created_by != nil {
 		fields = append(fields, agent.FieldCreatedBy)
 	}
@@ -987,6 +1259,15 @@ func (m *AgentMutation) Fields() []string {
 	if m.is_public != nil {
 		fields = append(fields, agent.FieldIsPublic)
 	}
+	if m.allow_clone != nil {
+		fields = append(fields, agent.FieldAllowClone)
+	}
+	if m.template_id != nil {
+		fields = append(fields, agent.FieldTemplateID)
+	}
+	if m.template_version != nil {
+		fields = append(fields, agent.FieldTemplateVersion)
+	}
 	return fields
 }
 
@@ -1007,6 +1288,8 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.Tools()
 	case agent.FieldKnowledgeBases:
 		return m.KnowledgeBases()
+	case agent.FieldMembers:
+		return m.Members()
 	case agent.FieldPromptTemplate:
 		return m.PromptTemplate()
 	case agent.FieldParameters:
@@ -1015,6 +1298,8 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.Status()
 	case agent.FieldVersion:
 		return m.Version()
+	case agent.FieldPublishedVersionID:
+		return m.PublishedVersionID()
 	case agent.FieldCreatedBy:
 		return m.CreatedBy()
 	case agent.FieldCreatedAt:
@@ -1027,6 +1312,12 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.Folder()
 	case agent.FieldIsPublic:
 		return m.IsPublic()
+	case agent.FieldAllowClone:
+		return m.AllowClone()
+	case agent.FieldTemplateID:
+		return m.TemplateID()
+	case agent.FieldTemplateVersion:
+		return m.TemplateVersion()
 	}
 	return nil, false
 }
@@ -1048,6 +1339,8 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldTools(ctx)
 	case agent.FieldKnowledgeBases:
 		return m.OldKnowledgeBases(ctx)
+	case agent.FieldMembers:
+		return m.OldMembers(ctx)
 	case agent.FieldPromptTemplate:
 		return m.OldPromptTemplate(ctx)
 	case agent.FieldParameters:
@@ -1056,6 +1349,8 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldStatus(ctx)
 	case agent.FieldVersion:
 		return m.OldVersion(ctx)
+	case agent.FieldPublishedVersionID:
+		return m.OldPublishedVersionID(ctx)
 	case agent.FieldCreatedBy:
 		return m.OldCreatedBy(ctx)
 	case agent.FieldCreatedAt:
@@ -1068,6 +1363,12 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldFolder(ctx)
 	case agent.FieldIsPublic:
 		return m.OldIsPublic(ctx)
+	case agent.FieldAllowClone:
+		return m.OldAllowClone(ctx)
+	case agent.FieldTemplateID:
+		return m.OldTemplateID(ctx)
+	case agent.FieldTemplateVersion:
+		return m.OldTemplateVersion(ctx)
 	}
 	return nil, fmt.Errorf("unknown Agent field %s", name)
 }
@@ -1119,6 +1420,13 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetKnowledgeBases(v)
 		return nil
+	case agent.FieldMembers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMembers(v)
+		return nil
 	case agent.FieldPromptTemplate:
 		v, ok := value.(string)
 		if !ok {
@@ -1147,6 +1455,13 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetVersion(v)
 		return nil
+	case agent.FieldPublishedVersionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedVersionID(v)
+		return nil
 	case agent.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
@@ -1189,6 +1504,27 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsPublic(v)
 		return nil
+	case agent.FieldAllowClone:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowClone(v)
+		return nil
+	case agent.FieldTemplateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateID(v)
+		return nil
+	case agent.FieldTemplateVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Agent field %s", name)
 }
@@ -1231,18 +1567,30 @@ func (m *AgentMutation) ClearedFields() []string {
 	if m.FieldCleared(agent.FieldKnowledgeBases) {
 		fields = append(fields, agent.FieldKnowledgeBases)
 	}
+	if m.FieldCleared(agent.FieldMembers) {
+		fields = append(fields, agent.FieldMembers)
+	}
 	if m.FieldCleared(agent.FieldPromptTemplate) {
 		fields = append(fields, agent.FieldPromptTemplate)
 	}
 	if m.FieldCleared(agent.FieldParameters) {
 		fields = append(fields, agent.FieldParameters)
 	}
+	if m.FieldCleared(agent.FieldPublishedVersionID) {
+		fields = append(fields, agent.FieldPublishedVersionID)
+	}
 	if m.FieldCleared(agent.FieldTags) {
 		fields = append(fields, agent.FieldTags)
 	}
 	if m.FieldCleared(agent.FieldFolder) {
 		fields = append(fields, agent.FieldFolder)
 	}
+	if m.FieldCleared(agent.FieldTemplateID) {
+		fields = append(fields, agent.FieldTemplateID)
+	}
+	if m.FieldCleared(agent.FieldTemplateVersion) {
+		fields = append(fields, agent.FieldTemplateVersion)
+	}
 	return fields
 }
 
@@ -1269,18 +1617,30 @@ func (m *AgentMutation) ClearField(name string) error {
 	case agent.FieldKnowledgeBases:
 		m.ClearKnowledgeBases()
 		return nil
+	case agent.FieldMembers:
+		m.ClearMembers()
+		return nil
 	case agent.FieldPromptTemplate:
 		m.ClearPromptTemplate()
 		return nil
 	case agent.FieldParameters:
 		m.ClearParameters()
 		return nil
+	case agent.FieldPublishedVersionID:
+		m.ClearPublishedVersionID()
+		return nil
 	case agent.FieldTags:
 		m.ClearTags()
 		return nil
 	case agent.FieldFolder:
 		m.ClearFolder()
 		return nil
+	case agent.FieldTemplateID:
+		m.ClearTemplateID()
+		return nil
+	case agent.FieldTemplateVersion:
+		m.ClearTemplateVersion()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent nullable field %s", name)
 }
@@ -1307,6 +1667,9 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldKnowledgeBases:
 		m.ResetKnowledgeBases()
 		return nil
+	case agent.FieldMembers:
+		m.ResetMembers()
+		return nil
 	case agent.FieldPromptTemplate:
 		m.ResetPromptTemplate()
 		return nil
@@ -1319,6 +1682,9 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldVersion:
 		m.ResetVersion()
 		return nil
+	case agent.FieldPublishedVersionID:
+		m.ResetPublishedVersionID()
+		return nil
 	case agent.FieldCreatedBy:
 		m.ResetCreatedBy()
 		return nil
@@ -1337,6 +1703,15 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldIsPublic:
 		m.ResetIsPublic()
 		return nil
+	case agent.FieldAllowClone:
+		m.ResetAllowClone()
+		return nil
+	case agent.FieldTemplateID:
+		m.ResetTemplateID()
+		return nil
+	case agent.FieldTemplateVersion:
+		m.ResetTemplateVersion()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent field %s", name)
 }
@@ -1389,40 +1764,32 @@ func (m *AgentMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Agent edge %s", name)
 }
 
-// ConversationMutation represents an operation that mutates the Conversation nodes in the graph.
-type ConversationMutation struct {
+// AgentFolderMutation represents an operation that mutates the AgentFolder nodes in the graph.
+type AgentFolderMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	agent_id        *string
-	user_id         *string
-	title           *string
-	messages        *[]interface{}
-	appendmessages  []interface{}
-	context         *map[string]interface{}
-	metadata        *map[string]interface{}
-	status          *string
-	created_at      *time.Time
-	updated_at      *time.Time
-	last_message_at *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Conversation, error)
-	predicates      []predicate.Conversation
+	op            Op
+	typ           string
+	id            *string
+	_path         *string
+	created_by    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AgentFolder, error)
+	predicates    []predicate.AgentFolder
 }
 
-var _ ent.Mutation = (*ConversationMutation)(nil)
+var _ ent.Mutation = (*AgentFolderMutation)(nil)
 
-// conversationOption allows management of the mutation configuration using functional options.
-type conversationOption func(*ConversationMutation)
+// agentfolderOption allows management of the mutation configuration using functional options.
+type agentfolderOption func(*AgentFolderMutation)
 
-// newConversationMutation creates new mutation for the Conversation entity.
-func newConversationMutation(c config, op Op, opts ...conversationOption) *ConversationMutation {
-	m := &ConversationMutation{
+// newAgentFolderMutation creates new mutation for the AgentFolder entity.
+func newAgentFolderMutation(c config, op Op, opts ...agentfolderOption) *AgentFolderMutation {
+	m := &AgentFolderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConversation,
+		typ:           TypeAgentFolder,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1431,20 +1798,20 @@ func newConversationMutation(c config, op Op, opts ...conversationOption) *Conve
 	return m
 }
 
-// withConversationID sets the ID field of the mutation.
-func withConversationID(id string) conversationOption {
-	return func(m *ConversationMutation) {
+// withAgentFolderID sets the ID field of the mutation.
+func withAgentFolderID(id string) agentfolderOption {
+	return func(m *AgentFolderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Conversation
+			value *AgentFolder
 		)
-		m.oldValue = func(ctx context.Context) (*Conversation, error) {
+		m.oldValue = func(ctx context.Context) (*AgentFolder, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Conversation.Get(ctx, id)
+					value, err = m.Client().AgentFolder.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1453,10 +1820,10 @@ func withConversationID(id string) conversationOption {
 	}
 }
 
-// withConversation sets the old Conversation of the mutation.
-func withConversation(node *Conversation) conversationOption {
-	return func(m *ConversationMutation) {
-		m.oldValue = func(context.Context) (*Conversation, error) {
+// withAgentFolder sets the old AgentFolder of the mutation.
+func withAgentFolder(node *AgentFolder) agentfolderOption {
+	return func(m *AgentFolderMutation) {
+		m.oldValue = func(context.Context) (*AgentFolder, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1465,7 +1832,7 @@ func withConversation(node *Conversation) conversationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConversationMutation) Client() *Client {
+func (m AgentFolderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1473,7 +1840,7 @@ func (m ConversationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConversationMutation) Tx() (*Tx, error) {
+func (m AgentFolderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1483,14 +1850,14 @@ func (m ConversationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Conversation entities.
-func (m *ConversationMutation) SetID(id string) {
+// operation is only accepted on creation of AgentFolder entities.
+func (m *AgentFolderMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConversationMutation) ID() (id string, exists bool) {
+func (m *AgentFolderMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1501,7 +1868,7 @@ func (m *ConversationMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConversationMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AgentFolderMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1510,254 +1877,5954 @@ func (m *ConversationMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Conversation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AgentFolder.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAgentID sets the "agent_id" field.
-func (m *ConversationMutation) SetAgentID(s string) {
-	m.agent_id = &s
+// SetPath sets the "path" field.
+func (m *AgentFolderMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *ConversationMutation) AgentID() (r string, exists bool) {
-	v := m.agent_id
+// Path returns the value of the "path" field in the mutation.
+func (m *AgentFolderMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the AgentFolder entity.
+// If the AgentFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldAgentID(ctx context.Context) (v string, err error) {
+func (m *AgentFolderMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.AgentID, nil
+	return oldValue.Path, nil
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *ConversationMutation) ResetAgentID() {
-	m.agent_id = nil
+// ResetPath resets all changes to the "path" field.
+func (m *AgentFolderMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ConversationMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetCreatedBy sets the "created_by" field.
+func (m *AgentFolderMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ConversationMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *AgentFolderMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the AgentFolder entity.
+// If the AgentFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *AgentFolderMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ConversationMutation) ResetUserID() {
-	m.user_id = nil
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *AgentFolderMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AgentFolderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AgentFolderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AgentFolder entity.
+// If the AgentFolder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentFolderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AgentFolderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AgentFolderMutation builder.
+func (m *AgentFolderMutation) Where(ps ...predicate.AgentFolder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AgentFolderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AgentFolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AgentFolder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AgentFolderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AgentFolderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AgentFolder).
+func (m *AgentFolderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AgentFolderMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._path != nil {
+		fields = append(fields, agentfolder.FieldPath)
+	}
+	if m.created_by != nil {
+		fields = append(fields, agentfolder.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, agentfolder.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AgentFolderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case agentfolder.FieldPath:
+		return m.Path()
+	case agentfolder.FieldCreatedBy:
+		return m.CreatedBy()
+	case agentfolder.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AgentFolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case agentfolder.FieldPath:
+		return m.OldPath(ctx)
+	case agentfolder.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case agentfolder.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AgentFolder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentFolderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case agentfolder.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case agentfolder.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case agentfolder.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AgentFolder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AgentFolderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AgentFolderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentFolderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AgentFolder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AgentFolderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AgentFolderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AgentFolderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AgentFolder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AgentFolderMutation) ResetField(name string) error {
+	switch name {
+	case agentfolder.FieldPath:
+		m.ResetPath()
+		return nil
+	case agentfolder.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case agentfolder.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentFolder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AgentFolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AgentFolderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AgentFolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AgentFolderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AgentFolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AgentFolderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AgentFolderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AgentFolder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AgentFolderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AgentFolder edge %s", name)
+}
+
+// AgentShareMutation represents an operation that mutates the AgentShare nodes in the graph.
+type AgentShareMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	agent_id       *string
+	principal_type *string
+	principal_id   *string
+	permission     *string
+	created_by     *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*AgentShare, error)
+	predicates     []predicate.AgentShare
+}
+
+var _ ent.Mutation = (*AgentShareMutation)(nil)
+
+// agentshareOption allows management of the mutation configuration using functional options.
+type agentshareOption func(*AgentShareMutation)
+
+// newAgentShareMutation creates new mutation for the AgentShare entity.
+func newAgentShareMutation(c config, op Op, opts ...agentshareOption) *AgentShareMutation {
+	m := &AgentShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAgentShare,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAgentShareID sets the ID field of the mutation.
+func withAgentShareID(id string) agentshareOption {
+	return func(m *AgentShareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AgentShare
+		)
+		m.oldValue = func(ctx context.Context) (*AgentShare, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AgentShare.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAgentShare sets the old AgentShare of the mutation.
+func withAgentShare(node *AgentShare) agentshareOption {
+	return func(m *AgentShareMutation) {
+		m.oldValue = func(context.Context) (*AgentShare, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AgentShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AgentShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AgentShare entities.
+func (m *AgentShareMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AgentShareMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AgentShareMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AgentShare.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *AgentShareMutation) SetAgentID(s string) {
+	m.agent_id = &s
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *AgentShareMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the AgentShare entity.
+// If the AgentShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentShareMutation) OldAgentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *AgentShareMutation) ResetAgentID() {
+	m.agent_id = nil
+}
+
+// SetPrincipalType sets the "principal_type" field.
+func (m *AgentShareMutation) SetPrincipalType(s string) {
+	m.principal_type = &s
+}
+
+// PrincipalType returns the value of the "principal_type" field in the mutation.
+func (m *AgentShareMutation) PrincipalType() (r string, exists bool) {
+	v := m.principal_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrincipalType returns the old "principal_type" field's value of the AgentShare entity.
+// If the AgentShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentShareMutation) OldPrincipalType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrincipalType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrincipalType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrincipalType: %w", err)
+	}
+	return oldValue.PrincipalType, nil
+}
+
+// ResetPrincipalType resets all changes to the "principal_type" field.
+func (m *AgentShareMutation) ResetPrincipalType() {
+	m.principal_type = nil
+}
+
+// SetPrincipalID sets the "principal_id" field.
+func (m *AgentShareMutation) SetPrincipalID(s string) {
+	m.principal_id = &s
+}
+
+// PrincipalID returns the value of the "principal_id" field in the mutation.
+func (m *AgentShareMutation) PrincipalID() (r string, exists bool) {
+	v := m.principal_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrincipalID returns the old "principal_id" field's value of the AgentShare entity.
+// If the AgentShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentShareMutation) OldPrincipalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrincipalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrincipalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrincipalID: %w", err)
+	}
+	return oldValue.PrincipalID, nil
+}
+
+// ResetPrincipalID resets all changes to the "principal_id" field.
+func (m *AgentShareMutation) ResetPrincipalID() {
+	m.principal_id = nil
+}
+
+// SetPermission sets the "permission" field.
+func (m *AgentShareMutation) SetPermission(s string) {
+	m.permission = &s
+}
+
+// Permission returns the value of the "permission" field in the mutation.
+func (m *AgentShareMutation) Permission() (r string, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermission returns the old "permission" field's value of the AgentShare entity.
+// If the AgentShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentShareMutation) OldPermission(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermission: %w", err)
+	}
+	return oldValue.Permission, nil
+}
+
+// ResetPermission resets all changes to the "permission" field.
+func (m *AgentShareMutation) ResetPermission() {
+	m.permission = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *AgentShareMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *AgentShareMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the AgentShare entity.
+// If the AgentShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentShareMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *AgentShareMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AgentShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AgentShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AgentShare entity.
+// If the AgentShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AgentShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AgentShareMutation builder.
+func (m *AgentShareMutation) Where(ps ...predicate.AgentShare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AgentShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AgentShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AgentShare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AgentShareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AgentShareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AgentShare).
+func (m *AgentShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AgentShareMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.agent_id != nil {
+		fields = append(fields, agentshare.FieldAgentID)
+	}
+	if m.principal_type != nil {
+		fields = append(fields, agentshare.FieldPrincipalType)
+	}
+	if m.principal_id != nil {
+		fields = append(fields, agentshare.FieldPrincipalID)
+	}
+	if m.permission != nil {
+		fields = append(fields, agentshare.FieldPermission)
+	}
+	if m.created_by != nil {
+		fields = append(fields, agentshare.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, agentshare.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AgentShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case agentshare.FieldAgentID:
+		return m.AgentID()
+	case agentshare.FieldPrincipalType:
+		return m.PrincipalType()
+	case agentshare.FieldPrincipalID:
+		return m.PrincipalID()
+	case agentshare.FieldPermission:
+		return m.Permission()
+	case agentshare.FieldCreatedBy:
+		return m.CreatedBy()
+	case agentshare.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AgentShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case agentshare.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case agentshare.FieldPrincipalType:
+		return m.OldPrincipalType(ctx)
+	case agentshare.FieldPrincipalID:
+		return m.OldPrincipalID(ctx)
+	case agentshare.FieldPermission:
+		return m.OldPermission(ctx)
+	case agentshare.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case agentshare.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AgentShare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case agentshare.FieldAgentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case agentshare.FieldPrincipalType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrincipalType(v)
+		return nil
+	case agentshare.FieldPrincipalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrincipalID(v)
+		return nil
+	case agentshare.FieldPermission:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermission(v)
+		return nil
+	case agentshare.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case agentshare.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AgentShare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AgentShareMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AgentShareMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AgentShare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AgentShareMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AgentShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AgentShareMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AgentShare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AgentShareMutation) ResetField(name string) error {
+	switch name {
+	case agentshare.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case agentshare.FieldPrincipalType:
+		m.ResetPrincipalType()
+		return nil
+	case agentshare.FieldPrincipalID:
+		m.ResetPrincipalID()
+		return nil
+	case agentshare.FieldPermission:
+		m.ResetPermission()
+		return nil
+	case agentshare.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case agentshare.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentShare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AgentShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AgentShareMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AgentShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AgentShareMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AgentShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AgentShareMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AgentShareMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AgentShare unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AgentShareMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AgentShare edge %s", name)
+}
+
+// AgentVersionMutation represents an operation that mutates the AgentVersion nodes in the graph.
+type AgentVersionMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	agent_id              *string
+	version               *string
+	name                  *string
+	description           *string
+	_type                 *string
+	model_config          *map[string]interface{}
+	tools                 *[]string
+	appendtools           []string
+	knowledge_bases       *[]string
+	appendknowledge_bases []string
+	members               *[]string
+	appendmembers         []string
+	prompt_template       *string
+	parameters            *map[string]interface{}
+	notes                 *string
+	created_by            *string
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*AgentVersion, error)
+	predicates            []predicate.AgentVersion
+}
+
+var _ ent.Mutation = (*AgentVersionMutation)(nil)
+
+// agentversionOption allows management of the mutation configuration using functional options.
+type agentversionOption func(*AgentVersionMutation)
+
+// newAgentVersionMutation creates new mutation for the AgentVersion entity.
+func newAgentVersionMutation(c config, op Op, opts ...agentversionOption) *AgentVersionMutation {
+	m := &AgentVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAgentVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAgentVersionID sets the ID field of the mutation.
+func withAgentVersionID(id string) agentversionOption {
+	return func(m *AgentVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AgentVersion
+		)
+		m.oldValue = func(ctx context.Context) (*AgentVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AgentVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAgentVersion sets the old AgentVersion of the mutation.
+func withAgentVersion(node *AgentVersion) agentversionOption {
+	return func(m *AgentVersionMutation) {
+		m.oldValue = func(context.Context) (*AgentVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AgentVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AgentVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AgentVersion entities.
+func (m *AgentVersionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AgentVersionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AgentVersionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AgentVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *AgentVersionMutation) SetAgentID(s string) {
+	m.agent_id = &s
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *AgentVersionMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldAgentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *AgentVersionMutation) ResetAgentID() {
+	m.agent_id = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *AgentVersionMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *AgentVersionMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *AgentVersionMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetName sets the "name" field.
+func (m *AgentVersionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AgentVersionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *AgentVersionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *AgentVersionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *AgentVersionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *AgentVersionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[agentversion.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *AgentVersionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *AgentVersionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, agentversion.FieldDescription)
+}
+
+// SetType sets the "type" field.
+func (m *AgentVersionMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *AgentVersionMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *AgentVersionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetModelConfig sets the "model_config" field.
+func (m *AgentVersionMutation) SetModelConfig(value map[string]interface{}) {
+	m.model_config = &value
+}
+
+// ModelConfig returns the value of the "model_config" field in the mutation.
+func (m *AgentVersionMutation) ModelConfig() (r map[string]interface{}, exists bool) {
+	v := m.model_config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelConfig returns the old "model_config" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldModelConfig(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelConfig: %w", err)
+	}
+	return oldValue.ModelConfig, nil
+}
+
+// ClearModelConfig clears the value of the "model_config" field.
+func (m *AgentVersionMutation) ClearModelConfig() {
+	m.model_config = nil
+	m.clearedFields[agentversion.FieldModelConfig] = struct{}{}
+}
+
+// ModelConfigCleared returns if the "model_config" field was cleared in this mutation.
+func (m *AgentVersionMutation) ModelConfigCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldModelConfig]
+	return ok
+}
+
+// ResetModelConfig resets all changes to the "model_config" field.
+func (m *AgentVersionMutation) ResetModelConfig() {
+	m.model_config = nil
+	delete(m.clearedFields, agentversion.FieldModelConfig)
+}
+
+// SetTools sets the "tools" field.
+func (m *AgentVersionMutation) SetTools(s []string) {
+	m.tools = &s
+	m.appendtools = nil
+}
+
+// Tools returns the value of the "tools" field in the mutation.
+func (m *AgentVersionMutation) Tools() (r []string, exists bool) {
+	v := m.tools
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTools returns the old "tools" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldTools(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTools is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTools requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTools: %w", err)
+	}
+	return oldValue.Tools, nil
+}
+
+// AppendTools adds s to the "tools" field.
+func (m *AgentVersionMutation) AppendTools(s []string) {
+	m.appendtools = append(m.appendtools, s...)
+}
+
+// AppendedTools returns the list of values that were appended to the "tools" field in this mutation.
+func (m *AgentVersionMutation) AppendedTools() ([]string, bool) {
+	if len(m.appendtools) == 0 {
+		return nil, false
+	}
+	return m.appendtools, true
+}
+
+// ClearTools clears the value of the "tools" field.
+func (m *AgentVersionMutation) ClearTools() {
+	m.tools = nil
+	m.appendtools = nil
+	m.clearedFields[agentversion.FieldTools] = struct{}{}
+}
+
+// ToolsCleared returns if the "tools" field was cleared in this mutation.
+func (m *AgentVersionMutation) ToolsCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldTools]
+	return ok
+}
+
+// ResetTools resets all changes to the "tools" field.
+func (m *AgentVersionMutation) ResetTools() {
+	m.tools = nil
+	m.appendtools = nil
+	delete(m.clearedFields, agentversion.FieldTools)
+}
+
+// SetKnowledgeBases sets the "knowledge_bases" field.
+func (m *AgentVersionMutation) SetKnowledgeBases(s []string) {
+	m.knowledge_bases = &s
+	m.appendknowledge_bases = nil
+}
+
+// KnowledgeBases returns the value of the "knowledge_bases" field in the mutation.
+func (m *AgentVersionMutation) KnowledgeBases() (r []string, exists bool) {
+	v := m.knowledge_bases
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKnowledgeBases returns the old "knowledge_bases" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldKnowledgeBases(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKnowledgeBases is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKnowledgeBases requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKnowledgeBases: %w", err)
+	}
+	return oldValue.KnowledgeBases, nil
+}
+
+// AppendKnowledgeBases adds s to the "knowledge_bases" field.
+func (m *AgentVersionMutation) AppendKnowledgeBases(s []string) {
+	m.appendknowledge_bases = append(m.appendknowledge_bases, s...)
+}
+
+// AppendedKnowledgeBases returns the list of values that were appended to the "knowledge_bases" field in this mutation.
+func (m *AgentVersionMutation) AppendedKnowledgeBases() ([]string, bool) {
+	if len(m.appendknowledge_bases) == 0 {
+		return nil, false
+	}
+	return m.appendknowledge_bases, true
+}
+
+// ClearKnowledgeBases clears the value of the "knowledge_bases" field.
+func (m *AgentVersionMutation) ClearKnowledgeBases() {
+	m.knowledge_bases = nil
+	m.appendknowledge_bases = nil
+	m.clearedFields[agentversion.FieldKnowledgeBases] = struct{}{}
+}
+
+// KnowledgeBasesCleared returns if the "knowledge_bases" field was cleared in this mutation.
+func (m *AgentVersionMutation) KnowledgeBasesCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldKnowledgeBases]
+	return ok
+}
+
+// ResetKnowledgeBases resets all changes to the "knowledge_bases" field.
+func (m *AgentVersionMutation) ResetKnowledgeBases() {
+	m.knowledge_bases = nil
+	m.appendknowledge_bases = nil
+	delete(m.clearedFields, agentversion.FieldKnowledgeBases)
+}
+
+// SetMembers sets the "members" field.
+func (m *AgentVersionMutation) SetMembers(s []string) {
+	m.members = &s
+	m.appendmembers = nil
+}
+
+// Members returns the value of the "members" field in the mutation.
+func (m *AgentVersionMutation) Members() (r []string, exists bool) {
+	v := m.members
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMembers returns the old "members" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldMembers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMembers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMembers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMembers: %w", err)
+	}
+	return oldValue.Members, nil
+}
+
+// AppendMembers adds s to the "members" field.
+func (m *AgentVersionMutation) AppendMembers(s []string) {
+	m.appendmembers = append(m.appendmembers, s...)
+}
+
+// AppendedMembers returns the list of values that were appended to the "members" field in this mutation.
+func (m *AgentVersionMutation) AppendedMembers() ([]string, bool) {
+	if len(m.appendmembers) == 0 {
+		return nil, false
+	}
+	return m.appendmembers, true
+}
+
+// ClearMembers clears the value of the "members" field.
+func (m *AgentVersionMutation) ClearMembers() {
+	m.members = nil
+	m.appendmembers = nil
+	m.clearedFields[agentversion.FieldMembers] = struct{}{}
+}
+
+// MembersCleared returns if the "members" field was cleared in this mutation.
+func (m *AgentVersionMutation) MembersCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldMembers]
+	return ok
+}
+
+// ResetMembers resets all changes to the "members" field.
+func (m *AgentVersionMutation) ResetMembers() {
+	m.members = nil
+	m.appendmembers = nil
+	delete(m.clearedFields, agentversion.FieldMembers)
+}
+
+// SetPromptTemplate sets the "prompt_template" field.
+func (m *AgentVersionMutation) SetPromptTemplate(s string) {
+	m.prompt_template = &s
+}
+
+// PromptTemplate returns the value of the "prompt_template" field in the mutation.
+func (m *AgentVersionMutation) PromptTemplate() (r string, exists bool) {
+	v := m.prompt_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTemplate returns the old "prompt_template" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldPromptTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTemplate: %w", err)
+	}
+	return oldValue.PromptTemplate, nil
+}
+
+// ClearPromptTemplate clears the value of the "prompt_template" field.
+func (m *AgentVersionMutation) ClearPromptTemplate() {
+	m.prompt_template = nil
+	m.clearedFields[agentversion.FieldPromptTemplate] = struct{}{}
+}
+
+// PromptTemplateCleared returns if the "prompt_template" field was cleared in this mutation.
+func (m *AgentVersionMutation) PromptTemplateCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldPromptTemplate]
+	return ok
+}
+
+// ResetPromptTemplate resets all changes to the "prompt_template" field.
+func (m *AgentVersionMutation) ResetPromptTemplate() {
+	m.prompt_template = nil
+	delete(m.clearedFields, agentversion.FieldPromptTemplate)
+}
+
+// SetParameters sets the "parameters" field.
+func (m *AgentVersionMutation) SetParameters(value map[string]interface{}) {
+	m.parameters = &value
+}
+
+// Parameters returns the value of the "parameters" field in the mutation.
+func (m *AgentVersionMutation) Parameters() (r map[string]interface{}, exists bool) {
+	v := m.parameters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParameters returns the old "parameters" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldParameters(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParameters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
+	}
+	return oldValue.Parameters, nil
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (m *AgentVersionMutation) ClearParameters() {
+	m.parameters = nil
+	m.clearedFields[agentversion.FieldParameters] = struct{}{}
+}
+
+// ParametersCleared returns if the "parameters" field was cleared in this mutation.
+func (m *AgentVersionMutation) ParametersCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldParameters]
+	return ok
+}
+
+// ResetParameters resets all changes to the "parameters" field.
+func (m *AgentVersionMutation) ResetParameters() {
+	m.parameters = nil
+	delete(m.clearedFields, agentversion.FieldParameters)
+}
+
+// SetNotes sets the "notes" field.
+func (m *AgentVersionMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *AgentVersionMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *AgentVersionMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[agentversion.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *AgentVersionMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[agentversion.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *AgentVersionMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, agentversion.FieldNotes)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *AgentVersionMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *AgentVersionMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *AgentVersionMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AgentVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AgentVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AgentVersion entity.
+// If the AgentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AgentVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AgentVersionMutation builder.
+func (m *AgentVersionMutation) Where(ps ...predicate.AgentVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AgentVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AgentVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AgentVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AgentVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AgentVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AgentVersion).
+func (m *AgentVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AgentVersionMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.agent_id != nil {
+		fields = append(fields, agentversion.FieldAgentID)
+	}
+	if m.version != nil {
+		fields = append(fields, agentversion.FieldVersion)
+	}
+	if m.name != nil {
+		fields = append(fields, agentversion.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, agentversion.FieldDescription)
+	}
+	if m._type != nil {
+		fields = append(fields, agentversion.FieldType)
+	}
+	if m.model_config != nil {
+		fields = append(fields, agentversion.FieldModelConfig)
+	}
+	if m.tools != nil {
+		fields = append(fields, agentversion.FieldTools)
+	}
+	if m.knowledge_bases != nil {
+		fields = append(fields, agentversion.FieldKnowledgeBases)
+	}
+	if m.members != nil {
+		fields = append(fields, agentversion.FieldMembers)
+	}
+	if m.prompt_template != nil {
+		fields = append(fields, agentversion.FieldPromptTemplate)
+	}
+	if m.parameters != nil {
+		fields = append(fields, agentversion.FieldParameters)
+	}
+	if m.notes != nil {
+		fields = append(fields, agentversion.FieldNotes)
+	}
+	if m.created_by != nil {
+		fields = append(fields, agentversion.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, agentversion.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AgentVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case agentversion.FieldAgentID:
+		return m.AgentID()
+	case agentversion.FieldVersion:
+		return m.Version()
+	case agentversion.FieldName:
+		return m.Name()
+	case agentversion.FieldDescription:
+		return m.Description()
+	case agentversion.FieldType:
+		return m.GetType()
+	case agentversion.FieldModelConfig:
+		return m.ModelConfig()
+	case agentversion.FieldTools:
+		return m.Tools()
+	case agentversion.FieldKnowledgeBases:
+		return m.KnowledgeBases()
+	case agentversion.FieldMembers:
+		return m.Members()
+	case agentversion.FieldPromptTemplate:
+		return m.PromptTemplate()
+	case agentversion.FieldParameters:
+		return m.Parameters()
+	case agentversion.FieldNotes:
+		return m.Notes()
+	case agentversion.FieldCreatedBy:
+		return m.CreatedBy()
+	case agentversion.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AgentVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case agentversion.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case agentversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case agentversion.FieldName:
+		return m.OldName(ctx)
+	case agentversion.FieldDescription:
+		return m.OldDescription(ctx)
+	case agentversion.FieldType:
+		return m.OldType(ctx)
+	case agentversion.FieldModelConfig:
+		return m.OldModelConfig(ctx)
+	case agentversion.FieldTools:
+		return m.OldTools(ctx)
+	case agentversion.FieldKnowledgeBases:
+		return m.OldKnowledgeBases(ctx)
+	case agentversion.FieldMembers:
+		return m.OldMembers(ctx)
+	case agentversion.FieldPromptTemplate:
+		return m.OldPromptTemplate(ctx)
+	case agentversion.FieldParameters:
+		return m.OldParameters(ctx)
+	case agentversion.FieldNotes:
+		return m.OldNotes(ctx)
+	case agentversion.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case agentversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AgentVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case agentversion.FieldAgentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case agentversion.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case agentversion.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case agentversion.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case agentversion.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case agentversion.FieldModelConfig:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelConfig(v)
+		return nil
+	case agentversion.FieldTools:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTools(v)
+		return nil
+	case agentversion.FieldKnowledgeBases:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKnowledgeBases(v)
+		return nil
+	case agentversion.FieldMembers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMembers(v)
+		return nil
+	case agentversion.FieldPromptTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTemplate(v)
+		return nil
+	case agentversion.FieldParameters:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParameters(v)
+		return nil
+	case agentversion.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case agentversion.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case agentversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AgentVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AgentVersionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AgentVersionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AgentVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AgentVersionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(agentversion.FieldDescription) {
+		fields = append(fields, agentversion.FieldDescription)
+	}
+	if m.FieldCleared(agentversion.FieldModelConfig) {
+		fields = append(fields, agentversion.FieldModelConfig)
+	}
+	if m.FieldCleared(agentversion.FieldTools) {
+		fields = append(fields, agentversion.FieldTools)
+	}
+	if m.FieldCleared(agentversion.FieldKnowledgeBases) {
+		fields = append(fields, agentversion.FieldKnowledgeBases)
+	}
+	if m.FieldCleared(agentversion.FieldMembers) {
+		fields = append(fields, agentversion.FieldMembers)
+	}
+	if m.FieldCleared(agentversion.FieldPromptTemplate) {
+		fields = append(fields, agentversion.FieldPromptTemplate)
+	}
+	if m.FieldCleared(agentversion.FieldParameters) {
+		fields = append(fields, agentversion.FieldParameters)
+	}
+	if m.FieldCleared(agentversion.FieldNotes) {
+		fields = append(fields, agentversion.FieldNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AgentVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AgentVersionMutation) ClearField(name string) error {
+	switch name {
+	case agentversion.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case agentversion.FieldModelConfig:
+		m.ClearModelConfig()
+		return nil
+	case agentversion.FieldTools:
+		m.ClearTools()
+		return nil
+	case agentversion.FieldKnowledgeBases:
+		m.ClearKnowledgeBases()
+		return nil
+	case agentversion.FieldMembers:
+		m.ClearMembers()
+		return nil
+	case agentversion.FieldPromptTemplate:
+		m.ClearPromptTemplate()
+		return nil
+	case agentversion.FieldParameters:
+		m.ClearParameters()
+		return nil
+	case agentversion.FieldNotes:
+		m.ClearNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AgentVersionMutation) ResetField(name string) error {
+	switch name {
+	case agentversion.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case agentversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case agentversion.FieldName:
+		m.ResetName()
+		return nil
+	case agentversion.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case agentversion.FieldType:
+		m.ResetType()
+		return nil
+	case agentversion.FieldModelConfig:
+		m.ResetModelConfig()
+		return nil
+	case agentversion.FieldTools:
+		m.ResetTools()
+		return nil
+	case agentversion.FieldKnowledgeBases:
+		m.ResetKnowledgeBases()
+		return nil
+	case agentversion.FieldMembers:
+		m.ResetMembers()
+		return nil
+	case agentversion.FieldPromptTemplate:
+		m.ResetPromptTemplate()
+		return nil
+	case agentversion.FieldParameters:
+		m.ResetParameters()
+		return nil
+	case agentversion.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case agentversion.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case agentversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AgentVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AgentVersionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AgentVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AgentVersionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AgentVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AgentVersionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AgentVersionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AgentVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AgentVersionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AgentVersion edge %s", name)
+}
+
+// ConversationMutation represents an operation that mutates the Conversation nodes in the graph.
+type ConversationMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	agent_id              *string
+	user_id               *string
+	title                 *string
+	title_source          *string
+	legacy_messages       *[]interface{}
+	appendlegacy_messages []interface{}
+	context               *map[string]interface{}
+	metadata              *map[string]interface{}
+	status                *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	last_message_at       *time.Time
+	active_message_id     *string
+	agent_version_id      *string
+	version               *int64
+	addversion            *int64
+	clearedFields         map[string]struct{}
+	messages              map[string]struct{}
+	removedmessages       map[string]struct{}
+	clearedmessages       bool
+	done                  bool
+	oldValue              func(context.Context) (*Conversation, error)
+	predicates            []predicate.Conversation
+}
+
+var _ ent.Mutation = (*ConversationMutation)(nil)
+
+// conversationOption allows management of the mutation configuration using functional options.
+type conversationOption func(*ConversationMutation)
+
+// newConversationMutation creates new mutation for the Conversation entity.
+func newConversationMutation(c config, op Op, opts ...conversationOption) *ConversationMutation {
+	m := &ConversationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConversation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConversationID sets the ID field of the mutation.
+func withConversationID(id string) conversationOption {
+	return func(m *ConversationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Conversation
+		)
+		m.oldValue = func(ctx context.Context) (*Conversation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Conversation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConversation sets the old Conversation of the mutation.
+func withConversation(node *Conversation) conversationOption {
+	return func(m *ConversationMutation) {
+		m.oldValue = func(context.Context) (*Conversation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConversationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConversationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Conversation entities.
+func (m *ConversationMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConversationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConversationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Conversation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *ConversationMutation) SetAgentID(s string) {
+	m.agent_id = &s
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *ConversationMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldAgentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *ConversationMutation) ResetAgentID() {
+	m.agent_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ConversationMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ConversationMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ConversationMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ConversationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ConversationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *ConversationMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[conversation.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ConversationMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ConversationMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, conversation.FieldTitle)
+}
+
+// SetTitleSource sets the "title_source" field.
+func (m *ConversationMutation) SetTitleSource(s string) {
+	m.title_source = &s
+}
+
+// TitleSource returns the value of the "title_source" field in the mutation.
+func (m *ConversationMutation) TitleSource() (r string, exists bool) {
+	v := m.title_source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleSource returns the old "title_source" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldTitleSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleSource: %w", err)
+	}
+	return oldValue.TitleSource, nil
+}
+
+// ResetTitleSource resets all changes to the "title_source" field.
+func (m *ConversationMutation) ResetTitleSource() {
+	m.title_source = nil
+}
+
+// SetLegacyMessages sets the "legacy_messages" field.
+func (m *ConversationMutation) SetLegacyMessages(i []interface{}) {
+	m.legacy_messages = &i
+	m.appendlegacy_messages = nil
+}
+
+// LegacyMessages returns the value of the "legacy_messages" field in the mutation.
+func (m *ConversationMutation) LegacyMessages() (r []interface{}, exists bool) {
+	v := m.legacy_messages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegacyMessages returns the old "legacy_messages" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldLegacyMessages(ctx context.Context) (v []interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegacyMessages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegacyMessages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegacyMessages: %w", err)
+	}
+	return oldValue.LegacyMessages, nil
+}
+
+// AppendLegacyMessages adds i to the "legacy_messages" field.
+func (m *ConversationMutation) AppendLegacyMessages(i []interface{}) {
+	m.appendlegacy_messages = append(m.appendlegacy_messages, i...)
+}
+
+// AppendedLegacyMessages returns the list of values that were appended to the "legacy_messages" field in this mutation.
+func (m *ConversationMutation) AppendedLegacyMessages() ([]interface{}, bool) {
+	if len(m.appendlegacy_messages) == 0 {
+		return nil, false
+	}
+	return m.appendlegacy_messages, true
+}
+
+// ClearLegacyMessages clears the value of the "legacy_messages" field.
+func (m *ConversationMutation) ClearLegacyMessages() {
+	m.legacy_messages = nil
+	m.appendlegacy_messages = nil
+	m.clearedFields[conversation.FieldLegacyMessages] = struct{}{}
+}
+
+// LegacyMessagesCleared returns if the "legacy_messages" field was cleared in this mutation.
+func (m *ConversationMutation) LegacyMessagesCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldLegacyMessages]
+	return ok
+}
+
+// ResetLegacyMessages resets all changes to the "legacy_messages" field.
+func (m *ConversationMutation) ResetLegacyMessages() {
+	m.legacy_messages = nil
+	m.appendlegacy_messages = nil
+	delete(m.clearedFields, conversation.FieldLegacyMessages)
+}
+
+// SetContext sets the "context" field.
+func (m *ConversationMutation) SetContext(value map[string]interface{}) {
+	m.context = &value
+}
+
+// Context returns the value of the "context" field in the mutation.
+func (m *ConversationMutation) Context() (r map[string]interface{}, exists bool) {
+	v := m.context
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContext returns the old "context" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldContext(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContext: %w", err)
+	}
+	return oldValue.Context, nil
+}
+
+// ClearContext clears the value of the "context" field.
+func (m *ConversationMutation) ClearContext() {
+	m.context = nil
+	m.clearedFields[conversation.FieldContext] = struct{}{}
+}
+
+// ContextCleared returns if the "context" field was cleared in this mutation.
+func (m *ConversationMutation) ContextCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldContext]
+	return ok
+}
+
+// ResetContext resets all changes to the "context" field.
+func (m *ConversationMutation) ResetContext() {
+	m.context = nil
+	delete(m.clearedFields, conversation.FieldContext)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ConversationMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ConversationMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ConversationMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[conversation.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ConversationMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ConversationMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, conversation.FieldMetadata)
+}
+
+// SetStatus sets the "status" field.
+func (m *ConversationMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ConversationMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ConversationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ConversationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConversationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConversationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConversationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConversationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConversationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (m *ConversationMutation) SetLastMessageAt(t time.Time) {
+	m.last_message_at = &t
+}
+
+// LastMessageAt returns the value of the "last_message_at" field in the mutation.
+func (m *ConversationMutation) LastMessageAt() (r time.Time, exists bool) {
+	v := m.last_message_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastMessageAt returns the old "last_message_at" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldLastMessageAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastMessageAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastMessageAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastMessageAt: %w", err)
+	}
+	return oldValue.LastMessageAt, nil
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (m *ConversationMutation) ClearLastMessageAt() {
+	m.last_message_at = nil
+	m.clearedFields[conversation.FieldLastMessageAt] = struct{}{}
+}
+
+// LastMessageAtCleared returns if the "last_message_at" field was cleared in this mutation.
+func (m *ConversationMutation) LastMessageAtCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldLastMessageAt]
+	return ok
+}
+
+// ResetLastMessageAt resets all changes to the "last_message_at" field.
+func (m *ConversationMutation) ResetLastMessageAt() {
+	m.last_message_at = nil
+	delete(m.clearedFields, conversation.FieldLastMessageAt)
+}
+
+// SetActiveMessageID sets the "active_message_id" field.
+func (m *ConversationMutation) SetActiveMessageID(s string) {
+	m.active_message_id = &s
+}
+
+// ActiveMessageID returns the value of the "active_message_id" field in the mutation.
+func (m *ConversationMutation) ActiveMessageID() (r string, exists bool) {
+	v := m.active_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActiveMessageID returns the old "active_message_id" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldActiveMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActiveMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActiveMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActiveMessageID: %w", err)
+	}
+	return oldValue.ActiveMessageID, nil
+}
+
+// ClearActiveMessageID clears the value of the "active_message_id" field.
+func (m *ConversationMutation) ClearActiveMessageID() {
+	m.active_message_id = nil
+	m.clearedFields[conversation.FieldActiveMessageID] = struct{}{}
+}
+
+// ActiveMessageIDCleared returns if the "active_message_id" field was cleared in this mutation.
+func (m *ConversationMutation) ActiveMessageIDCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldActiveMessageID]
+	return ok
+}
+
+// ResetActiveMessageID resets all changes to the "active_message_id" field.
+func (m *ConversationMutation) ResetActiveMessageID() {
+	m.active_message_id = nil
+	delete(m.clearedFields, conversation.FieldActiveMessageID)
+}
+
+// SetAgentVersionID sets the "agent_version_id" field.
+func (m *ConversationMutation) SetAgentVersionID(s string) {
+	m.agent_version_id = &s
+}
+
+// AgentVersionID returns the value of the "agent_version_id" field in the mutation.
+func (m *ConversationMutation) AgentVersionID() (r string, exists bool) {
+	v := m.agent_version_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentVersionID returns the old "agent_version_id" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldAgentVersionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentVersionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentVersionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentVersionID: %w", err)
+	}
+	return oldValue.AgentVersionID, nil
+}
+
+// ClearAgentVersionID clears the value of the "agent_version_id" field.
+func (m *ConversationMutation) ClearAgentVersionID() {
+	m.agent_version_id = nil
+	m.clearedFields[conversation.FieldAgentVersionID] = struct{}{}
+}
+
+// AgentVersionIDCleared returns if the "agent_version_id" field was cleared in this mutation.
+func (m *ConversationMutation) AgentVersionIDCleared() bool {
+	_, ok := m.clearedFields[conversation.FieldAgentVersionID]
+	return ok
+}
+
+// ResetAgentVersionID resets all changes to the "agent_version_id" field.
+func (m *ConversationMutation) ResetAgentVersionID() {
+	m.agent_version_id = nil
+	delete(m.clearedFields, conversation.FieldAgentVersionID)
+}
+
+// SetVersion sets the "version" field.
+func (m *ConversationMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ConversationMutation) Version() (r int64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Conversation entity.
+// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationMutation) OldVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ConversationMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ConversationMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ConversationMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ConversationMutation) AddMessageIDs(ids ...string) {
+	if m.messages == nil {
+		m.messages = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ConversationMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ConversationMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ConversationMutation) RemoveMessageIDs(ids ...string) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ConversationMutation) RemovedMessagesIDs() (ids []string) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ConversationMutation) MessagesIDs() (ids []string) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ConversationMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// Where appends a list predicates to the ConversationMutation builder.
+func (m *ConversationMutation) Where(ps ...predicate.Conversation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConversationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConversationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Conversation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConversationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConversationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Conversation).
+func (m *ConversationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConversationMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.agent_id != nil {
+		fields = append(fields, conversation.FieldAgentID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, conversation.FieldUserID)
+	}
+	if m.title != nil {
+		fields = append(fields, conversation.FieldTitle)
+	}
+	if m.title_source != nil {
+		fields = append(fields, conversation.FieldTitleSource)
+	}
+	if m.legacy_messages != nil {
+		fields = append(fields, conversation.FieldLegacyMessages)
+	}
+	if m.context != nil {
+		fields = append(fields, conversation.FieldContext)
+	}
+	if m.metadata != nil {
+		fields = append(fields, conversation.FieldMetadata)
+	}
+	if m.status != nil {
+		fields = append(fields, conversation.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, conversation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, conversation.FieldUpdatedAt)
+	}
+	if m.last_message_at != nil {
+		fields = append(fields, conversation.FieldLastMessageAt)
+	}
+	if m.active_message_id != nil {
+		fields = append(fields, conversation.FieldActiveMessageID)
+	}
+	if m.agent_version_id != nil {
+		fields = append(fields, conversation.FieldAgentVersionID)
+	}
+	if m.version != nil {
+		fields = append(fields, conversation.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConversationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case conversation.FieldAgentID:
+		return m.AgentID()
+	case conversation.FieldUserID:
+		return m.UserID()
+	case conversation.FieldTitle:
+		return m.Title()
+	case conversation.FieldTitleSource:
+		return m.TitleSource()
+	case conversation.FieldLegacyMessages:
+		return m.LegacyMessages()
+	case conversation.FieldContext:
+		return m.Context()
+	case conversation.FieldMetadata:
+		return m.Metadata()
+	case conversation.FieldStatus:
+		return m.Status()
+	case conversation.FieldCreatedAt:
+		return m.CreatedAt()
+	case conversation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case conversation.FieldLastMessageAt:
+		return m.LastMessageAt()
+	case conversation.FieldActiveMessageID:
+		return m.ActiveMessageID()
+	case conversation.FieldAgentVersionID:
+		return m.AgentVersionID()
+	case conversation.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConversationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case conversation.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case conversation.FieldUserID:
+		return m.OldUserID(ctx)
+	case conversation.FieldTitle:
+		return m.OldTitle(ctx)
+	case conversation.FieldTitleSource:
+		return m.OldTitleSource(ctx)
+	case conversation.FieldLegacyMessages:
+		return m.OldLegacyMessages(ctx)
+	case conversation.FieldContext:
+		return m.OldContext(ctx)
+	case conversation.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case conversation.FieldStatus:
+		return m.OldStatus(ctx)
+	case conversation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case conversation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case conversation.FieldLastMessageAt:
+		return m.OldLastMessageAt(ctx)
+	case conversation.FieldActiveMessageID:
+		return m.OldActiveMessageID(ctx)
+	case conversation.FieldAgentVersionID:
+		return m.OldAgentVersionID(ctx)
+	case conversation.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Conversation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConversationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case conversation.FieldAgentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case conversation.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case conversation.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case conversation.FieldTitleSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleSource(v)
+		return nil
+	case conversation.FieldLegacyMessages:
+		v, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegacyMessages(v)
+		return nil
+	case conversation.FieldContext:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContext(v)
+		return nil
+	case conversation.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case conversation.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case conversation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case conversation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case conversation.FieldLastMessageAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastMessageAt(v)
+		return nil
+	case conversation.FieldActiveMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActiveMessageID(v)
+		return nil
+	case conversation.FieldAgentVersionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentVersionID(v)
+		return nil
+	case conversation.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConversationMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, conversation.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConversationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case conversation.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConversationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case conversation.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConversationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(conversation.FieldTitle) {
+		fields = append(fields, conversation.FieldTitle)
+	}
+	if m.FieldCleared(conversation.FieldLegacyMessages) {
+		fields = append(fields, conversation.FieldLegacyMessages)
+	}
+	if m.FieldCleared(conversation.FieldContext) {
+		fields = append(fields, conversation.FieldContext)
+	}
+	if m.FieldCleared(conversation.FieldMetadata) {
+		fields = append(fields, conversation.FieldMetadata)
+	}
+	if m.FieldCleared(conversation.FieldLastMessageAt) {
+		fields = append(fields, conversation.FieldLastMessageAt)
+	}
+	if m.FieldCleared(conversation.FieldActiveMessageID) {
+		fields = append(fields, conversation.FieldActiveMessageID)
+	}
+	if m.FieldCleared(conversation.FieldAgentVersionID) {
+		fields = append(fields, conversation.FieldAgentVersionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConversationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConversationMutation) ClearField(name string) error {
+	switch name {
+	case conversation.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case conversation.FieldLegacyMessages:
+		m.ClearLegacyMessages()
+		return nil
+	case conversation.FieldContext:
+		m.ClearContext()
+		return nil
+	case conversation.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case conversation.FieldLastMessageAt:
+		m.ClearLastMessageAt()
+		return nil
+	case conversation.FieldActiveMessageID:
+		m.ClearActiveMessageID()
+		return nil
+	case conversation.FieldAgentVersionID:
+		m.ClearAgentVersionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConversationMutation) ResetField(name string) error {
+	switch name {
+	case conversation.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case conversation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case conversation.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case conversation.FieldTitleSource:
+		m.ResetTitleSource()
+		return nil
+	case conversation.FieldLegacyMessages:
+		m.ResetLegacyMessages()
+		return nil
+	case conversation.FieldContext:
+		m.ResetContext()
+		return nil
+	case conversation.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case conversation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case conversation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case conversation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case conversation.FieldLastMessageAt:
+		m.ResetLastMessageAt()
+		return nil
+	case conversation.FieldActiveMessageID:
+		m.ResetActiveMessageID()
+		return nil
+	case conversation.FieldAgentVersionID:
+		m.ResetAgentVersionID()
+		return nil
+	case conversation.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConversationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.messages != nil {
+		edges = append(edges, conversation.EdgeMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConversationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case conversation.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConversationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmessages != nil {
+		edges = append(edges, conversation.EdgeMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConversationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case conversation.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConversationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessages {
+		edges = append(edges, conversation.EdgeMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConversationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case conversation.EdgeMessages:
+		return m.clearedmessages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConversationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Conversation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConversationMutation) ResetEdge(name string) error {
+	switch name {
+	case conversation.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown Conversation edge %s", name)
+}
+
+// DocumentChunkMutation represents an operation that mutates the DocumentChunk nodes in the graph.
+type DocumentChunkMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	knowledge_base_id *string
+	document_id       *string
+	chunk_index       *int
+	addchunk_index    *int
+	content           *string
+	embedding         *[]float32
+	appendembedding   []float32
+	metadata          *map[string]interface{}
+	enabled           *bool
+	pinned            *bool
+	boost             *float64
+	addboost          *float64
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*DocumentChunk, error)
+	predicates        []predicate.DocumentChunk
+}
+
+var _ ent.Mutation = (*DocumentChunkMutation)(nil)
+
+// documentchunkOption allows management of the mutation configuration using functional options.
+type documentchunkOption func(*DocumentChunkMutation)
+
+// newDocumentChunkMutation creates new mutation for the DocumentChunk entity.
+func newDocumentChunkMutation(c config, op Op, opts ...documentchunkOption) *DocumentChunkMutation {
+	m := &DocumentChunkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocumentChunk,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentChunkID sets the ID field of the mutation.
+func withDocumentChunkID(id string) documentchunkOption {
+	return func(m *DocumentChunkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocumentChunk
+		)
+		m.oldValue = func(ctx context.Context) (*DocumentChunk, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocumentChunk.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocumentChunk sets the old DocumentChunk of the mutation.
+func withDocumentChunk(node *DocumentChunk) documentchunkOption {
+	return func(m *DocumentChunkMutation) {
+		m.oldValue = func(context.Context) (*DocumentChunk, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentChunkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentChunkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocumentChunk entities.
+func (m *DocumentChunkMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentChunkMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentChunkMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocumentChunk.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKnowledgeBaseID sets the "knowledge_base_id" field.
+func (m *DocumentChunkMutation) SetKnowledgeBaseID(s string) {
+	m.knowledge_base_id = &s
+}
+
+// KnowledgeBaseID returns the value of the "knowledge_base_id" field in the mutation.
+func (m *DocumentChunkMutation) KnowledgeBaseID() (r string, exists bool) {
+	v := m.knowledge_base_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKnowledgeBaseID returns the old "knowledge_base_id" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldKnowledgeBaseID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKnowledgeBaseID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKnowledgeBaseID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKnowledgeBaseID: %w", err)
+	}
+	return oldValue.KnowledgeBaseID, nil
+}
+
+// ResetKnowledgeBaseID resets all changes to the "knowledge_base_id" field.
+func (m *DocumentChunkMutation) ResetKnowledgeBaseID() {
+	m.knowledge_base_id = nil
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *DocumentChunkMutation) SetDocumentID(s string) {
+	m.document_id = &s
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *DocumentChunkMutation) DocumentID() (r string, exists bool) {
+	v := m.document_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldDocumentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *DocumentChunkMutation) ResetDocumentID() {
+	m.document_id = nil
+}
+
+// SetChunkIndex sets the "chunk_index" field.
+func (m *DocumentChunkMutation) SetChunkIndex(i int) {
+	m.chunk_index = &i
+	m.addchunk_index = nil
+}
+
+// ChunkIndex returns the value of the "chunk_index" field in the mutation.
+func (m *DocumentChunkMutation) ChunkIndex() (r int, exists bool) {
+	v := m.chunk_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkIndex returns the old "chunk_index" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldChunkIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkIndex: %w", err)
+	}
+	return oldValue.ChunkIndex, nil
+}
+
+// AddChunkIndex adds i to the "chunk_index" field.
+func (m *DocumentChunkMutation) AddChunkIndex(i int) {
+	if m.addchunk_index != nil {
+		*m.addchunk_index += i
+	} else {
+		m.addchunk_index = &i
+	}
+}
+
+// AddedChunkIndex returns the value that was added to the "chunk_index" field in this mutation.
+func (m *DocumentChunkMutation) AddedChunkIndex() (r int, exists bool) {
+	v := m.addchunk_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChunkIndex resets all changes to the "chunk_index" field.
+func (m *DocumentChunkMutation) ResetChunkIndex() {
+	m.chunk_index = nil
+	m.addchunk_index = nil
+}
+
+// SetContent sets the "content" field.
+func (m *DocumentChunkMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *DocumentChunkMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *DocumentChunkMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *DocumentChunkMutation) SetEmbedding(f []float32) {
+	m.embedding = &f
+	m.appendembedding = nil
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *DocumentChunkMutation) Embedding() (r []float32, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// AppendEmbedding adds f to the "embedding" field.
+func (m *DocumentChunkMutation) AppendEmbedding(f []float32) {
+	m.appendembedding = append(m.appendembedding, f...)
+}
+
+// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
+func (m *DocumentChunkMutation) AppendedEmbedding() ([]float32, bool) {
+	if len(m.appendembedding) == 0 {
+		return nil, false
+	}
+	return m.appendembedding, true
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *DocumentChunkMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	m.clearedFields[documentchunk.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *DocumentChunkMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[documentchunk.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *DocumentChunkMutation) ResetEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	delete(m.clearedFields, documentchunk.FieldEmbedding)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *DocumentChunkMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *DocumentChunkMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *DocumentChunkMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[documentchunk.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *DocumentChunkMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[documentchunk.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *DocumentChunkMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, documentchunk.FieldMetadata)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *DocumentChunkMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *DocumentChunkMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *DocumentChunkMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetPinned sets the "pinned" field.
+func (m *DocumentChunkMutation) SetPinned(b bool) {
+	m.pinned = &b
+}
+
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *DocumentChunkMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinned returns the old "pinned" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
+	}
+	return oldValue.Pinned, nil
+}
+
+// ResetPinned resets all changes to the "pinned" field.
+func (m *DocumentChunkMutation) ResetPinned() {
+	m.pinned = nil
+}
+
+// SetBoost sets the "boost" field.
+func (m *DocumentChunkMutation) SetBoost(f float64) {
+	m.boost = &f
+	m.addboost = nil
+}
+
+// Boost returns the value of the "boost" field in the mutation.
+func (m *DocumentChunkMutation) Boost() (r float64, exists bool) {
+	v := m.boost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoost returns the old "boost" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldBoost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoost: %w", err)
+	}
+	return oldValue.Boost, nil
+}
+
+// AddBoost adds f to the "boost" field.
+func (m *DocumentChunkMutation) AddBoost(f float64) {
+	if m.addboost != nil {
+		*m.addboost += f
+	} else {
+		m.addboost = &f
+	}
+}
+
+// AddedBoost returns the value that was added to the "boost" field in this mutation.
+func (m *DocumentChunkMutation) AddedBoost() (r float64, exists bool) {
+	v := m.addboost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBoost resets all changes to the "boost" field.
+func (m *DocumentChunkMutation) ResetBoost() {
+	m.boost = nil
+	m.addboost = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentChunkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentChunkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentChunkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocumentChunkMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocumentChunkMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DocumentChunk entity.
+// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentChunkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *DocumentChunkMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[documentchunk.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *DocumentChunkMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[documentchunk.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocumentChunkMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, documentchunk.FieldUpdatedAt)
+}
+
+// Where appends a list predicates to the DocumentChunkMutation builder.
+func (m *DocumentChunkMutation) Where(ps ...predicate.DocumentChunk) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentChunkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentChunkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentChunk, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentChunkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentChunkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocumentChunk).
+func (m *DocumentChunkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentChunkMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.knowledge_base_id != nil {
+		fields = append(fields, documentchunk.FieldKnowledgeBaseID)
+	}
+	if m.document_id != nil {
+		fields = append(fields, documentchunk.FieldDocumentID)
+	}
+	if m.chunk_index != nil {
+		fields = append(fields, documentchunk.FieldChunkIndex)
+	}
+	if m.content != nil {
+		fields = append(fields, documentchunk.FieldContent)
+	}
+	if m.embedding != nil {
+		fields = append(fields, documentchunk.FieldEmbedding)
+	}
+	if m.metadata != nil {
+		fields = append(fields, documentchunk.FieldMetadata)
+	}
+	if m.enabled != nil {
+		fields = append(fields, documentchunk.FieldEnabled)
+	}
+	if m.pinned != nil {
+		fields = append(fields, documentchunk.FieldPinned)
+	}
+	if m.boost != nil {
+		fields = append(fields, documentchunk.FieldBoost)
+	}
+	if m.created_at != nil {
+		fields = append(fields, documentchunk.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, documentchunk.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentChunkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case documentchunk.FieldKnowledgeBaseID:
+		return m.KnowledgeBaseID()
+	case documentchunk.FieldDocumentID:
+		return m.DocumentID()
+	case documentchunk.FieldChunkIndex:
+		return m.ChunkIndex()
+	case documentchunk.FieldContent:
+		return m.Content()
+	case documentchunk.FieldEmbedding:
+		return m.Embedding()
+	case documentchunk.FieldMetadata:
+		return m.Metadata()
+	case documentchunk.FieldEnabled:
+		return m.Enabled()
+	case documentchunk.FieldPinned:
+		return m.Pinned()
+	case documentchunk.FieldBoost:
+		return m.Boost()
+	case documentchunk.FieldCreatedAt:
+		return m.CreatedAt()
+	case documentchunk.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case documentchunk.FieldKnowledgeBaseID:
+		return m.OldKnowledgeBaseID(ctx)
+	case documentchunk.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case documentchunk.FieldChunkIndex:
+		return m.OldChunkIndex(ctx)
+	case documentchunk.FieldContent:
+		return m.OldContent(ctx)
+	case documentchunk.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case documentchunk.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case documentchunk.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case documentchunk.FieldPinned:
+		return m.OldPinned(ctx)
+	case documentchunk.FieldBoost:
+		return m.OldBoost(ctx)
+	case documentchunk.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case documentchunk.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocumentChunk field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentChunkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case documentchunk.FieldKnowledgeBaseID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKnowledgeBaseID(v)
+		return nil
+	case documentchunk.FieldDocumentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case documentchunk.FieldChunkIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkIndex(v)
+		return nil
+	case documentchunk.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case documentchunk.FieldEmbedding:
+		v, ok := value.([]float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case documentchunk.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case documentchunk.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case documentchunk.FieldPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinned(v)
+		return nil
+	case documentchunk.FieldBoost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoost(v)
+		return nil
+	case documentchunk.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case documentchunk.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentChunk field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentChunkMutation) AddedFields() []string {
+	var fields []string
+	if m.addchunk_index != nil {
+		fields = append(fields, documentchunk.FieldChunkIndex)
+	}
+	if m.addboost != nil {
+		fields = append(fields, documentchunk.FieldBoost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentChunkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case documentchunk.FieldChunkIndex:
+		return m.AddedChunkIndex()
+	case documentchunk.FieldBoost:
+		return m.AddedBoost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentChunkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case documentchunk.FieldChunkIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChunkIndex(v)
+		return nil
+	case documentchunk.FieldBoost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBoost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentChunk numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentChunkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(documentchunk.FieldEmbedding) {
+		fields = append(fields, documentchunk.FieldEmbedding)
+	}
+	if m.FieldCleared(documentchunk.FieldMetadata) {
+		fields = append(fields, documentchunk.FieldMetadata)
+	}
+	if m.FieldCleared(documentchunk.FieldUpdatedAt) {
+		fields = append(fields, documentchunk.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentChunkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentChunkMutation) ClearField(name string) error {
+	switch name {
+	case documentchunk.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case documentchunk.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case documentchunk.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentChunk nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentChunkMutation) ResetField(name string) error {
+	switch name {
+	case documentchunk.FieldKnowledgeBaseID:
+		m.ResetKnowledgeBaseID()
+		return nil
+	case documentchunk.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case documentchunk.FieldChunkIndex:
+		m.ResetChunkIndex()
+		return nil
+	case documentchunk.FieldContent:
+		m.ResetContent()
+		return nil
+	case documentchunk.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case documentchunk.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case documentchunk.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case documentchunk.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case documentchunk.FieldBoost:
+		m.ResetBoost()
+		return nil
+	case documentchunk.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case documentchunk.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentChunk field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentChunkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentChunkMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentChunkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentChunkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentChunkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentChunkMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentChunkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DocumentChunk unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentChunkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DocumentChunk edge %s", name)
+}
+
+// KnowledgeBaseMutation represents an operation that mutates the KnowledgeBase nodes in the graph.
+type KnowledgeBaseMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	name              *string
+	description       *string
+	_type             *string
+	embedding_model   *string
+	chunk_config      *map[string]interface{}
+	documents         *[]interface{}
+	appenddocuments   []interface{}
+	metadata          *map[string]interface{}
+	created_by        *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	document_count    *int
+	adddocument_count *int
+	vector_count      *int
+	addvector_count   *int
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*KnowledgeBase, error)
+	predicates        []predicate.KnowledgeBase
+}
+
+var _ ent.Mutation = (*KnowledgeBaseMutation)(nil)
+
+// knowledgebaseOption allows management of the mutation configuration using functional options.
+type knowledgebaseOption func(*KnowledgeBaseMutation)
+
+// newKnowledgeBaseMutation creates new mutation for the KnowledgeBase entity.
+func newKnowledgeBaseMutation(c config, op Op, opts ...knowledgebaseOption) *KnowledgeBaseMutation {
+	m := &KnowledgeBaseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKnowledgeBase,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKnowledgeBaseID sets the ID field of the mutation.
+func withKnowledgeBaseID(id string) knowledgebaseOption {
+	return func(m *KnowledgeBaseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KnowledgeBase
+		)
+		m.oldValue = func(ctx context.Context) (*KnowledgeBase, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KnowledgeBase.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKnowledgeBase sets the old KnowledgeBase of the mutation.
+func withKnowledgeBase(node *KnowledgeBase) knowledgebaseOption {
+	return func(m *KnowledgeBaseMutation) {
+		m.oldValue = func(context.Context) (*KnowledgeBase, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KnowledgeBaseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KnowledgeBaseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of KnowledgeBase entities.
+func (m *KnowledgeBaseMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KnowledgeBaseMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KnowledgeBaseMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KnowledgeBase.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *KnowledgeBaseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *KnowledgeBaseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *KnowledgeBaseMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *KnowledgeBaseMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *KnowledgeBaseMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *KnowledgeBaseMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[knowledgebase.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *KnowledgeBaseMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[knowledgebase.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *KnowledgeBaseMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, knowledgebase.FieldDescription)
+}
+
+// SetType sets the "type" field.
+func (m *KnowledgeBaseMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *KnowledgeBaseMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *KnowledgeBaseMutation) ResetType() {
+	m._type = nil
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *KnowledgeBaseMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *KnowledgeBaseMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldEmbeddingModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *KnowledgeBaseMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+}
+
+// SetChunkConfig sets the "chunk_config" field.
+func (m *KnowledgeBaseMutation) SetChunkConfig(value map[string]interface{}) {
+	m.chunk_config = &value
+}
+
+// ChunkConfig returns the value of the "chunk_config" field in the mutation.
+func (m *KnowledgeBaseMutation) ChunkConfig() (r map[string]interface{}, exists bool) {
+	v := m.chunk_config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkConfig returns the old "chunk_config" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldChunkConfig(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkConfig: %w", err)
+	}
+	return oldValue.ChunkConfig, nil
+}
+
+// ClearChunkConfig clears the value of the "chunk_config" field.
+func (m *KnowledgeBaseMutation) ClearChunkConfig() {
+	m.chunk_config = nil
+	m.clearedFields[knowledgebase.FieldChunkConfig] = struct{}{}
+}
+
+// ChunkConfigCleared returns if the "chunk_config" field was cleared in this mutation.
+func (m *KnowledgeBaseMutation) ChunkConfigCleared() bool {
+	_, ok := m.clearedFields[knowledgebase.FieldChunkConfig]
+	return ok
+}
+
+// ResetChunkConfig resets all changes to the "chunk_config" field.
+func (m *KnowledgeBaseMutation) ResetChunkConfig() {
+	m.chunk_config = nil
+	delete(m.clearedFields, knowledgebase.FieldChunkConfig)
+}
+
+// SetDocuments sets the "documents" field.
+func (m *KnowledgeBaseMutation) SetDocuments(i []interface{}) {
+	m.documents = &i
+	m.appenddocuments = nil
+}
+
+// Documents returns the value of the "documents" field in the mutation.
+func (m *KnowledgeBaseMutation) Documents() (r []interface{}, exists bool) {
+	v := m.documents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocuments returns the old "documents" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldDocuments(ctx context.Context) (v []interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocuments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocuments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocuments: %w", err)
+	}
+	return oldValue.Documents, nil
+}
+
+// AppendDocuments adds i to the "documents" field.
+func (m *KnowledgeBaseMutation) AppendDocuments(i []interface{}) {
+	m.appenddocuments = append(m.appenddocuments, i...)
+}
+
+// AppendedDocuments returns the list of values that were appended to the "documents" field in this mutation.
+func (m *KnowledgeBaseMutation) AppendedDocuments() ([]interface{}, bool) {
+	if len(m.appenddocuments) == 0 {
+		return nil, false
+	}
+	return m.appenddocuments, true
+}
+
+// ClearDocuments clears the value of the "documents" field.
+func (m *KnowledgeBaseMutation) ClearDocuments() {
+	m.documents = nil
+	m.appenddocuments = nil
+	m.clearedFields[knowledgebase.FieldDocuments] = struct{}{}
+}
+
+// DocumentsCleared returns if the "documents" field was cleared in this mutation.
+func (m *KnowledgeBaseMutation) DocumentsCleared() bool {
+	_, ok := m.clearedFields[knowledgebase.FieldDocuments]
+	return ok
+}
+
+// ResetDocuments resets all changes to the "documents" field.
+func (m *KnowledgeBaseMutation) ResetDocuments() {
+	m.documents = nil
+	m.appenddocuments = nil
+	delete(m.clearedFields, knowledgebase.FieldDocuments)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *KnowledgeBaseMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *KnowledgeBaseMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *KnowledgeBaseMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[knowledgebase.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *KnowledgeBaseMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[knowledgebase.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *KnowledgeBaseMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, knowledgebase.FieldMetadata)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *KnowledgeBaseMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *KnowledgeBaseMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *KnowledgeBaseMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KnowledgeBaseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KnowledgeBaseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KnowledgeBaseMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *KnowledgeBaseMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *KnowledgeBaseMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *KnowledgeBaseMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDocumentCount sets the "document_count" field.
+func (m *KnowledgeBaseMutation) SetDocumentCount(i int) {
+	m.document_count = &i
+	m.adddocument_count = nil
+}
+
+// DocumentCount returns the value of the "document_count" field in the mutation.
+func (m *KnowledgeBaseMutation) DocumentCount() (r int, exists bool) {
+	v := m.document_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentCount returns the old "document_count" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldDocumentCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentCount: %w", err)
+	}
+	return oldValue.DocumentCount, nil
+}
+
+// AddDocumentCount adds i to the "document_count" field.
+func (m *KnowledgeBaseMutation) AddDocumentCount(i int) {
+	if m.adddocument_count != nil {
+		*m.adddocument_count += i
+	} else {
+		m.adddocument_count = &i
+	}
+}
+
+// AddedDocumentCount returns the value that was added to the "document_count" field in this mutation.
+func (m *KnowledgeBaseMutation) AddedDocumentCount() (r int, exists bool) {
+	v := m.adddocument_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDocumentCount resets all changes to the "document_count" field.
+func (m *KnowledgeBaseMutation) ResetDocumentCount() {
+	m.document_count = nil
+	m.adddocument_count = nil
+}
+
+// SetVectorCount sets the "vector_count" field.
+func (m *KnowledgeBaseMutation) SetVectorCount(i int) {
+	m.vector_count = &i
+	m.addvector_count = nil
+}
+
+// VectorCount returns the value of the "vector_count" field in the mutation.
+func (m *KnowledgeBaseMutation) VectorCount() (r int, exists bool) {
+	v := m.vector_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVectorCount returns the old "vector_count" field's value of the KnowledgeBase entity.
+// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeBaseMutation) OldVectorCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVectorCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVectorCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVectorCount: %w", err)
+	}
+	return oldValue.VectorCount, nil
+}
+
+// AddVectorCount adds i to the "vector_count" field.
+func (m *KnowledgeBaseMutation) AddVectorCount(i int) {
+	if m.addvector_count != nil {
+		*m.addvector_count += i
+	} else {
+		m.addvector_count = &i
+	}
+}
+
+// AddedVectorCount returns the value that was added to the "vector_count" field in this mutation.
+func (m *KnowledgeBaseMutation) AddedVectorCount() (r int, exists bool) {
+	v := m.addvector_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVectorCount resets all changes to the "vector_count" field.
+func (m *KnowledgeBaseMutation) ResetVectorCount() {
+	m.vector_count = nil
+	m.addvector_count = nil
+}
+
+// Where appends a list predicates to the KnowledgeBaseMutation builder.
+func (m *KnowledgeBaseMutation) Where(ps ...predicate.KnowledgeBase) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KnowledgeBaseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KnowledgeBaseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KnowledgeBase, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KnowledgeBaseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KnowledgeBaseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KnowledgeBase).
+func (m *KnowledgeBaseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KnowledgeBaseMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, knowledgebase.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, knowledgebase.FieldDescription)
+	}
+	if m._type != nil {
+		fields = append(fields, knowledgebase.FieldType)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, knowledgebase.FieldEmbeddingModel)
+	}
+	if m.chunk_config != nil {
+		fields = append(fields, knowledgebase.FieldChunkConfig)
+	}
+	if m.documents != nil {
+		fields = append(fields, knowledgebase.FieldDocuments)
+	}
+	if m.metadata != nil {
+		fields = append(fields, knowledgebase.FieldMetadata)
+	}
+	if m.created_by != nil {
+		fields = append(fields, knowledgebase.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, knowledgebase.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, knowledgebase.FieldUpdatedAt)
+	}
+	if m.document_count != nil {
+		fields = append(fields, knowledgebase.FieldDocumentCount)
+	}
+	if m.vector_count != nil {
+		fields = append(fields, knowledgebase.FieldVectorCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KnowledgeBaseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case knowledgebase.FieldName:
+		return m.Name()
+	case knowledgebase.FieldDescription:
+		return m.Description()
+	case knowledgebase.FieldType:
+		return m.GetType()
+	case knowledgebase.FieldEmbeddingModel:
+		return m.EmbeddingModel()
+	case knowledgebase.FieldChunkConfig:
+		return m.ChunkConfig()
+	case knowledgebase.FieldDocuments:
+		return m.Documents()
+	case knowledgebase.FieldMetadata:
+		return m.Metadata()
+	case knowledgebase.FieldCreatedBy:
+		return m.CreatedBy()
+	case knowledgebase.FieldCreatedAt:
+		return m.CreatedAt()
+	case knowledgebase.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case knowledgebase.FieldDocumentCount:
+		return m.DocumentCount()
+	case knowledgebase.FieldVectorCount:
+		return m.VectorCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KnowledgeBaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case knowledgebase.FieldName:
+		return m.OldName(ctx)
+	case knowledgebase.FieldDescription:
+		return m.OldDescription(ctx)
+	case knowledgebase.FieldType:
+		return m.OldType(ctx)
+	case knowledgebase.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
+	case knowledgebase.FieldChunkConfig:
+		return m.OldChunkConfig(ctx)
+	case knowledgebase.FieldDocuments:
+		return m.OldDocuments(ctx)
+	case knowledgebase.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case knowledgebase.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case knowledgebase.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case knowledgebase.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case knowledgebase.FieldDocumentCount:
+		return m.OldDocumentCount(ctx)
+	case knowledgebase.FieldVectorCount:
+		return m.OldVectorCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown KnowledgeBase field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KnowledgeBaseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case knowledgebase.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case knowledgebase.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case knowledgebase.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case knowledgebase.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
+	case knowledgebase.FieldChunkConfig:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkConfig(v)
+		return nil
+	case knowledgebase.FieldDocuments:
+		v, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocuments(v)
+		return nil
+	case knowledgebase.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case knowledgebase.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case knowledgebase.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case knowledgebase.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case knowledgebase.FieldDocumentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentCount(v)
+		return nil
+	case knowledgebase.FieldVectorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVectorCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeBase field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KnowledgeBaseMutation) AddedFields() []string {
+	var fields []string
+	if m.adddocument_count != nil {
+		fields = append(fields, knowledgebase.FieldDocumentCount)
+	}
+	if m.addvector_count != nil {
+		fields = append(fields, knowledgebase.FieldVectorCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KnowledgeBaseMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case knowledgebase.FieldDocumentCount:
+		return m.AddedDocumentCount()
+	case knowledgebase.FieldVectorCount:
+		return m.AddedVectorCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KnowledgeBaseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case knowledgebase.FieldDocumentCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocumentCount(v)
+		return nil
+	case knowledgebase.FieldVectorCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVectorCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeBase numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KnowledgeBaseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(knowledgebase.FieldDescription) {
+		fields = append(fields, knowledgebase.FieldDescription)
+	}
+	if m.FieldCleared(knowledgebase.FieldChunkConfig) {
+		fields = append(fields, knowledgebase.FieldChunkConfig)
+	}
+	if m.FieldCleared(knowledgebase.FieldDocuments) {
+		fields = append(fields, knowledgebase.FieldDocuments)
+	}
+	if m.FieldCleared(knowledgebase.FieldMetadata) {
+		fields = append(fields, knowledgebase.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KnowledgeBaseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KnowledgeBaseMutation) ClearField(name string) error {
+	switch name {
+	case knowledgebase.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case knowledgebase.FieldChunkConfig:
+		m.ClearChunkConfig()
+		return nil
+	case knowledgebase.FieldDocuments:
+		m.ClearDocuments()
+		return nil
+	case knowledgebase.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeBase nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KnowledgeBaseMutation) ResetField(name string) error {
+	switch name {
+	case knowledgebase.FieldName:
+		m.ResetName()
+		return nil
+	case knowledgebase.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case knowledgebase.FieldType:
+		m.ResetType()
+		return nil
+	case knowledgebase.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
+	case knowledgebase.FieldChunkConfig:
+		m.ResetChunkConfig()
+		return nil
+	case knowledgebase.FieldDocuments:
+		m.ResetDocuments()
+		return nil
+	case knowledgebase.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case knowledgebase.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case knowledgebase.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case knowledgebase.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case knowledgebase.FieldDocumentCount:
+		m.ResetDocumentCount()
+		return nil
+	case knowledgebase.FieldVectorCount:
+		m.ResetVectorCount()
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeBase field %s", name)
 }
 
-// SetTitle sets the "title" field.
-func (m *ConversationMutation) SetTitle(s string) {
-	m.title = &s
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KnowledgeBaseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ConversationMutation) Title() (r string, exists bool) {
-	v := m.title
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KnowledgeBaseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KnowledgeBaseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KnowledgeBaseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KnowledgeBaseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KnowledgeBaseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KnowledgeBaseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KnowledgeBase unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KnowledgeBaseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KnowledgeBase edge %s", name)
+}
+
+// MemoryMutation represents an operation that mutates the Memory nodes in the graph.
+type MemoryMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	user_id                *string
+	agent_id               *string
+	content                *string
+	embedding              *[]float32
+	appendembedding        []float32
+	source_conversation_id *string
+	metadata               *map[string]interface{}
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Memory, error)
+	predicates             []predicate.Memory
+}
+
+var _ ent.Mutation = (*MemoryMutation)(nil)
+
+// memoryOption allows management of the mutation configuration using functional options.
+type memoryOption func(*MemoryMutation)
+
+// newMemoryMutation creates new mutation for the Memory entity.
+func newMemoryMutation(c config, op Op, opts ...memoryOption) *MemoryMutation {
+	m := &MemoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMemory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMemoryID sets the ID field of the mutation.
+func withMemoryID(id string) memoryOption {
+	return func(m *MemoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Memory
+		)
+		m.oldValue = func(ctx context.Context) (*Memory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Memory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMemory sets the old Memory of the mutation.
+func withMemory(node *Memory) memoryOption {
+	return func(m *MemoryMutation) {
+		m.oldValue = func(context.Context) (*Memory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MemoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MemoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Memory entities.
+func (m *MemoryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MemoryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MemoryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Memory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MemoryMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MemoryMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *MemoryMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.UserID, nil
 }
 
-// ClearTitle clears the value of the "title" field.
-func (m *ConversationMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[conversation.FieldTitle] = struct{}{}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MemoryMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *ConversationMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[conversation.FieldTitle]
-	return ok
+// SetAgentID sets the "agent_id" field.
+func (m *MemoryMutation) SetAgentID(s string) {
+	m.agent_id = &s
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ConversationMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, conversation.FieldTitle)
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *MemoryMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemoryMutation) OldAgentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *MemoryMutation) ResetAgentID() {
+	m.agent_id = nil
 }
 
-// SetMessages sets the "messages" field.
-func (m *ConversationMutation) SetMessages(i []interface{}) {
-	m.messages = &i
-	m.appendmessages = nil
+// SetContent sets the "content" field.
+func (m *MemoryMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Messages returns the value of the "messages" field in the mutation.
-func (m *ConversationMutation) Messages() (r []interface{}, exists bool) {
-	v := m.messages
+// Content returns the value of the "content" field in the mutation.
+func (m *MemoryMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessages returns the old "messages" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MemoryMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MemoryMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *MemoryMutation) SetEmbedding(f []float32) {
+	m.embedding = &f
+	m.appendembedding = nil
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *MemoryMutation) Embedding() (r []float32, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldMessages(ctx context.Context) (v []interface{}, err error) {
+func (m *MemoryMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessages is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessages requires an ID field in the mutation")
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessages: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
 	}
-	return oldValue.Messages, nil
+	return oldValue.Embedding, nil
 }
 
-// AppendMessages adds i to the "messages" field.
-func (m *ConversationMutation) AppendMessages(i []interface{}) {
-	m.appendmessages = append(m.appendmessages, i...)
+// AppendEmbedding adds f to the "embedding" field.
+func (m *MemoryMutation) AppendEmbedding(f []float32) {
+	m.appendembedding = append(m.appendembedding, f...)
 }
 
-// AppendedMessages returns the list of values that were appended to the "messages" field in this mutation.
-func (m *ConversationMutation) AppendedMessages() ([]interface{}, bool) {
-	if len(m.appendmessages) == 0 {
+// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
+func (m *MemoryMutation) AppendedEmbedding() ([]float32, bool) {
+	if len(m.appendembedding) == 0 {
 		return nil, false
 	}
-	return m.appendmessages, true
+	return m.appendembedding, true
 }
 
-// ClearMessages clears the value of the "messages" field.
-func (m *ConversationMutation) ClearMessages() {
-	m.messages = nil
-	m.appendmessages = nil
-	m.clearedFields[conversation.FieldMessages] = struct{}{}
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *MemoryMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	m.clearedFields[memory.FieldEmbedding] = struct{}{}
 }
 
-// MessagesCleared returns if the "messages" field was cleared in this mutation.
-func (m *ConversationMutation) MessagesCleared() bool {
-	_, ok := m.clearedFields[conversation.FieldMessages]
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *MemoryMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[memory.FieldEmbedding]
 	return ok
 }
 
-// ResetMessages resets all changes to the "messages" field.
-func (m *ConversationMutation) ResetMessages() {
-	m.messages = nil
-	m.appendmessages = nil
-	delete(m.clearedFields, conversation.FieldMessages)
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *MemoryMutation) ResetEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	delete(m.clearedFields, memory.FieldEmbedding)
 }
 
-// SetContext sets the "context" field.
-func (m *ConversationMutation) SetContext(value map[string]interface{}) {
-	m.context = &value
+// SetSourceConversationID sets the "source_conversation_id" field.
+func (m *MemoryMutation) SetSourceConversationID(s string) {
+	m.source_conversation_id = &s
 }
 
-// Context returns the value of the "context" field in the mutation.
-func (m *ConversationMutation) Context() (r map[string]interface{}, exists bool) {
-	v := m.context
+// SourceConversationID returns the value of the "source_conversation_id" field in the mutation.
+func (m *MemoryMutation) SourceConversationID() (r string, exists bool) {
+	v := m.source_conversation_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContext returns the old "context" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceConversationID returns the old "source_conversation_id" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldContext(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *MemoryMutation) OldSourceConversationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContext is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceConversationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContext requires an ID field in the mutation")
+		return v, errors.New("OldSourceConversationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContext: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceConversationID: %w", err)
 	}
-	return oldValue.Context, nil
+	return oldValue.SourceConversationID, nil
 }
 
-// ClearContext clears the value of the "context" field.
-func (m *ConversationMutation) ClearContext() {
-	m.context = nil
-	m.clearedFields[conversation.FieldContext] = struct{}{}
+// ClearSourceConversationID clears the value of the "source_conversation_id" field.
+func (m *MemoryMutation) ClearSourceConversationID() {
+	m.source_conversation_id = nil
+	m.clearedFields[memory.FieldSourceConversationID] = struct{}{}
 }
 
-// ContextCleared returns if the "context" field was cleared in this mutation.
-func (m *ConversationMutation) ContextCleared() bool {
-	_, ok := m.clearedFields[conversation.FieldContext]
+// SourceConversationIDCleared returns if the "source_conversation_id" field was cleared in this mutation.
+func (m *MemoryMutation) SourceConversationIDCleared() bool {
+	_, ok := m.clearedFields[memory.FieldSourceConversationID]
 	return ok
 }
 
-// ResetContext resets all changes to the "context" field.
-func (m *ConversationMutation) ResetContext() {
-	m.context = nil
-	delete(m.clearedFields, conversation.FieldContext)
+// ResetSourceConversationID resets all changes to the "source_conversation_id" field.
+func (m *MemoryMutation) ResetSourceConversationID() {
+	m.source_conversation_id = nil
+	delete(m.clearedFields, memory.FieldSourceConversationID)
 }
 
 // SetMetadata sets the "metadata" field.
-func (m *ConversationMutation) SetMetadata(value map[string]interface{}) {
+func (m *MemoryMutation) SetMetadata(value map[string]interface{}) {
 	m.metadata = &value
 }
 
 // Metadata returns the value of the "metadata" field in the mutation.
-func (m *ConversationMutation) Metadata() (r map[string]interface{}, exists bool) {
+func (m *MemoryMutation) Metadata() (r map[string]interface{}, exists bool) {
 	v := m.metadata
 	if v == nil {
 		return
@@ -1765,10 +7832,10 @@ func (m *ConversationMutation) Metadata() (r map[string]interface{}, exists bool
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *MemoryMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
@@ -1783,66 +7850,30 @@ func (m *ConversationMutation) OldMetadata(ctx context.Context) (v map[string]in
 }
 
 // ClearMetadata clears the value of the "metadata" field.
-func (m *ConversationMutation) ClearMetadata() {
+func (m *MemoryMutation) ClearMetadata() {
 	m.metadata = nil
-	m.clearedFields[conversation.FieldMetadata] = struct{}{}
+	m.clearedFields[memory.FieldMetadata] = struct{}{}
 }
 
 // MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *ConversationMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[conversation.FieldMetadata]
+func (m *MemoryMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[memory.FieldMetadata]
 	return ok
 }
 
 // ResetMetadata resets all changes to the "metadata" field.
-func (m *ConversationMutation) ResetMetadata() {
+func (m *MemoryMutation) ResetMetadata() {
 	m.metadata = nil
-	delete(m.clearedFields, conversation.FieldMetadata)
-}
-
-// SetStatus sets the "status" field.
-func (m *ConversationMutation) SetStatus(s string) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *ConversationMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *ConversationMutation) ResetStatus() {
-	m.status = nil
+	delete(m.clearedFields, memory.FieldMetadata)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ConversationMutation) SetCreatedAt(t time.Time) {
+func (m *MemoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConversationMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MemoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1850,10 +7881,10 @@ func (m *ConversationMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MemoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1868,17 +7899,17 @@ func (m *ConversationMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConversationMutation) ResetCreatedAt() {
+func (m *MemoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ConversationMutation) SetUpdatedAt(t time.Time) {
+func (m *MemoryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConversationMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *MemoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1886,10 +7917,10 @@ func (m *ConversationMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Memory entity.
+// If the Memory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MemoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1904,68 +7935,19 @@ func (m *ConversationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConversationMutation) ResetUpdatedAt() {
+func (m *MemoryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetLastMessageAt sets the "last_message_at" field.
-func (m *ConversationMutation) SetLastMessageAt(t time.Time) {
-	m.last_message_at = &t
-}
-
-// LastMessageAt returns the value of the "last_message_at" field in the mutation.
-func (m *ConversationMutation) LastMessageAt() (r time.Time, exists bool) {
-	v := m.last_message_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLastMessageAt returns the old "last_message_at" field's value of the Conversation entity.
-// If the Conversation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConversationMutation) OldLastMessageAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastMessageAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastMessageAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastMessageAt: %w", err)
-	}
-	return oldValue.LastMessageAt, nil
-}
-
-// ClearLastMessageAt clears the value of the "last_message_at" field.
-func (m *ConversationMutation) ClearLastMessageAt() {
-	m.last_message_at = nil
-	m.clearedFields[conversation.FieldLastMessageAt] = struct{}{}
-}
-
-// LastMessageAtCleared returns if the "last_message_at" field was cleared in this mutation.
-func (m *ConversationMutation) LastMessageAtCleared() bool {
-	_, ok := m.clearedFields[conversation.FieldLastMessageAt]
-	return ok
-}
-
-// ResetLastMessageAt resets all changes to the "last_message_at" field.
-func (m *ConversationMutation) ResetLastMessageAt() {
-	m.last_message_at = nil
-	delete(m.clearedFields, conversation.FieldLastMessageAt)
-}
-
-// Where appends a list predicates to the ConversationMutation builder.
-func (m *ConversationMutation) Where(ps ...predicate.Conversation) {
+// Where appends a list predicates to the MemoryMutation builder.
+func (m *MemoryMutation) Where(ps ...predicate.Memory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConversationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MemoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConversationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Conversation, len(ps))
+func (m *MemoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Memory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1973,54 +7955,48 @@ func (m *ConversationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConversationMutation) Op() Op {
+func (m *MemoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConversationMutation) SetOp(op Op) {
+func (m *MemoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Conversation).
-func (m *ConversationMutation) Type() string {
+// Type returns the node type of this mutation (Memory).
+func (m *MemoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConversationMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.agent_id != nil {
-		fields = append(fields, conversation.FieldAgentID)
-	}
+func (m *MemoryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.user_id != nil {
-		fields = append(fields, conversation.FieldUserID)
+		fields = append(fields, memory.FieldUserID)
 	}
-	if m.title != nil {
-		fields = append(fields, conversation.FieldTitle)
+	if m.agent_id != nil {
+		fields = append(fields, memory.FieldAgentID)
 	}
-	if m.messages != nil {
-		fields = append(fields, conversation.FieldMessages)
+	if m.content != nil {
+		fields = append(fields, memory.FieldContent)
 	}
-	if m.context != nil {
-		fields = append(fields, conversation.FieldContext)
+	if m.embedding != nil {
+		fields = append(fields, memory.FieldEmbedding)
 	}
-	if m.metadata != nil {
-		fields = append(fields, conversation.FieldMetadata)
+	if m.source_conversation_id != nil {
+		fields = append(fields, memory.FieldSourceConversationID)
 	}
-	if m.status != nil {
-		fields = append(fields, conversation.FieldStatus)
+	if m.metadata != nil {
+		fields = append(fields, memory.FieldMetadata)
 	}
 	if m.created_at != nil {
-		fields = append(fields, conversation.FieldCreatedAt)
+		fields = append(fields, memory.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, conversation.FieldUpdatedAt)
-	}
-	if m.last_message_at != nil {
-		fields = append(fields, conversation.FieldLastMessageAt)
+		fields = append(fields, memory.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2028,28 +8004,24 @@ func (m *ConversationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConversationMutation) Field(name string) (ent.Value, bool) {
+func (m *MemoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case conversation.FieldAgentID:
-		return m.AgentID()
-	case conversation.FieldUserID:
+	case memory.FieldUserID:
 		return m.UserID()
-	case conversation.FieldTitle:
-		return m.Title()
-	case conversation.FieldMessages:
-		return m.Messages()
-	case conversation.FieldContext:
-		return m.Context()
-	case conversation.FieldMetadata:
+	case memory.FieldAgentID:
+		return m.AgentID()
+	case memory.FieldContent:
+		return m.Content()
+	case memory.FieldEmbedding:
+		return m.Embedding()
+	case memory.FieldSourceConversationID:
+		return m.SourceConversationID()
+	case memory.FieldMetadata:
 		return m.Metadata()
-	case conversation.FieldStatus:
-		return m.Status()
-	case conversation.FieldCreatedAt:
+	case memory.FieldCreatedAt:
 		return m.CreatedAt()
-	case conversation.FieldUpdatedAt:
+	case memory.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case conversation.FieldLastMessageAt:
-		return m.LastMessageAt()
 	}
 	return nil, false
 }
@@ -2057,303 +8029,275 @@ func (m *ConversationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConversationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MemoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case conversation.FieldAgentID:
-		return m.OldAgentID(ctx)
-	case conversation.FieldUserID:
+	case memory.FieldUserID:
 		return m.OldUserID(ctx)
-	case conversation.FieldTitle:
-		return m.OldTitle(ctx)
-	case conversation.FieldMessages:
-		return m.OldMessages(ctx)
-	case conversation.FieldContext:
-		return m.OldContext(ctx)
-	case conversation.FieldMetadata:
+	case memory.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case memory.FieldContent:
+		return m.OldContent(ctx)
+	case memory.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case memory.FieldSourceConversationID:
+		return m.OldSourceConversationID(ctx)
+	case memory.FieldMetadata:
 		return m.OldMetadata(ctx)
-	case conversation.FieldStatus:
-		return m.OldStatus(ctx)
-	case conversation.FieldCreatedAt:
+	case memory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case conversation.FieldUpdatedAt:
+	case memory.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case conversation.FieldLastMessageAt:
-		return m.OldLastMessageAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Conversation field %s", name)
+	return nil, fmt.Errorf("unknown Memory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConversationMutation) SetField(name string, value ent.Value) error {
+func (m *MemoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case conversation.FieldAgentID:
+	case memory.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgentID(v)
+		m.SetUserID(v)
 		return nil
-	case conversation.FieldUserID:
+	case memory.FieldAgentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetAgentID(v)
 		return nil
-	case conversation.FieldTitle:
+	case memory.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetContent(v)
 		return nil
-	case conversation.FieldMessages:
-		v, ok := value.([]interface{})
+	case memory.FieldEmbedding:
+		v, ok := value.([]float32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessages(v)
+		m.SetEmbedding(v)
 		return nil
-	case conversation.FieldContext:
-		v, ok := value.(map[string]interface{})
+	case memory.FieldSourceConversationID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContext(v)
+		m.SetSourceConversationID(v)
 		return nil
-	case conversation.FieldMetadata:
+	case memory.FieldMetadata:
 		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMetadata(v)
 		return nil
-	case conversation.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case conversation.FieldCreatedAt:
+	case memory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case conversation.FieldUpdatedAt:
+	case memory.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case conversation.FieldLastMessageAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastMessageAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Conversation field %s", name)
+	return fmt.Errorf("unknown Memory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConversationMutation) AddedFields() []string {
+func (m *MemoryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConversationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MemoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConversationMutation) AddField(name string, value ent.Value) error {
+func (m *MemoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Conversation numeric field %s", name)
+	return fmt.Errorf("unknown Memory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConversationMutation) ClearedFields() []string {
+func (m *MemoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(conversation.FieldTitle) {
-		fields = append(fields, conversation.FieldTitle)
-	}
-	if m.FieldCleared(conversation.FieldMessages) {
-		fields = append(fields, conversation.FieldMessages)
+	if m.FieldCleared(memory.FieldEmbedding) {
+		fields = append(fields, memory.FieldEmbedding)
 	}
-	if m.FieldCleared(conversation.FieldContext) {
-		fields = append(fields, conversation.FieldContext)
-	}
-	if m.FieldCleared(conversation.FieldMetadata) {
-		fields = append(fields, conversation.FieldMetadata)
+	if m.FieldCleared(memory.FieldSourceConversationID) {
+		fields = append(fields, memory.FieldSourceConversationID)
 	}
-	if m.FieldCleared(conversation.FieldLastMessageAt) {
-		fields = append(fields, conversation.FieldLastMessageAt)
+	if m.FieldCleared(memory.FieldMetadata) {
+		fields = append(fields, memory.FieldMetadata)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConversationMutation) FieldCleared(name string) bool {
+func (m *MemoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConversationMutation) ClearField(name string) error {
+func (m *MemoryMutation) ClearField(name string) error {
 	switch name {
-	case conversation.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case conversation.FieldMessages:
-		m.ClearMessages()
+	case memory.FieldEmbedding:
+		m.ClearEmbedding()
 		return nil
-	case conversation.FieldContext:
-		m.ClearContext()
+	case memory.FieldSourceConversationID:
+		m.ClearSourceConversationID()
 		return nil
-	case conversation.FieldMetadata:
+	case memory.FieldMetadata:
 		m.ClearMetadata()
 		return nil
-	case conversation.FieldLastMessageAt:
-		m.ClearLastMessageAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Conversation nullable field %s", name)
+	return fmt.Errorf("unknown Memory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConversationMutation) ResetField(name string) error {
+func (m *MemoryMutation) ResetField(name string) error {
 	switch name {
-	case conversation.FieldAgentID:
-		m.ResetAgentID()
-		return nil
-	case conversation.FieldUserID:
+	case memory.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case conversation.FieldTitle:
-		m.ResetTitle()
+	case memory.FieldAgentID:
+		m.ResetAgentID()
 		return nil
-	case conversation.FieldMessages:
-		m.ResetMessages()
+	case memory.FieldContent:
+		m.ResetContent()
 		return nil
-	case conversation.FieldContext:
-		m.ResetContext()
+	case memory.FieldEmbedding:
+		m.ResetEmbedding()
 		return nil
-	case conversation.FieldMetadata:
-		m.ResetMetadata()
+	case memory.FieldSourceConversationID:
+		m.ResetSourceConversationID()
 		return nil
-	case conversation.FieldStatus:
-		m.ResetStatus()
+	case memory.FieldMetadata:
+		m.ResetMetadata()
 		return nil
-	case conversation.FieldCreatedAt:
+	case memory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case conversation.FieldUpdatedAt:
+	case memory.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case conversation.FieldLastMessageAt:
-		m.ResetLastMessageAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Conversation field %s", name)
+	return fmt.Errorf("unknown Memory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConversationMutation) AddedEdges() []string {
+func (m *MemoryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConversationMutation) AddedIDs(name string) []ent.Value {
+func (m *MemoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConversationMutation) RemovedEdges() []string {
+func (m *MemoryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConversationMutation) RemovedIDs(name string) []ent.Value {
+func (m *MemoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConversationMutation) ClearedEdges() []string {
+func (m *MemoryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConversationMutation) EdgeCleared(name string) bool {
+func (m *MemoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConversationMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Conversation unique edge %s", name)
+func (m *MemoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Memory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConversationMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Conversation edge %s", name)
+func (m *MemoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Memory edge %s", name)
 }
 
-// DocumentChunkMutation represents an operation that mutates the DocumentChunk nodes in the graph.
-type DocumentChunkMutation struct {
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	knowledge_base_id *string
-	document_id       *string
-	chunk_index       *int
-	addchunk_index    *int
-	content           *string
-	embedding         *[]float32
-	appendembedding   []float32
-	metadata          *map[string]interface{}
-	created_at        *time.Time
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*DocumentChunk, error)
-	predicates        []predicate.DocumentChunk
-}
-
-var _ ent.Mutation = (*DocumentChunkMutation)(nil)
-
-// documentchunkOption allows management of the mutation configuration using functional options.
-type documentchunkOption func(*DocumentChunkMutation)
-
-// newDocumentChunkMutation creates new mutation for the DocumentChunk entity.
-func newDocumentChunkMutation(c config, op Op, opts ...documentchunkOption) *DocumentChunkMutation {
-	m := &DocumentChunkMutation{
+	op                   Op
+	typ                  string
+	id                   *string
+	parent_id            *string
+	role                 *string
+	content              *string
+	metadata             *map[string]interface{}
+	model                *string
+	prompt_tokens        *int
+	addprompt_tokens     *int
+	completion_tokens    *int
+	addcompletion_tokens *int
+	idempotency_key      *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	conversation         *string
+	clearedconversation  bool
+	feedback             map[string]struct{}
+	removedfeedback      map[string]struct{}
+	clearedfeedback      bool
+	done                 bool
+	oldValue             func(context.Context) (*Message, error)
+	predicates           []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDocumentChunk,
+		typ:           TypeMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2362,20 +8306,20 @@ func newDocumentChunkMutation(c config, op Op, opts ...documentchunkOption) *Doc
 	return m
 }
 
-// withDocumentChunkID sets the ID field of the mutation.
-func withDocumentChunkID(id string) documentchunkOption {
-	return func(m *DocumentChunkMutation) {
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id string) messageOption {
+	return func(m *MessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DocumentChunk
+			value *Message
 		)
-		m.oldValue = func(ctx context.Context) (*DocumentChunk, error) {
+		m.oldValue = func(ctx context.Context) (*Message, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DocumentChunk.Get(ctx, id)
+					value, err = m.Client().Message.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2384,10 +8328,10 @@ func withDocumentChunkID(id string) documentchunkOption {
 	}
 }
 
-// withDocumentChunk sets the old DocumentChunk of the mutation.
-func withDocumentChunk(node *DocumentChunk) documentchunkOption {
-	return func(m *DocumentChunkMutation) {
-		m.oldValue = func(context.Context) (*DocumentChunk, error) {
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2396,7 +8340,7 @@ func withDocumentChunk(node *DocumentChunk) documentchunkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocumentChunkMutation) Client() *Client {
+func (m MessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2404,7 +8348,7 @@ func (m DocumentChunkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DocumentChunkMutation) Tx() (*Tx, error) {
+func (m MessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2414,14 +8358,14 @@ func (m DocumentChunkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DocumentChunk entities.
-func (m *DocumentChunkMutation) SetID(id string) {
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DocumentChunkMutation) ID() (id string, exists bool) {
+func (m *MessageMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2432,7 +8376,7 @@ func (m *DocumentChunkMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DocumentChunkMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *MessageMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2441,335 +8385,590 @@ func (m *DocumentChunkMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DocumentChunk.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKnowledgeBaseID sets the "knowledge_base_id" field.
-func (m *DocumentChunkMutation) SetKnowledgeBaseID(s string) {
-	m.knowledge_base_id = &s
+// SetConversationID sets the "conversation_id" field.
+func (m *MessageMutation) SetConversationID(s string) {
+	m.conversation = &s
 }
 
-// KnowledgeBaseID returns the value of the "knowledge_base_id" field in the mutation.
-func (m *DocumentChunkMutation) KnowledgeBaseID() (r string, exists bool) {
-	v := m.knowledge_base_id
+// ConversationID returns the value of the "conversation_id" field in the mutation.
+func (m *MessageMutation) ConversationID() (r string, exists bool) {
+	v := m.conversation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKnowledgeBaseID returns the old "knowledge_base_id" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldConversationID returns the old "conversation_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldKnowledgeBaseID(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldConversationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKnowledgeBaseID is only allowed on UpdateOne operations")
+		return v, errors.New("OldConversationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKnowledgeBaseID requires an ID field in the mutation")
+		return v, errors.New("OldConversationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKnowledgeBaseID: %w", err)
+		return v, fmt.Errorf("querying old value for OldConversationID: %w", err)
 	}
-	return oldValue.KnowledgeBaseID, nil
+	return oldValue.ConversationID, nil
 }
 
-// ResetKnowledgeBaseID resets all changes to the "knowledge_base_id" field.
-func (m *DocumentChunkMutation) ResetKnowledgeBaseID() {
-	m.knowledge_base_id = nil
+// ResetConversationID resets all changes to the "conversation_id" field.
+func (m *MessageMutation) ResetConversationID() {
+	m.conversation = nil
 }
 
-// SetDocumentID sets the "document_id" field.
-func (m *DocumentChunkMutation) SetDocumentID(s string) {
-	m.document_id = &s
+// SetParentID sets the "parent_id" field.
+func (m *MessageMutation) SetParentID(s string) {
+	m.parent_id = &s
 }
 
-// DocumentID returns the value of the "document_id" field in the mutation.
-func (m *DocumentChunkMutation) DocumentID() (r string, exists bool) {
-	v := m.document_id
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *MessageMutation) ParentID() (r string, exists bool) {
+	v := m.parent_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDocumentID returns the old "document_id" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldDocumentID(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldParentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.DocumentID, nil
+	return oldValue.ParentID, nil
 }
 
-// ResetDocumentID resets all changes to the "document_id" field.
-func (m *DocumentChunkMutation) ResetDocumentID() {
-	m.document_id = nil
+// ClearParentID clears the value of the "parent_id" field.
+func (m *MessageMutation) ClearParentID() {
+	m.parent_id = nil
+	m.clearedFields[message.FieldParentID] = struct{}{}
 }
 
-// SetChunkIndex sets the "chunk_index" field.
-func (m *DocumentChunkMutation) SetChunkIndex(i int) {
-	m.chunk_index = &i
-	m.addchunk_index = nil
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *MessageMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *MessageMutation) ResetParentID() {
+	m.parent_id = nil
+	delete(m.clearedFields, message.FieldParentID)
+}
+
+// SetRole sets the "role" field.
+func (m *MessageMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *MessageMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *MessageMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *MessageMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *MessageMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *MessageMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[message.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *MessageMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[message.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *MessageMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, message.FieldMetadata)
+}
+
+// SetModel sets the "model" field.
+func (m *MessageMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *MessageMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *MessageMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[message.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *MessageMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[message.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *MessageMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, message.FieldModel)
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *MessageMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
 }
 
-// ChunkIndex returns the value of the "chunk_index" field in the mutation.
-func (m *DocumentChunkMutation) ChunkIndex() (r int, exists bool) {
-	v := m.chunk_index
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *MessageMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChunkIndex returns the old "chunk_index" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldPromptTokens returns the old "prompt_tokens" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldChunkIndex(ctx context.Context) (v int, err error) {
+func (m *MessageMutation) OldPromptTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChunkIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChunkIndex requires an ID field in the mutation")
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChunkIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
 	}
-	return oldValue.ChunkIndex, nil
+	return oldValue.PromptTokens, nil
 }
 
-// AddChunkIndex adds i to the "chunk_index" field.
-func (m *DocumentChunkMutation) AddChunkIndex(i int) {
-	if m.addchunk_index != nil {
-		*m.addchunk_index += i
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *MessageMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
 	} else {
-		m.addchunk_index = &i
+		m.addprompt_tokens = &i
 	}
 }
 
-// AddedChunkIndex returns the value that was added to the "chunk_index" field in this mutation.
-func (m *DocumentChunkMutation) AddedChunkIndex() (r int, exists bool) {
-	v := m.addchunk_index
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *MessageMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetChunkIndex resets all changes to the "chunk_index" field.
-func (m *DocumentChunkMutation) ResetChunkIndex() {
-	m.chunk_index = nil
-	m.addchunk_index = nil
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *MessageMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
 }
 
-// SetContent sets the "content" field.
-func (m *DocumentChunkMutation) SetContent(s string) {
-	m.content = &s
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *MessageMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *DocumentChunkMutation) Content() (r string, exists bool) {
-	v := m.content
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *MessageMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletionTokens returns the old "completion_tokens" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldCompletionTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.CompletionTokens, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *DocumentChunkMutation) ResetContent() {
-	m.content = nil
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *MessageMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *DocumentChunkMutation) SetEmbedding(f []float32) {
-	m.embedding = &f
-	m.appendembedding = nil
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *MessageMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *DocumentChunkMutation) Embedding() (r []float32, exists bool) {
-	v := m.embedding
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *MessageMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *MessageMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *MessageMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbedding returns the old "embedding" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+func (m *MessageMutation) OldIdempotencyKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
-	}
-	return oldValue.Embedding, nil
-}
-
-// AppendEmbedding adds f to the "embedding" field.
-func (m *DocumentChunkMutation) AppendEmbedding(f []float32) {
-	m.appendembedding = append(m.appendembedding, f...)
-}
-
-// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
-func (m *DocumentChunkMutation) AppendedEmbedding() ([]float32, bool) {
-	if len(m.appendembedding) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
 	}
-	return m.appendembedding, true
+	return oldValue.IdempotencyKey, nil
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (m *DocumentChunkMutation) ClearEmbedding() {
-	m.embedding = nil
-	m.appendembedding = nil
-	m.clearedFields[documentchunk.FieldEmbedding] = struct{}{}
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *MessageMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[message.FieldIdempotencyKey] = struct{}{}
 }
 
-// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
-func (m *DocumentChunkMutation) EmbeddingCleared() bool {
-	_, ok := m.clearedFields[documentchunk.FieldEmbedding]
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *MessageMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[message.FieldIdempotencyKey]
 	return ok
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *DocumentChunkMutation) ResetEmbedding() {
-	m.embedding = nil
-	m.appendembedding = nil
-	delete(m.clearedFields, documentchunk.FieldEmbedding)
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *MessageMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, message.FieldIdempotencyKey)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *DocumentChunkMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *DocumentChunkMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (m *DocumentChunkMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[documentchunk.FieldMetadata] = struct{}{}
-}
-
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *DocumentChunkMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[documentchunk.FieldMetadata]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *DocumentChunkMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, documentchunk.FieldMetadata)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DocumentChunkMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DocumentChunkMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DocumentChunk entity.
-// If the DocumentChunk object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentChunkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DocumentChunkMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the DocumentChunkMutation builder.
-func (m *DocumentChunkMutation) Where(ps ...predicate.DocumentChunk) {
+// ClearConversation clears the "conversation" edge to the Conversation entity.
+func (m *MessageMutation) ClearConversation() {
+	m.clearedconversation = true
+	m.clearedFields[message.FieldConversationID] = struct{}{}
+}
+
+// ConversationCleared reports if the "conversation" edge to the Conversation entity was cleared.
+func (m *MessageMutation) ConversationCleared() bool {
+	return m.clearedconversation
+}
+
+// ConversationIDs returns the "conversation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConversationID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ConversationIDs() (ids []string) {
+	if id := m.conversation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConversation resets all changes to the "conversation" edge.
+func (m *MessageMutation) ResetConversation() {
+	m.conversation = nil
+	m.clearedconversation = false
+}
+
+// AddFeedbackIDs adds the "feedback" edge to the MessageFeedback entity by ids.
+func (m *MessageMutation) AddFeedbackIDs(ids ...string) {
+	if m.feedback == nil {
+		m.feedback = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.feedback[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFeedback clears the "feedback" edge to the MessageFeedback entity.
+func (m *MessageMutation) ClearFeedback() {
+	m.clearedfeedback = true
+}
+
+// FeedbackCleared reports if the "feedback" edge to the MessageFeedback entity was cleared.
+func (m *MessageMutation) FeedbackCleared() bool {
+	return m.clearedfeedback
+}
+
+// RemoveFeedbackIDs removes the "feedback" edge to the MessageFeedback entity by IDs.
+func (m *MessageMutation) RemoveFeedbackIDs(ids ...string) {
+	if m.removedfeedback == nil {
+		m.removedfeedback = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.feedback, ids[i])
+		m.removedfeedback[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFeedback returns the removed IDs of the "feedback" edge to the MessageFeedback entity.
+func (m *MessageMutation) RemovedFeedbackIDs() (ids []string) {
+	for id := range m.removedfeedback {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FeedbackIDs returns the "feedback" edge IDs in the mutation.
+func (m *MessageMutation) FeedbackIDs() (ids []string) {
+	for id := range m.feedback {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFeedback resets all changes to the "feedback" edge.
+func (m *MessageMutation) ResetFeedback() {
+	m.feedback = nil
+	m.clearedfeedback = false
+	m.removedfeedback = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DocumentChunkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocumentChunkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DocumentChunk, len(ps))
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2777,45 +8976,57 @@ func (m *DocumentChunkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DocumentChunkMutation) Op() Op {
+func (m *MessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DocumentChunkMutation) SetOp(op Op) {
+func (m *MessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DocumentChunk).
-func (m *DocumentChunkMutation) Type() string {
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DocumentChunkMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.knowledge_base_id != nil {
-		fields = append(fields, documentchunk.FieldKnowledgeBaseID)
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.conversation != nil {
+		fields = append(fields, message.FieldConversationID)
 	}
-	if m.document_id != nil {
-		fields = append(fields, documentchunk.FieldDocumentID)
+	if m.parent_id != nil {
+		fields = append(fields, message.FieldParentID)
 	}
-	if m.chunk_index != nil {
-		fields = append(fields, documentchunk.FieldChunkIndex)
+	if m.role != nil {
+		fields = append(fields, message.FieldRole)
 	}
 	if m.content != nil {
-		fields = append(fields, documentchunk.FieldContent)
-	}
-	if m.embedding != nil {
-		fields = append(fields, documentchunk.FieldEmbedding)
+		fields = append(fields, message.FieldContent)
 	}
 	if m.metadata != nil {
-		fields = append(fields, documentchunk.FieldMetadata)
+		fields = append(fields, message.FieldMetadata)
+	}
+	if m.model != nil {
+		fields = append(fields, message.FieldModel)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, message.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, message.FieldCompletionTokens)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, message.FieldIdempotencyKey)
 	}
 	if m.created_at != nil {
-		fields = append(fields, documentchunk.FieldCreatedAt)
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, message.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2823,22 +9034,30 @@ func (m *DocumentChunkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocumentChunkMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case documentchunk.FieldKnowledgeBaseID:
-		return m.KnowledgeBaseID()
-	case documentchunk.FieldDocumentID:
-		return m.DocumentID()
-	case documentchunk.FieldChunkIndex:
-		return m.ChunkIndex()
-	case documentchunk.FieldContent:
+	case message.FieldConversationID:
+		return m.ConversationID()
+	case message.FieldParentID:
+		return m.ParentID()
+	case message.FieldRole:
+		return m.Role()
+	case message.FieldContent:
 		return m.Content()
-	case documentchunk.FieldEmbedding:
-		return m.Embedding()
-	case documentchunk.FieldMetadata:
+	case message.FieldMetadata:
 		return m.Metadata()
-	case documentchunk.FieldCreatedAt:
+	case message.FieldModel:
+		return m.Model()
+	case message.FieldPromptTokens:
+		return m.PromptTokens()
+	case message.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case message.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case message.FieldCreatedAt:
 		return m.CreatedAt()
+	case message.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2846,90 +9065,129 @@ func (m *DocumentChunkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocumentChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case documentchunk.FieldKnowledgeBaseID:
-		return m.OldKnowledgeBaseID(ctx)
-	case documentchunk.FieldDocumentID:
-		return m.OldDocumentID(ctx)
-	case documentchunk.FieldChunkIndex:
-		return m.OldChunkIndex(ctx)
-	case documentchunk.FieldContent:
+	case message.FieldConversationID:
+		return m.OldConversationID(ctx)
+	case message.FieldParentID:
+		return m.OldParentID(ctx)
+	case message.FieldRole:
+		return m.OldRole(ctx)
+	case message.FieldContent:
 		return m.OldContent(ctx)
-	case documentchunk.FieldEmbedding:
-		return m.OldEmbedding(ctx)
-	case documentchunk.FieldMetadata:
+	case message.FieldMetadata:
 		return m.OldMetadata(ctx)
-	case documentchunk.FieldCreatedAt:
+	case message.FieldModel:
+		return m.OldModel(ctx)
+	case message.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case message.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case message.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case message.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case message.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DocumentChunk field %s", name)
+	return nil, fmt.Errorf("unknown Message field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentChunkMutation) SetField(name string, value ent.Value) error {
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case documentchunk.FieldKnowledgeBaseID:
+	case message.FieldConversationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConversationID(v)
+		return nil
+	case message.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case message.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case message.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKnowledgeBaseID(v)
+		m.SetContent(v)
 		return nil
-	case documentchunk.FieldDocumentID:
+	case message.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case message.FieldModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDocumentID(v)
+		m.SetModel(v)
 		return nil
-	case documentchunk.FieldChunkIndex:
+	case message.FieldPromptTokens:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChunkIndex(v)
+		m.SetPromptTokens(v)
 		return nil
-	case documentchunk.FieldContent:
-		v, ok := value.(string)
+	case message.FieldCompletionTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetCompletionTokens(v)
 		return nil
-	case documentchunk.FieldEmbedding:
-		v, ok := value.([]float32)
+	case message.FieldIdempotencyKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbedding(v)
+		m.SetIdempotencyKey(v)
 		return nil
-	case documentchunk.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetCreatedAt(v)
 		return nil
-	case documentchunk.FieldCreatedAt:
+	case message.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DocumentChunk field %s", name)
+	return fmt.Errorf("unknown Message field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocumentChunkMutation) AddedFields() []string {
+func (m *MessageMutation) AddedFields() []string {
 	var fields []string
-	if m.addchunk_index != nil {
-		fields = append(fields, documentchunk.FieldChunkIndex)
+	if m.addprompt_tokens != nil {
+		fields = append(fields, message.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, message.FieldCompletionTokens)
 	}
 	return fields
 }
@@ -2937,10 +9195,12 @@ func (m *DocumentChunkMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocumentChunkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case documentchunk.FieldChunkIndex:
-		return m.AddedChunkIndex()
+	case message.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case message.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
 	}
 	return nil, false
 }
@@ -2948,168 +9208,249 @@ func (m *DocumentChunkMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentChunkMutation) AddField(name string, value ent.Value) error {
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case documentchunk.FieldChunkIndex:
+	case message.FieldPromptTokens:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddChunkIndex(v)
+		m.AddPromptTokens(v)
+		return nil
+	case message.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DocumentChunk numeric field %s", name)
+	return fmt.Errorf("unknown Message numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocumentChunkMutation) ClearedFields() []string {
+func (m *MessageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(documentchunk.FieldEmbedding) {
-		fields = append(fields, documentchunk.FieldEmbedding)
+	if m.FieldCleared(message.FieldParentID) {
+		fields = append(fields, message.FieldParentID)
 	}
-	if m.FieldCleared(documentchunk.FieldMetadata) {
-		fields = append(fields, documentchunk.FieldMetadata)
+	if m.FieldCleared(message.FieldMetadata) {
+		fields = append(fields, message.FieldMetadata)
+	}
+	if m.FieldCleared(message.FieldModel) {
+		fields = append(fields, message.FieldModel)
+	}
+	if m.FieldCleared(message.FieldIdempotencyKey) {
+		fields = append(fields, message.FieldIdempotencyKey)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocumentChunkMutation) FieldCleared(name string) bool {
+func (m *MessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocumentChunkMutation) ClearField(name string) error {
+func (m *MessageMutation) ClearField(name string) error {
 	switch name {
-	case documentchunk.FieldEmbedding:
-		m.ClearEmbedding()
+	case message.FieldParentID:
+		m.ClearParentID()
 		return nil
-	case documentchunk.FieldMetadata:
+	case message.FieldMetadata:
 		m.ClearMetadata()
 		return nil
+	case message.FieldModel:
+		m.ClearModel()
+		return nil
+	case message.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
 	}
-	return fmt.Errorf("unknown DocumentChunk nullable field %s", name)
+	return fmt.Errorf("unknown Message nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocumentChunkMutation) ResetField(name string) error {
+func (m *MessageMutation) ResetField(name string) error {
 	switch name {
-	case documentchunk.FieldKnowledgeBaseID:
-		m.ResetKnowledgeBaseID()
+	case message.FieldConversationID:
+		m.ResetConversationID()
 		return nil
-	case documentchunk.FieldDocumentID:
-		m.ResetDocumentID()
+	case message.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case documentchunk.FieldChunkIndex:
-		m.ResetChunkIndex()
+	case message.FieldRole:
+		m.ResetRole()
 		return nil
-	case documentchunk.FieldContent:
+	case message.FieldContent:
 		m.ResetContent()
 		return nil
-	case documentchunk.FieldEmbedding:
-		m.ResetEmbedding()
-		return nil
-	case documentchunk.FieldMetadata:
+	case message.FieldMetadata:
 		m.ResetMetadata()
 		return nil
-	case documentchunk.FieldCreatedAt:
+	case message.FieldModel:
+		m.ResetModel()
+		return nil
+	case message.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case message.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case message.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case message.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case message.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown DocumentChunk field %s", name)
+	return fmt.Errorf("unknown Message field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocumentChunkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.conversation != nil {
+		edges = append(edges, message.EdgeConversation)
+	}
+	if m.feedback != nil {
+		edges = append(edges, message.EdgeFeedback)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocumentChunkMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeConversation:
+		if id := m.conversation; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeFeedback:
+		ids := make([]ent.Value, 0, len(m.feedback))
+		for id := range m.feedback {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocumentChunkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedfeedback != nil {
+		edges = append(edges, message.EdgeFeedback)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DocumentChunkMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeFeedback:
+		ids := make([]ent.Value, 0, len(m.removedfeedback))
+		for id := range m.removedfeedback {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DocumentChunkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedconversation {
+		edges = append(edges, message.EdgeConversation)
+	}
+	if m.clearedfeedback {
+		edges = append(edges, message.EdgeFeedback)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocumentChunkMutation) EdgeCleared(name string) bool {
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeConversation:
+		return m.clearedconversation
+	case message.EdgeFeedback:
+		return m.clearedfeedback
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocumentChunkMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DocumentChunk unique edge %s", name)
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeConversation:
+		m.ClearConversation()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocumentChunkMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DocumentChunk edge %s", name)
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeConversation:
+		m.ResetConversation()
+		return nil
+	case message.EdgeFeedback:
+		m.ResetFeedback()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
 }
 
-// KnowledgeBaseMutation represents an operation that mutates the KnowledgeBase nodes in the graph.
-type KnowledgeBaseMutation struct {
+// MessageFeedbackMutation represents an operation that mutates the MessageFeedback nodes in the graph.
+type MessageFeedbackMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	name              *string
-	description       *string
-	_type             *string
-	embedding_model   *string
-	chunk_config      *map[string]interface{}
-	documents         *[]interface{}
-	appenddocuments   []interface{}
-	metadata          *map[string]interface{}
-	created_by        *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	document_count    *int
-	adddocument_count *int
-	vector_count      *int
-	addvector_count   *int
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*KnowledgeBase, error)
-	predicates        []predicate.KnowledgeBase
+	op              Op
+	typ             string
+	id              *string
+	conversation_id *string
+	agent_id        *string
+	user_id         *string
+	rating          *int
+	addrating       *int
+	reason          *string
+	comment         *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	message         *string
+	clearedmessage  bool
+	done            bool
+	oldValue        func(context.Context) (*MessageFeedback, error)
+	predicates      []predicate.MessageFeedback
 }
 
-var _ ent.Mutation = (*KnowledgeBaseMutation)(nil)
+var _ ent.Mutation = (*MessageFeedbackMutation)(nil)
 
-// knowledgebaseOption allows management of the mutation configuration using functional options.
-type knowledgebaseOption func(*KnowledgeBaseMutation)
+// messagefeedbackOption allows management of the mutation configuration using functional options.
+type messagefeedbackOption func(*MessageFeedbackMutation)
 
-// newKnowledgeBaseMutation creates new mutation for the KnowledgeBase entity.
-func newKnowledgeBaseMutation(c config, op Op, opts ...knowledgebaseOption) *KnowledgeBaseMutation {
-	m := &KnowledgeBaseMutation{
+// newMessageFeedbackMutation creates new mutation for the MessageFeedback entity.
+func newMessageFeedbackMutation(c config, op Op, opts ...messagefeedbackOption) *MessageFeedbackMutation {
+	m := &MessageFeedbackMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeKnowledgeBase,
+		typ:           TypeMessageFeedback,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3118,20 +9459,20 @@ func newKnowledgeBaseMutation(c config, op Op, opts ...knowledgebaseOption) *Kno
 	return m
 }
 
-// withKnowledgeBaseID sets the ID field of the mutation.
-func withKnowledgeBaseID(id string) knowledgebaseOption {
-	return func(m *KnowledgeBaseMutation) {
+// withMessageFeedbackID sets the ID field of the mutation.
+func withMessageFeedbackID(id string) messagefeedbackOption {
+	return func(m *MessageFeedbackMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *KnowledgeBase
+			value *MessageFeedback
 		)
-		m.oldValue = func(ctx context.Context) (*KnowledgeBase, error) {
+		m.oldValue = func(ctx context.Context) (*MessageFeedback, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().KnowledgeBase.Get(ctx, id)
+					value, err = m.Client().MessageFeedback.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3140,10 +9481,10 @@ func withKnowledgeBaseID(id string) knowledgebaseOption {
 	}
 }
 
-// withKnowledgeBase sets the old KnowledgeBase of the mutation.
-func withKnowledgeBase(node *KnowledgeBase) knowledgebaseOption {
-	return func(m *KnowledgeBaseMutation) {
-		m.oldValue = func(context.Context) (*KnowledgeBase, error) {
+// withMessageFeedback sets the old MessageFeedback of the mutation.
+func withMessageFeedback(node *MessageFeedback) messagefeedbackOption {
+	return func(m *MessageFeedbackMutation) {
+		m.oldValue = func(context.Context) (*MessageFeedback, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3152,7 +9493,7 @@ func withKnowledgeBase(node *KnowledgeBase) knowledgebaseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KnowledgeBaseMutation) Client() *Client {
+func (m MessageFeedbackMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3160,7 +9501,7 @@ func (m KnowledgeBaseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m KnowledgeBaseMutation) Tx() (*Tx, error) {
+func (m MessageFeedbackMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3170,14 +9511,14 @@ func (m KnowledgeBaseMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of KnowledgeBase entities.
-func (m *KnowledgeBaseMutation) SetID(id string) {
+// operation is only accepted on creation of MessageFeedback entities.
+func (m *MessageFeedbackMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *KnowledgeBaseMutation) ID() (id string, exists bool) {
+func (m *MessageFeedbackMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3188,7 +9529,7 @@ func (m *KnowledgeBaseMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *KnowledgeBaseMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *MessageFeedbackMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3197,375 +9538,317 @@ func (m *KnowledgeBaseMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().KnowledgeBase.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MessageFeedback.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *KnowledgeBaseMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *KnowledgeBaseMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *KnowledgeBaseMutation) ResetName() {
-	m.name = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *KnowledgeBaseMutation) SetDescription(s string) {
-	m.description = &s
+// SetMessageID sets the "message_id" field.
+func (m *MessageFeedbackMutation) SetMessageID(s string) {
+	m.message = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *KnowledgeBaseMutation) Description() (r string, exists bool) {
-	v := m.description
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageFeedbackMutation) MessageID() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *MessageFeedbackMutation) OldMessageID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.MessageID, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *KnowledgeBaseMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[knowledgebase.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *KnowledgeBaseMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[knowledgebase.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *KnowledgeBaseMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, knowledgebase.FieldDescription)
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageFeedbackMutation) ResetMessageID() {
+	m.message = nil
 }
 
-// SetType sets the "type" field.
-func (m *KnowledgeBaseMutation) SetType(s string) {
-	m._type = &s
+// SetConversationID sets the "conversation_id" field.
+func (m *MessageFeedbackMutation) SetConversationID(s string) {
+	m.conversation_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *KnowledgeBaseMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ConversationID returns the value of the "conversation_id" field in the mutation.
+func (m *MessageFeedbackMutation) ConversationID() (r string, exists bool) {
+	v := m.conversation_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldConversationID returns the old "conversation_id" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *MessageFeedbackMutation) OldConversationID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldConversationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldConversationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldConversationID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.ConversationID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *KnowledgeBaseMutation) ResetType() {
-	m._type = nil
+// ResetConversationID resets all changes to the "conversation_id" field.
+func (m *MessageFeedbackMutation) ResetConversationID() {
+	m.conversation_id = nil
 }
 
-// SetEmbeddingModel sets the "embedding_model" field.
-func (m *KnowledgeBaseMutation) SetEmbeddingModel(s string) {
-	m.embedding_model = &s
+// SetAgentID sets the "agent_id" field.
+func (m *MessageFeedbackMutation) SetAgentID(s string) {
+	m.agent_id = &s
 }
 
-// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
-func (m *KnowledgeBaseMutation) EmbeddingModel() (r string, exists bool) {
-	v := m.embedding_model
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *MessageFeedbackMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbeddingModel returns the old "embedding_model" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentID returns the old "agent_id" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldEmbeddingModel(ctx context.Context) (v string, err error) {
+func (m *MessageFeedbackMutation) OldAgentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
 	}
-	return oldValue.EmbeddingModel, nil
+	return oldValue.AgentID, nil
 }
 
-// ResetEmbeddingModel resets all changes to the "embedding_model" field.
-func (m *KnowledgeBaseMutation) ResetEmbeddingModel() {
-	m.embedding_model = nil
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *MessageFeedbackMutation) ResetAgentID() {
+	m.agent_id = nil
 }
 
-// SetChunkConfig sets the "chunk_config" field.
-func (m *KnowledgeBaseMutation) SetChunkConfig(value map[string]interface{}) {
-	m.chunk_config = &value
+// SetUserID sets the "user_id" field.
+func (m *MessageFeedbackMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// ChunkConfig returns the value of the "chunk_config" field in the mutation.
-func (m *KnowledgeBaseMutation) ChunkConfig() (r map[string]interface{}, exists bool) {
-	v := m.chunk_config
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageFeedbackMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChunkConfig returns the old "chunk_config" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldChunkConfig(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *MessageFeedbackMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChunkConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChunkConfig requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChunkConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ChunkConfig, nil
-}
-
-// ClearChunkConfig clears the value of the "chunk_config" field.
-func (m *KnowledgeBaseMutation) ClearChunkConfig() {
-	m.chunk_config = nil
-	m.clearedFields[knowledgebase.FieldChunkConfig] = struct{}{}
-}
-
-// ChunkConfigCleared returns if the "chunk_config" field was cleared in this mutation.
-func (m *KnowledgeBaseMutation) ChunkConfigCleared() bool {
-	_, ok := m.clearedFields[knowledgebase.FieldChunkConfig]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetChunkConfig resets all changes to the "chunk_config" field.
-func (m *KnowledgeBaseMutation) ResetChunkConfig() {
-	m.chunk_config = nil
-	delete(m.clearedFields, knowledgebase.FieldChunkConfig)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageFeedbackMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetDocuments sets the "documents" field.
-func (m *KnowledgeBaseMutation) SetDocuments(i []interface{}) {
-	m.documents = &i
-	m.appenddocuments = nil
+// SetRating sets the "rating" field.
+func (m *MessageFeedbackMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
 }
 
-// Documents returns the value of the "documents" field in the mutation.
-func (m *KnowledgeBaseMutation) Documents() (r []interface{}, exists bool) {
-	v := m.documents
+// Rating returns the value of the "rating" field in the mutation.
+func (m *MessageFeedbackMutation) Rating() (r int, exists bool) {
+	v := m.rating
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDocuments returns the old "documents" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldRating returns the old "rating" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldDocuments(ctx context.Context) (v []interface{}, err error) {
+func (m *MessageFeedbackMutation) OldRating(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDocuments is only allowed on UpdateOne operations")
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDocuments requires an ID field in the mutation")
+		return v, errors.New("OldRating requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDocuments: %w", err)
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
 	}
-	return oldValue.Documents, nil
-}
-
-// AppendDocuments adds i to the "documents" field.
-func (m *KnowledgeBaseMutation) AppendDocuments(i []interface{}) {
-	m.appenddocuments = append(m.appenddocuments, i...)
+	return oldValue.Rating, nil
 }
 
-// AppendedDocuments returns the list of values that were appended to the "documents" field in this mutation.
-func (m *KnowledgeBaseMutation) AppendedDocuments() ([]interface{}, bool) {
-	if len(m.appenddocuments) == 0 {
-		return nil, false
+// AddRating adds i to the "rating" field.
+func (m *MessageFeedbackMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
 	}
-	return m.appenddocuments, true
-}
-
-// ClearDocuments clears the value of the "documents" field.
-func (m *KnowledgeBaseMutation) ClearDocuments() {
-	m.documents = nil
-	m.appenddocuments = nil
-	m.clearedFields[knowledgebase.FieldDocuments] = struct{}{}
 }
 
-// DocumentsCleared returns if the "documents" field was cleared in this mutation.
-func (m *KnowledgeBaseMutation) DocumentsCleared() bool {
-	_, ok := m.clearedFields[knowledgebase.FieldDocuments]
-	return ok
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *MessageFeedbackMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDocuments resets all changes to the "documents" field.
-func (m *KnowledgeBaseMutation) ResetDocuments() {
-	m.documents = nil
-	m.appenddocuments = nil
-	delete(m.clearedFields, knowledgebase.FieldDocuments)
+// ResetRating resets all changes to the "rating" field.
+func (m *MessageFeedbackMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *KnowledgeBaseMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetReason sets the "reason" field.
+func (m *MessageFeedbackMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *KnowledgeBaseMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// Reason returns the value of the "reason" field in the mutation.
+func (m *MessageFeedbackMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *MessageFeedbackMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.Reason, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *KnowledgeBaseMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[knowledgebase.FieldMetadata] = struct{}{}
+// ClearReason clears the value of the "reason" field.
+func (m *MessageFeedbackMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[messagefeedback.FieldReason] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *KnowledgeBaseMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[knowledgebase.FieldMetadata]
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *MessageFeedbackMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[messagefeedback.FieldReason]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *KnowledgeBaseMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, knowledgebase.FieldMetadata)
+// ResetReason resets all changes to the "reason" field.
+func (m *MessageFeedbackMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, messagefeedback.FieldReason)
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *KnowledgeBaseMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// SetComment sets the "comment" field.
+func (m *MessageFeedbackMutation) SetComment(s string) {
+	m.comment = &s
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *KnowledgeBaseMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// Comment returns the value of the "comment" field in the mutation.
+func (m *MessageFeedbackMutation) Comment() (r string, exists bool) {
+	v := m.comment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldComment returns the old "comment" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *MessageFeedbackMutation) OldComment(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldComment requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.Comment, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *KnowledgeBaseMutation) ResetCreatedBy() {
-	m.created_by = nil
+// ClearComment clears the value of the "comment" field.
+func (m *MessageFeedbackMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[messagefeedback.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *MessageFeedbackMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[messagefeedback.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *MessageFeedbackMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, messagefeedback.FieldComment)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *KnowledgeBaseMutation) SetCreatedAt(t time.Time) {
+func (m *MessageFeedbackMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *KnowledgeBaseMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MessageFeedbackMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3573,10 +9856,10 @@ func (m *KnowledgeBaseMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageFeedbackMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3591,17 +9874,17 @@ func (m *KnowledgeBaseMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *KnowledgeBaseMutation) ResetCreatedAt() {
+func (m *MessageFeedbackMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *KnowledgeBaseMutation) SetUpdatedAt(t time.Time) {
+func (m *MessageFeedbackMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *KnowledgeBaseMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *MessageFeedbackMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3609,10 +9892,10 @@ func (m *KnowledgeBaseMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the MessageFeedback entity.
+// If the MessageFeedback object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageFeedbackMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3627,131 +9910,46 @@ func (m *KnowledgeBaseMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *KnowledgeBaseMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetDocumentCount sets the "document_count" field.
-func (m *KnowledgeBaseMutation) SetDocumentCount(i int) {
-	m.document_count = &i
-	m.adddocument_count = nil
-}
-
-// DocumentCount returns the value of the "document_count" field in the mutation.
-func (m *KnowledgeBaseMutation) DocumentCount() (r int, exists bool) {
-	v := m.document_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDocumentCount returns the old "document_count" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldDocumentCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDocumentCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDocumentCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDocumentCount: %w", err)
-	}
-	return oldValue.DocumentCount, nil
-}
-
-// AddDocumentCount adds i to the "document_count" field.
-func (m *KnowledgeBaseMutation) AddDocumentCount(i int) {
-	if m.adddocument_count != nil {
-		*m.adddocument_count += i
-	} else {
-		m.adddocument_count = &i
-	}
-}
-
-// AddedDocumentCount returns the value that was added to the "document_count" field in this mutation.
-func (m *KnowledgeBaseMutation) AddedDocumentCount() (r int, exists bool) {
-	v := m.adddocument_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetDocumentCount resets all changes to the "document_count" field.
-func (m *KnowledgeBaseMutation) ResetDocumentCount() {
-	m.document_count = nil
-	m.adddocument_count = nil
-}
-
-// SetVectorCount sets the "vector_count" field.
-func (m *KnowledgeBaseMutation) SetVectorCount(i int) {
-	m.vector_count = &i
-	m.addvector_count = nil
-}
-
-// VectorCount returns the value of the "vector_count" field in the mutation.
-func (m *KnowledgeBaseMutation) VectorCount() (r int, exists bool) {
-	v := m.vector_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldVectorCount returns the old "vector_count" field's value of the KnowledgeBase entity.
-// If the KnowledgeBase object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeBaseMutation) OldVectorCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVectorCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVectorCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVectorCount: %w", err)
-	}
-	return oldValue.VectorCount, nil
+func (m *MessageFeedbackMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddVectorCount adds i to the "vector_count" field.
-func (m *KnowledgeBaseMutation) AddVectorCount(i int) {
-	if m.addvector_count != nil {
-		*m.addvector_count += i
-	} else {
-		m.addvector_count = &i
-	}
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageFeedbackMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagefeedback.FieldMessageID] = struct{}{}
 }
 
-// AddedVectorCount returns the value that was added to the "vector_count" field in this mutation.
-func (m *KnowledgeBaseMutation) AddedVectorCount() (r int, exists bool) {
-	v := m.addvector_count
-	if v == nil {
-		return
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageFeedbackMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageFeedbackMutation) MessageIDs() (ids []string) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetVectorCount resets all changes to the "vector_count" field.
-func (m *KnowledgeBaseMutation) ResetVectorCount() {
-	m.vector_count = nil
-	m.addvector_count = nil
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageFeedbackMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
 }
 
-// Where appends a list predicates to the KnowledgeBaseMutation builder.
-func (m *KnowledgeBaseMutation) Where(ps ...predicate.KnowledgeBase) {
+// Where appends a list predicates to the MessageFeedbackMutation builder.
+func (m *MessageFeedbackMutation) Where(ps ...predicate.MessageFeedback) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the KnowledgeBaseMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageFeedbackMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *KnowledgeBaseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.KnowledgeBase, len(ps))
+func (m *MessageFeedbackMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageFeedback, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3759,60 +9957,51 @@ func (m *KnowledgeBaseMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *KnowledgeBaseMutation) Op() Op {
+func (m *MessageFeedbackMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *KnowledgeBaseMutation) SetOp(op Op) {
+func (m *MessageFeedbackMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (KnowledgeBase).
-func (m *KnowledgeBaseMutation) Type() string {
+// Type returns the node type of this mutation (MessageFeedback).
+func (m *MessageFeedbackMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *KnowledgeBaseMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.name != nil {
-		fields = append(fields, knowledgebase.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, knowledgebase.FieldDescription)
+func (m *MessageFeedbackMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.message != nil {
+		fields = append(fields, messagefeedback.FieldMessageID)
 	}
-	if m._type != nil {
-		fields = append(fields, knowledgebase.FieldType)
+	if m.conversation_id != nil {
+		fields = append(fields, messagefeedback.FieldConversationID)
 	}
-	if m.embedding_model != nil {
-		fields = append(fields, knowledgebase.FieldEmbeddingModel)
+	if m.agent_id != nil {
+		fields = append(fields, messagefeedback.FieldAgentID)
 	}
-	if m.chunk_config != nil {
-		fields = append(fields, knowledgebase.FieldChunkConfig)
+	if m.user_id != nil {
+		fields = append(fields, messagefeedback.FieldUserID)
 	}
-	if m.documents != nil {
-		fields = append(fields, knowledgebase.FieldDocuments)
+	if m.rating != nil {
+		fields = append(fields, messagefeedback.FieldRating)
 	}
-	if m.metadata != nil {
-		fields = append(fields, knowledgebase.FieldMetadata)
+	if m.reason != nil {
+		fields = append(fields, messagefeedback.FieldReason)
 	}
-	if m.created_by != nil {
-		fields = append(fields, knowledgebase.FieldCreatedBy)
+	if m.comment != nil {
+		fields = append(fields, messagefeedback.FieldComment)
 	}
 	if m.created_at != nil {
-		fields = append(fields, knowledgebase.FieldCreatedAt)
+		fields = append(fields, messagefeedback.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, knowledgebase.FieldUpdatedAt)
-	}
-	if m.document_count != nil {
-		fields = append(fields, knowledgebase.FieldDocumentCount)
-	}
-	if m.vector_count != nil {
-		fields = append(fields, knowledgebase.FieldVectorCount)
+		fields = append(fields, messagefeedback.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3820,32 +10009,26 @@ func (m *KnowledgeBaseMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *KnowledgeBaseMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageFeedbackMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case knowledgebase.FieldName:
-		return m.Name()
-	case knowledgebase.FieldDescription:
-		return m.Description()
-	case knowledgebase.FieldType:
-		return m.GetType()
-	case knowledgebase.FieldEmbeddingModel:
-		return m.EmbeddingModel()
-	case knowledgebase.FieldChunkConfig:
-		return m.ChunkConfig()
-	case knowledgebase.FieldDocuments:
-		return m.Documents()
-	case knowledgebase.FieldMetadata:
-		return m.Metadata()
-	case knowledgebase.FieldCreatedBy:
-		return m.CreatedBy()
-	case knowledgebase.FieldCreatedAt:
+	case messagefeedback.FieldMessageID:
+		return m.MessageID()
+	case messagefeedback.FieldConversationID:
+		return m.ConversationID()
+	case messagefeedback.FieldAgentID:
+		return m.AgentID()
+	case messagefeedback.FieldUserID:
+		return m.UserID()
+	case messagefeedback.FieldRating:
+		return m.Rating()
+	case messagefeedback.FieldReason:
+		return m.Reason()
+	case messagefeedback.FieldComment:
+		return m.Comment()
+	case messagefeedback.FieldCreatedAt:
 		return m.CreatedAt()
-	case knowledgebase.FieldUpdatedAt:
+	case messagefeedback.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case knowledgebase.FieldDocumentCount:
-		return m.DocumentCount()
-	case knowledgebase.FieldVectorCount:
-		return m.VectorCount()
 	}
 	return nil, false
 }
@@ -3853,138 +10036,108 @@ func (m *KnowledgeBaseMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *KnowledgeBaseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageFeedbackMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case knowledgebase.FieldName:
-		return m.OldName(ctx)
-	case knowledgebase.FieldDescription:
-		return m.OldDescription(ctx)
-	case knowledgebase.FieldType:
-		return m.OldType(ctx)
-	case knowledgebase.FieldEmbeddingModel:
-		return m.OldEmbeddingModel(ctx)
-	case knowledgebase.FieldChunkConfig:
-		return m.OldChunkConfig(ctx)
-	case knowledgebase.FieldDocuments:
-		return m.OldDocuments(ctx)
-	case knowledgebase.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case knowledgebase.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case knowledgebase.FieldCreatedAt:
+	case messagefeedback.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagefeedback.FieldConversationID:
+		return m.OldConversationID(ctx)
+	case messagefeedback.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case messagefeedback.FieldUserID:
+		return m.OldUserID(ctx)
+	case messagefeedback.FieldRating:
+		return m.OldRating(ctx)
+	case messagefeedback.FieldReason:
+		return m.OldReason(ctx)
+	case messagefeedback.FieldComment:
+		return m.OldComment(ctx)
+	case messagefeedback.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case knowledgebase.FieldUpdatedAt:
+	case messagefeedback.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case knowledgebase.FieldDocumentCount:
-		return m.OldDocumentCount(ctx)
-	case knowledgebase.FieldVectorCount:
-		return m.OldVectorCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown KnowledgeBase field %s", name)
+	return nil, fmt.Errorf("unknown MessageFeedback field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KnowledgeBaseMutation) SetField(name string, value ent.Value) error {
+func (m *MessageFeedbackMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case knowledgebase.FieldName:
+	case messagefeedback.FieldMessageID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMessageID(v)
 		return nil
-	case knowledgebase.FieldDescription:
+	case messagefeedback.FieldConversationID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetConversationID(v)
 		return nil
-	case knowledgebase.FieldType:
+	case messagefeedback.FieldAgentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetAgentID(v)
 		return nil
-	case knowledgebase.FieldEmbeddingModel:
+	case messagefeedback.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbeddingModel(v)
-		return nil
-	case knowledgebase.FieldChunkConfig:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChunkConfig(v)
+		m.SetUserID(v)
 		return nil
-	case knowledgebase.FieldDocuments:
-		v, ok := value.([]interface{})
+	case messagefeedback.FieldRating:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDocuments(v)
+		m.SetRating(v)
 		return nil
-	case knowledgebase.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case messagefeedback.FieldReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetReason(v)
 		return nil
-	case knowledgebase.FieldCreatedBy:
+	case messagefeedback.FieldComment:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetComment(v)
 		return nil
-	case knowledgebase.FieldCreatedAt:
+	case messagefeedback.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case knowledgebase.FieldUpdatedAt:
+	case messagefeedback.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case knowledgebase.FieldDocumentCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDocumentCount(v)
-		return nil
-	case knowledgebase.FieldVectorCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVectorCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown KnowledgeBase field %s", name)
+	return fmt.Errorf("unknown MessageFeedback field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *KnowledgeBaseMutation) AddedFields() []string {
+func (m *MessageFeedbackMutation) AddedFields() []string {
 	var fields []string
-	if m.adddocument_count != nil {
-		fields = append(fields, knowledgebase.FieldDocumentCount)
-	}
-	if m.addvector_count != nil {
-		fields = append(fields, knowledgebase.FieldVectorCount)
+	if m.addrating != nil {
+		fields = append(fields, messagefeedback.FieldRating)
 	}
 	return fields
 }
@@ -3992,12 +10145,10 @@ func (m *KnowledgeBaseMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *KnowledgeBaseMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageFeedbackMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case knowledgebase.FieldDocumentCount:
-		return m.AddedDocumentCount()
-	case knowledgebase.FieldVectorCount:
-		return m.AddedVectorCount()
+	case messagefeedback.FieldRating:
+		return m.AddedRating()
 	}
 	return nil, false
 }
@@ -4005,162 +10156,160 @@ func (m *KnowledgeBaseMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KnowledgeBaseMutation) AddField(name string, value ent.Value) error {
+func (m *MessageFeedbackMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case knowledgebase.FieldDocumentCount:
+	case messagefeedback.FieldRating:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDocumentCount(v)
-		return nil
-	case knowledgebase.FieldVectorCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVectorCount(v)
+		m.AddRating(v)
 		return nil
 	}
-	return fmt.Errorf("unknown KnowledgeBase numeric field %s", name)
+	return fmt.Errorf("unknown MessageFeedback numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *KnowledgeBaseMutation) ClearedFields() []string {
+func (m *MessageFeedbackMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(knowledgebase.FieldDescription) {
-		fields = append(fields, knowledgebase.FieldDescription)
-	}
-	if m.FieldCleared(knowledgebase.FieldChunkConfig) {
-		fields = append(fields, knowledgebase.FieldChunkConfig)
-	}
-	if m.FieldCleared(knowledgebase.FieldDocuments) {
-		fields = append(fields, knowledgebase.FieldDocuments)
+	if m.FieldCleared(messagefeedback.FieldReason) {
+		fields = append(fields, messagefeedback.FieldReason)
 	}
-	if m.FieldCleared(knowledgebase.FieldMetadata) {
-		fields = append(fields, knowledgebase.FieldMetadata)
+	if m.FieldCleared(messagefeedback.FieldComment) {
+		fields = append(fields, messagefeedback.FieldComment)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *KnowledgeBaseMutation) FieldCleared(name string) bool {
+func (m *MessageFeedbackMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *KnowledgeBaseMutation) ClearField(name string) error {
+func (m *MessageFeedbackMutation) ClearField(name string) error {
 	switch name {
-	case knowledgebase.FieldDescription:
-		m.ClearDescription()
+	case messagefeedback.FieldReason:
+		m.ClearReason()
 		return nil
-	case knowledgebase.FieldChunkConfig:
-		m.ClearChunkConfig()
-		return nil
-	case knowledgebase.FieldDocuments:
-		m.ClearDocuments()
-		return nil
-	case knowledgebase.FieldMetadata:
-		m.ClearMetadata()
+	case messagefeedback.FieldComment:
+		m.ClearComment()
 		return nil
 	}
-	return fmt.Errorf("unknown KnowledgeBase nullable field %s", name)
+	return fmt.Errorf("unknown MessageFeedback nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *KnowledgeBaseMutation) ResetField(name string) error {
+func (m *MessageFeedbackMutation) ResetField(name string) error {
 	switch name {
-	case knowledgebase.FieldName:
-		m.ResetName()
+	case messagefeedback.FieldMessageID:
+		m.ResetMessageID()
 		return nil
-	case knowledgebase.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case knowledgebase.FieldType:
-		m.ResetType()
+	case messagefeedback.FieldConversationID:
+		m.ResetConversationID()
 		return nil
-	case knowledgebase.FieldEmbeddingModel:
-		m.ResetEmbeddingModel()
+	case messagefeedback.FieldAgentID:
+		m.ResetAgentID()
 		return nil
-	case knowledgebase.FieldChunkConfig:
-		m.ResetChunkConfig()
+	case messagefeedback.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case knowledgebase.FieldDocuments:
-		m.ResetDocuments()
+	case messagefeedback.FieldRating:
+		m.ResetRating()
 		return nil
-	case knowledgebase.FieldMetadata:
-		m.ResetMetadata()
+	case messagefeedback.FieldReason:
+		m.ResetReason()
 		return nil
-	case knowledgebase.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case messagefeedback.FieldComment:
+		m.ResetComment()
 		return nil
-	case knowledgebase.FieldCreatedAt:
+	case messagefeedback.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case knowledgebase.FieldUpdatedAt:
+	case messagefeedback.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case knowledgebase.FieldDocumentCount:
-		m.ResetDocumentCount()
-		return nil
-	case knowledgebase.FieldVectorCount:
-		m.ResetVectorCount()
-		return nil
 	}
-	return fmt.Errorf("unknown KnowledgeBase field %s", name)
+	return fmt.Errorf("unknown MessageFeedback field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KnowledgeBaseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageFeedbackMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagefeedback.EdgeMessage)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *KnowledgeBaseMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageFeedbackMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagefeedback.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KnowledgeBaseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageFeedbackMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *KnowledgeBaseMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageFeedbackMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KnowledgeBaseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageFeedbackMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagefeedback.EdgeMessage)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *KnowledgeBaseMutation) EdgeCleared(name string) bool {
+func (m *MessageFeedbackMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagefeedback.EdgeMessage:
+		return m.clearedmessage
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *KnowledgeBaseMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown KnowledgeBase unique edge %s", name)
+func (m *MessageFeedbackMutation) ClearEdge(name string) error {
+	switch name {
+	case messagefeedback.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageFeedback unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *KnowledgeBaseMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown KnowledgeBase edge %s", name)
+func (m *MessageFeedbackMutation) ResetEdge(name string) error {
+	switch name {
+	case messagefeedback.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageFeedback edge %s", name)
 }
 
 // ToolMutation represents an operation that mutates the Tool nodes in the graph.
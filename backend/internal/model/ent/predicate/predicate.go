@@ -9,6 +9,15 @@ import (
 // Agent is the predicate function for agent builders.
 type Agent func(*sql.Selector)
 
+// AgentFolder is the predicate function for agentfolder builders.
+type AgentFolder func(*sql.Selector)
+
+// AgentShare is the predicate function for agentshare builders.
+type AgentShare func(*sql.Selector)
+
+// AgentVersion is the predicate function for agentversion builders.
+type AgentVersion func(*sql.Selector)
+
 // Conversation is the predicate function for conversation builders.
 type Conversation func(*sql.Selector)
 
@@ -18,6 +27,15 @@ type DocumentChunk func(*sql.Selector)
 // KnowledgeBase is the predicate function for knowledgebase builders.
 type KnowledgeBase func(*sql.Selector)
 
+// Memory is the predicate function for memory builders.
+type Memory func(*sql.Selector)
+
+// Message is the predicate function for message builders.
+type Message func(*sql.Selector)
+
+// MessageFeedback is the predicate function for messagefeedback builders.
+type MessageFeedback func(*sql.Selector)
+
 // Tool is the predicate function for tool builders.
 type Tool func(*sql.Selector)
 
@@ -4,9 +4,15 @@ package ent
 
 import (
 	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/agentversion"
 	"agent-platform/internal/model/ent/conversation"
 	"agent-platform/internal/model/ent/documentchunk"
 	"agent-platform/internal/model/ent/knowledgebase"
+	"agent-platform/internal/model/ent/memory"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/messagefeedback"
 	"agent-platform/internal/model/ent/tool"
 	"agent-platform/internal/model/ent/user"
 	"agent-platform/internal/model/ent/workflow"
@@ -30,31 +36,97 @@ func init() {
 	// agent.DefaultType holds the default value on creation for the type field.
 	agent.DefaultType = agentDescType.Default.(string)
 	// agentDescStatus is the schema descriptor for status field.
-	agentDescStatus := agentFields[9].Descriptor()
+	agentDescStatus := agentFields[10].Descriptor()
 	// agent.DefaultStatus holds the default value on creation for the status field.
 	agent.DefaultStatus = agentDescStatus.Default.(string)
 	// agentDescVersion is the schema descriptor for version field.
-	agentDescVersion := agentFields[10].Descriptor()
+	agentDescVersion := agentFields[11].Descriptor()
 	// agent.DefaultVersion holds the default value on creation for the version field.
 	agent.DefaultVersion = agentDescVersion.Default.(string)
 	// agentDescCreatedBy is the schema descriptor for created_by field.
-	agentDescCreatedBy := agentFields[11].Descriptor()
+	agentDescCreatedBy := agentFields[13].Descriptor()
 	// agent.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	agent.CreatedByValidator = agentDescCreatedBy.Validators[0].(func(string) error)
 	// agentDescCreatedAt is the schema descriptor for created_at field.
-	agentDescCreatedAt := agentFields[12].Descriptor()
+	agentDescCreatedAt := agentFields[14].Descriptor()
 	// agent.DefaultCreatedAt holds the default value on creation for the created_at field.
 	agent.DefaultCreatedAt = agentDescCreatedAt.Default.(func() time.Time)
 	// agentDescUpdatedAt is the schema descriptor for updated_at field.
-	agentDescUpdatedAt := agentFields[13].Descriptor()
+	agentDescUpdatedAt := agentFields[15].Descriptor()
 	// agent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	agent.DefaultUpdatedAt = agentDescUpdatedAt.Default.(func() time.Time)
 	// agent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	agent.UpdateDefaultUpdatedAt = agentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// agentDescIsPublic is the schema descriptor for is_public field.
-	agentDescIsPublic := agentFields[16].Descriptor()
+	agentDescIsPublic := agentFields[18].Descriptor()
 	// agent.DefaultIsPublic holds the default value on creation for the is_public field.
 	agent.DefaultIsPublic = agentDescIsPublic.Default.(bool)
+	// agentDescAllowClone is the schema descriptor for allow_clone field.
+	agentDescAllowClone := agentFields[19].Descriptor()
+	// agent.DefaultAllowClone holds the default value on creation for the allow_clone field.
+	agent.DefaultAllowClone = agentDescAllowClone.Default.(bool)
+	agentfolderFields := schema.AgentFolder{}.Fields()
+	_ = agentfolderFields
+	// agentfolderDescPath is the schema descriptor for path field.
+	agentfolderDescPath := agentfolderFields[1].Descriptor()
+	// agentfolder.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	agentfolder.PathValidator = agentfolderDescPath.Validators[0].(func(string) error)
+	// agentfolderDescCreatedBy is the schema descriptor for created_by field.
+	agentfolderDescCreatedBy := agentfolderFields[2].Descriptor()
+	// agentfolder.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	agentfolder.CreatedByValidator = agentfolderDescCreatedBy.Validators[0].(func(string) error)
+	// agentfolderDescCreatedAt is the schema descriptor for created_at field.
+	agentfolderDescCreatedAt := agentfolderFields[3].Descriptor()
+	// agentfolder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	agentfolder.DefaultCreatedAt = agentfolderDescCreatedAt.Default.(func() time.Time)
+	agentshareFields := schema.AgentShare{}.Fields()
+	_ = agentshareFields
+	// agentshareDescAgentID is the schema descriptor for agent_id field.
+	agentshareDescAgentID := agentshareFields[1].Descriptor()
+	// agentshare.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	agentshare.AgentIDValidator = agentshareDescAgentID.Validators[0].(func(string) error)
+	// agentshareDescPrincipalType is the schema descriptor for principal_type field.
+	agentshareDescPrincipalType := agentshareFields[2].Descriptor()
+	// agentshare.PrincipalTypeValidator is a validator for the "principal_type" field. It is called by the builders before save.
+	agentshare.PrincipalTypeValidator = agentshareDescPrincipalType.Validators[0].(func(string) error)
+	// agentshareDescPrincipalID is the schema descriptor for principal_id field.
+	agentshareDescPrincipalID := agentshareFields[3].Descriptor()
+	// agentshare.PrincipalIDValidator is a validator for the "principal_id" field. It is called by the builders before save.
+	agentshare.PrincipalIDValidator = agentshareDescPrincipalID.Validators[0].(func(string) error)
+	// agentshareDescPermission is the schema descriptor for permission field.
+	agentshareDescPermission := agentshareFields[4].Descriptor()
+	// agentshare.PermissionValidator is a validator for the "permission" field. It is called by the builders before save.
+	agentshare.PermissionValidator = agentshareDescPermission.Validators[0].(func(string) error)
+	// agentshareDescCreatedBy is the schema descriptor for created_by field.
+	agentshareDescCreatedBy := agentshareFields[5].Descriptor()
+	// agentshare.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	agentshare.CreatedByValidator = agentshareDescCreatedBy.Validators[0].(func(string) error)
+	// agentshareDescCreatedAt is the schema descriptor for created_at field.
+	agentshareDescCreatedAt := agentshareFields[6].Descriptor()
+	// agentshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	agentshare.DefaultCreatedAt = agentshareDescCreatedAt.Default.(func() time.Time)
+	agentversionFields := schema.AgentVersion{}.Fields()
+	_ = agentversionFields
+	// agentversionDescAgentID is the schema descriptor for agent_id field.
+	agentversionDescAgentID := agentversionFields[1].Descriptor()
+	// agentversion.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	agentversion.AgentIDValidator = agentversionDescAgentID.Validators[0].(func(string) error)
+	// agentversionDescVersion is the schema descriptor for version field.
+	agentversionDescVersion := agentversionFields[2].Descriptor()
+	// agentversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	agentversion.VersionValidator = agentversionDescVersion.Validators[0].(func(string) error)
+	// agentversionDescName is the schema descriptor for name field.
+	agentversionDescName := agentversionFields[3].Descriptor()
+	// agentversion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	agentversion.NameValidator = agentversionDescName.Validators[0].(func(string) error)
+	// agentversionDescCreatedBy is the schema descriptor for created_by field.
+	agentversionDescCreatedBy := agentversionFields[13].Descriptor()
+	// agentversion.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	agentversion.CreatedByValidator = agentversionDescCreatedBy.Validators[0].(func(string) error)
+	// agentversionDescCreatedAt is the schema descriptor for created_at field.
+	agentversionDescCreatedAt := agentversionFields[14].Descriptor()
+	// agentversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	agentversion.DefaultCreatedAt = agentversionDescCreatedAt.Default.(func() time.Time)
 	conversationFields := schema.Conversation{}.Fields()
 	_ = conversationFields
 	// conversationDescAgentID is the schema descriptor for agent_id field.
@@ -65,20 +137,28 @@ func init() {
 	conversationDescUserID := conversationFields[2].Descriptor()
 	// conversation.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	conversation.UserIDValidator = conversationDescUserID.Validators[0].(func(string) error)
+	// conversationDescTitleSource is the schema descriptor for title_source field.
+	conversationDescTitleSource := conversationFields[4].Descriptor()
+	// conversation.DefaultTitleSource holds the default value on creation for the title_source field.
+	conversation.DefaultTitleSource = conversationDescTitleSource.Default.(string)
 	// conversationDescStatus is the schema descriptor for status field.
-	conversationDescStatus := conversationFields[7].Descriptor()
+	conversationDescStatus := conversationFields[8].Descriptor()
 	// conversation.DefaultStatus holds the default value on creation for the status field.
 	conversation.DefaultStatus = conversationDescStatus.Default.(string)
 	// conversationDescCreatedAt is the schema descriptor for created_at field.
-	conversationDescCreatedAt := conversationFields[8].Descriptor()
+	conversationDescCreatedAt := conversationFields[9].Descriptor()
 	// conversation.DefaultCreatedAt holds the default value on creation for the created_at field.
 	conversation.DefaultCreatedAt = conversationDescCreatedAt.Default.(func() time.Time)
 	// conversationDescUpdatedAt is the schema descriptor for updated_at field.
-	conversationDescUpdatedAt := conversationFields[9].Descriptor()
+	conversationDescUpdatedAt := conversationFields[10].Descriptor()
 	// conversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	conversation.DefaultUpdatedAt = conversationDescUpdatedAt.Default.(func() time.Time)
 	// conversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	conversation.UpdateDefaultUpdatedAt = conversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// conversationDescVersion is the schema descriptor for version field.
+	conversationDescVersion := conversationFields[14].Descriptor()
+	// conversation.DefaultVersion holds the default value on creation for the version field.
+	conversation.DefaultVersion = conversationDescVersion.Default.(int64)
 	documentchunkFields := schema.DocumentChunk{}.Fields()
 	_ = documentchunkFields
 	// documentchunkDescKnowledgeBaseID is the schema descriptor for knowledge_base_id field.
@@ -97,6 +177,18 @@ func init() {
 	documentchunkDescContent := documentchunkFields[4].Descriptor()
 	// documentchunk.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	documentchunk.ContentValidator = documentchunkDescContent.Validators[0].(func(string) error)
+	// documentchunkDescEnabled is the schema descriptor for enabled field.
+	documentchunkDescEnabled := documentchunkFields[7].Descriptor()
+	// documentchunk.DefaultEnabled holds the default value on creation for the enabled field.
+	documentchunk.DefaultEnabled = documentchunkDescEnabled.Default.(bool)
+	// documentchunkDescPinned is the schema descriptor for pinned field.
+	documentchunkDescPinned := documentchunkFields[8].Descriptor()
+	// documentchunk.DefaultPinned holds the default value on creation for the pinned field.
+	documentchunk.DefaultPinned = documentchunkDescPinned.Default.(bool)
+	// documentchunkDescBoost is the schema descriptor for boost field.
+	documentchunkDescBoost := documentchunkFields[9].Descriptor()
+	// documentchunk.DefaultBoost holds the default value on creation for the boost field.
+	documentchunk.DefaultBoost = documentchunkDescBoost.Default.(float64)
 	knowledgebaseFields := schema.KnowledgeBase{}.Fields()
 	_ = knowledgebaseFields
 	// knowledgebaseDescName is the schema descriptor for name field.
@@ -133,6 +225,86 @@ func init() {
 	knowledgebaseDescVectorCount := knowledgebaseFields[12].Descriptor()
 	// knowledgebase.DefaultVectorCount holds the default value on creation for the vector_count field.
 	knowledgebase.DefaultVectorCount = knowledgebaseDescVectorCount.Default.(int)
+	memoryFields := schema.Memory{}.Fields()
+	_ = memoryFields
+	// memoryDescUserID is the schema descriptor for user_id field.
+	memoryDescUserID := memoryFields[1].Descriptor()
+	// memory.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	memory.UserIDValidator = memoryDescUserID.Validators[0].(func(string) error)
+	// memoryDescAgentID is the schema descriptor for agent_id field.
+	memoryDescAgentID := memoryFields[2].Descriptor()
+	// memory.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	memory.AgentIDValidator = memoryDescAgentID.Validators[0].(func(string) error)
+	// memoryDescContent is the schema descriptor for content field.
+	memoryDescContent := memoryFields[3].Descriptor()
+	// memory.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	memory.ContentValidator = memoryDescContent.Validators[0].(func(string) error)
+	// memoryDescCreatedAt is the schema descriptor for created_at field.
+	memoryDescCreatedAt := memoryFields[7].Descriptor()
+	// memory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	memory.DefaultCreatedAt = memoryDescCreatedAt.Default.(func() time.Time)
+	// memoryDescUpdatedAt is the schema descriptor for updated_at field.
+	memoryDescUpdatedAt := memoryFields[8].Descriptor()
+	// memory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	memory.DefaultUpdatedAt = memoryDescUpdatedAt.Default.(func() time.Time)
+	// memory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	memory.UpdateDefaultUpdatedAt = memoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	messageFields := schema.Message{}.Fields()
+	_ = messageFields
+	// messageDescConversationID is the schema descriptor for conversation_id field.
+	messageDescConversationID := messageFields[1].Descriptor()
+	// message.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	message.ConversationIDValidator = messageDescConversationID.Validators[0].(func(string) error)
+	// messageDescRole is the schema descriptor for role field.
+	messageDescRole := messageFields[3].Descriptor()
+	// message.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	message.RoleValidator = messageDescRole.Validators[0].(func(string) error)
+	// messageDescPromptTokens is the schema descriptor for prompt_tokens field.
+	messageDescPromptTokens := messageFields[7].Descriptor()
+	// message.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	message.DefaultPromptTokens = messageDescPromptTokens.Default.(int)
+	// messageDescCompletionTokens is the schema descriptor for completion_tokens field.
+	messageDescCompletionTokens := messageFields[8].Descriptor()
+	// message.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	message.DefaultCompletionTokens = messageDescCompletionTokens.Default.(int)
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageFields[10].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	// messageDescUpdatedAt is the schema descriptor for updated_at field.
+	messageDescUpdatedAt := messageFields[11].Descriptor()
+	// message.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
+	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	messagefeedbackFields := schema.MessageFeedback{}.Fields()
+	_ = messagefeedbackFields
+	// messagefeedbackDescMessageID is the schema descriptor for message_id field.
+	messagefeedbackDescMessageID := messagefeedbackFields[1].Descriptor()
+	// messagefeedback.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	messagefeedback.MessageIDValidator = messagefeedbackDescMessageID.Validators[0].(func(string) error)
+	// messagefeedbackDescConversationID is the schema descriptor for conversation_id field.
+	messagefeedbackDescConversationID := messagefeedbackFields[2].Descriptor()
+	// messagefeedback.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	messagefeedback.ConversationIDValidator = messagefeedbackDescConversationID.Validators[0].(func(string) error)
+	// messagefeedbackDescAgentID is the schema descriptor for agent_id field.
+	messagefeedbackDescAgentID := messagefeedbackFields[3].Descriptor()
+	// messagefeedback.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	messagefeedback.AgentIDValidator = messagefeedbackDescAgentID.Validators[0].(func(string) error)
+	// messagefeedbackDescUserID is the schema descriptor for user_id field.
+	messagefeedbackDescUserID := messagefeedbackFields[4].Descriptor()
+	// messagefeedback.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	messagefeedback.UserIDValidator = messagefeedbackDescUserID.Validators[0].(func(string) error)
+	// messagefeedbackDescCreatedAt is the schema descriptor for created_at field.
+	messagefeedbackDescCreatedAt := messagefeedbackFields[8].Descriptor()
+	// messagefeedback.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagefeedback.DefaultCreatedAt = messagefeedbackDescCreatedAt.Default.(func() time.Time)
+	// messagefeedbackDescUpdatedAt is the schema descriptor for updated_at field.
+	messagefeedbackDescUpdatedAt := messagefeedbackFields[9].Descriptor()
+	// messagefeedback.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagefeedback.DefaultUpdatedAt = messagefeedbackDescUpdatedAt.Default.(func() time.Time)
+	// messagefeedback.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagefeedback.UpdateDefaultUpdatedAt = messagefeedbackDescUpdatedAt.UpdateDefault.(func() time.Time)
 	toolFields := schema.Tool{}.Fields()
 	_ = toolFields
 	// toolDescName is the schema descriptor for name field.
@@ -207,6 +379,16 @@ func init() {
 	workflowDescCreatedBy := workflowFields[6].Descriptor()
 	// workflow.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	workflow.CreatedByValidator = workflowDescCreatedBy.Validators[0].(func(string) error)
+	// workflowDescCreatedAt is the schema descriptor for created_at field.
+	workflowDescCreatedAt := workflowFields[7].Descriptor()
+	// workflow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workflow.DefaultCreatedAt = workflowDescCreatedAt.Default.(func() time.Time)
+	// workflowDescUpdatedAt is the schema descriptor for updated_at field.
+	workflowDescUpdatedAt := workflowFields[8].Descriptor()
+	// workflow.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	workflow.DefaultUpdatedAt = workflowDescUpdatedAt.Default.(func() time.Time)
+	// workflow.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	workflow.UpdateDefaultUpdatedAt = workflowDescUpdatedAt.UpdateDefault.(func() time.Time)
 	workflowexecutionFields := schema.WorkflowExecution{}.Fields()
 	_ = workflowexecutionFields
 	// workflowexecutionDescWorkflowID is the schema descriptor for workflow_id field.
@@ -221,4 +403,14 @@ func init() {
 	workflowexecutionDescStartedBy := workflowexecutionFields[8].Descriptor()
 	// workflowexecution.StartedByValidator is a validator for the "started_by" field. It is called by the builders before save.
 	workflowexecution.StartedByValidator = workflowexecutionDescStartedBy.Validators[0].(func(string) error)
+	// workflowexecutionDescStartedAt is the schema descriptor for started_at field.
+	workflowexecutionDescStartedAt := workflowexecutionFields[9].Descriptor()
+	// workflowexecution.DefaultStartedAt holds the default value on creation for the started_at field.
+	workflowexecution.DefaultStartedAt = workflowexecutionDescStartedAt.Default.(func() time.Time)
+	// workflowexecutionDescUpdatedAt is the schema descriptor for updated_at field.
+	workflowexecutionDescUpdatedAt := workflowexecutionFields[11].Descriptor()
+	// workflowexecution.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	workflowexecution.DefaultUpdatedAt = workflowexecutionDescUpdatedAt.Default.(func() time.Time)
+	// workflowexecution.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	workflowexecution.UpdateDefaultUpdatedAt = workflowexecutionDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
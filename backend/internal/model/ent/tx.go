@@ -14,12 +14,24 @@ type Tx struct {
 	config
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AgentFolder is the client for interacting with the AgentFolder builders.
+	AgentFolder *AgentFolderClient
+	// AgentShare is the client for interacting with the AgentShare builders.
+	AgentShare *AgentShareClient
+	// AgentVersion is the client for interacting with the AgentVersion builders.
+	AgentVersion *AgentVersionClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
 	// DocumentChunk is the client for interacting with the DocumentChunk builders.
 	DocumentChunk *DocumentChunkClient
 	// KnowledgeBase is the client for interacting with the KnowledgeBase builders.
 	KnowledgeBase *KnowledgeBaseClient
+	// Memory is the client for interacting with the Memory builders.
+	Memory *MemoryClient
+	// Message is the client for interacting with the Message builders.
+	Message *MessageClient
+	// MessageFeedback is the client for interacting with the MessageFeedback builders.
+	MessageFeedback *MessageFeedbackClient
 	// Tool is the client for interacting with the Tool builders.
 	Tool *ToolClient
 	// User is the client for interacting with the User builders.
@@ -160,9 +172,15 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Agent = NewAgentClient(tx.config)
+	tx.AgentFolder = NewAgentFolderClient(tx.config)
+	tx.AgentShare = NewAgentShareClient(tx.config)
+	tx.AgentVersion = NewAgentVersionClient(tx.config)
 	tx.Conversation = NewConversationClient(tx.config)
 	tx.DocumentChunk = NewDocumentChunkClient(tx.config)
 	tx.KnowledgeBase = NewKnowledgeBaseClient(tx.config)
+	tx.Memory = NewMemoryClient(tx.config)
+	tx.Message = NewMessageClient(tx.config)
+	tx.MessageFeedback = NewMessageFeedbackClient(tx.config)
 	tx.Tool = NewToolClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Workflow = NewWorkflowClient(tx.config)
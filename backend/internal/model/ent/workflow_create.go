@@ -78,12 +78,28 @@ func (wc *WorkflowCreate) SetCreatedAt(t time.Time) *WorkflowCreate {
 	return wc
 }
 
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WorkflowCreate) SetNillableCreatedAt(t *time.Time) *WorkflowCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (wc *WorkflowCreate) SetUpdatedAt(t time.Time) *WorkflowCreate {
 	wc.mutation.SetUpdatedAt(t)
 	return wc
 }
 
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WorkflowCreate) SetNillableUpdatedAt(t *time.Time) *WorkflowCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
 // SetID sets the "id" field.
 func (wc *WorkflowCreate) SetID(s string) *WorkflowCreate {
 	wc.mutation.SetID(s)
@@ -129,6 +145,14 @@ func (wc *WorkflowCreate) defaults() {
 		v := workflow.DefaultStatus
 		wc.mutation.SetStatus(v)
 	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := workflow.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := workflow.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -115,34 +115,12 @@ func (wu *WorkflowUpdate) SetNillableCreatedBy(s *string) *WorkflowUpdate {
 	return wu
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (wu *WorkflowUpdate) SetCreatedAt(t time.Time) *WorkflowUpdate {
-	wu.mutation.SetCreatedAt(t)
-	return wu
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (wu *WorkflowUpdate) SetNillableCreatedAt(t *time.Time) *WorkflowUpdate {
-	if t != nil {
-		wu.SetCreatedAt(*t)
-	}
-	return wu
-}
-
 // SetUpdatedAt sets the "updated_at" field.
 func (wu *WorkflowUpdate) SetUpdatedAt(t time.Time) *WorkflowUpdate {
 	wu.mutation.SetUpdatedAt(t)
 	return wu
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (wu *WorkflowUpdate) SetNillableUpdatedAt(t *time.Time) *WorkflowUpdate {
-	if t != nil {
-		wu.SetUpdatedAt(*t)
-	}
-	return wu
-}
-
 // Mutation returns the WorkflowMutation object of the builder.
 func (wu *WorkflowUpdate) Mutation() *WorkflowMutation {
 	return wu.mutation
@@ -150,6 +128,7 @@ func (wu *WorkflowUpdate) Mutation() *WorkflowMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (wu *WorkflowUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
 	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
 }
 
@@ -175,6 +154,14 @@ func (wu *WorkflowUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (wu *WorkflowUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := workflow.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (wu *WorkflowUpdate) check() error {
 	if v, ok := wu.mutation.Name(); ok {
@@ -231,9 +218,6 @@ func (wu *WorkflowUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := wu.mutation.CreatedBy(); ok {
 		_spec.SetField(workflow.FieldCreatedBy, field.TypeString, value)
 	}
-	if value, ok := wu.mutation.CreatedAt(); ok {
-		_spec.SetField(workflow.FieldCreatedAt, field.TypeTime, value)
-	}
 	if value, ok := wu.mutation.UpdatedAt(); ok {
 		_spec.SetField(workflow.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -343,34 +327,12 @@ func (wuo *WorkflowUpdateOne) SetNillableCreatedBy(s *string) *WorkflowUpdateOne
 	return wuo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (wuo *WorkflowUpdateOne) SetCreatedAt(t time.Time) *WorkflowUpdateOne {
-	wuo.mutation.SetCreatedAt(t)
-	return wuo
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (wuo *WorkflowUpdateOne) SetNillableCreatedAt(t *time.Time) *WorkflowUpdateOne {
-	if t != nil {
-		wuo.SetCreatedAt(*t)
-	}
-	return wuo
-}
-
 // SetUpdatedAt sets the "updated_at" field.
 func (wuo *WorkflowUpdateOne) SetUpdatedAt(t time.Time) *WorkflowUpdateOne {
 	wuo.mutation.SetUpdatedAt(t)
 	return wuo
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (wuo *WorkflowUpdateOne) SetNillableUpdatedAt(t *time.Time) *WorkflowUpdateOne {
-	if t != nil {
-		wuo.SetUpdatedAt(*t)
-	}
-	return wuo
-}
-
 // Mutation returns the WorkflowMutation object of the builder.
 func (wuo *WorkflowUpdateOne) Mutation() *WorkflowMutation {
 	return wuo.mutation
@@ -391,6 +353,7 @@ func (wuo *WorkflowUpdateOne) Select(field string, fields ...string) *WorkflowUp
 
 // Save executes the query and returns the updated Workflow entity.
 func (wuo *WorkflowUpdateOne) Save(ctx context.Context) (*Workflow, error) {
+	wuo.defaults()
 	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
 }
 
@@ -416,6 +379,14 @@ func (wuo *WorkflowUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (wuo *WorkflowUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := workflow.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (wuo *WorkflowUpdateOne) check() error {
 	if v, ok := wuo.mutation.Name(); ok {
@@ -489,9 +460,6 @@ func (wuo *WorkflowUpdateOne) sqlSave(ctx context.Context) (_node *Workflow, err
 	if value, ok := wuo.mutation.CreatedBy(); ok {
 		_spec.SetField(workflow.FieldCreatedBy, field.TypeString, value)
 	}
-	if value, ok := wuo.mutation.CreatedAt(); ok {
-		_spec.SetField(workflow.FieldCreatedAt, field.TypeTime, value)
-	}
 	if value, ok := wuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(workflow.FieldUpdatedAt, field.TypeTime, value)
 	}
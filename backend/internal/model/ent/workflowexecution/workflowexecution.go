@@ -3,6 +3,8 @@
 package workflowexecution
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -70,6 +72,12 @@ var (
 	DefaultStatus string
 	// StartedByValidator is a validator for the "started_by" field. It is called by the builders before save.
 	StartedByValidator func(string) error
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 )
 
 // OrderOption defines the ordering options for the WorkflowExecution queries.
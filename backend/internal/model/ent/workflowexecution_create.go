@@ -98,6 +98,14 @@ func (wec *WorkflowExecutionCreate) SetStartedAt(t time.Time) *WorkflowExecution
 	return wec
 }
 
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableStartedAt(t *time.Time) *WorkflowExecutionCreate {
+	if t != nil {
+		wec.SetStartedAt(*t)
+	}
+	return wec
+}
+
 // SetCompletedAt sets the "completed_at" field.
 func (wec *WorkflowExecutionCreate) SetCompletedAt(t time.Time) *WorkflowExecutionCreate {
 	wec.mutation.SetCompletedAt(t)
@@ -118,6 +126,14 @@ func (wec *WorkflowExecutionCreate) SetUpdatedAt(t time.Time) *WorkflowExecution
 	return wec
 }
 
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableUpdatedAt(t *time.Time) *WorkflowExecutionCreate {
+	if t != nil {
+		wec.SetUpdatedAt(*t)
+	}
+	return wec
+}
+
 // SetID sets the "id" field.
 func (wec *WorkflowExecutionCreate) SetID(s string) *WorkflowExecutionCreate {
 	wec.mutation.SetID(s)
@@ -163,6 +179,14 @@ func (wec *WorkflowExecutionCreate) defaults() {
 		v := workflowexecution.DefaultStatus
 		wec.mutation.SetStatus(v)
 	}
+	if _, ok := wec.mutation.StartedAt(); !ok {
+		v := workflowexecution.DefaultStartedAt()
+		wec.mutation.SetStartedAt(v)
+	}
+	if _, ok := wec.mutation.UpdatedAt(); !ok {
+		v := workflowexecution.DefaultUpdatedAt()
+		wec.mutation.SetUpdatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -146,20 +146,6 @@ func (weu *WorkflowExecutionUpdate) SetNillableStartedBy(s *string) *WorkflowExe
 	return weu
 }
 
-// SetStartedAt sets the "started_at" field.
-func (weu *WorkflowExecutionUpdate) SetStartedAt(t time.Time) *WorkflowExecutionUpdate {
-	weu.mutation.SetStartedAt(t)
-	return weu
-}
-
-// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
-func (weu *WorkflowExecutionUpdate) SetNillableStartedAt(t *time.Time) *WorkflowExecutionUpdate {
-	if t != nil {
-		weu.SetStartedAt(*t)
-	}
-	return weu
-}
-
 // SetCompletedAt sets the "completed_at" field.
 func (weu *WorkflowExecutionUpdate) SetCompletedAt(t time.Time) *WorkflowExecutionUpdate {
 	weu.mutation.SetCompletedAt(t)
@@ -186,14 +172,6 @@ func (weu *WorkflowExecutionUpdate) SetUpdatedAt(t time.Time) *WorkflowExecution
 	return weu
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (weu *WorkflowExecutionUpdate) SetNillableUpdatedAt(t *time.Time) *WorkflowExecutionUpdate {
-	if t != nil {
-		weu.SetUpdatedAt(*t)
-	}
-	return weu
-}
-
 // Mutation returns the WorkflowExecutionMutation object of the builder.
 func (weu *WorkflowExecutionUpdate) Mutation() *WorkflowExecutionMutation {
 	return weu.mutation
@@ -201,6 +179,7 @@ func (weu *WorkflowExecutionUpdate) Mutation() *WorkflowExecutionMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (weu *WorkflowExecutionUpdate) Save(ctx context.Context) (int, error) {
+	weu.defaults()
 	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
 }
 
@@ -226,6 +205,14 @@ func (weu *WorkflowExecutionUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (weu *WorkflowExecutionUpdate) defaults() {
+	if _, ok := weu.mutation.UpdatedAt(); !ok {
+		v := workflowexecution.UpdateDefaultUpdatedAt()
+		weu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (weu *WorkflowExecutionUpdate) check() error {
 	if v, ok := weu.mutation.WorkflowID(); ok {
@@ -292,9 +279,6 @@ func (weu *WorkflowExecutionUpdate) sqlSave(ctx context.Context) (n int, err err
 	if value, ok := weu.mutation.StartedBy(); ok {
 		_spec.SetField(workflowexecution.FieldStartedBy, field.TypeString, value)
 	}
-	if value, ok := weu.mutation.StartedAt(); ok {
-		_spec.SetField(workflowexecution.FieldStartedAt, field.TypeTime, value)
-	}
 	if value, ok := weu.mutation.CompletedAt(); ok {
 		_spec.SetField(workflowexecution.FieldCompletedAt, field.TypeTime, value)
 	}
@@ -442,20 +426,6 @@ func (weuo *WorkflowExecutionUpdateOne) SetNillableStartedBy(s *string) *Workflo
 	return weuo
 }
 
-// SetStartedAt sets the "started_at" field.
-func (weuo *WorkflowExecutionUpdateOne) SetStartedAt(t time.Time) *WorkflowExecutionUpdateOne {
-	weuo.mutation.SetStartedAt(t)
-	return weuo
-}
-
-// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
-func (weuo *WorkflowExecutionUpdateOne) SetNillableStartedAt(t *time.Time) *WorkflowExecutionUpdateOne {
-	if t != nil {
-		weuo.SetStartedAt(*t)
-	}
-	return weuo
-}
-
 // SetCompletedAt sets the "completed_at" field.
 func (weuo *WorkflowExecutionUpdateOne) SetCompletedAt(t time.Time) *WorkflowExecutionUpdateOne {
 	weuo.mutation.SetCompletedAt(t)
@@ -482,14 +452,6 @@ func (weuo *WorkflowExecutionUpdateOne) SetUpdatedAt(t time.Time) *WorkflowExecu
 	return weuo
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (weuo *WorkflowExecutionUpdateOne) SetNillableUpdatedAt(t *time.Time) *WorkflowExecutionUpdateOne {
-	if t != nil {
-		weuo.SetUpdatedAt(*t)
-	}
-	return weuo
-}
-
 // Mutation returns the WorkflowExecutionMutation object of the builder.
 func (weuo *WorkflowExecutionUpdateOne) Mutation() *WorkflowExecutionMutation {
 	return weuo.mutation
@@ -510,6 +472,7 @@ func (weuo *WorkflowExecutionUpdateOne) Select(field string, fields ...string) *
 
 // Save executes the query and returns the updated WorkflowExecution entity.
 func (weuo *WorkflowExecutionUpdateOne) Save(ctx context.Context) (*WorkflowExecution, error) {
+	weuo.defaults()
 	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
 }
 
@@ -535,6 +498,14 @@ func (weuo *WorkflowExecutionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (weuo *WorkflowExecutionUpdateOne) defaults() {
+	if _, ok := weuo.mutation.UpdatedAt(); !ok {
+		v := workflowexecution.UpdateDefaultUpdatedAt()
+		weuo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (weuo *WorkflowExecutionUpdateOne) check() error {
 	if v, ok := weuo.mutation.WorkflowID(); ok {
@@ -618,9 +589,6 @@ func (weuo *WorkflowExecutionUpdateOne) sqlSave(ctx context.Context) (_node *Wor
 	if value, ok := weuo.mutation.StartedBy(); ok {
 		_spec.SetField(workflowexecution.FieldStartedBy, field.TypeString, value)
 	}
-	if value, ok := weuo.mutation.StartedAt(); ok {
-		_spec.SetField(workflowexecution.FieldStartedAt, field.TypeTime, value)
-	}
 	if value, ok := weuo.mutation.CompletedAt(); ok {
 		_spec.SetField(workflowexecution.FieldCompletedAt, field.TypeTime, value)
 	}
@@ -24,13 +24,16 @@ func (Agent) Fields() []ent.Field {
 		field.Text("description").
 			Optional(),
 		field.String("type").
-			Default("single"), // single, multi
+			Default("single"), // single, workflow, multi-agent
 		field.JSON("model_config", map[string]interface{}{}).
 			Optional(),
 		field.JSON("tools", []string{}).
 			Optional(),
 		field.JSON("knowledge_bases", []string{}).
 			Optional(),
+		field.JSON("members", []string{}).
+			Optional().
+			Comment("Agents a multi-agent agent's supervisor hands turns and subtasks to"),
 		field.Text("prompt_template").
 			Optional(),
 		field.JSON("parameters", map[string]interface{}{}).
@@ -39,6 +42,9 @@ func (Agent) Fields() []ent.Field {
 			Default("draft"), // draft, published, archived
 		field.String("version").
 			Default("1.0.0"),
+		field.String("published_version_id").
+			Optional().
+			Comment("Agent version new conversations start on, empty until the agent is published"),
 		field.String("created_by").
 			NotEmpty(),
 		field.Time("created_at").
@@ -52,7 +58,19 @@ func (Agent) Fields() []ent.Field {
 		field.String("folder").
 			Optional(),
 		field.Bool("is_public").
-			Default(false),
+			Default(false).
+			Comment("Public published agents are listed in the marketplace, everyone can chat with them"),
+		field.Bool("allow_clone").
+			Default(false).
+			Comment("Public agents can be cloned by everyone, exposing their configuration"),
+		field.String("template_id").
+			Optional().
+			Immutable().
+			Comment("Template or agent the agent was cloned from"),
+		field.String("template_version").
+			Optional().
+			Immutable().
+			Comment("Version of the template when it was cloned"),
 	}
 }
 
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AgentFolder holds the schema definition for the AgentFolder entity. Agents reference
+// folders by path; folder records keep folders that hold no agents yet. Every user has their
+// own folders, which organize the agents they own.
+type AgentFolder struct {
+	ent.Schema
+}
+
+// Fields of the AgentFolder.
+func (AgentFolder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("path").
+			NotEmpty(), // slash separated, e.g. support/faq
+		field.String("created_by").
+			NotEmpty().
+			Immutable(), // owner
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AgentFolder.
+func (AgentFolder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_by", "path").
+			Unique(),
+	}
+}
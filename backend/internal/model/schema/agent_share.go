@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AgentShare holds the schema definition for the AgentShare entity, a permission on an
+// agent granted to a user or to every user with a role.
+type AgentShare struct {
+	ent.Schema
+}
+
+// Fields of the AgentShare.
+func (AgentShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("agent_id").
+			NotEmpty().
+			Immutable(),
+		field.String("principal_type").
+			NotEmpty().
+			Immutable(), // user, role
+		field.String("principal_id").
+			NotEmpty().
+			Immutable(), // user ID or role name
+		field.String("permission").
+			NotEmpty(), // viewer, editor
+		field.String("created_by").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AgentShare.
+func (AgentShare) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("agent_id", "principal_type", "principal_id").
+			Unique(),
+		index.Fields("principal_type", "principal_id"),
+	}
+}
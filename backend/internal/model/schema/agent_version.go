@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AgentVersion holds the schema definition for the AgentVersion entity,
+// an immutable snapshot of an agent's configuration taken when it is published.
+type AgentVersion struct {
+	ent.Schema
+}
+
+// Fields of the AgentVersion.
+func (AgentVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("agent_id").
+			NotEmpty().
+			Immutable(),
+		field.String("version").
+			NotEmpty().
+			Immutable(), // semantic version, e.g. 1.2.0
+		field.String("name").
+			NotEmpty().
+			Immutable(),
+		field.Text("description").
+			Optional().
+			Immutable(),
+		field.String("type").
+			Immutable(),
+		field.JSON("model_config", map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.JSON("tools", []string{}).
+			Optional().
+			Immutable(),
+		field.JSON("knowledge_bases", []string{}).
+			Optional().
+			Immutable(),
+		field.JSON("members", []string{}).
+			Optional().
+			Immutable(),
+		field.Text("prompt_template").
+			Optional().
+			Immutable(),
+		field.JSON("parameters", map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.Text("notes").
+			Optional().
+			Immutable().
+			Comment("Release notes given when publishing"),
+		field.String("created_by").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AgentVersion.
+func (AgentVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("agent_id", "version").
+			Unique(),
+		index.Fields("agent_id", "created_at"),
+	}
+}
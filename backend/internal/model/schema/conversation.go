@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -25,14 +27,19 @@ func (Conversation) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("title").
 			Optional(),
-		field.JSON("messages", []interface{}{}).
-			Optional(),
+		field.String("title_source").
+			Default("default").
+			Comment("default, user or generated; only default titles are replaced by generated ones"),
+		field.JSON("legacy_messages", []interface{}{}).
+			StorageKey("messages").
+			Optional().
+			Comment("Deprecated: messages are stored in the messages table, this column is only read to migrate old conversations"),
 		field.JSON("context", map[string]interface{}{}).
 			Optional(),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional(),
 		field.String("status").
-			Default("active"), // active, closed
+			Default("active"), // active, ended, archived
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -41,6 +48,24 @@ func (Conversation) Fields() []ent.Field {
 			UpdateDefault(time.Now),
 		field.Time("last_message_at").
 			Optional(),
+		field.String("active_message_id").
+			Optional().
+			Comment("Last message of the active branch, new messages reply to it"),
+		field.String("agent_version_id").
+			Optional().
+			Immutable().
+			Comment("Published agent version the conversation is pinned to, empty to follow the live agent"),
+		field.Int64("version").
+			Default(1).
+			Comment("Incremented by every change to the messages, active branch, title, status or context; background updates such as generated titles and summaries keep it"),
+	}
+}
+
+// Edges of the Conversation.
+func (Conversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("messages", Message.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -30,7 +30,17 @@ func (DocumentChunk) Fields() []ent.Field {
 			Comment("Vector embedding stored as JSON, converted to vector type in PostgreSQL"),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional(),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Disabled chunks are excluded from retrieval"),
+		field.Bool("pinned").
+			Default(false),
+		field.Float("boost").
+			Default(1.0).
+			Comment("Score multiplier applied to pinned chunks during retrieval"),
 		field.Time("created_at"),
+		field.Time("updated_at").
+			Optional(),
 	}
 }
 
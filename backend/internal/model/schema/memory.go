@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Memory holds the schema definition for the Memory entity.
+// A memory is a fact about a user learned by an agent, kept across conversations.
+type Memory struct {
+	ent.Schema
+}
+
+// Fields of the Memory.
+func (Memory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("agent_id").
+			NotEmpty(),
+		field.Text("content").
+			NotEmpty(),
+		field.JSON("embedding", []float32{}).
+			Optional().
+			Comment("Vector embedding stored as JSON, converted to vector type in PostgreSQL"),
+		field.String("source_conversation_id").
+			Optional().
+			Comment("Conversation the memory was extracted from"),
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Memory.
+func (Memory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "agent_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Message holds the schema definition for the Message entity.
+type Message struct {
+	ent.Schema
+}
+
+// Fields of the Message.
+func (Message) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("conversation_id").
+			NotEmpty().
+			Immutable(),
+		field.String("parent_id").
+			Optional().
+			Comment("Message this one replies to, empty for the first message of a conversation"),
+		field.String("role").
+			NotEmpty(), // user, assistant, system
+		field.Text("content"),
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional(),
+		field.String("model").
+			Optional().
+			Comment("Model that generated the message"),
+		field.Int("prompt_tokens").
+			Default(0).
+			Comment("Prompt tokens reported by the model when generating the message"),
+		field.Int("completion_tokens").
+			Default(0).
+			Comment("Completion tokens reported by the model when generating the message"),
+		field.String("idempotency_key").
+			Optional().
+			Immutable().
+			Comment("Client key of the request that sent the message, retries with the same key are replayed"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Message.
+func (Message) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", Conversation.Type).
+			Ref("messages").
+			Field("conversation_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("feedback", MessageFeedback.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Message.
+func (Message) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "created_at"),
+		index.Fields("parent_id"),
+		index.Fields("conversation_id", "idempotency_key").
+			Unique(),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MessageFeedback holds the schema definition for the MessageFeedback entity.
+// Each user rates an assistant message at most once.
+type MessageFeedback struct {
+	ent.Schema
+}
+
+// Fields of the MessageFeedback.
+func (MessageFeedback) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("message_id").
+			NotEmpty().
+			Immutable(),
+		field.String("conversation_id").
+			NotEmpty(),
+		field.String("agent_id").
+			NotEmpty().
+			Comment("Agent that generated the message, copied for per-agent queries"),
+		field.String("user_id").
+			NotEmpty(),
+		field.Int("rating").
+			Comment("1 = thumbs up, -1 = thumbs down"),
+		field.String("reason").
+			Optional(),
+		field.Text("comment").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the MessageFeedback.
+func (MessageFeedback) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).
+			Ref("feedback").
+			Field("message_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the MessageFeedback.
+func (MessageFeedback) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "user_id").
+			Unique(),
+		index.Fields("agent_id", "created_at"),
+	}
+}
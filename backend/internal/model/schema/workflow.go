@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -31,8 +33,12 @@ func (Workflow) Fields() []ent.Field {
 			Comment("draft, active, archived"),
 		field.String("created_by").
 			NotEmpty(),
-		field.Time("created_at"),
-		field.Time("updated_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
 	}
 }
 
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -39,10 +41,14 @@ func (WorkflowExecution) Fields() []ent.Field {
 			Comment("Error message if failed"),
 		field.String("started_by").
 			NotEmpty(),
-		field.Time("started_at"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
 		field.Time("completed_at").
 			Optional(),
-		field.Time("updated_at"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
 	}
 }
 
@@ -0,0 +1,317 @@
+// Package prompt renders agent prompt templates.
+//
+// Templates use Go text/template syntax with these variables:
+//
+//	.agent      name, description and type of the agent
+//	.params     the agent's parameters
+//	.context    the conversation context
+//	.user       id, username, email, role and metadata of the conversation's user
+//	.knowledge  context retrieved from the agent's knowledge bases
+//	.now        current time, .date, .time and .weekday its formatted parts
+//
+// Variables used outside if/with/range blocks and without a default are required:
+// rendering fails with a MissingVariablesError when they have no value. .knowledge is
+// never required since retrieval may find nothing.
+package prompt
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+// roots are the top-level variables available to templates
+var roots = map[string]bool{
+	"agent":     true,
+	"params":    true,
+	"context":   true,
+	"user":      true,
+	"knowledge": true,
+	"now":       true,
+	"date":      true,
+	"time":      true,
+	"weekday":   true,
+}
+
+// funcs are the functions available to templates, on top of the text/template builtins
+var funcs = template.FuncMap{
+	"default": func(fallback interface{}, value ...interface{}) interface{} {
+		if len(value) == 0 || isEmpty(value[0]) {
+			return fallback
+		}
+		return value[0]
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"join": func(sep string, values []interface{}) string {
+		parts := make([]string, len(values))
+		for i, v := range values {
+			parts[i] = fmt.Sprint(v)
+		}
+		return strings.Join(parts, sep)
+	},
+	"json": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		return string(data), err
+	},
+}
+
+// Funcs returns the functions available to prompt templates, for other templates rendered
+// the same way
+func Funcs() template.FuncMap {
+	copied := make(template.FuncMap, len(funcs))
+	for name, fn := range funcs {
+		copied[name] = fn
+	}
+	return copied
+}
+
+// Variables are the values a template is rendered with
+type Variables struct {
+	Agent     map[string]interface{}
+	Params    map[string]interface{}
+	Context   map[string]interface{}
+	User      map[string]interface{}
+	Knowledge string
+	Now       time.Time
+}
+
+// data returns the variables as template data
+func (v Variables) data() map[string]interface{} {
+	now := v.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	return map[string]interface{}{
+		"agent":     orEmpty(v.Agent),
+		"params":    orEmpty(v.Params),
+		"context":   orEmpty(v.Context),
+		"user":      orEmpty(v.User),
+		"knowledge": v.Knowledge,
+		"now":       now,
+		"date":      now.Format("2006-01-02"),
+		"time":      now.Format("15:04"),
+		"weekday":   now.Weekday().String(),
+	}
+}
+
+// MissingVariablesError lists the required variables that have no value
+type MissingVariablesError struct {
+	Names []string
+}
+
+func (e *MissingVariablesError) Error() string {
+	return "missing required prompt variables: " + strings.Join(e.Names, ", ")
+}
+
+// reference is a variable used by a template
+type reference struct {
+	path     []string
+	required bool
+}
+
+// Template is a parsed prompt template
+type Template struct {
+	tmpl       *template.Template
+	references []reference
+}
+
+// Parse parses a prompt template and checks that it only uses known variables
+func Parse(text string) (*Template, error) {
+	tmpl, err := template.New("prompt").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Template{tmpl: tmpl}
+	for _, named := range tmpl.Templates() {
+		if named.Tree != nil {
+			t.walk(named.Tree.Root, true, true)
+		}
+	}
+
+	for _, ref := range t.references {
+		if !roots[ref.path[0]] {
+			return nil, fmt.Errorf("unknown variable %q, available: %s", ref.path[0], strings.Join(rootNames(), ", "))
+		}
+	}
+
+	return t, nil
+}
+
+// Uses reports whether the template uses a top-level variable
+func (t *Template) Uses(root string) bool {
+	for _, ref := range t.references {
+		if ref.path[0] == root {
+			return true
+		}
+	}
+	return false
+}
+
+// Variables returns the dotted names of the variables used by the template, sorted
+func (t *Template) Variables() []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, ref := range t.references {
+		name := strings.Join(ref.path, ".")
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Missing returns the required variables without a value, limited to the given
+// top-level variables when any are passed
+func (t *Template) Missing(vars Variables, only ...string) []string {
+	data := vars.data()
+	seen := map[string]bool{}
+	missing := []string{}
+	for _, ref := range t.references {
+		if !ref.required || ref.path[0] == "knowledge" || (len(only) > 0 && !contains(only, ref.path[0])) {
+			continue
+		}
+		name := strings.Join(ref.path, ".")
+		if !seen[name] && !resolves(data, ref.path) {
+			seen[name] = true
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// Render renders the template, failing with a MissingVariablesError when required variables have no value
+func (t *Template) Render(vars Variables) (string, error) {
+	if missing := t.Missing(vars); len(missing) > 0 {
+		return "", &MissingVariablesError{Names: missing}
+	}
+
+	var b strings.Builder
+	if err := t.tmpl.Execute(&b, vars.data()); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// walk collects the variables used below node. rootDot tells whether dot is the template
+// data, which stops being the case inside with and range blocks; required is false inside
+// conditional blocks.
+func (t *Template) walk(node parse.Node, rootDot, required bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			t.walk(child, rootDot, required)
+		}
+	case *parse.ActionNode:
+		t.walkPipe(n.Pipe, rootDot, required)
+	case *parse.TemplateNode:
+		t.walkPipe(n.Pipe, rootDot, required)
+	case *parse.IfNode:
+		t.walkPipe(n.Pipe, rootDot, false)
+		t.walk(n.List, rootDot, false)
+		t.walk(n.ElseList, rootDot, false)
+	case *parse.WithNode:
+		t.walkPipe(n.Pipe, rootDot, false)
+		t.walk(n.List, false, false)
+		t.walk(n.ElseList, rootDot, false)
+	case *parse.RangeNode:
+		t.walkPipe(n.Pipe, rootDot, false)
+		t.walk(n.List, false, false)
+		t.walk(n.ElseList, rootDot, false)
+	}
+}
+
+// walkPipe collects the variables used by a pipeline; values piped through default are optional
+func (t *Template) walkPipe(pipe *parse.PipeNode, rootDot, required bool) {
+	if pipe == nil {
+		return
+	}
+	for _, cmd := range pipe.Cmds {
+		if len(cmd.Args) > 0 {
+			if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok && ident.Ident == "default" {
+				required = false
+			}
+		}
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			t.walkArg(arg, rootDot, required)
+		}
+	}
+}
+
+// walkArg collects the variable used by a command argument
+func (t *Template) walkArg(arg parse.Node, rootDot, required bool) {
+	switch a := arg.(type) {
+	case *parse.FieldNode:
+		if rootDot {
+			t.references = append(t.references, reference{path: a.Ident, required: required})
+		}
+	case *parse.VariableNode:
+		// $ is always the template data
+		if a.Ident[0] == "$" && len(a.Ident) > 1 {
+			t.references = append(t.references, reference{path: a.Ident[1:], required: required})
+		}
+	case *parse.ChainNode:
+		t.walkArg(a.Node, rootDot, required)
+	case *parse.PipeNode:
+		t.walkPipe(a, rootDot, required)
+	}
+}
+
+// resolves reports whether path leads to a non-empty value in data. Paths into values
+// other than maps, such as methods of .now, are assumed to resolve.
+func resolves(data map[string]interface{}, path []string) bool {
+	var current interface{} = data
+	for _, key := range path {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return true
+		}
+		if current, ok = m[key]; !ok {
+			return false
+		}
+	}
+	return !isEmpty(current)
+}
+
+// isEmpty reports whether a variable value counts as missing
+func isEmpty(value interface{}) bool {
+	return value == nil || value == ""
+}
+
+func orEmpty(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func rootNames() []string {
+	names := make([]string, 0, len(roots))
+	for name := range roots {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,220 @@
+package prompt
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		// variables and required hold the dotted names the template uses, and those of them
+		// that are required
+		variables []string
+		required  []string
+		err       string
+	}{
+		{
+			name:      "plain fields",
+			text:      "You are {{.agent.name}}, talking to {{.user.username}}.",
+			variables: []string{"agent.name", "user.username"},
+			required:  []string{"agent.name", "user.username"},
+		},
+		{
+			name:      "default as the last command",
+			text:      `Tone: {{.params.tone | default "friendly"}}`,
+			variables: []string{"params.tone"},
+		},
+		{
+			name:      "default called directly",
+			text:      `Tone: {{default "friendly" .params.tone}}`,
+			variables: []string{"params.tone"},
+		},
+		{
+			name:      "default further down a pipeline",
+			text:      `{{.params.tone | lower | default "friendly"}} {{.params.style | upper}}`,
+			variables: []string{"params.style", "params.tone"},
+			required:  []string{"params.style"},
+		},
+		{
+			name:      "default inside a parenthesized argument",
+			text:      `{{printf "%s" (.params.tone | default "friendly")}}`,
+			variables: []string{"params.tone"},
+		},
+		{
+			name:      "if blocks",
+			text:      "{{if .params.vip}}VIP {{.user.username}}{{else}}{{.agent.name}}{{end}} {{.agent.description}}",
+			variables: []string{"agent.description", "agent.name", "params.vip", "user.username"},
+			required:  []string{"agent.description"},
+		},
+		{
+			name:      "with blocks rebind dot",
+			text:      "{{with .user.metadata}}{{.plan}} {{.tier}}{{else}}{{.agent.name}}{{end}}",
+			variables: []string{"agent.name", "user.metadata"},
+		},
+		{
+			name:      "range blocks rebind dot",
+			text:      "{{range .context.topics}}- {{.}} {{.title}}{{end}}",
+			variables: []string{"context.topics"},
+		},
+		{
+			name:      "$ references the template data",
+			text:      "{{$.agent.name}} {{range .context.topics}}{{.}} for {{$.user.username}}{{end}}",
+			variables: []string{"agent.name", "context.topics", "user.username"},
+			required:  []string{"agent.name"},
+		},
+		{
+			name:      "declared variables are not template data",
+			text:      "{{$p := .params}}{{$p.tone}}",
+			variables: []string{"params"},
+			required:  []string{"params"},
+		},
+		{
+			name: "unknown root",
+			text: "{{.secrets.key}}",
+			err:  `unknown variable "secrets"`,
+		},
+		{
+			name: "unknown root through $",
+			text: "{{range .context.topics}}{{$.secrets}}{{end}}",
+			err:  `unknown variable "secrets"`,
+		},
+		{
+			name: "unknown root in a condition",
+			text: "{{if .env}}x{{end}}",
+			err:  `unknown variable "env"`,
+		},
+		{
+			name:      "unknown names below a rebound dot",
+			text:      "{{with .params}}{{.secrets}}{{end}}",
+			variables: []string{"params"},
+		},
+		{
+			name: "syntax error",
+			text: "{{.agent.name",
+			err:  "unclosed action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := Parse(tt.text)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Parse() error = %v, want ...%s...", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := tmpl.Variables(); !reflect.DeepEqual(got, tt.variables) {
+				t.Errorf("Variables() = %v, want %v", got, tt.variables)
+			}
+			if got := requiredVariables(tmpl); !reflect.DeepEqual(got, tt.required) {
+				t.Errorf("required variables = %v, want %v", got, tt.required)
+			}
+		})
+	}
+}
+
+// requiredVariables returns the dotted names of the required variables of a template, sorted
+func requiredVariables(tmpl *Template) []string {
+	var names []string
+	for _, ref := range tmpl.references {
+		if name := strings.Join(ref.path, "."); ref.required && !contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestMissing(t *testing.T) {
+	tmpl, err := Parse("{{.agent.name}} {{.params.tone}} {{.params.tone}} {{.user.metadata.plan}} {{.context.topic}} {{.now.Year}} {{.knowledge}}")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	vars := Variables{
+		Agent:   map[string]interface{}{"name": "Helper"},
+		Params:  map[string]interface{}{"tone": ""},
+		User:    map[string]interface{}{"metadata": map[string]interface{}{"plan": "pro"}},
+		Context: map[string]interface{}{},
+	}
+	if got, want := tmpl.Missing(vars), []string{"params.tone", "context.topic"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Missing() = %v, want %v", got, want)
+	}
+	if got, want := tmpl.Missing(vars, "context"), []string{"context.topic"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Missing(context) = %v, want %v", got, want)
+	}
+	if got := tmpl.Missing(vars, "agent", "user"); len(got) != 0 {
+		t.Errorf("Missing(agent, user) = %v, want none", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	now := time.Date(2024, 3, 8, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		text    string
+		vars    Variables
+		want    string
+		missing []string
+	}{
+		{
+			name: "variables and functions",
+			text: `{{.agent.name | upper}} on {{.weekday}} {{.date}} {{.time}}: {{.params.tone | default "friendly"}}, {{join ", " .context.topics}}`,
+			vars: Variables{
+				Agent:   map[string]interface{}{"name": "helper"},
+				Context: map[string]interface{}{"topics": []interface{}{"billing", "refunds"}},
+				Now:     now,
+			},
+			want: "HELPER on Friday 2024-03-08 14:30: friendly, billing, refunds",
+		},
+		{
+			name: "blocks",
+			text: "{{with .user.metadata}}Plan {{.plan}}{{else}}No plan{{end}}{{range .context.topics}} [{{.}} for {{$.user.username}}]{{end}}",
+			vars: Variables{
+				User:    map[string]interface{}{"username": "ana"},
+				Context: map[string]interface{}{"topics": []interface{}{"a", "b"}},
+			},
+			want: "No plan [a for ana] [b for ana]",
+		},
+		{
+			name:    "missing required variables",
+			text:    "{{.agent.name}} {{.params.tone}} {{.user.username}}",
+			vars:    Variables{Agent: map[string]interface{}{"name": "helper"}},
+			missing: []string{"params.tone", "user.username"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := Parse(tt.text)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := tmpl.Render(tt.vars)
+			if tt.missing != nil {
+				var missingErr *MissingVariablesError
+				if !errors.As(err, &missingErr) || !reflect.DeepEqual(missingErr.Names, tt.missing) {
+					t.Fatalf("Render() error = %v, want missing %v", err, tt.missing)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentfolder"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrFolderExists is returned when creating or moving a folder onto an existing path
+	ErrFolderExists = errors.New("folder already exists")
+	// ErrFolderNotEmpty is returned when deleting a folder holding agents or subfolders without force
+	ErrFolderNotEmpty = errors.New("folder is not empty")
+)
+
+// AgentFolderRepository handles agent folder data access. Folder paths are slash separated
+// and agents belong to the folder their folder field names; a folder exists when it has a
+// record or holds agents. Folders are per user: the folders of a user are their folder records
+// and the folders of the agents they own.
+type AgentFolderRepository struct {
+	client *ent.Client
+}
+
+// NewAgentFolderRepository creates a new agent folder repository
+func NewAgentFolderRepository(client *ent.Client) *AgentFolderRepository {
+	return &AgentFolderRepository{client: client}
+}
+
+// Create creates a folder of a user and its missing ancestors
+func (r *AgentFolderRepository) Create(ctx context.Context, path, createdBy string) (*ent.AgentFolder, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	exists, err := tx.AgentFolder.Query().Where(agentfolder.CreatedBy(createdBy), agentfolder.Path(path)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed querying folder: %w", err)
+	}
+	if exists {
+		tx.Rollback()
+		return nil, ErrFolderExists
+	}
+
+	if err := ensureFolders(ctx, tx, folderAncestors(path), createdBy); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	created, err := tx.AgentFolder.
+		Create().
+		SetID(uuid.New().String()).
+		SetPath(path).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, ErrFolderExists
+		}
+		return nil, fmt.Errorf("failed creating folder: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed committing folder: %w", err)
+	}
+
+	return created, nil
+}
+
+// List retrieves the folder records of a user ordered by path
+func (r *AgentFolderRepository) List(ctx context.Context, createdBy string) ([]*ent.AgentFolder, error) {
+	folders, err := r.client.AgentFolder.
+		Query().
+		Where(agentfolder.CreatedBy(createdBy)).
+		Order(ent.Asc(agentfolder.FieldPath)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing folders: %w", err)
+	}
+
+	return folders, nil
+}
+
+// Exists reports whether a folder of a user has a record or holds their agents, directly or
+// in subfolders
+func (r *AgentFolderRepository) Exists(ctx context.Context, path, createdBy string) (bool, error) {
+	return folderExists(ctx, r.client.AgentFolder, r.client.Agent, path, createdBy)
+}
+
+// Move moves a folder of a user with its subfolders and agents to another path, creating the
+// missing ancestors of the new path
+func (r *AgentFolderRepository) Move(ctx context.Context, from, to, createdBy string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	exists, err := folderExists(ctx, tx.AgentFolder, tx.Agent, to, createdBy)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if exists {
+		tx.Rollback()
+		return ErrFolderExists
+	}
+
+	folders, err := tx.AgentFolder.Query().Where(agentfolder.CreatedBy(createdBy), folderSubtree(from)).All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed querying folders: %w", err)
+	}
+	for _, f := range folders {
+		if err := tx.AgentFolder.UpdateOne(f).SetPath(to + strings.TrimPrefix(f.Path, from)).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed moving folder: %w", err)
+		}
+	}
+
+	agents, err := tx.Agent.Query().Where(agent.CreatedBy(createdBy), agentsInFolder(from)).All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed querying agents: %w", err)
+	}
+	for _, a := range agents {
+		if err := tx.Agent.UpdateOne(a).SetFolder(to + strings.TrimPrefix(a.Folder, from)).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed moving agent: %w", err)
+		}
+	}
+
+	if err := ensureFolders(ctx, tx, folderAncestors(to), createdBy); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed committing folder move: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a folder of a user. A folder holding agents or subfolders is only deleted
+// with force, which deletes the subfolders too and moves their agents to the folder's parent.
+func (r *AgentFolderRepository) Delete(ctx context.Context, path, createdBy string, force bool) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	if !force {
+		subfolders, err := tx.AgentFolder.Query().Where(agentfolder.CreatedBy(createdBy), agentfolder.PathHasPrefix(path+"/")).Exist(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed querying folders: %w", err)
+		}
+		agents, err := tx.Agent.Query().Where(agent.CreatedBy(createdBy), agentsInFolder(path)).Exist(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed querying agents: %w", err)
+		}
+		if subfolders || agents {
+			tx.Rollback()
+			return ErrFolderNotEmpty
+		}
+	}
+
+	if _, err := tx.AgentFolder.Delete().Where(agentfolder.CreatedBy(createdBy), folderSubtree(path)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed deleting folders: %w", err)
+	}
+
+	parent := ""
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		parent = path[:i]
+	}
+	if _, err := tx.Agent.Update().Where(agent.CreatedBy(createdBy), agentsInFolder(path)).SetFolder(parent).Save(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed moving agents: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed committing folder deletion: %w", err)
+	}
+
+	return nil
+}
+
+// ensureFolders creates the records of the given folders of a user that have none
+func ensureFolders(ctx context.Context, tx *ent.Tx, paths []string, createdBy string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	existing, err := tx.AgentFolder.Query().Where(agentfolder.CreatedBy(createdBy), agentfolder.PathIn(paths...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying folders: %w", err)
+	}
+	found := make(map[string]bool, len(existing))
+	for _, f := range existing {
+		found[f.Path] = true
+	}
+
+	for _, p := range paths {
+		if found[p] {
+			continue
+		}
+		if err := tx.AgentFolder.Create().SetID(uuid.New().String()).SetPath(p).SetCreatedBy(createdBy).Exec(ctx); err != nil {
+			return fmt.Errorf("failed creating folder %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// folderExists reports whether a folder of a user has a record or holds their agents
+func folderExists(ctx context.Context, folders *ent.AgentFolderClient, agents *ent.AgentClient, path, createdBy string) (bool, error) {
+	exists, err := folders.Query().Where(agentfolder.CreatedBy(createdBy), agentfolder.Path(path)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying folder: %w", err)
+	}
+	if exists {
+		return true, nil
+	}
+
+	exists, err = agents.Query().Where(agent.CreatedBy(createdBy), agentsInFolder(path)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying agents: %w", err)
+	}
+	return exists, nil
+}
+
+// folderAncestors returns the ancestors of a folder path, outermost first
+func folderAncestors(path string) []string {
+	var ancestors []string
+	for i, c := range path {
+		if c == '/' {
+			ancestors = append(ancestors, path[:i])
+		}
+	}
+	return ancestors
+}
+
+// folderSubtree matches a folder record and its descendants
+func folderSubtree(path string) predicate.AgentFolder {
+	return agentfolder.Or(agentfolder.Path(path), agentfolder.PathHasPrefix(path+"/"))
+}
+
+// agentsInFolder matches the agents in a folder and its subfolders
+func agentsInFolder(path string) predicate.Agent {
+	return agent.Or(agent.Folder(path), agent.FolderHasPrefix(path+"/"))
+}
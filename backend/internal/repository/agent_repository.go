@@ -3,10 +3,97 @@ package repository
 import (
 	"agent-platform/internal/model/ent"
 	"agent-platform/internal/model/ent/agent"
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/agentversion"
+	"agent-platform/internal/model/ent/predicate"
 	"context"
 	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 )
 
+// AgentFilter narrows the agents returned by List. Zero values are ignored.
+type AgentFilter struct {
+	Status    string
+	Type      string
+	CreatedBy string
+	Folder    string   // Folder and its subfolders
+	Tags      []string // Agents with any of the tags, or all of them with AllTags
+	AllTags   bool
+	Query     string // Case-insensitive match on name or description
+	// VisibleTo restricts the agents to those a user owns, those in SharedIDs and the
+	// public published agents
+	VisibleTo string
+	SharedIDs []string
+	// PublicOnly restricts the agents to the public published ones
+	PublicOnly bool
+}
+
+// predicates converts the filter to query predicates
+func (f AgentFilter) predicates() []predicate.Agent {
+	predicates := []predicate.Agent{}
+	if f.Status != "" {
+		predicates = append(predicates, agent.Status(f.Status))
+	}
+	if f.Type != "" {
+		predicates = append(predicates, agent.Type(f.Type))
+	}
+	if f.CreatedBy != "" {
+		predicates = append(predicates, agent.CreatedBy(f.CreatedBy))
+	}
+	if f.Folder != "" {
+		predicates = append(predicates, agentsInFolder(f.Folder))
+	}
+	if len(f.Tags) > 0 {
+		tags := make([]predicate.Agent, len(f.Tags))
+		for i, tag := range f.Tags {
+			tags[i] = hasTag(tag)
+		}
+		if f.AllTags {
+			predicates = append(predicates, agent.And(tags...))
+		} else {
+			predicates = append(predicates, agent.Or(tags...))
+		}
+	}
+	if f.Query != "" {
+		predicates = append(predicates, agent.Or(
+			agent.NameContainsFold(f.Query),
+			agent.DescriptionContainsFold(f.Query),
+		))
+	}
+	if f.VisibleTo != "" {
+		predicates = append(predicates, agent.Or(
+			agent.CreatedBy(f.VisibleTo),
+			agent.IDIn(f.SharedIDs...),
+			published(),
+		))
+	}
+	if f.PublicOnly {
+		predicates = append(predicates, published())
+	}
+	return predicates
+}
+
+// published matches the public published agents
+func published() predicate.Agent {
+	return agent.And(agent.IsPublic(true), agent.Status("published"))
+}
+
+// hasTag matches the agents tagged with tag
+func hasTag(tag string) predicate.Agent {
+	return predicate.Agent(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(agent.FieldTags), tag))
+	})
+}
+
+// AgentSortFields are the fields List can order agents by
+var AgentSortFields = map[string]string{
+	"created_at": agent.FieldCreatedAt,
+	"updated_at": agent.FieldUpdatedAt,
+	"name":       agent.FieldName,
+}
+
 // AgentRepository handles agent data access
 type AgentRepository struct {
 	client *ent.Client
@@ -28,7 +115,8 @@ func (r *AgentRepository) Create(ctx context.Context, a *ent.Agent) (*ent.Agent,
 		SetStatus(a.Status).
 		SetVersion(a.Version).
 		SetCreatedBy(a.CreatedBy).
-		SetIsPublic(a.IsPublic)
+		SetIsPublic(a.IsPublic).
+		SetAllowClone(a.AllowClone)
 
 	// Set optional fields
 	if a.ModelConfig != nil {
@@ -40,6 +128,9 @@ func (r *AgentRepository) Create(ctx context.Context, a *ent.Agent) (*ent.Agent,
 	if a.KnowledgeBases != nil {
 		builder = builder.SetKnowledgeBases(a.KnowledgeBases)
 	}
+	if a.Members != nil {
+		builder = builder.SetMembers(a.Members)
+	}
 	if a.PromptTemplate != "" {
 		builder = builder.SetPromptTemplate(a.PromptTemplate)
 	}
@@ -52,6 +143,9 @@ func (r *AgentRepository) Create(ctx context.Context, a *ent.Agent) (*ent.Agent,
 	if a.Folder != "" {
 		builder = builder.SetFolder(a.Folder)
 	}
+	if a.TemplateID != "" {
+		builder = builder.SetTemplateID(a.TemplateID).SetTemplateVersion(a.TemplateVersion)
+	}
 
 	created, err := builder.Save(ctx)
 	if err != nil {
@@ -78,21 +172,69 @@ func (r *AgentRepository) Get(ctx context.Context, id string) (*ent.Agent, error
 	return a, nil
 }
 
-// List retrieves agents with pagination and filters
-func (r *AgentRepository) List(ctx context.Context, page, pageSize int32, status, agentType, createdBy string) ([]*ent.Agent, int, error) {
-	query := r.client.Agent.Query()
+// ListByIDs retrieves the agents with the given IDs, ignoring unknown IDs
+func (r *AgentRepository) ListByIDs(ctx context.Context, ids []string) ([]*ent.Agent, error) {
+	agents, err := r.client.Agent.
+		Query().
+		Where(agent.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing agents: %w", err)
+	}
+
+	return agents, nil
+}
+
+// Find retrieves all agents matching a filter, newest first
+func (r *AgentRepository) Find(ctx context.Context, filter AgentFilter) ([]*ent.Agent, error) {
+	agents, err := r.client.Agent.
+		Query().
+		Where(filter.predicates()...).
+		Order(ent.Desc(agent.FieldCreatedAt, agent.FieldID)).
+		All(ctx)
 
-	// Apply filters
-	if status != "" {
-		query = query.Where(agent.Status(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed listing agents: %w", err)
 	}
-	if agentType != "" {
-		query = query.Where(agent.Type(agentType))
+
+	return agents, nil
+}
+
+// ListByNames retrieves the agents with any of the given names
+func (r *AgentRepository) ListByNames(ctx context.Context, names []string) ([]*ent.Agent, error) {
+	agents, err := r.client.Agent.
+		Query().
+		Where(agent.NameIn(names...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing agents: %w", err)
 	}
-	if createdBy != "" {
-		query = query.Where(agent.CreatedBy(createdBy))
+
+	return agents, nil
+}
+
+// ListByName retrieves the agents a user created with the given name
+func (r *AgentRepository) ListByName(ctx context.Context, name, createdBy string) ([]*ent.Agent, error) {
+	agents, err := r.client.Agent.
+		Query().
+		Where(agent.Name(name), agent.CreatedBy(createdBy)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing agents: %w", err)
 	}
 
+	return agents, nil
+}
+
+// List retrieves agents with pagination and filters, ordered by one of AgentSortFields
+// (created_at when empty), newest or last first unless ascending
+func (r *AgentRepository) List(ctx context.Context, page, pageSize int32, filter AgentFilter, sortBy string, ascending bool) ([]*ent.Agent, int, error) {
+	query := r.client.Agent.Query().
+		Where(filter.predicates()...)
+
 	// Get total count
 	total, err := query.Count(ctx)
 	if err != nil {
@@ -101,8 +243,16 @@ func (r *AgentRepository) List(ctx context.Context, page, pageSize int32, status
 
 	// Apply pagination
 	offset := int((page - 1) * pageSize)
+	field, ok := AgentSortFields[sortBy]
+	if !ok {
+		field = agent.FieldCreatedAt
+	}
+	order := ent.Desc(field, agent.FieldID)
+	if ascending {
+		order = ent.Asc(field, agent.FieldID)
+	}
 	agents, err := query.
-		Order(ent.Desc(agent.FieldCreatedAt)).
+		Order(order).
 		Offset(offset).
 		Limit(int(pageSize)).
 		All(ctx)
@@ -114,6 +264,83 @@ func (r *AgentRepository) List(ctx context.Context, page, pageSize int32, status
 	return agents, total, nil
 }
 
+// FolderCounts returns the number of agents matching a filter directly in each folder;
+// agents outside any folder are counted under the empty path
+func (r *AgentRepository) FolderCounts(ctx context.Context, filter AgentFilter) (map[string]int, error) {
+	var rows []struct {
+		Folder *string `json:"folder"` // NULL for agents created without a folder
+		Count  int     `json:"count"`
+	}
+	err := r.client.Agent.
+		Query().
+		Where(filter.predicates()...).
+		GroupBy(agent.FieldFolder).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed counting agents by folder: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		folder := ""
+		if row.Folder != nil {
+			folder = *row.Folder
+		}
+		counts[folder] += row.Count
+	}
+	return counts, nil
+}
+
+// MoveToFolder moves agents to a folder, the empty path moving them out of any folder,
+// and returns how many were moved
+func (r *AgentRepository) MoveToFolder(ctx context.Context, ids []string, folder string) (int, error) {
+	moved, err := r.client.Agent.
+		Update().
+		Where(agent.IDIn(ids...)).
+		SetFolder(folder).
+		Save(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed moving agents: %w", err)
+	}
+
+	return moved, nil
+}
+
+// ListCloneable retrieves the public published agents that allow cloning, newest first.
+// Unlike List it takes an offset so that callers can page through them after other items.
+func (r *AgentRepository) ListCloneable(ctx context.Context, offset, limit int) ([]*ent.Agent, int, error) {
+	query := r.client.Agent.
+		Query().
+		Where(
+			published(),
+			agent.AllowClone(true),
+		)
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting public agents: %w", err)
+	}
+	if limit <= 0 {
+		return nil, total, nil
+	}
+
+	agents, err := query.
+		Order(ent.Desc(agent.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing public agents: %w", err)
+	}
+
+	return agents, total, nil
+}
+
 // Update updates an existing agent
 func (r *AgentRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.Agent, error) {
 	updateQuery := r.client.Agent.UpdateOneID(id)
@@ -145,6 +372,10 @@ func (r *AgentRepository) Update(ctx context.Context, id string, updates map[str
 			if v, ok := value.([]string); ok {
 				updateQuery = updateQuery.SetKnowledgeBases(v)
 			}
+		case "members":
+			if v, ok := value.([]string); ok {
+				updateQuery = updateQuery.SetMembers(v)
+			}
 		case "prompt_template":
 			if v, ok := value.(string); ok {
 				updateQuery = updateQuery.SetPromptTemplate(v)
@@ -169,6 +400,10 @@ func (r *AgentRepository) Update(ctx context.Context, id string, updates map[str
 			if v, ok := value.(bool); ok {
 				updateQuery = updateQuery.SetIsPublic(v)
 			}
+		case "allow_clone":
+			if v, ok := value.(bool); ok {
+				updateQuery = updateQuery.SetAllowClone(v)
+			}
 		}
 	}
 
@@ -183,18 +418,33 @@ func (r *AgentRepository) Update(ctx context.Context, id string, updates map[str
 	return updated, nil
 }
 
-// Delete deletes an agent by ID
+// Delete deletes an agent with its published versions and shares by ID
 func (r *AgentRepository) Delete(ctx context.Context, id string) error {
-	err := r.client.Agent.
-		DeleteOneID(id).
-		Exec(ctx)
-
+	tx, err := r.client.Tx(ctx)
 	if err != nil {
+		return fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	if _, err := tx.AgentVersion.Delete().Where(agentversion.AgentID(id)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed deleting agent versions: %w", err)
+	}
+	if _, err := tx.AgentShare.Delete().Where(agentshare.AgentID(id)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed deleting agent shares: %w", err)
+	}
+
+	if err := tx.Agent.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
 		if ent.IsNotFound(err) {
 			return fmt.Errorf("agent not found: %s", id)
 		}
 		return fmt.Errorf("failed deleting agent: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed committing agent deletion: %w", err)
+	}
+
 	return nil
 }
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/agentshare"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"fmt"
+)
+
+// sharePermissionRank orders share permissions from weakest to strongest
+var sharePermissionRank = map[string]int{
+	"viewer": 1,
+	"editor": 2,
+}
+
+// AgentShareRepository handles agent share data access
+type AgentShareRepository struct {
+	client *ent.Client
+}
+
+// NewAgentShareRepository creates a new agent share repository
+func NewAgentShareRepository(client *ent.Client) *AgentShareRepository {
+	return &AgentShareRepository{client: client}
+}
+
+// Upsert shares an agent with a principal, replacing the permission of an existing share
+func (r *AgentShareRepository) Upsert(ctx context.Context, s *ent.AgentShare) (*ent.AgentShare, error) {
+	existing, err := r.client.AgentShare.
+		Query().
+		Where(
+			agentshare.AgentID(s.AgentID),
+			agentshare.PrincipalType(s.PrincipalType),
+			agentshare.PrincipalID(s.PrincipalID),
+		).
+		Only(ctx)
+
+	if err == nil {
+		updated, err := existing.Update().
+			SetPermission(s.Permission).
+			SetCreatedBy(s.CreatedBy).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed updating agent share: %w", err)
+		}
+		return updated, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed querying agent share: %w", err)
+	}
+
+	created, err := r.client.AgentShare.
+		Create().
+		SetID(s.ID).
+		SetAgentID(s.AgentID).
+		SetPrincipalType(s.PrincipalType).
+		SetPrincipalID(s.PrincipalID).
+		SetPermission(s.Permission).
+		SetCreatedBy(s.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating agent share: %w", err)
+	}
+
+	return created, nil
+}
+
+// List retrieves the shares of an agent, oldest first
+func (r *AgentShareRepository) List(ctx context.Context, agentID string) ([]*ent.AgentShare, error) {
+	shares, err := r.client.AgentShare.
+		Query().
+		Where(agentshare.AgentID(agentID)).
+		Order(ent.Asc(agentshare.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing agent shares: %w", err)
+	}
+
+	return shares, nil
+}
+
+// Delete removes the share of an agent with a principal
+func (r *AgentShareRepository) Delete(ctx context.Context, agentID, principalType, principalID string) error {
+	deleted, err := r.client.AgentShare.
+		Delete().
+		Where(
+			agentshare.AgentID(agentID),
+			agentshare.PrincipalType(principalType),
+			agentshare.PrincipalID(principalID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed deleting agent share: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("agent share not found: %s %s", principalType, principalID)
+	}
+
+	return nil
+}
+
+// Permission returns the strongest permission on an agent shared with a user directly or
+// through their role, empty when it is not shared with them
+func (r *AgentShareRepository) Permission(ctx context.Context, agentID, userID, role string) (string, error) {
+	shares, err := r.client.AgentShare.
+		Query().
+		Where(
+			agentshare.AgentID(agentID),
+			sharedWith(userID, role),
+		).
+		All(ctx)
+
+	if err != nil {
+		return "", fmt.Errorf("failed querying agent shares: %w", err)
+	}
+
+	permission := ""
+	for _, s := range shares {
+		if sharePermissionRank[s.Permission] > sharePermissionRank[permission] {
+			permission = s.Permission
+		}
+	}
+	return permission, nil
+}
+
+// SharedWith returns the agents shared with a user directly or through their role, mapped
+// to the strongest permission
+func (r *AgentShareRepository) SharedWith(ctx context.Context, userID, role string) (map[string]string, error) {
+	shares, err := r.client.AgentShare.
+		Query().
+		Where(sharedWith(userID, role)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed querying agent shares: %w", err)
+	}
+
+	permissions := make(map[string]string, len(shares))
+	for _, s := range shares {
+		if sharePermissionRank[s.Permission] > sharePermissionRank[permissions[s.AgentID]] {
+			permissions[s.AgentID] = s.Permission
+		}
+	}
+	return permissions, nil
+}
+
+// sharedWith matches the shares granted to a user or their role
+func sharedWith(userID, role string) predicate.AgentShare {
+	return agentshare.Or(
+		agentshare.And(agentshare.PrincipalType("user"), agentshare.PrincipalID(userID)),
+		agentshare.And(agentshare.PrincipalType("role"), agentshare.PrincipalID(role)),
+	)
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/agentversion"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrVersionExists is returned when publishing a version number an agent already has
+var ErrVersionExists = errors.New("agent version already exists")
+
+// AgentVersionRepository handles agent version data access
+type AgentVersionRepository struct {
+	client *ent.Client
+}
+
+// NewAgentVersionRepository creates a new agent version repository
+func NewAgentVersionRepository(client *ent.Client) *AgentVersionRepository {
+	return &AgentVersionRepository{client: client}
+}
+
+// Publish stores a snapshot of an agent and makes it the version new conversations start on
+func (r *AgentVersionRepository) Publish(ctx context.Context, v *ent.AgentVersion) (*ent.AgentVersion, *ent.Agent, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	builder := tx.AgentVersion.
+		Create().
+		SetID(v.ID).
+		SetAgentID(v.AgentID).
+		SetVersion(v.Version).
+		SetName(v.Name).
+		SetDescription(v.Description).
+		SetType(v.Type).
+		SetPromptTemplate(v.PromptTemplate).
+		SetNotes(v.Notes).
+		SetCreatedBy(v.CreatedBy)
+
+	// Set optional fields
+	if v.ModelConfig != nil {
+		builder = builder.SetModelConfig(v.ModelConfig)
+	}
+	if v.Tools != nil {
+		builder = builder.SetTools(v.Tools)
+	}
+	if v.KnowledgeBases != nil {
+		builder = builder.SetKnowledgeBases(v.KnowledgeBases)
+	}
+	if v.Members != nil {
+		builder = builder.SetMembers(v.Members)
+	}
+	if v.Parameters != nil {
+		builder = builder.SetParameters(v.Parameters)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, nil, ErrVersionExists
+		}
+		return nil, nil, fmt.Errorf("failed creating agent version: %w", err)
+	}
+
+	agent, err := tx.Agent.
+		UpdateOneID(v.AgentID).
+		SetVersion(created.Version).
+		SetPublishedVersionID(created.ID).
+		SetStatus("published").
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("agent not found: %s", v.AgentID)
+		}
+		return nil, nil, fmt.Errorf("failed updating agent: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed committing agent version: %w", err)
+	}
+
+	return created, agent, nil
+}
+
+// Restore copies a version's configuration back onto its agent and makes it the
+// version new conversations start on
+func (r *AgentVersionRepository) Restore(ctx context.Context, v *ent.AgentVersion) (*ent.Agent, error) {
+	agent, err := r.client.Agent.
+		UpdateOneID(v.AgentID).
+		SetName(v.Name).
+		SetDescription(v.Description).
+		SetType(v.Type).
+		SetModelConfig(v.ModelConfig).
+		SetTools(v.Tools).
+		SetKnowledgeBases(v.KnowledgeBases).
+		SetMembers(v.Members).
+		SetPromptTemplate(v.PromptTemplate).
+		SetParameters(v.Parameters).
+		SetVersion(v.Version).
+		SetPublishedVersionID(v.ID).
+		SetStatus("published").
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("agent not found: %s", v.AgentID)
+		}
+		return nil, fmt.Errorf("failed restoring agent version: %w", err)
+	}
+
+	return agent, nil
+}
+
+// Get retrieves an agent version by ID
+func (r *AgentVersionRepository) Get(ctx context.Context, id string) (*ent.AgentVersion, error) {
+	v, err := r.client.AgentVersion.
+		Query().
+		Where(agentversion.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("agent version not found: %s", id)
+		}
+		return nil, fmt.Errorf("failed querying agent version: %w", err)
+	}
+
+	return v, nil
+}
+
+// ListByIDs retrieves the agent versions with the given IDs, ignoring unknown IDs
+func (r *AgentVersionRepository) ListByIDs(ctx context.Context, ids []string) ([]*ent.AgentVersion, error) {
+	versions, err := r.client.AgentVersion.
+		Query().
+		Where(agentversion.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing agent versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// GetByVersion retrieves an agent's version by its version number
+func (r *AgentVersionRepository) GetByVersion(ctx context.Context, agentID, version string) (*ent.AgentVersion, error) {
+	v, err := r.client.AgentVersion.
+		Query().
+		Where(
+			agentversion.AgentID(agentID),
+			agentversion.Version(version),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("agent version not found: %s", version)
+		}
+		return nil, fmt.Errorf("failed querying agent version: %w", err)
+	}
+
+	return v, nil
+}
+
+// Latest retrieves the most recently published version of an agent, nil when it has none
+func (r *AgentVersionRepository) Latest(ctx context.Context, agentID string) (*ent.AgentVersion, error) {
+	v, err := r.client.AgentVersion.
+		Query().
+		Where(agentversion.AgentID(agentID)).
+		Order(ent.Desc(agentversion.FieldCreatedAt)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed querying agent version: %w", err)
+	}
+
+	return v, nil
+}
+
+// List retrieves an agent's versions with pagination, newest first
+func (r *AgentVersionRepository) List(ctx context.Context, agentID string, page, pageSize int32) ([]*ent.AgentVersion, int, error) {
+	query := r.client.AgentVersion.
+		Query().
+		Where(agentversion.AgentID(agentID))
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting agent versions: %w", err)
+	}
+
+	// Apply pagination
+	offset := int((page - 1) * pageSize)
+	versions, err := query.
+		Order(ent.Desc(agentversion.FieldCreatedAt)).
+		Offset(offset).
+		Limit(int(pageSize)).
+		All(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing agent versions: %w", err)
+	}
+
+	return versions, total, nil
+}
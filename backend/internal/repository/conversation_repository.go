@@ -3,11 +3,56 @@ package repository
 import (
 	"agent-platform/internal/model/ent"
 	"agent-platform/internal/model/ent/conversation"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/predicate"
 	"context"
+	"errors"
 	"fmt"
 	"time"
+
+	"entgo.io/ent/dialect/sql"
 )
 
+// ErrVersionConflict is returned when a conversation changed since the version a write expected
+var ErrVersionConflict = errors.New("conversation was modified concurrently")
+
+// ConversationFilter narrows the conversations returned by List and Search.
+// Zero values are ignored.
+type ConversationFilter struct {
+	AgentID       string
+	UserID        string
+	Status        string
+	CreatedAfter  time.Time // Inclusive
+	CreatedBefore time.Time // Exclusive
+}
+
+// predicates converts the filter to query predicates
+func (f ConversationFilter) predicates() []predicate.Conversation {
+	predicates := []predicate.Conversation{}
+	if f.AgentID != "" {
+		predicates = append(predicates, conversation.AgentID(f.AgentID))
+	}
+	if f.UserID != "" {
+		predicates = append(predicates, conversation.UserID(f.UserID))
+	}
+	if f.Status != "" {
+		predicates = append(predicates, conversation.Status(f.Status))
+	}
+	if !f.CreatedAfter.IsZero() {
+		predicates = append(predicates, conversation.CreatedAtGTE(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		predicates = append(predicates, conversation.CreatedAtLT(f.CreatedBefore))
+	}
+	return predicates
+}
+
+// AgentUsage counts the conversations held with an agent
+type AgentUsage struct {
+	Conversations int
+	Users         int
+}
+
 // ConversationRepository handles conversation data access
 type ConversationRepository struct {
 	client *ent.Client
@@ -20,7 +65,44 @@ func NewConversationRepository(client *ent.Client) *ConversationRepository {
 
 // Create creates a new conversation
 func (r *ConversationRepository) Create(ctx context.Context, c *ent.Conversation) (*ent.Conversation, error) {
-	builder := r.client.Conversation.
+	created, err := conversationCreate(r.client.Conversation, c).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating conversation: %w", err)
+	}
+
+	return created, nil
+}
+
+// CreateWithMessages stores a conversation and its messages in a single transaction. The
+// messages are stored in order and the last one becomes the end of the active branch.
+func (r *ConversationRepository) CreateWithMessages(ctx context.Context, c *ent.Conversation, messages []*ent.Message) (*ent.Conversation, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	created, err := conversationCreate(tx.Conversation, c).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed creating conversation: %w", err)
+	}
+	if len(messages) > 0 {
+		if _, err := appendMessages(ctx, tx, created.ID, 0, messages); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed committing conversation: %w", err)
+	}
+
+	return created, nil
+}
+
+// conversationCreate prepares the creation of a conversation
+func conversationCreate(client *ent.ConversationClient, c *ent.Conversation) *ent.ConversationCreate {
+	builder := client.
 		Create().
 		SetID(c.ID).
 		SetAgentID(c.AgentID).
@@ -31,8 +113,11 @@ func (r *ConversationRepository) Create(ctx context.Context, c *ent.Conversation
 	if c.Title != "" {
 		builder = builder.SetTitle(c.Title)
 	}
-	if c.Messages != nil {
-		builder = builder.SetMessages(c.Messages)
+	if c.TitleSource != "" {
+		builder = builder.SetTitleSource(c.TitleSource)
+	}
+	if c.AgentVersionID != "" {
+		builder = builder.SetAgentVersionID(c.AgentVersionID)
 	}
 	if c.Context != nil {
 		builder = builder.SetContext(c.Context)
@@ -41,19 +126,34 @@ func (r *ConversationRepository) Create(ctx context.Context, c *ent.Conversation
 		builder = builder.SetMetadata(c.Metadata)
 	}
 
-	created, err := builder.Save(ctx)
+	return builder
+}
+
+// Get retrieves a conversation by ID
+func (r *ConversationRepository) Get(ctx context.Context, id string) (*ent.Conversation, error) {
+	c, err := r.client.Conversation.
+		Query().
+		Where(conversation.ID(id)).
+		Only(ctx)
+
 	if err != nil {
-		return nil, fmt.Errorf("failed creating conversation: %w", err)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("conversation not found: %s", id)
+		}
+		return nil, fmt.Errorf("failed querying conversation: %w", err)
 	}
 
-	return created, nil
+	return c, nil
 }
 
-// Get retrieves a conversation by ID
-func (r *ConversationRepository) Get(ctx context.Context, id string) (*ent.Conversation, error) {
+// GetWithMessages retrieves a conversation by ID with its messages in chronological order
+func (r *ConversationRepository) GetWithMessages(ctx context.Context, id string) (*ent.Conversation, error) {
 	c, err := r.client.Conversation.
 		Query().
 		Where(conversation.ID(id)).
+		WithMessages(func(q *ent.MessageQuery) {
+			q.Order(ent.Asc(message.FieldCreatedAt))
+		}).
 		Only(ctx)
 
 	if err != nil {
@@ -67,19 +167,79 @@ func (r *ConversationRepository) Get(ctx context.Context, id string) (*ent.Conve
 }
 
 // List retrieves conversations with pagination and filters
-func (r *ConversationRepository) List(ctx context.Context, page, pageSize int32, agentID, userID, status string) ([]*ent.Conversation, int, error) {
-	query := r.client.Conversation.Query()
+func (r *ConversationRepository) List(ctx context.Context, page, pageSize int32, filter ConversationFilter) ([]*ent.Conversation, int, error) {
+	query := r.client.Conversation.Query().
+		Where(filter.predicates()...)
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting conversations: %w", err)
+	}
+
+	// Apply pagination
+	offset := int((page - 1) * pageSize)
+	conversations, err := query.
+		Order(ent.Desc(conversation.FieldUpdatedAt)).
+		Offset(offset).
+		Limit(int(pageSize)).
+		All(ctx)
 
-	// Apply filters
-	if agentID != "" {
-		query = query.Where(conversation.AgentID(agentID))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing conversations: %w", err)
 	}
-	if userID != "" {
-		query = query.Where(conversation.UserID(userID))
+
+	return conversations, total, nil
+}
+
+// ListWithMessages retrieves up to limit conversations matching the filter, oldest first,
+// with their messages in chronological order
+func (r *ConversationRepository) ListWithMessages(ctx context.Context, filter ConversationFilter, limit int) ([]*ent.Conversation, error) {
+	conversations, err := r.client.Conversation.
+		Query().
+		Where(filter.predicates()...).
+		WithMessages(func(q *ent.MessageQuery) {
+			q.Order(ent.Asc(message.FieldCreatedAt))
+		}).
+		Order(ent.Asc(conversation.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing conversations: %w", err)
 	}
-	if status != "" {
-		query = query.Where(conversation.Status(status))
+
+	return conversations, nil
+}
+
+// SearchConfig is the text search configuration of conversation search. simple only splits
+// and lowercases words, without the stemming of a single language. The full-text indexes
+// created by the database migrations use the same configuration.
+const SearchConfig = "simple"
+
+// matchesText matches rows whose column matches a web search style query, such as
+// `"exact phrase" refund -invoice`, using the expression the full-text indexes are built on
+func matchesText(column, queryText string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("to_tsvector('" + SearchConfig + "', ").
+				WriteString(s.C(column)).
+				WriteString(") @@ websearch_to_tsquery('" + SearchConfig + "', ").
+				Arg(queryText).
+				WriteString(")")
+		}))
 	}
+}
+
+// Search retrieves conversations whose title or message contents match the query through
+// full-text search, most recently updated first
+func (r *ConversationRepository) Search(ctx context.Context, queryText string, page, pageSize int32, filter ConversationFilter) ([]*ent.Conversation, int, error) {
+	query := r.client.Conversation.Query().
+		Where(filter.predicates()...).
+		Where(conversation.Or(
+			predicate.Conversation(matchesText(conversation.FieldTitle, queryText)),
+			conversation.HasMessagesWith(predicate.Message(matchesText(message.FieldContent, queryText))),
+		))
 
 	// Get total count
 	total, err := query.Count(ctx)
@@ -96,48 +256,105 @@ func (r *ConversationRepository) List(ctx context.Context, page, pageSize int32,
 		All(ctx)
 
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed listing conversations: %w", err)
+		return nil, 0, fmt.Errorf("failed searching conversations: %w", err)
 	}
 
 	return conversations, total, nil
 }
 
-// AddMessage adds a message to a conversation
-func (r *ConversationRepository) AddMessage(ctx context.Context, id string, message interface{}) (*ent.Conversation, error) {
-	c, err := r.Get(ctx, id)
+// UsageByAgent returns the usage of the given agents; agents without conversations are left out
+func (r *ConversationRepository) UsageByAgent(ctx context.Context, agentIDs []string) (map[string]AgentUsage, error) {
+	var rows []struct {
+		AgentID       string `json:"agent_id"`
+		Conversations int    `json:"conversations"`
+		Users         int    `json:"users"`
+	}
+	err := r.client.Conversation.
+		Query().
+		Where(conversation.AgentIDIn(agentIDs...)).
+		GroupBy(conversation.FieldAgentID).
+		Aggregate(
+			ent.As(ent.Count(), "conversations"),
+			func(s *sql.Selector) string {
+				return sql.As(sql.Count(sql.Distinct(s.C(conversation.FieldUserID))), "users")
+			},
+		).
+		Scan(ctx, &rows)
+
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed counting conversations: %w", err)
 	}
 
-	messages := c.Messages
-	if messages == nil {
-		messages = []interface{}{}
+	usage := make(map[string]AgentUsage, len(rows))
+	for _, row := range rows {
+		usage[row.AgentID] = AgentUsage{Conversations: row.Conversations, Users: row.Users}
 	}
-	messages = append(messages, message)
+	return usage, nil
+}
 
-	updated, err := r.client.Conversation.
-		UpdateOneID(id).
-		SetMessages(messages).
-		SetLastMessageAt(time.Now()).
+// SetStatus sets the status of a user's conversations and returns how many were updated
+func (r *ConversationRepository) SetStatus(ctx context.Context, userID string, ids []string, status string) (int, error) {
+	n, err := r.client.Conversation.
+		Update().
+		Where(
+			conversation.IDIn(ids...),
+			conversation.UserID(userID),
+		).
+		SetStatus(status).
+		AddVersion(1).
 		Save(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed adding message: %w", err)
+		return 0, fmt.Errorf("failed updating conversations: %w", err)
 	}
 
-	return updated, nil
+	return n, nil
+}
+
+// SetGeneratedTitle sets a generated title unless the conversation's title was changed
+// in the meantime. It reports whether the title was updated.
+func (r *ConversationRepository) SetGeneratedTitle(ctx context.Context, id, title string) (bool, error) {
+	n, err := r.client.Conversation.
+		Update().
+		Where(
+			conversation.ID(id),
+			conversation.TitleSource("default"),
+		).
+		SetTitle(title).
+		SetTitleSource("generated").
+		Save(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("failed updating conversation title: %w", err)
+	}
+
+	return n > 0, nil
 }
 
-// Update updates an existing conversation
-func (r *ConversationRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.Conversation, error) {
+// Update updates an existing conversation. When version is non-zero the update only applies
+// if the conversation is still at that version, ErrVersionConflict is returned otherwise.
+// Every update except metadata-only ones increments the version.
+func (r *ConversationRepository) Update(ctx context.Context, id string, version int64, updates map[string]interface{}) (*ent.Conversation, error) {
 	updateQuery := r.client.Conversation.UpdateOneID(id)
+	if version != 0 {
+		updateQuery = updateQuery.Where(conversation.Version(version))
+	}
 
+	bump := false
 	for key, value := range updates {
+		if key != "metadata" {
+			bump = true
+		}
+
 		switch key {
 		case "title":
 			if v, ok := value.(string); ok {
 				updateQuery = updateQuery.SetTitle(v)
 			}
+		case "title_source":
+			if v, ok := value.(string); ok {
+				updateQuery = updateQuery.SetTitleSource(v)
+			}
 		case "status":
 			if v, ok := value.(string); ok {
 				updateQuery = updateQuery.SetStatus(v)
@@ -150,13 +367,21 @@ func (r *ConversationRepository) Update(ctx context.Context, id string, updates
 			if v, ok := value.(map[string]interface{}); ok {
 				updateQuery = updateQuery.SetMetadata(v)
 			}
+		case "active_message_id":
+			if v, ok := value.(string); ok {
+				updateQuery = updateQuery.SetActiveMessageID(v)
+			}
 		}
 	}
 
+	if bump {
+		updateQuery = updateQuery.AddVersion(1)
+	}
+
 	updated, err := updateQuery.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("conversation not found: %s", id)
+			return nil, versionError(ctx, r.client, id, version)
 		}
 		return nil, fmt.Errorf("failed updating conversation: %w", err)
 	}
@@ -164,6 +389,21 @@ func (r *ConversationRepository) Update(ctx context.Context, id string, updates
 	return updated, nil
 }
 
+// versionError explains why a write matched no conversation: either it does not exist or,
+// when the write expected a version, it was changed concurrently
+func versionError(ctx context.Context, client *ent.Client, id string, version int64) error {
+	if version != 0 {
+		exists, err := client.Conversation.Query().Where(conversation.ID(id)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying conversation: %w", err)
+		}
+		if exists {
+			return ErrVersionConflict
+		}
+	}
+	return fmt.Errorf("conversation not found: %s", id)
+}
+
 // Delete deletes a conversation by ID
 func (r *ConversationRepository) Delete(ctx context.Context, id string) error {
 	err := r.client.Conversation.
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/messagefeedback"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"fmt"
+	"time"
+)
+
+// FeedbackFilter narrows the feedback returned by List and Stats.
+// Zero values are ignored.
+type FeedbackFilter struct {
+	AgentID        string
+	ConversationID string
+	UserID         string
+	Rating         int
+	CreatedAfter   time.Time // Inclusive
+	CreatedBefore  time.Time // Exclusive
+}
+
+// predicates converts the filter to query predicates
+func (f FeedbackFilter) predicates() []predicate.MessageFeedback {
+	predicates := []predicate.MessageFeedback{}
+	if f.AgentID != "" {
+		predicates = append(predicates, messagefeedback.AgentID(f.AgentID))
+	}
+	if f.ConversationID != "" {
+		predicates = append(predicates, messagefeedback.ConversationID(f.ConversationID))
+	}
+	if f.UserID != "" {
+		predicates = append(predicates, messagefeedback.UserID(f.UserID))
+	}
+	if f.Rating != 0 {
+		predicates = append(predicates, messagefeedback.Rating(f.Rating))
+	}
+	if !f.CreatedAfter.IsZero() {
+		predicates = append(predicates, messagefeedback.CreatedAtGTE(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		predicates = append(predicates, messagefeedback.CreatedAtLT(f.CreatedBefore))
+	}
+	return predicates
+}
+
+// FeedbackStats aggregates feedback ratings
+type FeedbackStats struct {
+	Total    int
+	Positive int
+	Negative int
+	Reasons  map[string]int // Number of feedback entries per reason
+}
+
+// FeedbackRepository handles message feedback data access
+type FeedbackRepository struct {
+	client *ent.Client
+}
+
+// NewFeedbackRepository creates a new feedback repository
+func NewFeedbackRepository(client *ent.Client) *FeedbackRepository {
+	return &FeedbackRepository{client: client}
+}
+
+// Save creates or replaces a user's feedback on a message
+func (r *FeedbackRepository) Save(ctx context.Context, f *ent.MessageFeedback) (*ent.MessageFeedback, error) {
+	existing, err := r.client.MessageFeedback.
+		Query().
+		Where(
+			messagefeedback.MessageID(f.MessageID),
+			messagefeedback.UserID(f.UserID),
+		).
+		Only(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed querying feedback: %w", err)
+	}
+
+	if existing != nil {
+		updated, err := existing.Update().
+			SetRating(f.Rating).
+			SetReason(f.Reason).
+			SetComment(f.Comment).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed updating feedback: %w", err)
+		}
+		return updated, nil
+	}
+
+	created, err := r.client.MessageFeedback.
+		Create().
+		SetID(f.ID).
+		SetMessageID(f.MessageID).
+		SetConversationID(f.ConversationID).
+		SetAgentID(f.AgentID).
+		SetUserID(f.UserID).
+		SetRating(f.Rating).
+		SetReason(f.Reason).
+		SetComment(f.Comment).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed creating feedback: %w", err)
+	}
+
+	return created, nil
+}
+
+// Delete removes a user's feedback on a message
+func (r *FeedbackRepository) Delete(ctx context.Context, conversationID, messageID, userID string) error {
+	n, err := r.client.MessageFeedback.
+		Delete().
+		Where(
+			messagefeedback.ConversationID(conversationID),
+			messagefeedback.MessageID(messageID),
+			messagefeedback.UserID(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed deleting feedback: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("feedback not found for message: %s", messageID)
+	}
+
+	return nil
+}
+
+// List retrieves feedback with pagination and filters, newest first, with the rated messages
+func (r *FeedbackRepository) List(ctx context.Context, page, pageSize int32, filter FeedbackFilter) ([]*ent.MessageFeedback, int, error) {
+	query := r.client.MessageFeedback.Query().
+		Where(filter.predicates()...)
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting feedback: %w", err)
+	}
+
+	// Apply pagination
+	offset := int((page - 1) * pageSize)
+	feedback, err := query.
+		WithMessage().
+		Order(ent.Desc(messagefeedback.FieldCreatedAt)).
+		Offset(offset).
+		Limit(int(pageSize)).
+		All(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing feedback: %w", err)
+	}
+
+	return feedback, total, nil
+}
+
+// Stats aggregates the ratings and reasons of the feedback matching the filter
+func (r *FeedbackRepository) Stats(ctx context.Context, filter FeedbackFilter) (*FeedbackStats, error) {
+	var ratings []struct {
+		Rating int `json:"rating"`
+		Count  int `json:"count"`
+	}
+	err := r.client.MessageFeedback.Query().
+		Where(filter.predicates()...).
+		GroupBy(messagefeedback.FieldRating).
+		Aggregate(ent.Count()).
+		Scan(ctx, &ratings)
+	if err != nil {
+		return nil, fmt.Errorf("failed aggregating ratings: %w", err)
+	}
+
+	var reasons []struct {
+		Reason string `json:"reason"`
+		Count  int    `json:"count"`
+	}
+	err = r.client.MessageFeedback.Query().
+		Where(filter.predicates()...).
+		Where(messagefeedback.ReasonNEQ("")).
+		GroupBy(messagefeedback.FieldReason).
+		Aggregate(ent.Count()).
+		Scan(ctx, &reasons)
+	if err != nil {
+		return nil, fmt.Errorf("failed aggregating reasons: %w", err)
+	}
+
+	stats := &FeedbackStats{Reasons: make(map[string]int, len(reasons))}
+	for _, row := range ratings {
+		stats.Total += row.Count
+		if row.Rating > 0 {
+			stats.Positive += row.Count
+		} else {
+			stats.Negative += row.Count
+		}
+	}
+	for _, row := range reasons {
+		stats.Reasons[row.Reason] = row.Count
+	}
+
+	return stats, nil
+}
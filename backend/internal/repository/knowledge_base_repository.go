@@ -66,6 +66,34 @@ func (r *KnowledgeBaseRepository) Get(ctx context.Context, id string) (*ent.Know
 	return kb, nil
 }
 
+// ListByIDs retrieves the knowledge bases with the given IDs, ignoring unknown IDs
+func (r *KnowledgeBaseRepository) ListByIDs(ctx context.Context, ids []string) ([]*ent.KnowledgeBase, error) {
+	kbs, err := r.client.KnowledgeBase.
+		Query().
+		Where(knowledgebase.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing knowledge bases: %w", err)
+	}
+
+	return kbs, nil
+}
+
+// ListByNames retrieves the knowledge bases with the given names, ignoring unknown names
+func (r *KnowledgeBaseRepository) ListByNames(ctx context.Context, names []string) ([]*ent.KnowledgeBase, error) {
+	kbs, err := r.client.KnowledgeBase.
+		Query().
+		Where(knowledgebase.NameIn(names...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing knowledge bases: %w", err)
+	}
+
+	return kbs, nil
+}
+
 // List retrieves knowledge bases with pagination and filters
 func (r *KnowledgeBaseRepository) List(ctx context.Context, page, pageSize int32, kbType, createdBy string) ([]*ent.KnowledgeBase, int, error) {
 	query := r.client.KnowledgeBase.Query()
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/conversation"
+	"agent-platform/internal/model/ent/message"
+	"agent-platform/internal/model/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MessageRepository handles conversation message data access
+type MessageRepository struct {
+	client *ent.Client
+}
+
+// NewMessageRepository creates a new message repository
+func NewMessageRepository(client *ent.Client) *MessageRepository {
+	return &MessageRepository{client: client}
+}
+
+// ErrDuplicateIdempotencyKey is returned when a message with the same idempotency key was
+// already stored in the conversation
+var ErrDuplicateIdempotencyKey = errors.New("idempotency key already used in this conversation")
+
+// Create stores a message and makes it the end of its conversation's active branch
+func (r *MessageRepository) Create(ctx context.Context, m *ent.Message) (*ent.Message, error) {
+	created, err := r.Append(ctx, m.ConversationID, 0, m)
+	if err != nil {
+		return nil, err
+	}
+	return created[0], nil
+}
+
+// Append stores messages of a conversation in a single transaction and makes the last one
+// the end of the active branch. When version is non-zero the messages are only stored if
+// the conversation is still at that version, ErrVersionConflict is returned otherwise.
+// The conversation version is incremented.
+func (r *MessageRepository) Append(ctx context.Context, conversationID string, version int64, messages ...*ent.Message) ([]*ent.Message, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	created, err := appendMessages(ctx, tx, conversationID, version, messages)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, versionError(ctx, r.client, conversationID, version)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed committing messages: %w", err)
+	}
+
+	return created, nil
+}
+
+// appendMessages stores messages of a conversation within tx, see Append. A not found error
+// is returned when the conversation does not exist or is not at version.
+func appendMessages(ctx context.Context, tx *ent.Tx, conversationID string, version int64, messages []*ent.Message) ([]*ent.Message, error) {
+	created := make([]*ent.Message, len(messages))
+	for i, m := range messages {
+		builder := tx.Message.
+			Create().
+			SetID(m.ID).
+			SetConversationID(conversationID).
+			SetRole(m.Role).
+			SetContent(m.Content).
+			SetPromptTokens(m.PromptTokens).
+			SetCompletionTokens(m.CompletionTokens)
+
+		// Set optional fields
+		if m.ParentID != "" {
+			builder = builder.SetParentID(m.ParentID)
+		}
+		if m.Metadata != nil {
+			builder = builder.SetMetadata(m.Metadata)
+		}
+		if m.Model != "" {
+			builder = builder.SetModel(m.Model)
+		}
+		if m.IdempotencyKey != "" {
+			builder = builder.SetIdempotencyKey(m.IdempotencyKey)
+		}
+		if !m.CreatedAt.IsZero() {
+			builder = builder.SetCreatedAt(m.CreatedAt)
+		}
+
+		var err error
+		created[i], err = builder.Save(ctx)
+		if err != nil {
+			if m.IdempotencyKey != "" && ent.IsConstraintError(err) {
+				return nil, ErrDuplicateIdempotencyKey
+			}
+			return nil, fmt.Errorf("failed creating message: %w", err)
+		}
+	}
+
+	last := created[len(created)-1]
+	update := tx.Conversation.
+		UpdateOneID(conversationID).
+		SetLastMessageAt(last.CreatedAt).
+		SetActiveMessageID(last.ID).
+		AddVersion(1)
+	if version != 0 {
+		update = update.Where(conversation.Version(version))
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed updating conversation: %w", err)
+	}
+
+	return created, nil
+}
+
+// Get retrieves a message by ID
+func (r *MessageRepository) Get(ctx context.Context, id string) (*ent.Message, error) {
+	m, err := r.client.Message.
+		Query().
+		Where(message.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("message not found: %s", id)
+		}
+		return nil, fmt.Errorf("failed querying message: %w", err)
+	}
+
+	return m, nil
+}
+
+// List retrieves a page of a conversation's messages in chronological order
+func (r *MessageRepository) List(ctx context.Context, conversationID string, page, pageSize int32) ([]*ent.Message, int, error) {
+	query := r.client.Message.
+		Query().
+		Where(message.ConversationID(conversationID))
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting messages: %w", err)
+	}
+
+	// Apply pagination
+	offset := int((page - 1) * pageSize)
+	messages, err := query.
+		Order(ent.Asc(message.FieldCreatedAt)).
+		Offset(offset).
+		Limit(int(pageSize)).
+		All(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing messages: %w", err)
+	}
+
+	return messages, total, nil
+}
+
+// ListByConversation retrieves all messages of a conversation in chronological order
+func (r *MessageRepository) ListByConversation(ctx context.Context, conversationID string) ([]*ent.Message, error) {
+	messages, err := r.client.Message.
+		Query().
+		Where(message.ConversationID(conversationID)).
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// FirstMatching returns, for each conversation, its oldest message matching the query
+func (r *MessageRepository) FirstMatching(ctx context.Context, conversationIDs []string, queryText string) (map[string]*ent.Message, error) {
+	messages, err := r.client.Message.
+		Query().
+		Where(
+			message.ConversationIDIn(conversationIDs...),
+			predicate.Message(matchesText(message.FieldContent, queryText)),
+		).
+		Order(ent.Asc(message.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed searching messages: %w", err)
+	}
+
+	matches := make(map[string]*ent.Message, len(conversationIDs))
+	for _, m := range messages {
+		if _, ok := matches[m.ConversationID]; !ok {
+			matches[m.ConversationID] = m
+		}
+	}
+
+	return matches, nil
+}
@@ -68,6 +68,34 @@ func (r *ToolRepository) Get(ctx context.Context, id string) (*ent.Tool, error)
 	return t, nil
 }
 
+// ListByIDs retrieves the tools with the given IDs, ignoring unknown IDs
+func (r *ToolRepository) ListByIDs(ctx context.Context, ids []string) ([]*ent.Tool, error) {
+	tools, err := r.client.Tool.
+		Query().
+		Where(tool.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing tools: %w", err)
+	}
+
+	return tools, nil
+}
+
+// ListByNames retrieves the tools with the given names, ignoring unknown names
+func (r *ToolRepository) ListByNames(ctx context.Context, names []string) ([]*ent.Tool, error) {
+	tools, err := r.client.Tool.
+		Query().
+		Where(tool.NameIn(names...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing tools: %w", err)
+	}
+
+	return tools, nil
+}
+
 // List retrieves tools with pagination and filters
 func (r *ToolRepository) List(ctx context.Context, page, pageSize int32, toolType, category, createdBy string, isPublic *bool) ([]*ent.Tool, int, error) {
 	query := r.client.Tool.Query()
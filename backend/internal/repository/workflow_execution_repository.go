@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/workflowexecution"
+	"context"
+	"fmt"
+	"time"
+)
+
+// WorkflowExecutionRepository handles workflow execution data access
+type WorkflowExecutionRepository struct {
+	client *ent.Client
+}
+
+// NewWorkflowExecutionRepository creates a new workflow execution repository
+func NewWorkflowExecutionRepository(client *ent.Client) *WorkflowExecutionRepository {
+	return &WorkflowExecutionRepository{client: client}
+}
+
+// Create creates a new workflow execution
+func (r *WorkflowExecutionRepository) Create(ctx context.Context, e *ent.WorkflowExecution) (*ent.WorkflowExecution, error) {
+	builder := r.client.WorkflowExecution.
+		Create().
+		SetID(e.ID).
+		SetWorkflowID(e.WorkflowID).
+		SetStatus(e.Status).
+		SetStartedBy(e.StartedBy)
+
+	// Set optional fields
+	if e.Input != nil {
+		builder = builder.SetInput(e.Input)
+	}
+	if e.Context != nil {
+		builder = builder.SetContext(e.Context)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating workflow execution: %w", err)
+	}
+
+	return created, nil
+}
+
+// Get retrieves a workflow execution by ID
+func (r *WorkflowExecutionRepository) Get(ctx context.Context, id string) (*ent.WorkflowExecution, error) {
+	e, err := r.client.WorkflowExecution.
+		Query().
+		Where(workflowexecution.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("workflow execution not found: %s", id)
+		}
+		return nil, fmt.Errorf("failed querying workflow execution: %w", err)
+	}
+
+	return e, nil
+}
+
+// List retrieves workflow executions with pagination, optionally filtered by workflow,
+// starter and status
+func (r *WorkflowExecutionRepository) List(ctx context.Context, page, pageSize int32, workflowID, startedBy, status string) ([]*ent.WorkflowExecution, int, error) {
+	query := r.client.WorkflowExecution.Query()
+
+	// Apply filters
+	if workflowID != "" {
+		query = query.Where(workflowexecution.WorkflowID(workflowID))
+	}
+	if startedBy != "" {
+		query = query.Where(workflowexecution.StartedBy(startedBy))
+	}
+	if status != "" {
+		query = query.Where(workflowexecution.Status(status))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting workflow executions: %w", err)
+	}
+
+	// Apply pagination
+	offset := int((page - 1) * pageSize)
+	executions, err := query.
+		Order(ent.Desc(workflowexecution.FieldStartedAt)).
+		Offset(offset).
+		Limit(int(pageSize)).
+		All(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing workflow executions: %w", err)
+	}
+
+	return executions, total, nil
+}
+
+// Cancel marks an execution cancelled unless it already finished, reporting whether it was
+func (r *WorkflowExecutionRepository) Cancel(ctx context.Context, id string) (bool, error) {
+	n, err := r.client.WorkflowExecution.
+		Update().
+		Where(
+			workflowexecution.ID(id),
+			workflowexecution.StatusIn("pending", "running"),
+		).
+		SetStatus("cancelled").
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("failed cancelling workflow execution: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+// ListUnfinished retrieves the executions that are pending or running, oldest first
+func (r *WorkflowExecutionRepository) ListUnfinished(ctx context.Context) ([]*ent.WorkflowExecution, error) {
+	executions, err := r.client.WorkflowExecution.
+		Query().
+		Where(workflowexecution.StatusIn("pending", "running")).
+		Order(ent.Asc(workflowexecution.FieldStartedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed listing unfinished workflow executions: %w", err)
+	}
+
+	return executions, nil
+}
+
+// SaveProgress stores the state of a pending or running execution, marking it running.
+// It reports false when the execution was cancelled or deleted in the meantime.
+func (r *WorkflowExecutionRepository) SaveProgress(ctx context.Context, id string, state map[string]interface{}, currentStep string) (bool, error) {
+	n, err := r.client.WorkflowExecution.
+		Update().
+		Where(
+			workflowexecution.ID(id),
+			workflowexecution.StatusIn("pending", "running"),
+		).
+		SetStatus("running").
+		SetContext(state).
+		SetCurrentStep(currentStep).
+		Save(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("failed saving workflow execution progress: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+// Finish stores the outcome of a running execution: completed with its output or failed
+// with an error. It reports false when the execution was cancelled or deleted in the meantime.
+func (r *WorkflowExecutionRepository) Finish(ctx context.Context, id, status string, state, output map[string]interface{}, errMsg string) (bool, error) {
+	update := r.client.WorkflowExecution.
+		Update().
+		Where(
+			workflowexecution.ID(id),
+			workflowexecution.StatusIn("pending", "running"),
+		).
+		SetStatus(status).
+		SetContext(state).
+		ClearCurrentStep().
+		SetCompletedAt(time.Now())
+
+	if output != nil {
+		update = update.SetOutput(output)
+	}
+	if errMsg != "" {
+		update = update.SetError(errMsg)
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed finishing workflow execution: %w", err)
+	}
+
+	return n > 0, nil
+}
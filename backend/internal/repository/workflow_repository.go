@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"agent-platform/internal/model/ent"
+	"agent-platform/internal/model/ent/workflow"
+	"agent-platform/internal/model/ent/workflowexecution"
+	"context"
+	"fmt"
+)
+
+// WorkflowRepository handles workflow data access
+type WorkflowRepository struct {
+	client *ent.Client
+}
+
+// NewWorkflowRepository creates a new workflow repository
+func NewWorkflowRepository(client *ent.Client) *WorkflowRepository {
+	return &WorkflowRepository{client: client}
+}
+
+// Create creates a new workflow
+func (r *WorkflowRepository) Create(ctx context.Context, w *ent.Workflow) (*ent.Workflow, error) {
+	builder := r.client.Workflow.
+		Create().
+		SetID(w.ID).
+		SetName(w.Name).
+		SetDescription(w.Description).
+		SetSteps(w.Steps).
+		SetStatus(w.Status).
+		SetCreatedBy(w.CreatedBy)
+
+	// Set optional fields
+	if w.Config != nil {
+		builder = builder.SetConfig(w.Config)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating workflow: %w", err)
+	}
+
+	return created, nil
+}
+
+// Get retrieves a workflow by ID
+func (r *WorkflowRepository) Get(ctx context.Context, id string) (*ent.Workflow, error) {
+	w, err := r.client.Workflow.
+		Query().
+		Where(workflow.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("workflow not found: %s", id)
+		}
+		return nil, fmt.Errorf("failed querying workflow: %w", err)
+	}
+
+	return w, nil
+}
+
+// List retrieves workflows with pagination, optionally filtered by creator and status
+func (r *WorkflowRepository) List(ctx context.Context, page, pageSize int32, createdBy, status string) ([]*ent.Workflow, int, error) {
+	query := r.client.Workflow.Query()
+
+	// Apply filters
+	if createdBy != "" {
+		query = query.Where(workflow.CreatedBy(createdBy))
+	}
+	if status != "" {
+		query = query.Where(workflow.Status(status))
+	}
+
+	// Get total count
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed counting workflows: %w", err)
+	}
+
+	// Apply pagination
+	offset := int((page - 1) * pageSize)
+	workflows, err := query.
+		Order(ent.Desc(workflow.FieldCreatedAt)).
+		Offset(offset).
+		Limit(int(pageSize)).
+		All(ctx)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed listing workflows: %w", err)
+	}
+
+	return workflows, total, nil
+}
+
+// Update updates an existing workflow
+func (r *WorkflowRepository) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.Workflow, error) {
+	updateQuery := r.client.Workflow.UpdateOneID(id)
+
+	// Apply updates dynamically
+	for key, value := range updates {
+		switch key {
+		case "name":
+			if v, ok := value.(string); ok {
+				updateQuery = updateQuery.SetName(v)
+			}
+		case "description":
+			if v, ok := value.(string); ok {
+				updateQuery = updateQuery.SetDescription(v)
+			}
+		case "steps":
+			if v, ok := value.([]map[string]interface{}); ok {
+				updateQuery = updateQuery.SetSteps(v)
+			}
+		case "config":
+			if v, ok := value.(map[string]interface{}); ok {
+				updateQuery = updateQuery.SetConfig(v)
+			}
+		case "status":
+			if v, ok := value.(string); ok {
+				updateQuery = updateQuery.SetStatus(v)
+			}
+		}
+	}
+
+	updated, err := updateQuery.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("workflow not found: %s", id)
+		}
+		return nil, fmt.Errorf("failed updating workflow: %w", err)
+	}
+
+	return updated, nil
+}
+
+// Delete deletes a workflow with its executions by ID
+func (r *WorkflowRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed starting transaction: %w", err)
+	}
+
+	if _, err := tx.WorkflowExecution.Delete().Where(workflowexecution.WorkflowID(id)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed deleting workflow executions: %w", err)
+	}
+
+	if err := tx.Workflow.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("workflow not found: %s", id)
+		}
+		return fmt.Errorf("failed deleting workflow: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed committing workflow deletion: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package workflow
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// operators compare the value of a condition's variable to the condition's value
+var operators = map[string]func(actual interface{}, found bool, value interface{}) bool{
+	"equals":       func(a interface{}, _ bool, v interface{}) bool { return equal(a, v) },
+	"not_equals":   func(a interface{}, _ bool, v interface{}) bool { return !equal(a, v) },
+	"contains":     func(a interface{}, _ bool, v interface{}) bool { return contains(a, v) },
+	"not_contains": func(a interface{}, _ bool, v interface{}) bool { return !contains(a, v) },
+	"greater_than": func(a interface{}, _ bool, v interface{}) bool { return compare(a, v) > 0 },
+	"less_than":    func(a interface{}, _ bool, v interface{}) bool { return compare(a, v) < 0 },
+	"exists":       func(a interface{}, found bool, _ interface{}) bool { return found && a != nil },
+	"not_exists":   func(a interface{}, found bool, _ interface{}) bool { return !found || a == nil },
+}
+
+// Condition decides which branch a condition step continues with, by evaluating an
+// expression or by comparing a variable to a value
+type Condition struct {
+	Expression *Expression // Expression deciding, instead of Variable, Operator and Value
+	Variable   string      // Dotted path into the scope
+	Operator   string      // One of the operators, equals by default
+	Value      interface{} // Value the variable is compared to
+	Then       []string    // Steps continued with when the condition holds
+	Else       []string    // Steps continued with otherwise
+}
+
+// ParseCondition reads the condition of a condition step
+func ParseCondition(m map[string]interface{}) (*Condition, error) {
+	c := &Condition{
+		Operator: "equals",
+		Value:    m["value"],
+		Then:     stepList(m["then"]),
+		Else:     stepList(m["else"]),
+	}
+	if raw, ok := m["expression"]; ok {
+		source, _ := raw.(string)
+		if strings.TrimSpace(source) == "" {
+			return nil, fmt.Errorf("expression must be a non-empty string")
+		}
+		if m["variable"] != nil || m["operator"] != nil || m["value"] != nil {
+			return nil, fmt.Errorf("set either expression or variable, operator and value")
+		}
+		expression, err := CompileExpression(source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression: %w", err)
+		}
+		c.Expression = expression
+		return c, nil
+	}
+
+	c.Variable, _ = m["variable"].(string)
+	if c.Variable == "" {
+		return nil, fmt.Errorf("expression or variable is required")
+	}
+	if op, ok := m["operator"].(string); ok && op != "" {
+		c.Operator = op
+	}
+	if _, ok := operators[c.Operator]; !ok {
+		return nil, fmt.Errorf("unknown operator %q, available: %s", c.Operator, strings.Join(operatorNames(), ", "))
+	}
+	return c, nil
+}
+
+// Evaluate reports whether the condition holds in scope. Missing variables have no value,
+// expressions must evaluate to true or false.
+func (c *Condition) Evaluate(scope Scope) (bool, error) {
+	if c.Expression != nil {
+		v, err := c.Expression.Evaluate(scope)
+		if err != nil {
+			return false, err
+		}
+		holds, ok := v.(bool)
+		if !ok {
+			return false, fmt.Errorf("expression must evaluate to true or false, got %s", TypeOf(v))
+		}
+		return holds, nil
+	}
+	actual, found := scope.Lookup(c.Variable)
+	return operators[c.Operator](actual, found, c.Value), nil
+}
+
+func operatorNames() []string {
+	names := make([]string, 0, len(operators))
+	for name := range operators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// number converts numbers and numeric strings to float64
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// equal compares values as numbers when both are, as text when one is a string
+func equal(a, b interface{}) bool {
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			return x == y
+		}
+	}
+	if s, ok := b.(string); ok && a != nil {
+		return strings.TrimSpace(fmt.Sprint(a)) == s
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// contains reports whether a string contains a substring or a list an element
+func contains(a, v interface{}) bool {
+	switch list := a.(type) {
+	case string:
+		return strings.Contains(list, fmt.Sprint(v))
+	case []interface{}:
+		for _, item := range list {
+			if equal(item, v) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		_, ok := list[fmt.Sprint(v)]
+		return ok
+	}
+	return false
+}
+
+// compare orders values as numbers, or as text when either is not a number
+func compare(a, b interface{}) int {
+	if a == nil {
+		return 0
+	}
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
@@ -0,0 +1,267 @@
+package workflow
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/ast"
+	celops "github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Expressions decide conditions and map the outputs of steps into the input of later ones.
+// They are written in CEL (https://cel.dev) with the string, math and list extensions:
+//
+//	steps.review.output.score >= 0.8 && !input.?draft.orValue(false)
+//	"urgent" in input.tags ? config.fast_model : config.model
+//	steps.classify.output.lowerAscii().contains("refund")
+//
+// input, steps and config are maps of JSON values, so numbers read from them are doubles.
+// Reading a key that is not set is an error; has(input.draft) tests for a key and
+// input.?draft.orValue(false) supplies a default. Evaluation is bounded by a cost limit.
+
+const (
+	// maxExpressionLength is the length of expressions, in bytes
+	maxExpressionLength = 4096
+	// maxExpressionDepth is the depth expressions nest at most
+	maxExpressionDepth = 64
+	// maxExpressionCost is the cost of evaluating an expression at most, in CEL cost units
+	maxExpressionCost = 1000000
+)
+
+// expressionEnv declares the scope roots and functions of expressions
+var expressionEnv = mustExpressionEnv()
+
+func mustExpressionEnv() *cel.Env {
+	scopeMap := cel.MapType(cel.StringType, cel.DynType)
+	env, err := cel.NewEnv(
+		cel.Variable("input", scopeMap),
+		cel.Variable("steps", scopeMap),
+		cel.Variable("config", scopeMap),
+		cel.OptionalTypes(),
+		cel.CrossTypeNumericComparisons(true),
+		cel.ParserRecursionLimit(maxExpressionDepth),
+		ext.Strings(),
+		ext.Math(),
+		ext.Lists(),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("workflow: invalid expression environment: %v", err))
+	}
+	return env
+}
+
+// Expression is a compiled expression
+type Expression struct {
+	source     string
+	program    cel.Program
+	references []reference
+}
+
+// reference is a path into the scope an expression reads. It is optional when the
+// expression tests for it or falls back when it is not set.
+type reference struct {
+	path     string
+	optional bool
+}
+
+// CompileExpression parses and checks an expression, reporting the position of syntax
+// errors, unknown names and calls to unknown functions
+func CompileExpression(source string) (*Expression, error) {
+	if len(source) > maxExpressionLength {
+		return nil, fmt.Errorf("expression exceeds %d characters", maxExpressionLength)
+	}
+	checked, issues := expressionEnv.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, issuesError(issues)
+	}
+	program, err := expressionEnv.Program(checked, cel.CostLimit(maxExpressionCost))
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Expression{source: source, program: program}
+	seen := map[string]bool{}
+	collectReferences(checked.NativeRep().Expr(), nil, func(ref reference) {
+		if !seen[ref.path] {
+			seen[ref.path] = true
+			e.references = append(e.references, ref)
+		}
+	})
+	return e, nil
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Evaluate evaluates the expression in scope, returning its value as JSON values: nil,
+// bool, float64, string, []interface{} or map[string]interface{}
+func (e *Expression) Evaluate(scope Scope) (interface{}, error) {
+	out, _, err := e.program.Eval(map[string]interface{}(scope))
+	if err != nil {
+		return nil, err
+	}
+	if opt, ok := out.(*types.Optional); ok {
+		if !opt.HasValue() {
+			return nil, nil
+		}
+		out = opt.GetValue()
+	}
+	native, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("expression result is not a JSON value: %s", out.Type().TypeName())
+	}
+	return native.(*structpb.Value).AsInterface(), nil
+}
+
+// References returns the dotted paths of the scope values the expression refers to, such as
+// steps.research.output.title, in order of appearance. Paths stop before keys computed when
+// the expression is evaluated.
+func (e *Expression) References() []string {
+	refs := make([]string, len(e.references))
+	for i, ref := range e.references {
+		refs[i] = ref.path
+	}
+	return refs
+}
+
+// TypeOf names the type of a value expressions work with: null, bool, number, string, list
+// or map
+func TypeOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64, int, int64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "map"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// issuesError formats compile issues on one line each, without the source excerpts CEL adds
+func issuesError(issues *cel.Issues) error {
+	messages := make([]string, 0, len(issues.Errors()))
+	for _, e := range issues.Errors() {
+		if column := e.Location.Column(); column >= 0 {
+			messages = append(messages, fmt.Sprintf("%s at position %d", e.Message, column+1))
+		} else {
+			messages = append(messages, e.Message)
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
+
+// scopeRoots are the variables expressions read the scope through
+var scopeRoots = map[string]bool{"input": true, "steps": true, "config": true}
+
+// pathOf returns the path into the scope an expression reads, when it is a root followed by
+// constant keys, and whether reading it tolerates missing keys
+func pathOf(e ast.Expr, shadowed map[string]bool) ([]string, bool, bool) {
+	switch e.Kind() {
+	case ast.IdentKind:
+		name := e.AsIdent()
+		if scopeRoots[name] && !shadowed[name] {
+			return []string{name}, false, true
+		}
+	case ast.SelectKind:
+		sel := e.AsSelect()
+		path, optional, ok := pathOf(sel.Operand(), shadowed)
+		if ok {
+			return append(path, sel.FieldName()), optional || sel.IsTestOnly(), true
+		}
+	case ast.CallKind:
+		call := e.AsCall()
+		fn := call.FunctionName()
+		if len(call.Args()) != 2 || (fn != celops.Index && fn != celops.OptIndex && fn != celops.OptSelect) {
+			return nil, false, false
+		}
+		path, optional, ok := pathOf(call.Args()[0], shadowed)
+		if !ok {
+			return nil, false, false
+		}
+		key, ok := constantKey(call.Args()[1])
+		if !ok {
+			return nil, false, false
+		}
+		return append(path, key), optional || fn != celops.Index, true
+	}
+	return nil, false, false
+}
+
+// constantKey returns a literal map key or list index usable in a dotted path
+func constantKey(e ast.Expr) (string, bool) {
+	if e.Kind() != ast.LiteralKind {
+		return "", false
+	}
+	switch key := e.AsLiteral().(type) {
+	case types.String:
+		if key != "" && !strings.Contains(string(key), ".") {
+			return string(key), true
+		}
+	case types.Int:
+		if key >= 0 {
+			return strconv.FormatInt(int64(key), 10), true
+		}
+	case types.Uint:
+		return strconv.FormatUint(uint64(key), 10), true
+	}
+	return "", false
+}
+
+// collectReferences reports the paths into the scope read below e, the longest ones known
+// before evaluation. shadowed holds the comprehension variables hiding scope roots.
+func collectReferences(e ast.Expr, shadowed map[string]bool, report func(reference)) {
+	if path, optional, ok := pathOf(e, shadowed); ok {
+		report(reference{path: strings.Join(path, "."), optional: optional})
+		return
+	}
+	switch e.Kind() {
+	case ast.SelectKind:
+		collectReferences(e.AsSelect().Operand(), shadowed, report)
+	case ast.CallKind:
+		call := e.AsCall()
+		if call.IsMemberFunction() {
+			collectReferences(call.Target(), shadowed, report)
+		}
+		for _, arg := range call.Args() {
+			collectReferences(arg, shadowed, report)
+		}
+	case ast.ListKind:
+		for _, item := range e.AsList().Elements() {
+			collectReferences(item, shadowed, report)
+		}
+	case ast.MapKind:
+		for _, entry := range e.AsMap().Entries() {
+			collectReferences(entry.AsMapEntry().Key(), shadowed, report)
+			collectReferences(entry.AsMapEntry().Value(), shadowed, report)
+		}
+	case ast.ComprehensionKind:
+		comp := e.AsComprehension()
+		collectReferences(comp.IterRange(), shadowed, report)
+		collectReferences(comp.AccuInit(), shadowed, report)
+		inner := map[string]bool{comp.IterVar(): true, comp.AccuVar(): true}
+		if comp.HasIterVar2() {
+			inner[comp.IterVar2()] = true
+		}
+		for name := range shadowed {
+			inner[name] = true
+		}
+		collectReferences(comp.LoopCondition(), inner, report)
+		collectReferences(comp.LoopStep(), inner, report)
+		collectReferences(comp.Result(), inner, report)
+	}
+}
@@ -0,0 +1,277 @@
+package workflow
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testScope() Scope {
+	many := make([]interface{}, 2000)
+	for i := range many {
+		many[i] = float64(i)
+	}
+	return NewScope(
+		map[string]interface{}{
+			"topic": "Go",
+			"tags":  []interface{}{"urgent", "billing"},
+			"draft": false,
+			"i":     1.0,
+			"many":  many,
+		},
+		map[string]interface{}{"model": "big", "fast_model": "small", "threshold": 0.8},
+		map[string]interface{}{
+			"review":   map[string]interface{}{"score": 0.9, "title": "Refund request"},
+			"classify": "REFUND please",
+		},
+	)
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		source string
+		want   interface{}
+	}{
+		// Literals, converted to JSON values
+		{"42", 42.0},
+		{"1.5e3", 1500.0},
+		{`"a\né"`, "a\né"},
+		{"null", nil},
+		{"[1, 'x', [true]]", []interface{}{1.0, "x", []interface{}{true}}},
+		{"{'a': 1, 'b c': null}", map[string]interface{}{"a": 1.0, "b c": nil}},
+		{"[]", []interface{}{}},
+
+		// Scope access
+		{"input.topic", "Go"},
+		{"input['topic']", "Go"},
+		{"input.tags[0]", "urgent"},
+		{"input.tags[input.i]", "billing"},
+		{"steps.review.output.score >= config.threshold", true},
+		{"'urgent' in input.tags ? config.fast_model : config.model", "small"},
+		{"'topic' in input", true},
+		{"has(input.topic) && !has(input.missing)", true},
+		{"input.?missing.orValue('default')", "default"},
+		{"input.?missing", nil},
+		{"input.?topic", "Go"},
+		{"input[?'tags'][?5].orValue('none')", "none"},
+		{"!input.?draft.orValue(true)", true},
+
+		// Operators
+		{"1 + 2 * 3", 7.0},
+		{"input.i == 1", true},
+		{"steps.review.output.score > 0", true},
+		{"7.0 / 2.0", 3.5},
+		{"[1] + [2]", []interface{}{1.0, 2.0}},
+		{"'go' + 'lang'", "golang"},
+		{"false && 1 / 0 == 1", false},
+
+		// Functions and extensions
+		{"size('héllo')", 5.0},
+		{"size(input.tags)", 2.0},
+		{"steps.classify.output.lowerAscii().contains('refund')", true},
+		{"steps.review.output.title.startsWith('Refund')", true},
+		{"steps.review.output.title.matches('^R.*t$')", true},
+		{"'  x '.trim()", "x"},
+		{"'a,b'.split(',')", []interface{}{"a", "b"}},
+		{"['a', 'b'].join('-')", "a-b"},
+		{"string(1.5)", "1.5"},
+		{"int(-2.7)", -2.0},
+		{"math.greatest(3, 1, 2)", 3.0},
+		{"math.abs(-3)", 3.0},
+		{"input.tags.map(t, t.upperAscii())", []interface{}{"URGENT", "BILLING"}},
+		{"input.tags.exists(t, t == 'billing')", true},
+		{"input.tags.filter(t, t != 'urgent')", []interface{}{"billing"}},
+		{"[2, 1, 2].distinct()", []interface{}{2.0, 1.0}},
+	}
+
+	scope := testScope()
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expression, err := CompileExpression(tt.source)
+			if err != nil {
+				t.Fatalf("CompileExpression() error = %v", err)
+			}
+			got, err := expression.Evaluate(scope)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileExpressionErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		err    string
+	}{
+		{"", "Syntax error"},
+		{"1 +", "at position 4"},
+		{"(1", "at position 3"},
+		{"foo", "undeclared reference to 'foo'"},
+		{"nope(1)", "undeclared reference to 'nope'"},
+		{"size(1, 2)", "found no matching overload for 'size'"},
+		{"1 + 'a'", "found no matching overload for '_+_'"},
+		{"'abc", "at position 1"},
+		{strings.Repeat("(", maxExpressionDepth+1) + "1" + strings.Repeat(")", maxExpressionDepth+1), "recursion"},
+		{strings.Repeat("1+", maxExpressionLength/2) + "1", "exceeds 4096 characters"},
+	}
+
+	for _, tt := range tests {
+		name := tt.source
+		if len(name) > 40 {
+			name = name[:40]
+		}
+		t.Run(name, func(t *testing.T) {
+			_, err := CompileExpression(tt.source)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("CompileExpression() error = %v, want ...%s...", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		err    string
+	}{
+		{"input.missing", "no such key: missing"},
+		{"steps.nope.output", "no such key: nope"},
+		{"input.tags[5]", "index out of bounds"},
+		{"input.topic + 1", "no such overload"},
+		{"1 / 0", "division by zero"},
+		{"input.topic.x", "no such key"},
+		{"input.many.all(x, input.many.all(y, x + y >= 0.0))", "cost limit exceeded"},
+		{"type(input)", "not a JSON value"},
+	}
+
+	scope := testScope()
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expression, err := CompileExpression(tt.source)
+			if err != nil {
+				t.Fatalf("CompileExpression() error = %v", err)
+			}
+			_, err = expression.Evaluate(scope)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Evaluate() error = %v, want ...%s...", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestExpressionReferences(t *testing.T) {
+	tests := []struct {
+		source string
+		want   []reference
+	}{
+		{"1 + 2", nil},
+		{
+			"steps.review.output.score >= 0.8 && !input.?draft.orValue(false)",
+			[]reference{{path: "steps.review.output.score"}, {path: "input.draft", optional: true}},
+		},
+		{
+			"input.tags[0] + input.tags[input.i]",
+			[]reference{{path: "input.tags.0"}, {path: "input.tags"}, {path: "input.i"}},
+		},
+		{
+			"size(config.items) > 0 ? steps.a.output['x.y'] : null",
+			[]reference{{path: "config.items"}, {path: "steps.a.output"}},
+		},
+		{"has(config.model) ? config.model : 'small'", []reference{{path: "config.model", optional: true}}},
+		{"config[?'lang'].orValue('en')", []reference{{path: "config.lang", optional: true}}},
+		{"[input.a, {'k': input.a}]", []reference{{path: "input.a"}}},
+		{"input.tags.map(input, input + steps.a.output)", []reference{{path: "input.tags"}, {path: "steps.a.output"}}},
+		{"steps", []reference{{path: "steps"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expression, err := CompileExpression(tt.source)
+			if err != nil {
+				t.Fatalf("CompileExpression() error = %v", err)
+			}
+			if got := expression.references; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("references = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "null"},
+		{true, "bool"},
+		{1.5, "number"},
+		{"a", "string"},
+		{[]interface{}{}, "list"},
+		{map[string]interface{}{}, "map"},
+	}
+
+	for _, tt := range tests {
+		if got := TypeOf(tt.value); got != tt.want {
+			t.Errorf("TypeOf(%#v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestConditionEvaluate(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition map[string]interface{}
+		want      bool
+		err       string
+	}{
+		{"expression", map[string]interface{}{"expression": "steps.review.output.score > 0.5"}, true, ""},
+		{"expression not boolean", map[string]interface{}{"expression": "input.topic"}, false, "must evaluate to true or false, got string"},
+		{"equals by default", map[string]interface{}{"variable": "input.topic", "value": "Go"}, true, ""},
+		{"contains", map[string]interface{}{"variable": "input.tags", "operator": "contains", "value": "urgent"}, true, ""},
+		{"greater than", map[string]interface{}{"variable": "steps.review.output.score", "operator": "greater_than", "value": 0.95}, false, ""},
+		{"not exists", map[string]interface{}{"variable": "input.missing", "operator": "not_exists"}, true, ""},
+	}
+
+	scope := testScope()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := ParseCondition(tt.condition)
+			if err != nil {
+				t.Fatalf("ParseCondition() error = %v", err)
+			}
+			got, err := condition.Evaluate(scope)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("Evaluate() error = %v, want ...%s...", err, tt.err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Evaluate() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConditionErrors(t *testing.T) {
+	tests := []struct {
+		condition map[string]interface{}
+		err       string
+	}{
+		{map[string]interface{}{}, "expression or variable is required"},
+		{map[string]interface{}{"expression": " "}, "expression must be a non-empty string"},
+		{map[string]interface{}{"expression": "true", "variable": "input.x"}, "set either expression or variable"},
+		{map[string]interface{}{"expression": "1 +"}, "invalid expression"},
+		{map[string]interface{}{"variable": "input.x", "operator": "near"}, `unknown operator "near"`},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseCondition(tt.condition); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("ParseCondition(%v) error = %v, want ...%s...", tt.condition, err, tt.err)
+		}
+	}
+}
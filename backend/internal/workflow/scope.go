@@ -0,0 +1,150 @@
+package workflow
+
+import (
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"agent-platform/internal/prompt"
+)
+
+// Scope holds the values step inputs and conditions refer to:
+//
+//	input   the input the execution was started with
+//	steps   the finished steps by ID, their result under output
+//	config  the workflow's config
+type Scope map[string]interface{}
+
+// NewScope builds the scope of an execution from its input, the workflow's config and the
+// outputs of the steps finished so far
+func NewScope(input, config map[string]interface{}, outputs map[string]interface{}) Scope {
+	steps := make(map[string]interface{}, len(outputs))
+	for id, output := range outputs {
+		steps[id] = map[string]interface{}{"output": output}
+	}
+	return Scope{
+		"input":  orEmpty(input),
+		"steps":  steps,
+		"config": orEmpty(config),
+	}
+}
+
+// Lookup returns the value at a dotted path such as steps.research.output.title, list
+// elements being selected by index. ok is false when the path leads nowhere.
+func (s Scope) Lookup(path string) (interface{}, bool) {
+	var current interface{} = map[string]interface{}(s)
+	for _, key := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[key]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			current = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// Render renders a template of step input, written in Go text/template syntax such as
+// {{.steps.research.output}}, with the functions of prompt templates. Referring to values
+// missing from the scope is an error.
+func (s Scope) Render(text string) (string, error) {
+	tmpl, err := ParseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, map[string]interface{}(s)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// ParseTemplate parses a template of step input
+func ParseTemplate(text string) (*template.Template, error) {
+	return template.New("input").Funcs(prompt.Funcs()).Option("missingkey=error").Parse(text)
+}
+
+// TemplateReferences parses a template of step input and returns the dotted paths of the
+// scope values it refers to. Values referred to relative to with or range blocks are not
+// known statically and left out.
+func TemplateReferences(text string) ([]string, error) {
+	tmpl, err := ParseTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	var refs []string
+	for _, named := range tmpl.Templates() {
+		if named.Tree != nil {
+			walkTemplate(named.Tree.Root, true, &refs)
+		}
+	}
+	return refs, nil
+}
+
+// walkTemplate collects the scope values used below node. rootDot tells whether dot is the
+// scope, which stops being the case inside with and range blocks.
+func walkTemplate(node parse.Node, rootDot bool, refs *[]string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			walkTemplate(child, rootDot, refs)
+		}
+	case *parse.ActionNode:
+		walkTemplate(n.Pipe, rootDot, refs)
+	case *parse.TemplateNode:
+		walkTemplate(n.Pipe, rootDot, refs)
+	case *parse.IfNode:
+		walkTemplate(n.Pipe, rootDot, refs)
+		walkTemplate(n.List, rootDot, refs)
+		walkTemplate(n.ElseList, rootDot, refs)
+	case *parse.WithNode:
+		walkTemplate(n.Pipe, rootDot, refs)
+		walkTemplate(n.List, false, refs)
+		walkTemplate(n.ElseList, rootDot, refs)
+	case *parse.RangeNode:
+		walkTemplate(n.Pipe, rootDot, refs)
+		walkTemplate(n.List, false, refs)
+		walkTemplate(n.ElseList, rootDot, refs)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				walkTemplate(arg, rootDot, refs)
+			}
+		}
+	case *parse.FieldNode:
+		if rootDot {
+			*refs = append(*refs, strings.Join(n.Ident, "."))
+		}
+	case *parse.VariableNode:
+		// $ is always the scope
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			*refs = append(*refs, strings.Join(n.Ident[1:], "."))
+		}
+	case *parse.ChainNode:
+		walkTemplate(n.Node, rootDot, refs)
+	}
+}
+
+func orEmpty(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
@@ -0,0 +1,247 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Problem is a problem found in a workflow definition, Field locating it by step ID such
+// as steps.review.condition
+type Problem struct {
+	Field   string
+	Message string
+}
+
+// validator collects the problems of a workflow definition
+type validator struct {
+	problems []Problem
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate checks the steps of a workflow before it runs: step IDs and types, references
+// to unknown steps, steps never reached from the first one, cycles other than loops through
+// conditions, conditions, input templates and expressions that do not parse, and references
+// to values of the scope that will not be there when a step runs. Agents are not looked up.
+func Validate(steps []Step, config map[string]interface{}) []Problem {
+	v := &validator{}
+
+	ids := map[string]bool{}
+	for i, step := range steps {
+		if step.ID == "" {
+			v.add(fmt.Sprintf("steps[%d].id", i), "is required")
+			continue
+		}
+		if strings.Contains(step.ID, ".") {
+			v.add(stepField(step.ID, "id"), "must not contain dots")
+		}
+		if ids[step.ID] {
+			v.add(stepField(step.ID, "id"), "is used by several steps")
+		}
+		ids[step.ID] = true
+	}
+
+	graph := NewGraph(steps)
+	for i := range steps {
+		if steps[i].ID != "" {
+			v.checkStep(&steps[i], ids)
+		}
+	}
+
+	// Steps without an ID were reported above
+	reachable := graph.Reachable()
+	for _, id := range graph.Steps() {
+		if id == "" {
+			continue
+		}
+		if !reachable[id] {
+			v.add(stepField(id, ""), "is never reached from the first step %q", graph.Entry())
+		}
+		for _, to := range graph.Next(id) {
+			if graph.IsCycle(id, to) {
+				v.add(stepField(id, "next_steps"), "leads back to step %q, making a cycle that never finishes; repeat steps with a condition instead", to)
+			}
+		}
+	}
+
+	for _, id := range graph.Steps() {
+		if id != "" {
+			v.checkReferences(graph, graph.Step(id), config)
+		}
+	}
+
+	return v.problems
+}
+
+// checkStep checks the settings of a step and its references to other steps
+func (v *validator) checkStep(step *Step, ids map[string]bool) {
+	switch step.Type {
+	case TypeAgent:
+		if step.AgentID == "" {
+			v.add(stepField(step.ID, "agent_id"), "is required for agent steps")
+		}
+		switch output := step.ConfigString("output"); output {
+		case "", "text", "json":
+		default:
+			v.add(stepField(step.ID, "config.output"), "must be text or json, got %q", output)
+		}
+		if _, ok := step.Config["inputs"]; ok {
+			if _, err := step.InputMappings(); err != nil {
+				v.add(stepField(step.ID, "config.inputs"), "%v", err)
+			}
+			if _, ok := step.Config["input"]; ok {
+				v.add(stepField(step.ID, "config.input"), "cannot be set along with config.inputs")
+			}
+		}
+	case TypeCondition:
+		if len(step.NextSteps) > 0 {
+			v.add(stepField(step.ID, "next_steps"), "is not used by condition steps, set condition.then and condition.else")
+		}
+		condition, err := ParseCondition(step.Condition)
+		if err != nil {
+			v.add(stepField(step.ID, "condition"), "%v", err)
+			break
+		}
+		if len(condition.Then) == 0 && len(condition.Else) == 0 {
+			v.add(stepField(step.ID, "condition"), "must continue with steps in then or else")
+		}
+	case TypeParallel:
+		if len(step.NextSteps) == 0 {
+			v.add(stepField(step.ID, "next_steps"), "must list the steps to start")
+		}
+	default:
+		v.add(stepField(step.ID, "type"), "must be agent, condition or parallel, got %q", step.Type)
+	}
+
+	fields := []string{"next_steps"}
+	refs := [][]string{step.NextSteps}
+	if step.Type == TypeCondition {
+		fields = []string{"condition.then", "condition.else"}
+		refs = [][]string{stepList(step.Condition["then"]), stepList(step.Condition["else"])}
+	}
+	for i, targets := range refs {
+		for _, target := range targets {
+			if !ids[target] {
+				v.add(stepField(step.ID, fields[i]), "refers to unknown step %q", target)
+			}
+		}
+	}
+}
+
+// checkReferences checks the scope values a step refers to in its input templates, input
+// mappings and condition
+func (v *validator) checkReferences(graph *Graph, step *Step, config map[string]interface{}) {
+	if step.Type == TypeAgent {
+		for _, key := range []string{"input", "context"} {
+			field := stepField(step.ID, "config."+key)
+			raw, ok := step.Config[key]
+			if !ok {
+				continue
+			}
+			text, isString := raw.(string)
+			if !isString {
+				v.add(field, "must be a template string")
+				continue
+			}
+			refs, err := TemplateReferences(text)
+			if err != nil {
+				v.add(field, "invalid template: %v", err)
+				continue
+			}
+			for _, ref := range refs {
+				v.checkReference(graph, step, field, ref, config, false)
+			}
+		}
+
+		mappings, _ := step.InputMappings()
+		for _, name := range sortedNames(mappings) {
+			v.checkExpression(graph, step, stepField(step.ID, "config.inputs."+name), mappings[name], config)
+		}
+	}
+
+	if step.Type == TypeCondition {
+		condition, err := ParseCondition(step.Condition)
+		switch {
+		case err != nil:
+		case condition.Expression != nil:
+			v.checkExpressionReferences(graph, step, stepField(step.ID, "condition.expression"), condition.Expression, config)
+		default:
+			v.checkReference(graph, step, stepField(step.ID, "condition.variable"), condition.Variable, config, false)
+		}
+	}
+}
+
+// checkExpression checks that an expression compiles and the scope values it refers to
+func (v *validator) checkExpression(graph *Graph, step *Step, field, source string, config map[string]interface{}) {
+	expression, err := CompileExpression(source)
+	if err != nil {
+		v.add(field, "invalid expression: %v", err)
+		return
+	}
+	v.checkExpressionReferences(graph, step, field, expression, config)
+}
+
+// checkExpressionReferences checks the scope values an expression refers to. Config values
+// an expression tests for or falls back from may be left unset.
+func (v *validator) checkExpressionReferences(graph *Graph, step *Step, field string, expression *Expression, config map[string]interface{}) {
+	for _, ref := range expression.references {
+		v.checkReference(graph, step, field, ref.path, config, ref.optional)
+	}
+}
+
+// checkReference checks a reference to a value of the scope: input values are only known
+// at run time, config values must be set unless optional, as they are where expressions use
+// has() or ?. on them, and step outputs must come from steps that finish before, with
+// fields only when they output JSON
+func (v *validator) checkReference(graph *Graph, step *Step, field, ref string, config map[string]interface{}, optional bool) {
+	path := strings.Split(ref, ".")
+	for _, part := range path {
+		if part == "" {
+			v.add(field, "invalid reference %q", ref)
+			return
+		}
+	}
+
+	switch path[0] {
+	case "input":
+	case "config":
+		if len(path) > 1 && !optional {
+			if _, ok := (Scope{"config": orEmpty(config)}).Lookup(ref); !ok {
+				v.add(field, "refers to %s, which is not set in the workflow config", ref)
+			}
+		}
+	case "steps":
+		if len(path) < 2 {
+			return
+		}
+		target := graph.Step(path[1])
+		switch {
+		case target == nil:
+			v.add(field, "refers to unknown step %q", path[1])
+		case target.ID == step.ID:
+			v.add(field, "refers to the step's own output")
+		case !graph.Upstream(step.ID)[target.ID]:
+			v.add(field, "refers to step %q, which does not run before this step", target.ID)
+		case len(path) > 2 && path[2] != "output":
+			v.add(field, "refers to %s, steps only provide output", ref)
+		case len(path) > 2 && target.Type == TypeParallel:
+			v.add(field, "refers to the output of parallel step %q, which has none", target.ID)
+		case len(path) > 3 && target.Type == TypeCondition:
+			v.add(field, "refers to a field of condition step %q, which outputs true or false", target.ID)
+		case len(path) > 3 && target.Type == TypeAgent && target.ConfigString("output") != "json":
+			v.add(field, "refers to a field of the output of step %q, which outputs text; set its config.output to json", target.ID)
+		}
+	default:
+		v.add(field, "refers to unknown value %q, available: input, steps, config", path[0])
+	}
+}
+
+// stepField returns the name of a field of a step in problems
+func stepField(id, field string) string {
+	if field == "" {
+		return "steps." + id
+	}
+	return "steps." + id + "." + field
+}
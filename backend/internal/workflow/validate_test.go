@@ -0,0 +1,268 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+)
+
+func agentStep(id string, next ...string) Step {
+	return Step{ID: id, Type: TypeAgent, AgentID: "agent-" + id, NextSteps: next}
+}
+
+func withConfig(step Step, config map[string]interface{}) Step {
+	step.Config = config
+	return step
+}
+
+func conditionStep(id string, condition map[string]interface{}) Step {
+	return Step{ID: id, Type: TypeCondition, Condition: condition}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		steps  []Step
+		config map[string]interface{}
+		// want holds the field and a fragment of the message of each problem, in order
+		want [][2]string
+	}{
+		{
+			name: "linear",
+			steps: []Step{
+				agentStep("research", "write"),
+				withConfig(agentStep("write"), map[string]interface{}{"input": "{{.steps.research.output}} in {{.input.lang}}"}),
+			},
+		},
+		{
+			name: "loop through a condition",
+			steps: []Step{
+				agentStep("draft", "review"),
+				conditionStep("review", map[string]interface{}{
+					"expression": "steps.draft.output.contains('TODO')",
+					"then":       []interface{}{"draft"},
+					"else":       []interface{}{"publish"},
+				}),
+				agentStep("publish"),
+			},
+		},
+		{
+			name: "parallel branches joining",
+			steps: []Step{
+				{ID: "fanout", Type: TypeParallel, NextSteps: []string{"en", "fr"}},
+				agentStep("en", "merge"),
+				agentStep("fr", "merge"),
+				withConfig(agentStep("merge"), map[string]interface{}{"input": "{{.steps.en.output}} {{.steps.fr.output}}"}),
+			},
+		},
+		{
+			name:  "cycle without a condition",
+			steps: []Step{agentStep("a", "b"), agentStep("b", "c"), agentStep("c", "a")},
+			want:  [][2]string{{"steps.c.next_steps", `leads back to step "a"`}},
+		},
+		{
+			name:  "step leading to itself",
+			steps: []Step{agentStep("a", "a")},
+			want:  [][2]string{{"steps.a.next_steps", `leads back to step "a"`}},
+		},
+		{
+			name:  "unreachable step",
+			steps: []Step{agentStep("a"), agentStep("orphan")},
+			want:  [][2]string{{"steps.orphan", `never reached from the first step "a"`}},
+		},
+		{
+			name: "unreachable loop",
+			steps: []Step{
+				agentStep("a"),
+				agentStep("b", "check"),
+				conditionStep("check", map[string]interface{}{"variable": "input.done", "then": "b"}),
+			},
+			want: [][2]string{
+				{"steps.b", "never reached"},
+				{"steps.check", "never reached"},
+			},
+		},
+		{
+			name:  "unknown next step",
+			steps: []Step{agentStep("a", "missing")},
+			want:  [][2]string{{"steps.a.next_steps", `unknown step "missing"`}},
+		},
+		{
+			name: "unknown condition branch",
+			steps: []Step{
+				agentStep("a", "check"),
+				conditionStep("check", map[string]interface{}{"variable": "input.ok", "then": "a", "else": "missing"}),
+			},
+			want: [][2]string{{"steps.check.condition.else", `unknown step "missing"`}},
+		},
+		{
+			name:  "step IDs",
+			steps: []Step{agentStep("a", "b.c"), agentStep("b.c"), agentStep("a"), {Type: TypeAgent, AgentID: "x"}},
+			want: [][2]string{
+				{"steps.b.c.id", "must not contain dots"},
+				{"steps.a.id", "used by several steps"},
+				{"steps[3].id", "is required"},
+			},
+		},
+		{
+			name:  "step settings",
+			steps: []Step{{ID: "a", Type: "loop", NextSteps: []string{"b"}}, {ID: "b", Type: TypeAgent, NextSteps: []string{"c"}}, {ID: "c", Type: TypeParallel}},
+			want: [][2]string{
+				{"steps.a.type", `got "loop"`},
+				{"steps.b.agent_id", "is required"},
+				{"steps.c.next_steps", "must list the steps to start"},
+			},
+		},
+		{
+			name: "condition settings",
+			steps: []Step{
+				agentStep("a", "check", "empty"),
+				conditionStep("check", map[string]interface{}{"variable": "input.ok", "operator": "about", "then": "a"}),
+				conditionStep("empty", map[string]interface{}{"expression": "true"}),
+			},
+			want: [][2]string{
+				{"steps.check.condition", `unknown operator "about"`},
+				{"steps.empty.condition", "must continue with steps"},
+			},
+		},
+		{
+			name: "reference to a later step",
+			steps: []Step{
+				withConfig(agentStep("a", "b"), map[string]interface{}{"input": "{{.steps.b.output}}"}),
+				agentStep("b"),
+			},
+			want: [][2]string{{"steps.a.config.input", `step "b", which does not run before`}},
+		},
+		{
+			name: "reference to a parallel branch",
+			steps: []Step{
+				{ID: "fanout", Type: TypeParallel, NextSteps: []string{"en", "fr"}},
+				agentStep("en"),
+				withConfig(agentStep("fr"), map[string]interface{}{"input": "{{.steps.en.output}}"}),
+			},
+			want: [][2]string{{"steps.fr.config.input", `step "en", which does not run before`}},
+		},
+		{
+			name:  "reference to the step's own output",
+			steps: []Step{withConfig(agentStep("a"), map[string]interface{}{"context": "{{.steps.a.output}}"})},
+			want:  [][2]string{{"steps.a.config.context", "own output"}},
+		},
+		{
+			name: "reference to unknown values",
+			steps: []Step{
+				agentStep("a", "b"),
+				withConfig(agentStep("b"), map[string]interface{}{"input": "{{.steps.nope.output}} {{.secrets.key}} {{.steps.a.result}}"}),
+			},
+			want: [][2]string{
+				{"steps.b.config.input", `unknown step "nope"`},
+				{"steps.b.config.input", `unknown value "secrets"`},
+				{"steps.b.config.input", "steps only provide output"},
+			},
+		},
+		{
+			name:   "reference to workflow config",
+			steps:  []Step{withConfig(agentStep("a"), map[string]interface{}{"input": "{{.config.tone}} {{.config.style}}"})},
+			config: map[string]interface{}{"tone": "formal"},
+			want:   [][2]string{{"steps.a.config.input", "config.style, which is not set"}},
+		},
+		{
+			name: "reference to fields of outputs",
+			steps: []Step{
+				agentStep("text", "json"),
+				withConfig(agentStep("json", "fanout"), map[string]interface{}{"output": "json"}),
+				{ID: "fanout", Type: TypeParallel, NextSteps: []string{"check"}},
+				conditionStep("check", map[string]interface{}{"variable": "steps.json.output.score", "then": "use"}),
+				withConfig(agentStep("use"), map[string]interface{}{
+					"input": "{{.steps.json.output.title}} {{.steps.text.output.title}} {{.steps.fanout.output}} {{.steps.check.output.x}}",
+				}),
+			},
+			want: [][2]string{
+				{"steps.use.config.input", `step "text", which outputs text`},
+				{"steps.use.config.input", `parallel step "fanout"`},
+				{"steps.use.config.input", `condition step "check"`},
+			},
+		},
+		{
+			name:  "invalid template",
+			steps: []Step{withConfig(agentStep("a"), map[string]interface{}{"input": "{{.input.topic"})},
+			want:  [][2]string{{"steps.a.config.input", "invalid template"}},
+		},
+		{
+			name: "input mappings",
+			steps: []Step{
+				agentStep("a", "b"),
+				withConfig(agentStep("b"), map[string]interface{}{"inputs": map[string]interface{}{
+					"text":  "steps.a.output",
+					"lang":  "config.?lang.orValue('en')",
+					"later": "steps.b.output",
+					"bad":   "1 +",
+				}}),
+			},
+			want: [][2]string{
+				{"steps.b.config.inputs.bad", "invalid expression"},
+				{"steps.b.config.inputs.later", "own output"},
+			},
+		},
+		{
+			name: "inputs along with input",
+			steps: []Step{withConfig(agentStep("a"), map[string]interface{}{
+				"input":  "{{.input.topic}}",
+				"inputs": map[string]interface{}{"topic": "input.topic"},
+			})},
+			want: [][2]string{{"steps.a.config.input", "cannot be set along with config.inputs"}},
+		},
+		{
+			name: "condition expression reference",
+			steps: []Step{
+				agentStep("a", "check"),
+				conditionStep("check", map[string]interface{}{"expression": "steps.later.output == 'ok'", "then": "later"}),
+				agentStep("later"),
+			},
+			want: [][2]string{{"steps.check.condition.expression", `step "later", which does not run before`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := Validate(tt.steps, tt.config)
+			if len(problems) != len(tt.want) {
+				t.Fatalf("Validate() = %v, want %d problems", problems, len(tt.want))
+			}
+			for i, want := range tt.want {
+				got := problems[i]
+				if got.Field != want[0] || !strings.Contains(got.Message, want[1]) {
+					t.Errorf("problem %d = %s: %s, want %s: ...%s...", i, got.Field, got.Message, want[0], want[1])
+				}
+			}
+		})
+	}
+}
+
+func TestGraph(t *testing.T) {
+	graph := NewGraph([]Step{
+		agentStep("draft", "review"),
+		conditionStep("review", map[string]interface{}{"variable": "input.ok", "then": "publish", "else": "draft"}),
+		agentStep("publish"),
+	})
+
+	if got := graph.Entry(); got != "draft" {
+		t.Errorf("Entry() = %q, want draft", got)
+	}
+	if !graph.IsLoop("review", "draft") {
+		t.Error("IsLoop(review, draft) = false, want true")
+	}
+	if graph.IsCycle("review", "draft") {
+		t.Error("IsCycle(review, draft) = true, want false")
+	}
+	if preds := graph.Predecessors("draft"); len(preds) != 0 {
+		t.Errorf("Predecessors(draft) = %v, want none", preds)
+	}
+	if up := graph.Upstream("publish"); !up["draft"] || !up["review"] || len(up) != 2 {
+		t.Errorf("Upstream(publish) = %v, want draft and review", up)
+	}
+	if !graph.Terminal("publish") || graph.Terminal("review") {
+		t.Error("Terminal() should hold for publish only")
+	}
+	if down := graph.Downstream("review"); len(down) != 2 {
+		t.Errorf("Downstream(review) = %v, want review and publish", down)
+	}
+}
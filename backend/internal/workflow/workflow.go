@@ -0,0 +1,357 @@
+// Package workflow defines the steps of workflows and the graph executions move through.
+//
+// Workflows are stored as a list of steps, JSON objects such as:
+//
+//	{"id": "research", "type": "agent", "agent_id": "...", "config": {"input": "{{.input.topic}}"}, "next_steps": ["review"]}
+//	{"id": "review", "type": "condition", "condition": {"expression": "steps.research.output.contains('TODO')", "then": ["research"], "else": ["publish"]}}
+//	{"id": "publish", "type": "agent", "agent_id": "...", "config": {"inputs": {"text": "steps.research.output", "lang": "input.?lang.orValue('en')"}}}
+//	{"id": "fanout", "type": "parallel", "next_steps": ["en", "fr"]}
+//
+// Executions start with the first step. Agent steps call an agent with the input rendered
+// from the config.input template, or the values config.inputs maps expressions to, and
+// continue with their next steps. Condition steps continue with the steps of condition.then or
+// condition.else, depending on condition.expression or on comparing condition.variable to
+// condition.value. Parallel steps start all their next steps at once. A step starts once
+// every step leading to it has finished, so branches join at the first step they share;
+// steps only reached through branches that were not taken are skipped. Conditions may lead
+// back to an earlier step to repeat part of the workflow, which makes a loop.
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Step types
+const (
+	TypeAgent     = "agent"
+	TypeCondition = "condition"
+	TypeParallel  = "parallel"
+)
+
+// Step is a step of a workflow
+type Step struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name,omitempty"`
+	Type      string                 `json:"type"`
+	AgentID   string                 `json:"agent_id,omitempty"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+	NextSteps []string               `json:"next_steps,omitempty"`
+	Condition map[string]interface{} `json:"condition,omitempty"`
+}
+
+// ParseSteps decodes the steps stored with a workflow
+func ParseSteps(stored []map[string]interface{}) ([]Step, error) {
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+	var steps []Step
+	if err := json.Unmarshal(data, &steps); err != nil {
+		return nil, fmt.Errorf("invalid steps: %w", err)
+	}
+	return steps, nil
+}
+
+// ConfigString returns a string setting of a step, empty when it is not set
+func (s *Step) ConfigString(key string) string {
+	v, _ := s.Config[key].(string)
+	return v
+}
+
+// InputMappings returns the expressions of config.inputs by name, nil when the step maps
+// no inputs
+func (s *Step) InputMappings() (map[string]string, error) {
+	raw, ok := s.Config["inputs"]
+	if !ok {
+		return nil, nil
+	}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("inputs must map names to expressions")
+	}
+	mappings := make(map[string]string, len(m))
+	for name, v := range m {
+		source, ok := v.(string)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("inputs must map names to expressions")
+		}
+		mappings[name] = source
+	}
+	return mappings, nil
+}
+
+// MapInputs evaluates the expressions of config.inputs in scope, returning the values by
+// name. It returns nil when the step maps no inputs.
+func (s *Step) MapInputs(scope Scope) (map[string]interface{}, error) {
+	mappings, err := s.InputMappings()
+	if err != nil || mappings == nil {
+		return nil, err
+	}
+	values := make(map[string]interface{}, len(mappings))
+	for _, name := range sortedNames(mappings) {
+		expression, err := CompileExpression(mappings[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid inputs.%s: %w", name, err)
+		}
+		if values[name], err = expression.Evaluate(scope); err != nil {
+			return nil, fmt.Errorf("failed to evaluate inputs.%s: %w", name, err)
+		}
+	}
+	return values, nil
+}
+
+// Graph links the steps of a workflow
+type Graph struct {
+	steps  map[string]*Step
+	order  []string
+	next   map[string][]string        // Steps each step may continue with
+	preds  map[string][]string        // Steps leading to each step, loops excepted
+	loops  map[string]map[string]bool // Edges from conditions back to earlier steps
+	cycles map[string]map[string]bool // Other edges back to earlier steps
+}
+
+// NewGraph links steps. References to unknown steps are ignored.
+func NewGraph(steps []Step) *Graph {
+	g := &Graph{
+		steps:  make(map[string]*Step, len(steps)),
+		next:   make(map[string][]string, len(steps)),
+		preds:  make(map[string][]string, len(steps)),
+		loops:  make(map[string]map[string]bool),
+		cycles: make(map[string]map[string]bool),
+	}
+	for i := range steps {
+		step := &steps[i]
+		if _, dup := g.steps[step.ID]; dup {
+			continue
+		}
+		g.steps[step.ID] = step
+		g.order = append(g.order, step.ID)
+	}
+
+	for _, id := range g.order {
+		seen := map[string]bool{}
+		for _, to := range g.references(g.steps[id]) {
+			if _, ok := g.steps[to]; ok && !seen[to] {
+				seen[to] = true
+				g.next[id] = append(g.next[id], to)
+			}
+		}
+	}
+
+	// Edges from a condition back to a step leading to it repeat part of the workflow
+	for from, targets := range g.backEdges() {
+		if g.steps[from].Type == TypeCondition {
+			g.loops[from] = targets
+		} else {
+			g.cycles[from] = targets
+		}
+	}
+	for _, id := range g.order {
+		for _, to := range g.next[id] {
+			if !g.IsLoop(id, to) {
+				g.preds[to] = append(g.preds[to], id)
+			}
+		}
+	}
+
+	return g
+}
+
+// backEdges returns the edges leading back to a step that leads to them, found by walking
+// the graph depth first from the first step, then from the steps nothing leads to
+func (g *Graph) backEdges() map[string]map[string]bool {
+	referenced := map[string]bool{}
+	for _, id := range g.order {
+		for _, to := range g.next[id] {
+			referenced[to] = true
+		}
+	}
+	starts := []string{g.Entry()}
+	for _, id := range g.order {
+		if !referenced[id] {
+			starts = append(starts, id)
+		}
+	}
+	starts = append(starts, g.order...)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	marks := map[string]int{}
+	back := map[string]map[string]bool{}
+	var visit func(id string)
+	visit = func(id string) {
+		marks[id] = visiting
+		for _, to := range g.next[id] {
+			switch marks[to] {
+			case visiting:
+				if back[id] == nil {
+					back[id] = map[string]bool{}
+				}
+				back[id][to] = true
+			case 0:
+				visit(to)
+			}
+		}
+		marks[id] = visited
+	}
+	for _, id := range starts {
+		if marks[id] == 0 {
+			visit(id)
+		}
+	}
+	return back
+}
+
+// references returns the steps a step refers to as its successors
+func (g *Graph) references(step *Step) []string {
+	if step.Type != TypeCondition {
+		return step.NextSteps
+	}
+	var refs []string
+	for _, key := range []string{"then", "else"} {
+		refs = append(refs, stepList(step.Condition[key])...)
+	}
+	return refs
+}
+
+// Entry returns the step executions start with, the first one
+func (g *Graph) Entry() string {
+	if len(g.order) == 0 {
+		return ""
+	}
+	return g.order[0]
+}
+
+// Step returns the step with the given ID, nil when there is none
+func (g *Graph) Step(id string) *Step {
+	return g.steps[id]
+}
+
+// Steps returns the IDs of the steps in their configured order
+func (g *Graph) Steps() []string {
+	return g.order
+}
+
+// Next returns the steps a step may continue with
+func (g *Graph) Next(id string) []string {
+	return g.next[id]
+}
+
+// Predecessors returns the steps leading to a step, edges closing loops excepted
+func (g *Graph) Predecessors(id string) []string {
+	return g.preds[id]
+}
+
+// IsLoop reports whether the edge from a condition to a step closes a loop
+func (g *Graph) IsLoop(from, to string) bool {
+	return g.loops[from][to]
+}
+
+// IsCycle reports whether the edge from a step that is not a condition leads back to a step
+// leading to it. Such cycles never finish: the step waits for itself.
+func (g *Graph) IsCycle(from, to string) bool {
+	return g.cycles[from][to]
+}
+
+// Reachable returns the steps reachable from the entry, loops included
+func (g *Graph) Reachable() map[string]bool {
+	seen := map[string]bool{}
+	if len(g.order) == 0 {
+		return seen
+	}
+	stack := []string{g.Entry()}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		stack = append(stack, g.next[id]...)
+	}
+	return seen
+}
+
+// Upstream returns the steps that finish before a step starts, those leading to it
+// directly or indirectly
+func (g *Graph) Upstream(id string) map[string]bool {
+	seen := map[string]bool{}
+	stack := append([]string(nil), g.preds[id]...)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[current] || current == id {
+			continue
+		}
+		seen[current] = true
+		stack = append(stack, g.preds[current]...)
+	}
+	return seen
+}
+
+// Downstream returns the step and the steps reachable from it, edges closing loops excepted
+func (g *Graph) Downstream(id string) []string {
+	seen := map[string]bool{}
+	var ids []string
+	stack := []string{id}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if seen[current] {
+			continue
+		}
+		seen[current] = true
+		ids = append(ids, current)
+		for _, to := range g.next[current] {
+			if !g.IsLoop(current, to) {
+				stack = append(stack, to)
+			}
+		}
+	}
+	return ids
+}
+
+// Terminal reports whether a step ends the workflow, having no successors but loops
+func (g *Graph) Terminal(id string) bool {
+	for _, to := range g.next[id] {
+		if !g.IsLoop(id, to) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedNames(m map[string]string) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// stepList reads a step ID or list of step IDs
+func stepList(v interface{}) []string {
+	switch list := v.(type) {
+	case string:
+		if list == "" {
+			return nil
+		}
+		return []string{list}
+	case []interface{}:
+		ids := make([]string, 0, len(list))
+		for _, item := range list {
+			if id, ok := item.(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	case []string:
+		return list
+	}
+	return nil
+}
@@ -98,7 +98,7 @@ const AgentCreate = () => {
           >
             <Select>
               <Select.Option value="single">单 Agent</Select.Option>
-              <Select.Option value="multi">多 Agent</Select.Option>
+              <Select.Option value="multi-agent">多 Agent</Select.Option>
             </Select>
           </Form.Item>
 
@@ -2,7 +2,7 @@ export interface Agent {
   id: string
   name: string
   description?: string
-  type: 'single' | 'multi'
+  type: 'single' | 'workflow' | 'multi-agent'
   model_config?: Record<string, any>
   tools?: string[]
   knowledge_bases?: string[]